@@ -9,11 +9,14 @@ import (
 	"lead_exchange/internal/app"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/lib/logger/handlers/slogpretty"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/tracing"
 
 	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 const (
@@ -28,7 +31,18 @@ func main() {
 
 	log := setupLogger(cfg.Env)
 
-	pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, log)
+	if err != nil {
+		panic(err)
+	}
+
+	poolCfg, err := pgxpool.ParseConfig(cfg.DatabaseURL)
+	if err != nil {
+		panic(err)
+	}
+	poolCfg.ConnConfig.Tracer = tracing.NewQueryTracer()
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 	if err != nil {
 		panic(err)
 	}
@@ -45,17 +59,50 @@ func main() {
 
 	application := app.New(log, cfg.GRPC.Port, pool, cfg.TokenTTL, cfg.Secret, minioClient, cfg.DisableAuth, cfg)
 
+	// Векторы другой модели несравнимы с сохранёнными — не стартуем
+	if err := application.EmbeddingModels.Check(ctx); err != nil {
+		panic(err)
+	}
+
 	go func() {
 		application.GRPCServer.MustRun()
 	}()
 
+	feedCtx, stopFeed := context.WithCancel(ctx)
+	go application.LeadFeed.Run(feedCtx)
+	go application.SavedSearchScheduler.Run(feedCtx)
+	go application.Health.Run(feedCtx, cfg.Health.Interval)
+	if application.ImageAnalysis != nil {
+		go application.ImageAnalysis.Run(feedCtx)
+	}
+	if application.Uploads != nil {
+		go application.Uploads.Run(feedCtx, cfg.Minio.UploadSweepInterval)
+	}
+
+	// Воркеры embedding дорабатывают забранные задания перед остановкой
+	embeddingDone := make(chan struct{})
+	go func() {
+		application.EmbeddingWorker.Run(feedCtx)
+		close(embeddingDone)
+	}()
+
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
 	<-stop
 
+	stopFeed()
+	<-embeddingDone
 	application.GRPCServer.Stop()
+
+	// Дозаписываем накопленные спаны
+	tracingCtx, cancelTracing := context.WithTimeout(ctx, 5*time.Second)
+	defer cancelTracing()
+	if err := shutdownTracing(tracingCtx); err != nil {
+		log.Error("failed to flush traces", sl.Err(err))
+	}
+
 	log.Info("Gracefully stopped")
 }
 
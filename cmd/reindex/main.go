@@ -0,0 +1,173 @@
+// Команда reindex пересчитывает embedding лидов и объектов через ML ReindexBatch.
+// Нужна при смене модели embedding или после простоя ML сервиса.
+//
+//	go run ./cmd/reindex --entity=all --only-missing --since=2025-01-01T00:00:00Z --batch-size=50 --concurrency=2
+//
+// С --shadow (нужен ML_NEXT_BASE_URL) заполняет теневые колонки векторами новой модели,
+// а при полном покрытии сразу переключает поиск на неё.
+//
+// Конфигурация (DATABASE_URL, ML_*) читается из окружения, как у сервера.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository/embedding_model_repository"
+	"lead_exchange/internal/repository/lead_repository"
+	"lead_exchange/internal/repository/property_repository"
+	"lead_exchange/internal/services/embedding"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	entity := flag.String("entity", "all", "entities to reindex: lead, property or all")
+	onlyMissing := flag.Bool("only-missing", false, "reindex only records without embedding")
+	since := flag.String("since", "", "reindex only records updated since this time (RFC3339)")
+	batchSize := flag.Int("batch-size", embedding.DefaultReindexBatchSize, "entities per ReindexBatch request")
+	concurrency := flag.Int("concurrency", embedding.DefaultReindexConcurrency, "concurrent ReindexBatch requests")
+	shadow := flag.Bool("shadow", false, "write embeddings of the next model (ML_NEXT_BASE_URL) into shadow columns")
+	flag.Parse()
+
+	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	opts, err := reindexOptions(*entity, *onlyMissing, *since, *batchSize, *concurrency)
+	opts.Shadow = *shadow
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg := config.MustLoad()
+	if !cfg.ML.Enabled {
+		// Заглушка ML клиента возвращает нулевые векторы и затёрла бы настоящие embedding
+		log.Error("ML service is disabled (ML_ENABLE=false), nothing to reindex with")
+		os.Exit(1)
+	}
+
+	// Ctrl+C останавливает выборку новых пачек; уже записанные embedding остаются
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Error("failed to connect to database", sl.Err(err))
+		os.Exit(1)
+	}
+	defer pool.Close()
+
+	leadRepository := lead_repository.NewLeadRepository(pool, log)
+	propertyRepository := property_repository.NewPropertyRepository(pool, log)
+	mlClient := ml.NewSwitch(ml.NewClient(cfg.ML, log))
+
+	models := embedding.NewModelManager(
+		log,
+		embedding_model_repository.NewEmbeddingModelRepository(pool, log),
+		mlClient,
+		ml.NewNextClient(cfg.ML, log),
+		leadRepository,
+		propertyRepository,
+		cfg.ML,
+	)
+	if err := models.Check(ctx); err != nil {
+		log.Error("embedding model check failed", sl.Err(err))
+		pool.Close()
+		os.Exit(1)
+	}
+	if opts.Shadow && models.Target() == "" {
+		log.Error("--shadow requires an embedding model migration: set ML_NEXT_BASE_URL to the new model")
+		pool.Close()
+		os.Exit(1)
+	}
+
+	reindexer := embedding.NewReindexer(log, mlClient, models, leadRepository, propertyRepository)
+
+	started := time.Now()
+	results, err := reindexer.ReindexAll(ctx, opts, func(p domain.ReindexProgress) {
+		log.Info("reindex progress",
+			slog.String("entity_type", p.EntityType.String()),
+			slog.String("progress", fmt.Sprintf("%d/%d", p.Processed, p.Total)),
+			slog.Int("updated", p.Updated),
+			slog.Int("failed", p.Failed),
+		)
+	})
+
+	failed := 0
+	for _, r := range results {
+		failed += r.Failed
+		log.Info("reindex summary",
+			slog.String("entity_type", r.EntityType.String()),
+			slog.Int("total", r.Total),
+			slog.Int("processed", r.Processed),
+			slog.Int("updated", r.Updated),
+			slog.Int("failed", r.Failed),
+			slog.Bool("done", r.Done),
+		)
+	}
+	log.Info("reindex finished", slog.Duration("elapsed", time.Since(started).Round(time.Second)))
+
+	if err != nil {
+		log.Error("reindex stopped", sl.Err(err))
+		pool.Close()
+		os.Exit(1)
+	}
+	if failed > 0 {
+		pool.Close()
+		os.Exit(1)
+	}
+
+	if opts.Shadow {
+		switched, err := models.Sync(ctx)
+		if err != nil {
+			log.Error("failed to cut over embedding model", sl.Err(err))
+			pool.Close()
+			os.Exit(1)
+		}
+		if !switched {
+			log.Info("shadow coverage is incomplete, server will cut over once it reaches 100%")
+		}
+	}
+}
+
+// reindexOptions разбирает флаги командной строки.
+func reindexOptions(entity string, onlyMissing bool, since string, batchSize, concurrency int) (domain.ReindexOptions, error) {
+	opts := domain.ReindexOptions{
+		OnlyMissing: onlyMissing,
+		BatchSize:   batchSize,
+		Concurrency: concurrency,
+	}
+
+	switch entity {
+	case "all":
+	case domain.EmbeddingEntityLead.String(), domain.EmbeddingEntityProperty.String():
+		opts.EntityType = domain.EmbeddingEntityType(entity)
+	default:
+		return opts, fmt.Errorf("unknown --entity %q: want lead, property or all", entity)
+	}
+
+	if since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return opts, fmt.Errorf("invalid --since: %w", err)
+		}
+		opts.Since = &t
+	}
+
+	if batchSize <= 0 || concurrency <= 0 {
+		return opts, errors.New("--batch-size and --concurrency must be positive")
+	}
+
+	return opts, nil
+}
@@ -214,6 +214,8 @@ curl -X PATCH "http://localhost:8081/v1/properties/{PROPERTY_ID}" \
 3. Для матчинга нужен лид с уже сгенерированным embedding
 4. Коэффициент схожести (similarity) от 0 до 1, где 1 - полное совпадение
 5. Матчинг использует косинусное расстояние через pgvector
-6. requirement для лида передаётся как base64 строка JSON
+6. requirement для лида передаётся как base64 строка JSON; старый формат (roomNumber, preferredPrice,
+   district) конвертируется в LeadRequirement. Вместо него можно передать structured_requirement:
+   {"budget_max": 8000000, "rooms_min": 3, "rooms_max": 3, "districts": ["Центральный"]}
 
 EOF
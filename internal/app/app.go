@@ -1,20 +1,36 @@
 package app
 
 import (
+	"lead_exchange/internal/authz"
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/health"
 	minio "lead_exchange/internal/lib/minio/core"
 	"lead_exchange/internal/lib/ml"
 	"lead_exchange/internal/lib/llm"
 	"lead_exchange/internal/lib/metrics"
 	"lead_exchange/internal/lib/reranker"
 	"lead_exchange/internal/lib/vision"
+	"lead_exchange/internal/middleware"
 	"lead_exchange/internal/repository/deal_repository"
+	"lead_exchange/internal/repository/embedding_job_repository"
+	"lead_exchange/internal/repository/embedding_model_repository"
 	"lead_exchange/internal/repository/lead_repository"
+	"lead_exchange/internal/repository/notification_repository"
+	"lead_exchange/internal/repository/property_image_repository"
 	"lead_exchange/internal/repository/property_repository"
+	"lead_exchange/internal/repository/rate_limit_repository"
+	"lead_exchange/internal/repository/saved_search_repository"
+	"lead_exchange/internal/repository/upload_repository"
 	"lead_exchange/internal/services/clarification"
 	"lead_exchange/internal/services/deal"
+	"lead_exchange/internal/services/embedding"
+	"lead_exchange/internal/services/imageanalysis"
 	"lead_exchange/internal/services/lead"
+	"lead_exchange/internal/services/notification"
 	"lead_exchange/internal/services/property"
+	"lead_exchange/internal/services/propertyimage"
+	"lead_exchange/internal/services/savedsearch"
+	"lead_exchange/internal/services/upload"
 	"lead_exchange/internal/services/weights"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -34,6 +50,22 @@ type App struct {
 	RerankerClient reranker.Client
 	VisionClient   vision.Client
 	AIMetrics      *metrics.AIMetrics
+	// LeadFeed — лента событий лидов, запускается через Run
+	LeadFeed *lead.Feed
+	// SavedSearchScheduler — планировщик сохранённых поисков, запускается через Run
+	SavedSearchScheduler *savedsearch.Scheduler
+	// EmbeddingWorker — пул воркеров outbox embedding_jobs, запускается через Run
+	EmbeddingWorker *embedding.Service
+	// EmbeddingModels — проверка модели embedding на старте (Check) и миграция на новую модель
+	EmbeddingModels *embedding.ModelManager
+	// Health — проверки зависимостей; Run периодически обновляет статус grpc.health.v1
+	Health *health.Checker
+	// ImageAnalysis — воркер анализа больших галерей, запускается через Run;
+	// nil, если выключены MinIO или CV API
+	ImageAnalysis *imageanalysis.Service
+	// Uploads — загрузка файлов; Run удаляет неподтверждённые загрузки с истёкшей ссылкой.
+	// nil, если выключен MinIO
+	Uploads *upload.Service
 }
 
 func New(
@@ -44,9 +76,16 @@ func New(
 	leadRepository := lead_repository.NewLeadRepository(pool, log)
 	dealRepository := deal_repository.NewDealRepository(pool, log)
 	propertyRepository := property_repository.NewPropertyRepository(pool, log)
+	savedSearchRepository := saved_search_repository.NewSavedSearchRepository(pool, log)
+	notificationRepository := notification_repository.NewNotificationRepository(pool, log)
+	embeddingJobRepository := embedding_job_repository.NewEmbeddingJobRepository(pool, log)
+	embeddingModelRepository := embedding_model_repository.NewEmbeddingModelRepository(pool, log)
+	propertyImageRepository := property_image_repository.NewPropertyImageRepository(pool, log)
+	uploadRepository := upload_repository.NewUploadRepository(pool, log)
 
-	// Создаём ML клиент (embeddings)
-	mlClient := ml.NewClient(cfg.ML, log)
+	// Создаём ML клиент (embeddings). Через Switch сервисы переходят на новую модель после переключения
+	mlClient := ml.NewSwitch(ml.NewClient(cfg.ML, log))
+	nextMLClient := ml.NewNextClient(cfg.ML, log)
 
 	// Создаём AI-клиенты
 	llmClient := llm.NewClient(cfg.LLM, log)
@@ -56,6 +95,15 @@ func New(
 	// Создаём AI-метрики
 	aiMetrics := metrics.GetAIMetrics(log)
 
+	// Метрики Prometheus: RPC, пул соединений, AI-сервисы, лиды и сделки по статусам
+	prometheusMetrics := metrics.NewPrometheus()
+	prometheusMetrics.MustRegister(
+		aiMetrics,
+		metrics.NewPoolCollector(pool),
+		metrics.NewStatusCollector("leads", "Лиды по статусам.", leadRepository.CountByStatus, log),
+		metrics.NewStatusCollector("deals", "Сделки по статусам.", dealRepository.CountByStatus, log),
+	)
+
 	// Логируем статус AI-сервисов
 	log.Info("AI services initialized",
 		slog.Bool("llm_enabled", llmClient.IsEnabled()),
@@ -71,8 +119,10 @@ func New(
 	// Создаём агента для уточняющих вопросов (использует LLM)
 	clarificationAgent := clarification.NewAgent(log, llmClient, weightsAnalyzer)
 
-	userService := user.New(log, userRepository, tokenTTL, secret)
-	leadService := lead.New(log, leadRepository, mlClient)
+	userService := user.NewWithLoginProtection(log, userRepository, tokenTTL, cfg.RefreshTokenTTL, secret, cfg.Login)
+	// Лента событий лидов (LISTEN/NOTIFY) для SubscribeLeads
+	leadFeed := lead.NewFeed(log, leadRepository, leadRepository)
+	leadService := lead.NewWithFeed(log, leadRepository, mlClient, leadFeed)
 	dealService := deal.New(log, dealRepository)
 
 	// Создаём property service с поддержкой расширенного поиска
@@ -85,6 +135,67 @@ func New(
 		leadService,
 		cfg.Search,
 	)
+	// gRPC API работает с объектами через проверки доступа
+	authzPropertyService := authz.NewPropertyService(propertyService)
+
+	// Сохранённые поиски перезапускаются планировщиком без пользователя в контексте,
+	// поэтому используют сервис объектов без authz-декоратора и проверяют видимость сами
+	savedSearchService := savedsearch.New(log, savedSearchRepository, propertyService)
+	savedSearchScheduler := savedsearch.NewScheduler(log, savedSearchService, cfg.SavedSearch)
+	notificationService := notification.New(log, notificationRepository)
+	// Embedding генерируется воркерами по заданиям, которые репозитории ставят вместе с изменением сущности
+	embeddingModels := embedding.NewModelManager(
+		log, embeddingModelRepository, mlClient, nextMLClient, leadRepository, propertyRepository, cfg.ML,
+	)
+	reindexer := embedding.NewReindexer(log, mlClient, embeddingModels, leadRepository, propertyRepository)
+	embeddingService := embedding.New(
+		log, embeddingJobRepository, leadService, propertyService, reindexer, embeddingModels, cfg.EmbeddingWorker,
+	)
+
+	// Проверки зависимостей: Postgres, миграции и MinIO критичны, AI-провайдеры — нет
+	healthChecks := []health.Check{
+		health.PingCheck("postgres", true, pool),
+		health.MigrationsCheck(pool, cfg.Health.MigrationsDir),
+	}
+	if minioClient != nil {
+		healthChecks = append(healthChecks, health.PingCheck("minio", true, minioClient))
+	}
+	if cfg.Health.CheckAI && cfg.ML.Enabled {
+		healthChecks = append(healthChecks, health.PingCheck("ml", false, mlClient))
+	}
+	if cfg.Health.CheckAI && cfg.LLM.Enabled {
+		healthChecks = append(healthChecks, health.PingCheck("llm", false, llmClient))
+	}
+	healthChecker := health.NewChecker(log, cfg.Health.Timeout, healthChecks...)
+
+	grpcOpts := []grpcapp.Option{
+		grpcapp.WithSavedSearchService(savedSearchService),
+		grpcapp.WithNotificationService(notificationService),
+		grpcapp.WithEmbeddingService(embeddingService),
+		grpcapp.WithMetrics(prometheusMetrics),
+		grpcapp.WithHealth(healthChecker),
+	}
+	// Галерея фотографий объектов хранит файлы в MinIO
+	var imageAnalysisService *imageanalysis.Service
+	var uploadService *upload.Service
+	if minioClient != nil {
+		propertyImageService := propertyimage.New(log, propertyImageRepository, uploadRepository, minioClient, cfg.Minio.PresignTTL)
+		grpcOpts = append(grpcOpts, grpcapp.WithPropertyImageService(
+			authz.NewPropertyImageService(propertyImageService, authzPropertyService),
+		))
+		uploadService = upload.New(log, uploadRepository, minioClient, cfg.Minio)
+		grpcOpts = append(grpcOpts, grpcapp.WithUploadService(uploadService))
+
+		if cfg.Vision.Enabled {
+			imageAnalysisService = imageanalysis.New(log, propertyImageRepository, minioClient, visionClient, cfg.Vision)
+			grpcOpts = append(grpcOpts, grpcapp.WithImageAnalysisService(
+				authz.NewImageAnalysisService(imageAnalysisService, authzPropertyService),
+			))
+		}
+	}
+	if cfg.RateLimit.Enabled {
+		grpcOpts = append(grpcOpts, rateLimitOption(log, cfg.RateLimit, pool))
+	}
 
 	// Создаём gRPC приложение с AI-клиентами
 	grpcApp := grpcapp.NewWithAI(
@@ -92,9 +203,9 @@ func New(
 		userService,
 		userService,
 		minioClient,
-		leadService,
+		authz.NewLeadService(leadService),
 		dealService,
-		propertyService,
+		authzPropertyService,
 		clarificationAgent,
 		weightsAnalyzer,
 		llmClient,
@@ -102,13 +213,43 @@ func New(
 		grpcPort,
 		secret,
 		disableAuth,
+		grpcOpts...,
 	)
 
 	return &App{
-		GRPCServer:     grpcApp,
-		LLMClient:      llmClient,
-		RerankerClient: rerankerClient,
-		VisionClient:   visionClient,
-		AIMetrics:      aiMetrics,
+		GRPCServer:           grpcApp,
+		LeadFeed:             leadFeed,
+		SavedSearchScheduler: savedSearchScheduler,
+		EmbeddingWorker:      embeddingService,
+		EmbeddingModels:      embeddingModels,
+		Health:               healthChecker,
+		ImageAnalysis:        imageAnalysisService,
+		Uploads:              uploadService,
+		LLMClient:            llmClient,
+		RerankerClient:       rerankerClient,
+		VisionClient:         visionClient,
+		AIMetrics:            aiMetrics,
 	}
 }
+
+// rateLimitOption собирает бюджеты из middleware.MethodRateLimits с переопределениями
+// из конфигурации и выбирает хранилище счётчиков. Некорректная конфигурация — panic на старте.
+func rateLimitOption(log *slog.Logger, cfg config.RateLimitConfig, pool *pgxpool.Pool) grpcapp.Option {
+	limits, err := middleware.ParseRateLimits(middleware.MethodRateLimits, cfg.Methods)
+	if err != nil {
+		panic("invalid RATE_LIMIT_METHODS: " + err.Error())
+	}
+
+	var store middleware.RateLimitStore
+	switch cfg.Store {
+	case "memory":
+		store = middleware.NewMemoryRateLimitStore()
+	case "postgres":
+		store = rate_limit_repository.NewRateLimitRepository(pool, log)
+	default:
+		panic("unknown RATE_LIMIT_STORE: " + cfg.Store)
+	}
+
+	log.Info("rate limit enabled", slog.String("store", cfg.Store), slog.Int("methods", len(limits)))
+	return grpcapp.WithRateLimit(store, limits)
+}
@@ -7,16 +7,22 @@ import (
 	"fmt"
 	"lead_exchange/internal/grpc/authgrpc"
 	"lead_exchange/internal/grpc/dealgrpc"
+	"lead_exchange/internal/grpc/embeddinggrpc"
 	"lead_exchange/internal/grpc/filegrpc"
 	"lead_exchange/internal/grpc/leadgrpc"
+	"lead_exchange/internal/grpc/notificationgrpc"
 	"lead_exchange/internal/grpc/propertygrpc"
+	"lead_exchange/internal/grpc/savedsearchgrpc"
 	"lead_exchange/internal/grpc/usergrpc"
+	"lead_exchange/internal/lib/health"
+	"lead_exchange/internal/lib/metrics"
 	minio "lead_exchange/internal/lib/minio/core"
 	"lead_exchange/internal/middleware"
 	"log/slog"
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	pb "lead_exchange/pkg"
@@ -28,9 +34,12 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
@@ -38,6 +47,8 @@ type App struct {
 	log        *slog.Logger
 	gRPCServer *grpc.Server
 	port       int
+	metrics    *metrics.Prometheus
+	health     *health.Checker
 }
 
 // ClarificationAgent интерфейс для агента уточнения.
@@ -46,6 +57,86 @@ type ClarificationAgent = leadgrpc.ClarificationAgent
 // WeightsAnalyzer интерфейс для анализатора весов.
 type WeightsAnalyzer = leadgrpc.WeightsAnalyzer
 
+// Option — опциональный сервис gRPC приложения.
+type Option func(*options)
+
+type options struct {
+	savedSearchSvc  savedsearchgrpc.SavedSearchService
+	notificationSvc notificationgrpc.NotificationService
+	embeddingSvc    embeddinggrpc.EmbeddingService
+	metrics         *metrics.Prometheus
+	health          *health.Checker
+	rateLimitStore  middleware.RateLimitStore
+	rateLimits      map[string]middleware.RateLimit
+	imageSvc        propertygrpc.ImageService
+	imageAnalysis   propertygrpc.ImageAnalysisService
+	uploadSvc       filegrpc.UploadService
+}
+
+// WithSavedSearchService регистрирует SavedSearchService.
+func WithSavedSearchService(svc savedsearchgrpc.SavedSearchService) Option {
+	return func(o *options) {
+		o.savedSearchSvc = svc
+	}
+}
+
+// WithNotificationService регистрирует NotificationService.
+func WithNotificationService(svc notificationgrpc.NotificationService) Option {
+	return func(o *options) {
+		o.notificationSvc = svc
+	}
+}
+
+// WithEmbeddingService регистрирует EmbeddingService (администрирование outbox embedding).
+func WithEmbeddingService(svc embeddinggrpc.EmbeddingService) Option {
+	return func(o *options) {
+		o.embeddingSvc = svc
+	}
+}
+
+// WithMetrics включает метрики RPC и отдаёт реестр на /metrics HTTP-сервера.
+func WithMetrics(p *metrics.Prometheus) Option {
+	return func(o *options) {
+		o.metrics = p
+	}
+}
+
+// WithHealth регистрирует grpc.health.v1, отдаёт /readyz и включает проверку зависимостей в HealthCheck.
+func WithHealth(c *health.Checker) Option {
+	return func(o *options) {
+		o.health = c
+	}
+}
+
+// WithRateLimit ограничивает частоту вызовов методов из limits; счётчики хранятся в store.
+func WithRateLimit(store middleware.RateLimitStore, limits map[string]middleware.RateLimit) Option {
+	return func(o *options) {
+		o.rateLimitStore = store
+		o.rateLimits = limits
+	}
+}
+
+// WithPropertyImageService включает галерею фотографий объектов в PropertyService.
+func WithPropertyImageService(svc propertygrpc.ImageService) Option {
+	return func(o *options) {
+		o.imageSvc = svc
+	}
+}
+
+// WithImageAnalysisService включает анализ фотографий галереи в PropertyService.
+func WithImageAnalysisService(svc propertygrpc.ImageAnalysisService) Option {
+	return func(o *options) {
+		o.imageAnalysis = svc
+	}
+}
+
+// WithUploadService включает загрузку файлов по presigned-ссылке и потоком.
+func WithUploadService(svc filegrpc.UploadService) Option {
+	return func(o *options) {
+		o.uploadSvc = svc
+	}
+}
+
 // New создаёт gRPC + HTTP (Gateway) сервер с Auth, User, File, Lead, Deal и Property сервисами.
 func New(
 	log *slog.Logger,
@@ -58,8 +149,9 @@ func New(
 	port int,
 	secret string,
 	disableAuth bool,
+	opts ...Option,
 ) *App {
-	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, nil, nil, nil, nil, port, secret, disableAuth)
+	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, nil, nil, nil, nil, port, secret, disableAuth, opts...)
 }
 
 // NewWithAI создаёт gRPC сервер с поддержкой AI-функций (LLM, Vision).
@@ -78,8 +170,9 @@ func NewWithAI(
 	port int,
 	secret string,
 	disableAuth bool,
+	opts ...Option,
 ) *App {
-	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, llmClient, visionClient, clarificationAgent, weightsAnalyzer, port, secret, disableAuth)
+	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, llmClient, visionClient, clarificationAgent, weightsAnalyzer, port, secret, disableAuth, opts...)
 }
 
 // newApp — внутренняя функция для создания приложения.
@@ -98,7 +191,13 @@ func newApp(
 	port int,
 	secret string,
 	disableAuth bool,
+	opts ...Option,
 ) *App {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(logging.PayloadReceived, logging.PayloadSent),
 	}
@@ -109,28 +208,62 @@ func newApp(
 		}),
 	}
 
-	interceptors := []grpc.UnaryServerInterceptor{
+	var interceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+
+	// Метрики — первыми, чтобы учитывать и запросы, отклонённые авторизацией или завершившиеся паникой
+	if o.metrics != nil {
+		interceptors = append(interceptors, middleware.MetricsUnaryInterceptor(o.metrics))
+		streamInterceptors = append(streamInterceptors, middleware.MetricsStreamInterceptor(o.metrics))
+	}
+
+	interceptors = append(interceptors,
 		recovery.UnaryServerInterceptor(recoveryOpts...),
 		logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
-	}
+	)
+	streamInterceptors = append(streamInterceptors,
+		recovery.StreamServerInterceptor(recoveryOpts...),
+		logging.StreamServerInterceptor(InterceptorLogger(log), loggingOpts...),
+	)
 
 	// Добавляем JWT interceptor только если auth не отключен
 	if !disableAuth {
-		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, false))
+		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, false, authSvc))
+		streamInterceptors = append(streamInterceptors, middleware.JWTStreamInterceptor(secret, false, authSvc))
 	} else {
 		log.Warn("Authentication is DISABLED - all requests will use test user ID")
 		// Когда auth отключен, используем interceptor который всегда пропускает с тестовым user ID
-		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, true))
+		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, true, nil))
+		streamInterceptors = append(streamInterceptors, middleware.JWTStreamInterceptor(secret, true, nil))
 	}
 
-	gRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	// Проверка ролей по карте методов — после JWT, когда роль уже в контексте
+	interceptors = append(interceptors, middleware.RoleUnaryInterceptor(middleware.MethodRoles))
+	streamInterceptors = append(streamInterceptors, middleware.RoleStreamInterceptor(middleware.MethodRoles))
+
+	// Rate limit — после JWT: аутентифицированные запросы считаются по пользователю, остальные по IP
+	if o.rateLimitStore != nil {
+		interceptors = append(interceptors, middleware.RateLimitUnaryInterceptor(o.rateLimitStore, o.rateLimits, log))
+	}
+
+	gRPCServer := grpc.NewServer(
+		// Спан на каждый RPC; контекст трейса приходит из метаданных (в т.ч. от gateway)
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
 	// Регистрируем все gRPC сервера
-	authgrpc.RegisterAuthServerGRPC(gRPCServer, authSvc)
+	var authOpts []authgrpc.ServerOption
+	if o.health != nil {
+		healthpb.RegisterHealthServer(gRPCServer, o.health.Server())
+		authOpts = append(authOpts, authgrpc.WithHealthReporter(o.health))
+	}
+	authgrpc.RegisterAuthServerGRPC(gRPCServer, authSvc, authOpts...)
 	usergrpc.RegisterUserServerGRPC(gRPCServer, userSvc)
 
 	// Регистрируем LeadService с опциональными AI-сервисами
-	leadOpts := []leadgrpc.ServerOption{}
+	leadOpts := []leadgrpc.ServerOption{leadgrpc.WithLogger(log)}
 	if clarificationAgent != nil {
 		if ca, ok := clarificationAgent.(leadgrpc.ClarificationAgent); ok {
 			leadOpts = append(leadOpts, leadgrpc.WithClarificationAgent(ca))
@@ -141,7 +274,10 @@ func newApp(
 			leadOpts = append(leadOpts, leadgrpc.WithWeightsAnalyzer(wa))
 		}
 	}
-	leadgrpc.RegisterLeadServerGRPC(gRPCServer, leadSvc, leadOpts...)
+	if propertySvc != nil {
+		leadOpts = append(leadOpts, leadgrpc.WithPropertyService(propertySvc))
+	}
+	leadgrpc.RegisterLeadServerGRPC(gRPCServer, leadSvc, dealSvc, leadOpts...)
 
 	dealgrpc.RegisterDealServerGRPC(gRPCServer, dealSvc, userSvc)
 
@@ -157,16 +293,38 @@ func newApp(
 			propertyOpts = append(propertyOpts, propertygrpc.WithVisionClient(vc))
 		}
 	}
+	if o.imageSvc != nil {
+		propertyOpts = append(propertyOpts, propertygrpc.WithImageService(o.imageSvc))
+	}
+	if o.imageAnalysis != nil {
+		propertyOpts = append(propertyOpts, propertygrpc.WithImageAnalysisService(o.imageAnalysis))
+	}
 	propertygrpc.RegisterPropertyServerGRPC(gRPCServer, propertySvc, propertyOpts...)
 
 	if minioClient != nil {
-		filegrpc.RegisterFileServerGRPC(gRPCServer, minioClient)
+		var fileOpts []filegrpc.ServerOption
+		if o.uploadSvc != nil {
+			fileOpts = append(fileOpts, filegrpc.WithUploadService(o.uploadSvc))
+		}
+		filegrpc.RegisterFileServerGRPC(gRPCServer, fileOpts...)
+	}
+
+	if o.savedSearchSvc != nil {
+		savedsearchgrpc.RegisterSavedSearchServerGRPC(gRPCServer, o.savedSearchSvc, leadSvc)
+	}
+	if o.notificationSvc != nil {
+		notificationgrpc.RegisterNotificationServerGRPC(gRPCServer, o.notificationSvc)
+	}
+	if o.embeddingSvc != nil {
+		embeddinggrpc.RegisterEmbeddingServerGRPC(gRPCServer, o.embeddingSvc)
 	}
 
 	return &App{
 		log:        log,
 		gRPCServer: gRPCServer,
 		port:       port,
+		metrics:    o.metrics,
+		health:     o.health,
 	}
 }
 
@@ -262,7 +420,11 @@ func (a *App) Run() error {
 
 	// === HTTP Gateway ===
 	gwMux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// Gateway передаёт контекст трейса HTTP-запроса в gRPC-вызов
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
 
 	for _, register := range []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
 		pb.RegisterAuthServiceHandlerFromEndpoint,
@@ -271,6 +433,9 @@ func (a *App) Run() error {
 		pb.RegisterLeadServiceHandlerFromEndpoint,
 		pb.RegisterDealServiceHandlerFromEndpoint,
 		pb.RegisterPropertyServiceHandlerFromEndpoint,
+		pb.RegisterSavedSearchServiceHandlerFromEndpoint,
+		pb.RegisterNotificationServiceHandlerFromEndpoint,
+		pb.RegisterEmbeddingServiceHandlerFromEndpoint,
 	} {
 		if err := register(ctx, gwMux, fmt.Sprintf("localhost:%d", a.port), opts); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
@@ -290,6 +455,9 @@ func (a *App) Run() error {
 		"pkg/lead.swagger.json",
 		"pkg/deal.swagger.json",
 		"pkg/property.swagger.json",
+		"pkg/saved_search.swagger.json",
+		"pkg/notification.swagger.json",
+		"pkg/embedding.swagger.json",
 	}
 
 	// Объединённый swagger.json со всеми сервисами
@@ -311,6 +479,9 @@ func (a *App) Run() error {
 		"/swagger/lead/doc.json":      "pkg/lead.swagger.json",
 		"/swagger/deal/doc.json":      "pkg/deal.swagger.json",
 		"/swagger/property/doc.json":  "pkg/property.swagger.json",
+		"/swagger/saved-search/doc.json": "pkg/saved_search.swagger.json",
+		"/swagger/notification/doc.json": "pkg/notification.swagger.json",
+		"/swagger/embedding/doc.json": "pkg/embedding.swagger.json",
 	}
 
 	for route, path := range swaggerFileMap {
@@ -334,9 +505,20 @@ func (a *App) Run() error {
 
 	httpMux.Handle("/swagger/", swaggerMux)
 
+	// === Пробы оркестратора ===
+	httpMux.Handle("/healthz", health.LivenessHandler())
+	if a.health != nil {
+		httpMux.Handle("/readyz", a.health.ReadinessHandler())
+	}
+
+	// === Prometheus ===
+	if a.metrics != nil {
+		httpMux.Handle("/metrics", a.metrics.Handler())
+	}
+
 	httpServer := &http.Server{
 		Addr:         ":8081",
-		Handler:      cors.AllowAll().Handler(httpMux),
+		Handler:      cors.AllowAll().Handler(tracedHandler(httpMux)),
 		TLSNextProto: make(map[string]func(*http.Server, *tls.Conn, http.Handler)),
 	}
 
@@ -377,6 +559,19 @@ func (a *App) Run() error {
 	return shutdownErr
 }
 
+// tracedHandler открывает серверный спан на HTTP-запрос; служебные эндпоинты не трассируются.
+func tracedHandler(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, "gateway",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			switch r.URL.Path {
+			case "/metrics", "/healthz", "/readyz":
+				return false
+			}
+			return !strings.HasPrefix(r.URL.Path, "/swagger/")
+		}),
+	)
+}
+
 func (a *App) Stop() {
 	a.log.Info("stopping gRPC server", slog.Int("port", a.port))
 	a.gRPCServer.GracefulStop()
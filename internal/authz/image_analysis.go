@@ -0,0 +1,42 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/imageanalysis"
+
+	"github.com/google/uuid"
+)
+
+// ImageAnalysisService — декоратор imageanalysis.Service: запускать анализ могут владелец объекта
+// и администратор, а результаты видят те, кому виден объект.
+type ImageAnalysisService struct {
+	*imageanalysis.Service
+	properties *PropertyService
+}
+
+// NewImageAnalysisService оборачивает imageanalysis.Service проверками доступа к объекту.
+func NewImageAnalysisService(svc *imageanalysis.Service, properties *PropertyService) *ImageAnalysisService {
+	return &ImageAnalysisService{Service: svc, properties: properties}
+}
+
+// AnalyzeImages — запустить анализ может владелец объекта или администратор.
+func (s *ImageAnalysisService) AnalyzeImages(ctx context.Context, propertyID uuid.UUID, force bool) (domain.PropertyImageAnalysis, error) {
+	const op = "authz.ImageAnalysisService.AnalyzeImages"
+
+	if err := s.properties.checkWrite(ctx, propertyID, domain.PropertyFilter{}); err != nil {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.AnalyzeImages(ctx, propertyID, force)
+}
+
+// GetAnalysis — результаты доступны, если виден сам объект.
+func (s *ImageAnalysisService) GetAnalysis(ctx context.Context, propertyID uuid.UUID) (domain.PropertyImageAnalysis, error) {
+	if _, err := s.properties.GetProperty(ctx, propertyID); err != nil {
+		return domain.PropertyImageAnalysis{}, err
+	}
+
+	return s.Service.GetAnalysis(ctx, propertyID)
+}
@@ -0,0 +1,133 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/lead"
+
+	"github.com/google/uuid"
+)
+
+// LeadService — декоратор lead.Service, применяющий политики доступа к лидам.
+// Методы, не требующие проверок, доступны напрямую через встроенный сервис.
+type LeadService struct {
+	*lead.Service
+}
+
+// NewLeadService оборачивает lead.Service проверками доступа.
+func NewLeadService(svc *lead.Service) *LeadService {
+	return &LeadService{Service: svc}
+}
+
+// GetLead — возвращает лид, если он виден пользователю; чужой NEW-лид выглядит как несуществующий.
+func (s *LeadService) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+	const op = "authz.LeadService.GetLead"
+
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return domain.Lead{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	l, err := s.Service.GetLead(ctx, id)
+	if err != nil {
+		return domain.Lead{}, err
+	}
+
+	if !CanViewLead(sub, l) {
+		return domain.Lead{}, fmt.Errorf("%s: %w", op, lead.ErrLeadNotFound)
+	}
+
+	return l, nil
+}
+
+// ListLeads — возвращает только лиды, видимые пользователю.
+func (s *LeadService) ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
+	const op = "authz.LeadService.ListLeads"
+
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !sub.IsAdmin() {
+		filter.VisibleToUserID = &sub.UserID
+	}
+	return s.Service.ListLeads(ctx, filter)
+}
+
+// MatchLeads — подбирает для объекта только лиды, видимые пользователю.
+func (s *LeadService) MatchLeads(
+	ctx context.Context,
+	property domain.Property,
+	filter domain.LeadFilter,
+	limit int,
+	weights *domain.MatchWeights,
+) ([]domain.MatchedLead, error) {
+	const op = "authz.LeadService.MatchLeads"
+
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !sub.IsAdmin() {
+		filter.VisibleToUserID = &sub.UserID
+	}
+	return s.Service.MatchLeads(ctx, property, filter, limit, weights)
+}
+
+// SubscribeLeads — поток только тех лидов, которые видны пользователю.
+func (s *LeadService) SubscribeLeads(ctx context.Context, sub domain.LeadSubscription) (<-chan domain.Lead, error) {
+	const op = "authz.LeadService.SubscribeLeads"
+
+	subject, err := subjectFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !subject.IsAdmin() {
+		sub.Filter.VisibleToUserID = &subject.UserID
+	}
+	return s.Service.SubscribeLeads(ctx, sub)
+}
+
+// UpdateLead — обновляет лид, если пользователь владелец или администратор.
+func (s *LeadService) UpdateLead(ctx context.Context, id uuid.UUID, update domain.LeadFilter) (domain.Lead, error) {
+	const op = "authz.LeadService.UpdateLead"
+
+	if err := s.checkWrite(ctx, id, update); err != nil {
+		return domain.Lead{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.UpdateLead(ctx, id, update)
+}
+
+// ReindexLead — переиндексация доступна владельцу и администратору.
+func (s *LeadService) ReindexLead(ctx context.Context, id uuid.UUID) error {
+	const op = "authz.LeadService.ReindexLead"
+
+	if err := s.checkWrite(ctx, id, domain.LeadFilter{}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.ReindexLead(ctx, id)
+}
+
+func (s *LeadService) checkWrite(ctx context.Context, id uuid.UUID, update domain.LeadFilter) error {
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	l, err := s.Service.GetLead(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !CanViewLead(sub, l) {
+		return lead.ErrLeadNotFound
+	}
+
+	return CheckLeadUpdate(sub, l, update)
+}
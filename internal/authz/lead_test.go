@@ -0,0 +1,336 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// mockLeadRepository хранит лиды в памяти и запоминает, что до него дошло от декоратора.
+type mockLeadRepository struct {
+	leads       map[uuid.UUID]domain.Lead
+	updated     []uuid.UUID
+	reindexed   []uuid.UUID
+	listFilter  *domain.LeadFilter
+	matchFilter *domain.LeadFilter
+}
+
+func (m *mockLeadRepository) CreateLead(ctx context.Context, l domain.Lead) (uuid.UUID, error) {
+	return uuid.New(), nil
+}
+func (m *mockLeadRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+	l, ok := m.leads[id]
+	if !ok {
+		return domain.Lead{}, repository.ErrLeadNotFound
+	}
+	return l, nil
+}
+func (m *mockLeadRepository) UpdateLead(ctx context.Context, id uuid.UUID, update domain.LeadFilter) error {
+	m.updated = append(m.updated, id)
+	return nil
+}
+func (m *mockLeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
+	m.listFilter = &filter
+	return &domain.PaginatedResult[domain.Lead]{}, nil
+}
+func (m *mockLeadRepository) UpdateEmbedding(ctx context.Context, id uuid.UUID, embedding []float32) error {
+	m.reindexed = append(m.reindexed, id)
+	return nil
+}
+func (m *mockLeadRepository) CreateContactReveal(ctx context.Context, reveal domain.LeadContactReveal) error {
+	return nil
+}
+func (m *mockLeadRepository) MatchLeads(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.LeadHardFilters, limit int) ([]domain.MatchedLead, error) {
+	m.matchFilter = &filter
+	return nil, nil
+}
+
+// mockMLClient отвечает на Reindex фиксированным вектором.
+type mockMLClient struct{}
+
+func (mockMLClient) PrepareAndEmbed(ctx context.Context, req ml.PrepareAndEmbedRequest) (*ml.PrepareAndEmbedResponse, error) {
+	return &ml.PrepareAndEmbedResponse{Embedding: []float64{1, 0}}, nil
+}
+func (mockMLClient) Reindex(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error) {
+	return &ml.ReindexResponse{Embedding: []float64{1, 0}}, nil
+}
+func (mockMLClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+	return &ml.ReindexBatchResponse{}, nil
+}
+func (mockMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
+	return &ml.ModelInfo{}, nil
+}
+func (mockMLClient) Ping(ctx context.Context) error { return nil }
+
+// fakeLeadEventSource передаёт события ленты из канала вместо Postgres NOTIFY.
+type fakeLeadEventSource struct {
+	events chan uuid.UUID
+}
+
+func (f *fakeLeadEventSource) ListenLeadEvents(ctx context.Context, handle func(leadID uuid.UUID)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case id := <-f.events:
+			handle(id)
+		}
+	}
+}
+
+// testSubjects — владелец, посторонний пользователь и администратор.
+type testSubjects struct {
+	owner, stranger, admin Subject
+}
+
+func newTestSubjects() testSubjects {
+	return testSubjects{
+		owner:    Subject{UserID: uuid.New(), Role: domain.UserRoleUser},
+		stranger: Subject{UserID: uuid.New(), Role: domain.UserRoleUser},
+		admin:    Subject{UserID: uuid.New(), Role: domain.UserRoleAdmin},
+	}
+}
+
+// subjectContext — контекст запроса от имени sub, как его собирает JWT middleware.
+func subjectContext(sub Subject) context.Context {
+	return middleware.WithUser(context.Background(), sub.UserID, sub.Role, domain.UserStatusActive)
+}
+
+func newTestLeadService(leads ...domain.Lead) (*LeadService, *mockLeadRepository) {
+	repo := &mockLeadRepository{leads: map[uuid.UUID]domain.Lead{}}
+	for _, l := range leads {
+		repo.leads[l.ID] = l
+	}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewLeadService(lead.New(log, repo, mockMLClient{})), repo
+}
+
+func TestLeadService_GetLead(t *testing.T) {
+	subs := newTestSubjects()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		status  domain.LeadStatus
+		wantErr error
+	}{
+		{"owner gets new", subjectContext(subs.owner), domain.LeadStatusNew, nil},
+		{"stranger does not get new", subjectContext(subs.stranger), domain.LeadStatusNew, lead.ErrLeadNotFound},
+		{"stranger gets published", subjectContext(subs.stranger), domain.LeadStatusPublished, nil},
+		{"admin gets new", subjectContext(subs.admin), domain.LeadStatusNew, nil},
+		{"anonymous is denied", context.Background(), domain.LeadStatusPublished, ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := domain.Lead{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: tt.status}
+			svc, _ := newTestLeadService(l)
+
+			got, err := svc.GetLead(tt.ctx, l.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetLead() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.ID != l.ID {
+				t.Errorf("GetLead() = %s, want %s", got.ID, l.ID)
+			}
+		})
+	}
+}
+
+func TestLeadService_UpdateLead(t *testing.T) {
+	subs := newTestSubjects()
+	deleted := domain.LeadStatusDeleted
+
+	tests := []struct {
+		name    string
+		sub     Subject
+		status  domain.LeadStatus
+		update  domain.LeadFilter
+		wantErr error
+	}{
+		{"owner updates", subs.owner, domain.LeadStatusNew, domain.LeadFilter{}, nil},
+		{"owner cannot delete", subs.owner, domain.LeadStatusPublished, domain.LeadFilter{Status: &deleted}, ErrDeleteRequiresAdmin},
+		{"stranger cannot update published", subs.stranger, domain.LeadStatusPublished, domain.LeadFilter{}, ErrForbidden},
+		{"stranger does not see new", subs.stranger, domain.LeadStatusNew, domain.LeadFilter{}, lead.ErrLeadNotFound},
+		{"admin updates foreign lead", subs.admin, domain.LeadStatusNew, domain.LeadFilter{}, nil},
+		{"admin deletes", subs.admin, domain.LeadStatusPublished, domain.LeadFilter{Status: &deleted}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := domain.Lead{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: tt.status}
+			svc, repo := newTestLeadService(l)
+
+			_, err := svc.UpdateLead(subjectContext(tt.sub), l.ID, tt.update)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateLead() error = %v, want %v", err, tt.wantErr)
+			}
+			if updated := len(repo.updated) > 0; updated != (tt.wantErr == nil) {
+				t.Errorf("repository updated = %v, want %v", updated, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestLeadService_ReindexLead(t *testing.T) {
+	subs := newTestSubjects()
+
+	tests := []struct {
+		name    string
+		sub     Subject
+		wantErr error
+	}{
+		{"owner reindexes", subs.owner, nil},
+		{"stranger cannot reindex", subs.stranger, ErrForbidden},
+		{"admin reindexes foreign lead", subs.admin, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := domain.Lead{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: domain.LeadStatusPublished}
+			svc, repo := newTestLeadService(l)
+
+			err := svc.ReindexLead(subjectContext(tt.sub), l.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReindexLead() error = %v, want %v", err, tt.wantErr)
+			}
+			if reindexed := len(repo.reindexed) > 0; reindexed != (tt.wantErr == nil) {
+				t.Errorf("embedding updated = %v, want %v", reindexed, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+// TestLeadService_VisibilityFilter — списки и матчинг для пользователя ограничены видимыми ему лидами,
+// администратору фильтр не добавляется; без пользователя в контексте запрос отклоняется.
+func TestLeadService_VisibilityFilter(t *testing.T) {
+	subs := newTestSubjects()
+	property := domain.Property{ID: uuid.New(), Embedding: []float32{1, 0}}
+
+	calls := []struct {
+		name   string
+		call   func(svc *LeadService, ctx context.Context) error
+		filter func(repo *mockLeadRepository) *domain.LeadFilter
+	}{
+		{
+			name: "ListLeads",
+			call: func(svc *LeadService, ctx context.Context) error {
+				_, err := svc.ListLeads(ctx, domain.LeadFilter{})
+				return err
+			},
+			filter: func(repo *mockLeadRepository) *domain.LeadFilter { return repo.listFilter },
+		},
+		{
+			name: "MatchLeads",
+			call: func(svc *LeadService, ctx context.Context) error {
+				_, err := svc.MatchLeads(ctx, property, domain.LeadFilter{}, 10, nil)
+				return err
+			},
+			filter: func(repo *mockLeadRepository) *domain.LeadFilter { return repo.matchFilter },
+		},
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		wantVisible *uuid.UUID
+		wantErr     error
+	}{
+		{"owner is limited to visible leads", subjectContext(subs.owner), &subs.owner.UserID, nil},
+		{"stranger is limited to visible leads", subjectContext(subs.stranger), &subs.stranger.UserID, nil},
+		{"admin sees everything", subjectContext(subs.admin), nil, nil},
+		{"anonymous is denied", context.Background(), nil, ErrForbidden},
+	}
+
+	for _, c := range calls {
+		for _, tt := range tests {
+			t.Run(c.name+"/"+tt.name, func(t *testing.T) {
+				svc, repo := newTestLeadService()
+
+				err := c.call(svc, tt.ctx)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				filter := c.filter(repo)
+				if tt.wantErr != nil {
+					if filter != nil {
+						t.Error("denied request must not reach the repository")
+					}
+					return
+				}
+				if filter == nil {
+					t.Fatal("request did not reach the repository")
+				}
+				switch {
+				case tt.wantVisible == nil && filter.VisibleToUserID != nil:
+					t.Errorf("VisibleToUserID = %s, want none", filter.VisibleToUserID)
+				case tt.wantVisible != nil && (filter.VisibleToUserID == nil || *filter.VisibleToUserID != *tt.wantVisible):
+					t.Errorf("VisibleToUserID = %v, want %s", filter.VisibleToUserID, tt.wantVisible)
+				}
+			})
+		}
+	}
+}
+
+func TestLeadService_SubscribeLeads(t *testing.T) {
+	subs := newTestSubjects()
+	hidden := domain.Lead{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: domain.LeadStatusNew}
+	public := domain.Lead{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: domain.LeadStatusPublished}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		wantFirst uuid.UUID
+		wantErr   error
+	}{
+		{"owner receives own new lead", subjectContext(subs.owner), hidden.ID, nil},
+		{"stranger skips foreign new lead", subjectContext(subs.stranger), public.ID, nil},
+		{"admin receives foreign new lead", subjectContext(subs.admin), hidden.ID, nil},
+		{"anonymous is denied", context.Background(), uuid.Nil, ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockLeadRepository{leads: map[uuid.UUID]domain.Lead{hidden.ID: hidden, public.ID: public}}
+			source := &fakeLeadEventSource{events: make(chan uuid.UUID)}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			feed := lead.NewFeed(log, repo, source)
+			svc := NewLeadService(lead.NewWithFeed(log, repo, mockMLClient{}, feed))
+
+			ctx, cancel := context.WithCancel(tt.ctx)
+			defer cancel()
+			go feed.Run(ctx)
+
+			ch, err := svc.SubscribeLeads(ctx, domain.LeadSubscription{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SubscribeLeads() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			// События доставляются по порядку: первым приходит скрытый лид, если он виден
+			source.events <- hidden.ID
+			source.events <- public.ID
+
+			select {
+			case got := <-ch:
+				if got.ID != tt.wantFirst {
+					t.Errorf("first lead = %s, want %s", got.ID, tt.wantFirst)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("expected a lead in the feed")
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Package authz содержит построчные политики доступа к лидам и объектам
+// недвижимости и декораторы сервисов, которые применяют их к каждому вызову.
+package authz
+
+import (
+	"errors"
+	"lead_exchange/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrForbidden — у пользователя нет прав на операцию с сущностью.
+	ErrForbidden = errors.New("access denied")
+	// ErrDeleteRequiresAdmin — статус DELETED может установить только администратор.
+	ErrDeleteRequiresAdmin = errors.New("only admin can delete")
+)
+
+// Subject — пользователь, от имени которого выполняется запрос.
+type Subject struct {
+	UserID uuid.UUID
+	Role   domain.UserRole
+}
+
+// IsAdmin — true, если пользователь администратор.
+func (s Subject) IsAdmin() bool {
+	return s.Role == domain.UserRoleAdmin
+}
+
+// ===== Лиды =====
+
+// CanViewLead — NEW-лид виден только владельцу, создателю и администратору, остальные статусы — всем.
+func CanViewLead(sub Subject, l domain.Lead) bool {
+	if l.Status != domain.LeadStatusNew || sub.IsAdmin() {
+		return true
+	}
+	return l.OwnerUserID == sub.UserID || l.CreatedUserID == sub.UserID
+}
+
+// CheckLeadUpdate — изменять лид может владелец или администратор;
+// устанавливать статус DELETED и изменять удалённый лид — только администратор.
+// Статус PURCHASED и нового владельца пользователь установить не может: лид переходит
+// к покупателю только при завершении сделки (deal.Service, CompleteDeal).
+func CheckLeadUpdate(sub Subject, l domain.Lead, update domain.LeadFilter) error {
+	if sub.IsAdmin() {
+		return nil
+	}
+	if l.OwnerUserID != sub.UserID {
+		return ErrForbidden
+	}
+	if l.Status == domain.LeadStatusDeleted {
+		return ErrDeleteRequiresAdmin
+	}
+	if update.Status != nil && *update.Status == domain.LeadStatusDeleted {
+		return ErrDeleteRequiresAdmin
+	}
+	if update.OwnerUserID != nil || (update.Status != nil && *update.Status == domain.LeadStatusPurchased) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// ===== Объекты недвижимости =====
+
+// CanViewProperty — NEW-объект виден только владельцу, создателю и администратору, остальные статусы — всем.
+func CanViewProperty(sub Subject, p domain.Property) bool {
+	if p.Status != domain.PropertyStatusNew || sub.IsAdmin() {
+		return true
+	}
+	return p.OwnerUserID == sub.UserID || p.CreatedUserID == sub.UserID
+}
+
+// CheckPropertyUpdate — изменять объект может владелец или администратор;
+// устанавливать статус DELETED, изменять удалённый объект и передавать его другому владельцу — только администратор.
+func CheckPropertyUpdate(sub Subject, p domain.Property, update domain.PropertyFilter) error {
+	if sub.IsAdmin() {
+		return nil
+	}
+	if p.OwnerUserID != sub.UserID {
+		return ErrForbidden
+	}
+	if p.Status == domain.PropertyStatusDeleted {
+		return ErrDeleteRequiresAdmin
+	}
+	if update.Status != nil && *update.Status == domain.PropertyStatusDeleted {
+		return ErrDeleteRequiresAdmin
+	}
+	if update.OwnerUserID != nil {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package authz
+
+import (
+	"errors"
+	"lead_exchange/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCanViewLead(t *testing.T) {
+	owner := uuid.New()
+	creator := uuid.New()
+	stranger := uuid.New()
+
+	tests := []struct {
+		name   string
+		sub    Subject
+		status domain.LeadStatus
+		want   bool
+	}{
+		{"owner sees new", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.LeadStatusNew, true},
+		{"creator sees new", Subject{UserID: creator, Role: domain.UserRoleUser}, domain.LeadStatusNew, true},
+		{"admin sees new", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.LeadStatusNew, true},
+		{"stranger does not see new", Subject{UserID: stranger, Role: domain.UserRoleUser}, domain.LeadStatusNew, false},
+		{"stranger sees published", Subject{UserID: stranger, Role: domain.UserRoleUser}, domain.LeadStatusPublished, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := domain.Lead{OwnerUserID: owner, CreatedUserID: creator, Status: tt.status}
+			if got := CanViewLead(tt.sub, l); got != tt.want {
+				t.Errorf("CanViewLead() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckLeadUpdate(t *testing.T) {
+	owner := uuid.New()
+	stranger := uuid.New()
+	deleted := domain.LeadStatusDeleted
+	published := domain.LeadStatusPublished
+	purchased := domain.LeadStatusPurchased
+
+	tests := []struct {
+		name    string
+		sub     Subject
+		status  domain.LeadStatus
+		update  domain.LeadFilter
+		wantErr error
+	}{
+		{"owner updates", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.LeadStatusNew, domain.LeadFilter{Status: &published}, nil},
+		{"stranger cannot update", Subject{UserID: stranger, Role: domain.UserRoleUser}, domain.LeadStatusPublished, domain.LeadFilter{}, ErrForbidden},
+		{"admin updates foreign lead", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.LeadStatusPublished, domain.LeadFilter{}, nil},
+		{"owner cannot delete", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.LeadStatusPublished, domain.LeadFilter{Status: &deleted}, ErrDeleteRequiresAdmin},
+		{"admin deletes", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.LeadStatusPublished, domain.LeadFilter{Status: &deleted}, nil},
+		{"owner cannot modify deleted", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.LeadStatusDeleted, domain.LeadFilter{Status: &published}, ErrDeleteRequiresAdmin},
+		{"owner cannot mark purchased", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.LeadStatusPublished, domain.LeadFilter{Status: &purchased}, ErrForbidden},
+		{"owner cannot transfer", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.LeadStatusPublished, domain.LeadFilter{OwnerUserID: &stranger}, ErrForbidden},
+		{"admin marks purchased", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.LeadStatusPublished, domain.LeadFilter{Status: &purchased}, nil},
+		{"admin transfers", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.LeadStatusPublished, domain.LeadFilter{OwnerUserID: &stranger}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := domain.Lead{OwnerUserID: owner, CreatedUserID: owner, Status: tt.status}
+			if err := CheckLeadUpdate(tt.sub, l, tt.update); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckLeadUpdate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanViewProperty(t *testing.T) {
+	owner := uuid.New()
+	creator := uuid.New()
+	stranger := uuid.New()
+
+	tests := []struct {
+		name   string
+		sub    Subject
+		status domain.PropertyStatus
+		want   bool
+	}{
+		{"owner sees new", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.PropertyStatusNew, true},
+		{"creator sees new", Subject{UserID: creator, Role: domain.UserRoleUser}, domain.PropertyStatusNew, true},
+		{"admin sees new", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.PropertyStatusNew, true},
+		{"stranger does not see new", Subject{UserID: stranger, Role: domain.UserRoleUser}, domain.PropertyStatusNew, false},
+		{"stranger sees published", Subject{UserID: stranger, Role: domain.UserRoleUser}, domain.PropertyStatusPublished, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.Property{OwnerUserID: owner, CreatedUserID: creator, Status: tt.status}
+			if got := CanViewProperty(tt.sub, p); got != tt.want {
+				t.Errorf("CanViewProperty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPropertyUpdate(t *testing.T) {
+	owner := uuid.New()
+	stranger := uuid.New()
+	deleted := domain.PropertyStatusDeleted
+	published := domain.PropertyStatusPublished
+
+	tests := []struct {
+		name    string
+		sub     Subject
+		status  domain.PropertyStatus
+		update  domain.PropertyFilter
+		wantErr error
+	}{
+		{"owner updates", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.PropertyStatusNew, domain.PropertyFilter{Status: &published}, nil},
+		{"stranger cannot update", Subject{UserID: stranger, Role: domain.UserRoleUser}, domain.PropertyStatusPublished, domain.PropertyFilter{}, ErrForbidden},
+		{"admin updates foreign property", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.PropertyStatusPublished, domain.PropertyFilter{}, nil},
+		{"owner cannot delete", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.PropertyStatusPublished, domain.PropertyFilter{Status: &deleted}, ErrDeleteRequiresAdmin},
+		{"admin deletes", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.PropertyStatusPublished, domain.PropertyFilter{Status: &deleted}, nil},
+		{"owner cannot modify deleted", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.PropertyStatusDeleted, domain.PropertyFilter{Status: &published}, ErrDeleteRequiresAdmin},
+		{"owner cannot transfer", Subject{UserID: owner, Role: domain.UserRoleUser}, domain.PropertyStatusPublished, domain.PropertyFilter{OwnerUserID: &stranger}, ErrForbidden},
+		{"admin transfers", Subject{UserID: stranger, Role: domain.UserRoleAdmin}, domain.PropertyStatusPublished, domain.PropertyFilter{OwnerUserID: &stranger}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.Property{OwnerUserID: owner, CreatedUserID: owner, Status: tt.status}
+			if err := CheckPropertyUpdate(tt.sub, p, tt.update); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckPropertyUpdate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/property"
+
+	"github.com/google/uuid"
+)
+
+// PropertyService — декоратор property.Service, применяющий политики доступа к объектам.
+// Методы, не требующие проверок, доступны напрямую через встроенный сервис.
+type PropertyService struct {
+	*property.Service
+}
+
+// NewPropertyService оборачивает property.Service проверками доступа.
+func NewPropertyService(svc *property.Service) *PropertyService {
+	return &PropertyService{Service: svc}
+}
+
+// GetProperty — возвращает объект, если он виден пользователю; чужой NEW-объект выглядит как несуществующий.
+func (s *PropertyService) GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+	const op = "authz.PropertyService.GetProperty"
+
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return domain.Property{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	p, err := s.Service.GetProperty(ctx, id)
+	if err != nil {
+		return domain.Property{}, err
+	}
+
+	if !CanViewProperty(sub, p) {
+		return domain.Property{}, fmt.Errorf("%s: %w", op, property.ErrPropertyNotFound)
+	}
+
+	return p, nil
+}
+
+// ListProperties — возвращает только объекты, видимые пользователю.
+func (s *PropertyService) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
+	const op = "authz.PropertyService.ListProperties"
+
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !sub.IsAdmin() {
+		filter.VisibleToUserID = &sub.UserID
+	}
+	return s.Service.ListProperties(ctx, filter)
+}
+
+// UpdateProperty — обновляет объект, если пользователь владелец или администратор.
+func (s *PropertyService) UpdateProperty(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) (domain.Property, error) {
+	const op = "authz.PropertyService.UpdateProperty"
+
+	if err := s.checkWrite(ctx, id, update); err != nil {
+		return domain.Property{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.UpdateProperty(ctx, id, update)
+}
+
+// ReindexProperty — переиндексация доступна владельцу и администратору.
+func (s *PropertyService) ReindexProperty(ctx context.Context, id uuid.UUID) error {
+	const op = "authz.PropertyService.ReindexProperty"
+
+	if err := s.checkWrite(ctx, id, domain.PropertyFilter{}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.ReindexProperty(ctx, id)
+}
+
+func (s *PropertyService) checkWrite(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) error {
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.Service.GetProperty(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !CanViewProperty(sub, p) {
+		return property.ErrPropertyNotFound
+	}
+
+	return CheckPropertyUpdate(sub, p, update)
+}
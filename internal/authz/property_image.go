@@ -0,0 +1,68 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/propertyimage"
+
+	"github.com/google/uuid"
+)
+
+// PropertyImageService — декоратор propertyimage.Service: галерею видят те, кому виден объект,
+// а изменяют владелец объекта и администратор.
+type PropertyImageService struct {
+	*propertyimage.Service
+	properties *PropertyService
+}
+
+// NewPropertyImageService оборачивает propertyimage.Service проверками доступа к объекту.
+func NewPropertyImageService(svc *propertyimage.Service, properties *PropertyService) *PropertyImageService {
+	return &PropertyImageService{Service: svc, properties: properties}
+}
+
+// AddImages — добавить фотографии может владелец объекта или администратор, и только из своих загрузок.
+func (s *PropertyImageService) AddImages(ctx context.Context, propertyID uuid.UUID, keys []string) ([]domain.PropertyImage, error) {
+	const op = "authz.PropertyImageService.AddImages"
+
+	sub, err := subjectFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.properties.checkWrite(ctx, propertyID, domain.PropertyFilter{}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.AddImages(ctx, sub.UserID, propertyID, keys)
+}
+
+// ListImages — галерея доступна, если виден сам объект.
+func (s *PropertyImageService) ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	if _, err := s.properties.GetProperty(ctx, propertyID); err != nil {
+		return nil, err
+	}
+
+	return s.Service.ListImages(ctx, propertyID)
+}
+
+// ReorderImages — менять порядок может владелец объекта или администратор.
+func (s *PropertyImageService) ReorderImages(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "authz.PropertyImageService.ReorderImages"
+
+	if err := s.properties.checkWrite(ctx, propertyID, domain.PropertyFilter{}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.ReorderImages(ctx, propertyID, imageIDs)
+}
+
+// DeleteImage — удалять фотографии может владелец объекта или администратор.
+func (s *PropertyImageService) DeleteImage(ctx context.Context, propertyID, imageID uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "authz.PropertyImageService.DeleteImage"
+
+	if err := s.properties.checkWrite(ctx, propertyID, domain.PropertyFilter{}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.Service.DeleteImage(ctx, propertyID, imageID)
+}
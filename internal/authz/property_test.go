@@ -0,0 +1,205 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/repository/property_repository"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// mockPropertyRepository хранит объекты в памяти и запоминает, что до него дошло от декоратора.
+type mockPropertyRepository struct {
+	properties map[uuid.UUID]domain.Property
+	updated    []uuid.UUID
+	reindexed  []uuid.UUID
+	listFilter *domain.PropertyFilter
+}
+
+func (m *mockPropertyRepository) CreateProperty(ctx context.Context, p domain.Property) (uuid.UUID, error) {
+	return uuid.New(), nil
+}
+func (m *mockPropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+	p, ok := m.properties[id]
+	if !ok {
+		return domain.Property{}, repository.ErrPropertyNotFound
+	}
+	return p, nil
+}
+func (m *mockPropertyRepository) UpdateProperty(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) error {
+	m.updated = append(m.updated, id)
+	return nil
+}
+func (m *mockPropertyRepository) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
+	m.listFilter = &filter
+	return &domain.PaginatedResult[domain.Property]{}, nil
+}
+func (m *mockPropertyRepository) UpdateEmbedding(ctx context.Context, id uuid.UUID, embedding []float32) error {
+	m.reindexed = append(m.reindexed, id)
+	return nil
+}
+func (m *mockPropertyRepository) MatchProperties(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error) {
+	return nil, nil
+}
+func (m *mockPropertyRepository) MatchPropertiesWithHardFilters(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error) {
+	return nil, nil
+}
+func (m *mockPropertyRepository) HybridSearch(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error) {
+	return nil, nil
+}
+func (m *mockPropertyRepository) FulltextSearch(ctx context.Context, query string, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error) {
+	return nil, nil
+}
+
+func newTestPropertyService(properties ...domain.Property) (*PropertyService, *mockPropertyRepository) {
+	repo := &mockPropertyRepository{properties: map[uuid.UUID]domain.Property{}}
+	for _, p := range properties {
+		repo.properties[p.ID] = p
+	}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewPropertyService(property.New(log, repo, mockMLClient{}, nil)), repo
+}
+
+func TestPropertyService_GetProperty(t *testing.T) {
+	subs := newTestSubjects()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		status  domain.PropertyStatus
+		wantErr error
+	}{
+		{"owner gets new", subjectContext(subs.owner), domain.PropertyStatusNew, nil},
+		{"stranger does not get new", subjectContext(subs.stranger), domain.PropertyStatusNew, property.ErrPropertyNotFound},
+		{"stranger gets published", subjectContext(subs.stranger), domain.PropertyStatusPublished, nil},
+		{"admin gets new", subjectContext(subs.admin), domain.PropertyStatusNew, nil},
+		{"anonymous is denied", context.Background(), domain.PropertyStatusPublished, ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.Property{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: tt.status}
+			svc, _ := newTestPropertyService(p)
+
+			got, err := svc.GetProperty(tt.ctx, p.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetProperty() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.ID != p.ID {
+				t.Errorf("GetProperty() = %s, want %s", got.ID, p.ID)
+			}
+		})
+	}
+}
+
+func TestPropertyService_UpdateProperty(t *testing.T) {
+	subs := newTestSubjects()
+	deleted := domain.PropertyStatusDeleted
+
+	tests := []struct {
+		name    string
+		sub     Subject
+		status  domain.PropertyStatus
+		update  domain.PropertyFilter
+		wantErr error
+	}{
+		{"owner updates", subs.owner, domain.PropertyStatusNew, domain.PropertyFilter{}, nil},
+		{"owner cannot delete", subs.owner, domain.PropertyStatusPublished, domain.PropertyFilter{Status: &deleted}, ErrDeleteRequiresAdmin},
+		{"stranger cannot update published", subs.stranger, domain.PropertyStatusPublished, domain.PropertyFilter{}, ErrForbidden},
+		{"stranger does not see new", subs.stranger, domain.PropertyStatusNew, domain.PropertyFilter{}, property.ErrPropertyNotFound},
+		{"admin updates foreign property", subs.admin, domain.PropertyStatusNew, domain.PropertyFilter{}, nil},
+		{"admin deletes", subs.admin, domain.PropertyStatusPublished, domain.PropertyFilter{Status: &deleted}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.Property{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: tt.status}
+			svc, repo := newTestPropertyService(p)
+
+			_, err := svc.UpdateProperty(subjectContext(tt.sub), p.ID, tt.update)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateProperty() error = %v, want %v", err, tt.wantErr)
+			}
+			if updated := len(repo.updated) > 0; updated != (tt.wantErr == nil) {
+				t.Errorf("repository updated = %v, want %v", updated, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestPropertyService_ReindexProperty(t *testing.T) {
+	subs := newTestSubjects()
+
+	tests := []struct {
+		name    string
+		sub     Subject
+		wantErr error
+	}{
+		{"owner reindexes", subs.owner, nil},
+		{"stranger cannot reindex", subs.stranger, ErrForbidden},
+		{"admin reindexes foreign property", subs.admin, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.Property{ID: uuid.New(), OwnerUserID: subs.owner.UserID, CreatedUserID: subs.owner.UserID, Status: domain.PropertyStatusPublished}
+			svc, repo := newTestPropertyService(p)
+
+			err := svc.ReindexProperty(subjectContext(tt.sub), p.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReindexProperty() error = %v, want %v", err, tt.wantErr)
+			}
+			if reindexed := len(repo.reindexed) > 0; reindexed != (tt.wantErr == nil) {
+				t.Errorf("embedding updated = %v, want %v", reindexed, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestPropertyService_ListProperties(t *testing.T) {
+	subs := newTestSubjects()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		wantVisible *uuid.UUID
+		wantErr     error
+	}{
+		{"owner is limited to visible properties", subjectContext(subs.owner), &subs.owner.UserID, nil},
+		{"stranger is limited to visible properties", subjectContext(subs.stranger), &subs.stranger.UserID, nil},
+		{"admin sees everything", subjectContext(subs.admin), nil, nil},
+		{"anonymous is denied", context.Background(), nil, ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newTestPropertyService()
+
+			_, err := svc.ListProperties(tt.ctx, domain.PropertyFilter{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListProperties() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if repo.listFilter != nil {
+					t.Error("denied request must not reach the repository")
+				}
+				return
+			}
+			if repo.listFilter == nil {
+				t.Fatal("request did not reach the repository")
+			}
+			switch got := repo.listFilter.VisibleToUserID; {
+			case tt.wantVisible == nil && got != nil:
+				t.Errorf("VisibleToUserID = %s, want none", got)
+			case tt.wantVisible != nil && (got == nil || *got != *tt.wantVisible):
+				t.Errorf("VisibleToUserID = %v, want %s", got, tt.wantVisible)
+			}
+		})
+	}
+}
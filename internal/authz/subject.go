@@ -0,0 +1,22 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/middleware"
+)
+
+// subjectFromContext собирает Subject из user ID и роли, которые JWT middleware положил в контекст.
+func subjectFromContext(ctx context.Context) (Subject, error) {
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return Subject{}, fmt.Errorf("%w: user not found in context", ErrForbidden)
+	}
+
+	role, ok := middleware.RoleFromContext(ctx)
+	if !ok {
+		return Subject{}, fmt.Errorf("%w: user role not found in context", ErrForbidden)
+	}
+
+	return Subject{UserID: userID, Role: role}, nil
+}
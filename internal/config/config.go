@@ -7,18 +7,25 @@ import (
 )
 
 type Config struct {
-	Env         string `env:"ENV" env-default:"local"`
-	DatabaseURL string `env:"DATABASE_URL" env-required:"true"`
-	GRPC        GRPCConfig
-	TokenTTL    time.Duration `env:"TOKEN_TTL" env-default:"1h"`
-	Secret      string        `env:"SECRET" env-required:"true"`
-	DisableAuth bool          `env:"DISABLE_AUTH" env-default:"false"`
-	Minio       MinioConfig
-	ML          MLConfig
-	Reranker    RerankerConfig
-	LLM         LLMConfig
-	Vision      VisionConfig
-	Search      SearchConfig
+	Env             string `env:"ENV" env-default:"local"`
+	DatabaseURL     string `env:"DATABASE_URL" env-required:"true"`
+	GRPC            GRPCConfig
+	TokenTTL        time.Duration `env:"TOKEN_TTL" env-default:"1h"`
+	RefreshTokenTTL time.Duration `env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+	Secret          string        `env:"SECRET" env-required:"true"`
+	DisableAuth     bool          `env:"DISABLE_AUTH" env-default:"false"`
+	Minio           MinioConfig
+	ML              MLConfig
+	Reranker        RerankerConfig
+	LLM             LLMConfig
+	Vision          VisionConfig
+	Search          SearchConfig
+	SavedSearch     SavedSearchConfig
+	EmbeddingWorker EmbeddingWorkerConfig
+	Tracing         TracingConfig
+	Health          HealthConfig
+	RateLimit       RateLimitConfig
+	Login           LoginProtectionConfig
 }
 
 type GRPCConfig struct {
@@ -34,12 +41,35 @@ type MinioConfig struct {
 	MinioRootUser     string `env:"MINIO_USER"`
 	MinioRootPassword string `env:"MINIO_PASSWORD"`
 	MinioUseSSL       bool   `env:"MINIO_USE_SSL"`
+	// PresignTTL — срок действия ссылок на фотографии галереи, выдаваемых при чтении
+	PresignTTL time.Duration `env:"MINIO_PRESIGN_TTL" env-default:"1h"`
+	// UploadMaxSize — максимальный размер файла, загружаемого через CreateUploadURL или UploadFileStream (байты)
+	UploadMaxSize int64 `env:"UPLOAD_MAX_SIZE" env-default:"20971520"`
+	// UploadURLTTL — срок действия ссылки на загрузку из CreateUploadURL
+	UploadURLTTL time.Duration `env:"UPLOAD_URL_TTL" env-default:"15m"`
+	// UploadProcessConcurrency — сколько изображений обрабатывается одновременно (каждое держит в памяти весь файл и растр)
+	UploadProcessConcurrency int `env:"UPLOAD_PROCESS_CONCURRENCY" env-default:"2"`
+	// UploadSweepInterval — как часто удаляются неподтверждённые загрузки с истёкшей ссылкой
+	UploadSweepInterval time.Duration `env:"UPLOAD_SWEEP_INTERVAL" env-default:"10m"`
 }
 
 type MLConfig struct {
 	Enabled  bool   `env:"ML_ENABLE" env-default:"true"`
 	BaseURL  string `env:"ML_BASE_URL" env-default:"https://calcifer0323-matching.hf.space"`
 	Timeout  time.Duration `env:"ML_TIMEOUT" env-default:"30s"`
+	// NextBaseURL — ML сервис новой модели embedding. Если задан, сервер ведёт миграцию:
+	// пишет векторы новой модели в теневые колонки и переключается на них при полном покрытии.
+	NextBaseURL string `env:"ML_NEXT_BASE_URL"`
+	// Provider — источник embedding: remote (ML сервис по BaseURL), hashing (локальный
+	// детерминированный embedder без сети) или openai (OpenAI-совместимый BaseURL/embeddings).
+	Provider string `env:"ML_PROVIDER" env-default:"remote"`
+	// APIKey и Model — ключ и модель для провайдера openai.
+	APIKey string `env:"ML_API_KEY"`
+	Model  string `env:"ML_MODEL" env-default:"text-embedding-3-small"`
+	// Dimensions — размерность векторов для hashing и openai; 0 — по умолчанию (384 для hashing, размерность модели для openai).
+	Dimensions int `env:"ML_DIMENSIONS"`
+	// Resilience — повторы, лимит запросов и circuit breaker (ML_MAX_RETRIES, ML_RATE_LIMIT, ...).
+	Resilience ResilienceConfig `env-prefix:"ML_"`
 }
 
 // RerankerConfig — конфигурация для Reranker API (Jina AI, Cohere и др.).
@@ -50,6 +80,8 @@ type RerankerConfig struct {
 	Model   string        `env:"RERANKER_MODEL" env-default:"jina-reranker-v2-base-multilingual"`
 	Timeout time.Duration `env:"RERANKER_TIMEOUT" env-default:"30s"`
 	TopN    int           `env:"RERANKER_TOP_N" env-default:"10"`
+	// Resilience — повторы, лимит запросов и circuit breaker (RERANKER_MAX_RETRIES, ...).
+	Resilience ResilienceConfig `env-prefix:"RERANKER_"`
 }
 
 // LLMConfig — конфигурация для LLM API (OpenAI, Azure OpenAI и др.).
@@ -59,6 +91,8 @@ type LLMConfig struct {
 	APIKey  string        `env:"LLM_API_KEY"`
 	Model   string        `env:"LLM_MODEL" env-default:"gpt-4o-mini"`
 	Timeout time.Duration `env:"LLM_TIMEOUT" env-default:"60s"`
+	// Resilience — повторы, лимит запросов и circuit breaker (LLM_MAX_RETRIES, ...).
+	Resilience ResilienceConfig `env-prefix:"LLM_"`
 }
 
 // VisionConfig — конфигурация для Computer Vision API.
@@ -67,6 +101,33 @@ type VisionConfig struct {
 	BaseURL string        `env:"VISION_BASE_URL"`
 	APIKey  string        `env:"VISION_API_KEY"`
 	Timeout time.Duration `env:"VISION_TIMEOUT" env-default:"30s"`
+	// Resilience — повторы, лимит запросов и circuit breaker (VISION_MAX_RETRIES, ...).
+	Resilience ResilienceConfig `env-prefix:"VISION_"`
+	// SyncMaxImages — если анализа ждут не больше стольких фотографий, галерея анализируется
+	// прямо в запросе; иначе — фоновым воркером
+	SyncMaxImages int `env:"VISION_SYNC_MAX_IMAGES" env-default:"5"`
+	// PollInterval — как часто воркер проверяет очередь анализа галерей
+	PollInterval time.Duration `env:"VISION_POLL_INTERVAL" env-default:"10s"`
+	// AnalysisLease — сколько галерея считается занятой анализом; после этого её забирает другой воркер
+	AnalysisLease time.Duration `env:"VISION_ANALYSIS_LEASE" env-default:"10m"`
+}
+
+// ResilienceConfig — защита вызовов внешнего AI-провайдера. Переменные читаются
+// с префиксом провайдера: LLM_MAX_RETRIES, RERANKER_RATE_LIMIT, ML_BREAKER_THRESHOLD и т.д.
+type ResilienceConfig struct {
+	// MaxRetries — сколько раз повторяется запрос после сетевой ошибки, 429 или 5xx
+	MaxRetries int `env:"MAX_RETRIES" env-default:"2"`
+	// RetryBaseDelay и RetryMaxDelay — границы экспоненциальной задержки с jitter между повторами
+	RetryBaseDelay time.Duration `env:"RETRY_BASE_DELAY" env-default:"200ms"`
+	RetryMaxDelay  time.Duration `env:"RETRY_MAX_DELAY" env-default:"5s"`
+	// RateLimit — запросов в секунду к провайдеру (token bucket); 0 — без ограничения
+	RateLimit float64 `env:"RATE_LIMIT" env-default:"0"`
+	// RateBurst — сколько запросов можно отправить подряд сверх RateLimit
+	RateBurst int `env:"RATE_BURST" env-default:"5"`
+	// BreakerThreshold — после стольких неудачных вызовов подряд провайдер отключается; 0 — без circuit breaker
+	BreakerThreshold int `env:"BREAKER_THRESHOLD" env-default:"5"`
+	// BreakerCooldown — через сколько отключённый провайдер получает пробный запрос
+	BreakerCooldown time.Duration `env:"BREAKER_COOLDOWN" env-default:"30s"`
 }
 
 // SearchConfig — конфигурация для гибридного поиска.
@@ -85,6 +146,91 @@ type SearchConfig struct {
 	DynamicWeightsEnabled bool `env:"DYNAMIC_WEIGHTS_ENABLE" env-default:"false"`
 }
 
+// SavedSearchConfig — конфигурация планировщика сохранённых поисков.
+type SavedSearchConfig struct {
+	// Enabled включает периодический перезапуск сохранённых поисков
+	Enabled bool `env:"SAVED_SEARCH_ENABLE" env-default:"true"`
+	// Interval — как часто перезапускается каждый сохранённый поиск
+	Interval time.Duration `env:"SAVED_SEARCH_INTERVAL" env-default:"15m"`
+	// BatchSize — сколько поисков забирается из очереди за один раз
+	BatchSize int `env:"SAVED_SEARCH_BATCH" env-default:"50"`
+}
+
+// EmbeddingWorkerConfig — конфигурация пула воркеров, генерирующих embedding по outbox embedding_jobs.
+type EmbeddingWorkerConfig struct {
+	// Enabled включает обработку заданий в этом экземпляре сервера
+	Enabled bool `env:"EMBEDDING_WORKER_ENABLE" env-default:"true"`
+	// Workers — количество параллельных воркеров
+	Workers int `env:"EMBEDDING_WORKERS" env-default:"4"`
+	// BatchSize — сколько заданий забирается из очереди за один раз
+	BatchSize int `env:"EMBEDDING_WORKER_BATCH" env-default:"20"`
+	// PollInterval — пауза между опросами пустой очереди
+	PollInterval time.Duration `env:"EMBEDDING_WORKER_POLL_INTERVAL" env-default:"2s"`
+	// Lease — на сколько задание закрепляется за воркером; после истечения его заберёт другой
+	Lease time.Duration `env:"EMBEDDING_WORKER_LEASE" env-default:"2m"`
+	// MaxAttempts — после стольких неудачных попыток задание переводится в DEAD
+	MaxAttempts int `env:"EMBEDDING_WORKER_MAX_ATTEMPTS" env-default:"8"`
+	// BackoffBase и BackoffMax — границы экспоненциальной задержки между попытками
+	BackoffBase time.Duration `env:"EMBEDDING_WORKER_BACKOFF_BASE" env-default:"5s"`
+	BackoffMax  time.Duration `env:"EMBEDDING_WORKER_BACKOFF_MAX" env-default:"30m"`
+	// ModelSyncInterval — как часто при миграции модели проверяется покрытие теневых колонок
+	// и переключение, выполненное другим экземпляром
+	ModelSyncInterval time.Duration `env:"EMBEDDING_MODEL_SYNC_INTERVAL" env-default:"1m"`
+}
+
+// TracingConfig — экспорт трейсов OpenTelemetry.
+type TracingConfig struct {
+	// Exporter — none (трейсинг выключен), stdout (спаны в консоль для локальной отладки) или otlp
+	Exporter string `env:"TRACING_EXPORTER" env-default:"none"`
+	// OTLPEndpoint — адрес OTLP/gRPC коллектора (Jaeger, Tempo, OpenTelemetry Collector)
+	OTLPEndpoint string `env:"TRACING_OTLP_ENDPOINT" env-default:"localhost:4317"`
+	// OTLPInsecure — подключаться к коллектору без TLS
+	OTLPInsecure bool `env:"TRACING_OTLP_INSECURE" env-default:"true"`
+	// ServiceName — имя сервиса в трейсах
+	ServiceName string `env:"TRACING_SERVICE_NAME" env-default:"lead-exchange"`
+	// SampleRatio — доля сэмплируемых трейсов (0-1); решение родительского спана уважается
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+// HealthConfig — проверки зависимостей для grpc.health.v1, /readyz и HealthCheck.
+type HealthConfig struct {
+	// Interval — как часто обновляется статус grpc.health.v1
+	Interval time.Duration `env:"HEALTH_CHECK_INTERVAL" env-default:"10s"`
+	// Timeout — ограничение одной проверки
+	Timeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+	// CheckAI — проверять доступность ML и LLM; их отказ не делает экземпляр неготовым
+	CheckAI bool `env:"HEALTH_CHECK_AI" env-default:"true"`
+	// MigrationsDir — каталог миграций goose, сверяемый с goose_db_version
+	MigrationsDir string `env:"HEALTH_MIGRATIONS_DIR" env-default:"migrations"`
+}
+
+// RateLimitConfig — ограничение частоты вызовов gRPC методов (бюджеты по умолчанию — middleware.MethodRateLimits).
+type RateLimitConfig struct {
+	// Enabled включает rate limit interceptor
+	Enabled bool `env:"RATE_LIMIT_ENABLE" env-default:"true"`
+	// Store — где хранятся счётчики: memory (один экземпляр) или postgres (общие для всех экземпляров)
+	Store string `env:"RATE_LIMIT_STORE" env-default:"memory"`
+	// Methods — переопределения бюджетов: "/pkg.Service/Method=requests/window,..."; requests=0 снимает лимит
+	Methods string `env:"RATE_LIMIT_METHODS"`
+}
+
+// LoginProtectionConfig — защита входа от подбора пароля.
+type LoginProtectionConfig struct {
+	// MaxFailures — сколько неудачных попыток подряд по email приводят к блокировке; 0 — не блокировать
+	MaxFailures int `env:"LOGIN_MAX_FAILURES" env-default:"5"`
+	// FailureWindow — неудачи считаются подряд, если между ними прошло меньше этого времени;
+	// это же окно используется для подсчёта неудач с одного IP
+	FailureWindow time.Duration `env:"LOGIN_FAILURE_WINDOW" env-default:"15m"`
+	// LockoutDuration — на сколько блокируется вход после MaxFailures неудач
+	LockoutDuration time.Duration `env:"LOGIN_LOCKOUT_DURATION" env-default:"15m"`
+	// IPMaxFailures — сколько неудач с одного IP за FailureWindow блокируют вход с него; 0 — не блокировать
+	IPMaxFailures int `env:"LOGIN_IP_MAX_FAILURES" env-default:"20"`
+	// DelayBase и DelayMax — задержка ответа на неудачную попытку: DelayBase, удваивается
+	// с каждой следующей неудачей, но не больше DelayMax; 0 — без задержки
+	DelayBase time.Duration `env:"LOGIN_DELAY_BASE" env-default:"250ms"`
+	DelayMax  time.Duration `env:"LOGIN_DELAY_MAX" env-default:"4s"`
+}
+
 func MustLoad() *Config {
 	var cfg Config
 	if err := cleanenv.ReadEnv(&cfg); err != nil {
@@ -34,6 +34,55 @@ func (s DealStatus) String() string {
 	return string(s)
 }
 
+// IsTerminal — true, если из статуса нет переходов.
+func (s DealStatus) IsTerminal() bool {
+	return s == DealStatusCompleted || s == DealStatusCancelled || s == DealStatusRejected
+}
+
+// DealParty — сторона сделки, инициирующая переход статуса.
+type DealParty string
+
+const (
+	DealPartySeller DealParty = "SELLER" // Продавец лида
+	DealPartyBuyer  DealParty = "BUYER"  // Покупатель (или претендент для PENDING-сделки)
+)
+
+// dealTransitions — разрешённые переходы статусов сделки и сторона, которая может их выполнить.
+//
+//	PENDING  → ACCEPTED  (покупатель)
+//	PENDING  → CANCELLED (продавец)
+//	ACCEPTED → COMPLETED (покупатель)
+//	ACCEPTED → REJECTED  (покупатель)
+//	ACCEPTED → CANCELLED (продавец)
+var dealTransitions = map[DealStatus]map[DealStatus]DealParty{
+	DealStatusPending: {
+		DealStatusAccepted:  DealPartyBuyer,
+		DealStatusCancelled: DealPartySeller,
+	},
+	DealStatusAccepted: {
+		DealStatusCompleted: DealPartyBuyer,
+		DealStatusRejected:  DealPartyBuyer,
+		DealStatusCancelled: DealPartySeller,
+	},
+}
+
+// DealTransitionParty возвращает сторону, которой разрешён переход from → to.
+// ok == false, если такой переход не предусмотрен state machine.
+func DealTransitionParty(from, to DealStatus) (party DealParty, ok bool) {
+	party, ok = dealTransitions[from][to]
+	return party, ok
+}
+
+// DealStatusChange — запись истории изменения статуса сделки.
+type DealStatusChange struct {
+	ID              uuid.UUID
+	DealID          uuid.UUID
+	FromStatus      DealStatus // пустой для первой записи (создание сделки)
+	ToStatus        DealStatus
+	ChangedByUserID uuid.UUID
+	CreatedAt       time.Time
+}
+
 // DealFilter — фильтр для выборок или обновлений сделок.
 type DealFilter struct {
 	LeadID       *uuid.UUID
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmbeddingEntityType — тип сущности, для которой генерируется embedding.
+type EmbeddingEntityType string
+
+const (
+	EmbeddingEntityUnspecified EmbeddingEntityType = ""
+	EmbeddingEntityLead        EmbeddingEntityType = "lead"
+	EmbeddingEntityProperty    EmbeddingEntityType = "property"
+)
+
+func (t EmbeddingEntityType) String() string {
+	return string(t)
+}
+
+// EmbeddingJobStatus — статус задания на генерацию embedding.
+type EmbeddingJobStatus string
+
+const (
+	EmbeddingJobStatusUnspecified EmbeddingJobStatus = ""
+	EmbeddingJobStatusPending     EmbeddingJobStatus = "PENDING"    // Ожидает выполнения (в том числе повтора)
+	EmbeddingJobStatusProcessing  EmbeddingJobStatus = "PROCESSING" // Забрано воркером
+	EmbeddingJobStatusDead        EmbeddingJobStatus = "DEAD"       // Попытки исчерпаны, нужен ручной повтор
+)
+
+func (s EmbeddingJobStatus) String() string {
+	return string(s)
+}
+
+// EmbeddingJob — задание outbox на генерацию embedding лида или объекта.
+type EmbeddingJob struct {
+	ID         uuid.UUID
+	EntityType EmbeddingEntityType
+	EntityID   uuid.UUID
+	Status     EmbeddingJobStatus
+	Attempts   int
+	LastError  *string
+	NextRunAt  time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	// LockedBy — токен захвата, выданный ClaimJobs; с ним воркер фиксирует результат задания
+	LockedBy uuid.UUID
+}
+
+// EmbeddingJobFilter — фильтр выборки заданий для администратора.
+type EmbeddingJobFilter struct {
+	Status     *EmbeddingJobStatus
+	EntityType *EmbeddingEntityType
+	Pagination *PaginationParams
+}
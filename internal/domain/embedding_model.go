@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// EmbeddingModelState — активная модель embedding и целевая модель идущей миграции.
+type EmbeddingModelState struct {
+	// ActiveModel — модель, чьи векторы лежат в колонке embedding; nil до первой проверки на старте.
+	ActiveModel      *string
+	ActiveDimensions int
+	// NextModel — модель, чьи векторы пишутся в теневую колонку embedding_next; nil, если миграции нет.
+	NextModel          *string
+	NextDimensions     *int
+	MigrationStartedAt *time.Time
+	CutoverAt          *time.Time
+	UpdatedAt          time.Time
+}
+
+// MigrationInProgress — true, если идёт миграция на новую модель.
+func (s EmbeddingModelState) MigrationInProgress() bool {
+	return s.NextModel != nil
+}
+
+// EmbeddingModelStats — сколько записей сущности хранят векторы модели Model размерности Dimensions.
+type EmbeddingModelStats struct {
+	EntityType EmbeddingEntityType
+	// Model — nil для векторов без отметки о модели.
+	Model      *string
+	Dimensions int
+	Count      int
+}
+
+// EmbeddingCoverage — заполненность теневой колонки векторами целевой модели.
+type EmbeddingCoverage struct {
+	EntityType EmbeddingEntityType
+	Total      int
+	Embedded   int
+}
+
+// Complete — true, если у всех записей есть вектор целевой модели.
+func (c EmbeddingCoverage) Complete() bool {
+	return c.Embedded >= c.Total
+}
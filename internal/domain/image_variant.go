@@ -0,0 +1,17 @@
+package domain
+
+// ImageVariant — обработанная копия загруженной фотографии: один из размеров
+// (thumbnail, card, full) в основном формате или в WebP.
+type ImageVariant struct {
+	// Name — размер копии: thumbnail, card или full
+	Name string
+	// Format — jpeg, png или webp
+	Format      string
+	Key         string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+	// URL — временная ссылка на скачивание; заполняется при чтении, в БД не хранится
+	URL string
+}
@@ -11,8 +11,8 @@ type Lead struct {
 	ID          uuid.UUID
 	Title       string
 	Description string
-	// JSON с предпочтениями ("roomNumber": 3, "price": "5000000")
-	Requirement   []byte
+	// Requirement — типизированные требования к объекту (JSONB requirement)
+	Requirement   LeadRequirement
 	ContactName   string
 	ContactPhone  string
 	ContactEmail  *string
@@ -44,16 +44,40 @@ func (s LeadStatus) String() string {
 	return string(s)
 }
 
+// ContactAccessReason — основание, по которому пользователь получил доступ к контактам лида.
+type ContactAccessReason string
+
+const (
+	ContactAccessOwner ContactAccessReason = "OWNER" // Владелец лида
+	ContactAccessBuyer ContactAccessReason = "BUYER" // Покупатель по завершённой сделке
+	ContactAccessAdmin ContactAccessReason = "ADMIN" // Администратор
+)
+
+func (r ContactAccessReason) String() string {
+	return string(r)
+}
+
+// LeadContactReveal — запись аудита раскрытия контактов лида.
+type LeadContactReveal struct {
+	ID        uuid.UUID
+	LeadID    uuid.UUID
+	UserID    uuid.UUID
+	Reason    ContactAccessReason
+	CreatedAt time.Time
+}
+
 // LeadFilter — фильтр для выборок или обновлений лидов.
 type LeadFilter struct {
 	Title         *string
 	Description   *string
-	Requirement   *[]byte
+	Requirement   *LeadRequirement
 	City          *string
 	PropertyType  *PropertyType
 	Status        *LeadStatus
 	OwnerUserID   *uuid.UUID
 	CreatedUserID *uuid.UUID
+	// VisibleToUserID — ограничивает выборку лидами, видимыми пользователю (чужие NEW скрыты)
+	VisibleToUserID *uuid.UUID
 
 	// Пагинация
 	Pagination    *PaginationParams
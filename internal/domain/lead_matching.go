@@ -0,0 +1,48 @@
+package domain
+
+// MatchedLead — лид, подобранный для объекта недвижимости (обратный матчинг).
+// Scores считаются так же, как в MatchedProperty: объект оценивается по критериям лида.
+type MatchedLead struct {
+	Lead       Lead
+	Similarity float64 // Косинусная близость (0-1)
+	// Взвешенные scores
+	TotalScore       *float64
+	PriceScore       *float64
+	DistrictScore    *float64
+	RoomsScore       *float64
+	AreaScore        *float64
+	SemanticScore    *float64
+	VisualScore      *float64
+	MatchExplanation *string
+}
+
+// LeadHardFilters — жёсткие фильтры обратного матчинга, обратные HardFilters.
+// Значения берутся из объекта; лид проходит, если объект укладывается в его требования
+// с теми же допусками (комнаты ±1 к диапазону, бюджет ±20%). Лиды без соответствующего требования не отсекаются.
+type LeadHardFilters struct {
+	// City — город объекта (лиды без города не отсекаются)
+	City *string
+	// PropertyType — тип объекта
+	PropertyType *PropertyType
+	// Rooms — количество комнат объекта
+	Rooms *int32
+	// Price — цена объекта
+	Price *int64
+}
+
+// LeadHardFiltersFromProperty создаёт LeadHardFilters из данных объекта.
+func LeadHardFiltersFromProperty(p Property) LeadHardFilters {
+	hf := LeadHardFilters{
+		Rooms: p.Rooms,
+		Price: p.Price,
+	}
+	if p.City != nil && *p.City != "" {
+		city := NormalizeCity(*p.City)
+		hf.City = &city
+	}
+	if p.PropertyType != PropertyTypeUnspecified {
+		pt := p.PropertyType
+		hf.PropertyType = &pt
+	}
+	return hf
+}
@@ -0,0 +1,311 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LeadRequirementVersion — текущая версия схемы требований лида.
+const LeadRequirementVersion = 1
+
+// Ограничения схемы требований лида.
+const (
+	maxRequirementListLen  = 20
+	maxRequirementItemLen  = 100
+	maxRequirementRooms    = 50
+	maxRequirementFloorAbs = 200
+)
+
+// ErrInvalidLeadRequirement — требования лида не соответствуют схеме.
+var ErrInvalidLeadRequirement = errors.New("invalid lead requirement")
+
+// LeadRequirement — типизированные требования лида к объекту (хранятся в leads.requirement).
+// Все поля необязательны; диапазоны задаются парами min/max.
+type LeadRequirement struct {
+	Version          int           `json:"version"`
+	BudgetMin        *int64        `json:"budget_min,omitempty"`
+	BudgetMax        *int64        `json:"budget_max,omitempty"`
+	RoomsMin         *int32        `json:"rooms_min,omitempty"`
+	RoomsMax         *int32        `json:"rooms_max,omitempty"`
+	AreaMin          *float64      `json:"area_min,omitempty"`
+	AreaMax          *float64      `json:"area_max,omitempty"`
+	FloorMin         *int32        `json:"floor_min,omitempty"`
+	FloorMax         *int32        `json:"floor_max,omitempty"`
+	Districts        []string      `json:"districts,omitempty"`
+	MustHaveFeatures []string      `json:"must_have_features,omitempty"`
+	PropertyType     *PropertyType `json:"property_type,omitempty"`
+	// Deadline — срок, до которого клиенту нужен объект
+	Deadline *time.Time `json:"deadline,omitempty"`
+}
+
+// NewLeadRequirement возвращает пустые требования текущей версии.
+func NewLeadRequirement() LeadRequirement {
+	return LeadRequirement{Version: LeadRequirementVersion}
+}
+
+// IsEmpty — true, если не задано ни одного требования.
+func (r LeadRequirement) IsEmpty() bool {
+	return r.BudgetMin == nil && r.BudgetMax == nil &&
+		r.RoomsMin == nil && r.RoomsMax == nil &&
+		r.AreaMin == nil && r.AreaMax == nil &&
+		r.FloorMin == nil && r.FloorMax == nil &&
+		len(r.Districts) == 0 && len(r.MustHaveFeatures) == 0 &&
+		r.PropertyType == nil && r.Deadline == nil
+}
+
+// Validate проверяет требования на соответствие схеме текущей версии.
+func (r LeadRequirement) Validate() error {
+	if r.Version != LeadRequirementVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidLeadRequirement, r.Version)
+	}
+
+	if r.BudgetMin != nil && *r.BudgetMin < 0 {
+		return fmt.Errorf("%w: budget_min must be non-negative", ErrInvalidLeadRequirement)
+	}
+	if r.BudgetMax != nil && *r.BudgetMax <= 0 {
+		return fmt.Errorf("%w: budget_max must be positive", ErrInvalidLeadRequirement)
+	}
+	if r.BudgetMin != nil && r.BudgetMax != nil && *r.BudgetMin > *r.BudgetMax {
+		return fmt.Errorf("%w: budget_min exceeds budget_max", ErrInvalidLeadRequirement)
+	}
+
+	for _, rooms := range []*int32{r.RoomsMin, r.RoomsMax} {
+		if rooms != nil && (*rooms < 0 || *rooms > maxRequirementRooms) {
+			return fmt.Errorf("%w: rooms must be between 0 and %d", ErrInvalidLeadRequirement, maxRequirementRooms)
+		}
+	}
+	if r.RoomsMin != nil && r.RoomsMax != nil && *r.RoomsMin > *r.RoomsMax {
+		return fmt.Errorf("%w: rooms_min exceeds rooms_max", ErrInvalidLeadRequirement)
+	}
+
+	for _, area := range []*float64{r.AreaMin, r.AreaMax} {
+		if area != nil && *area <= 0 {
+			return fmt.Errorf("%w: area must be positive", ErrInvalidLeadRequirement)
+		}
+	}
+	if r.AreaMin != nil && r.AreaMax != nil && *r.AreaMin > *r.AreaMax {
+		return fmt.Errorf("%w: area_min exceeds area_max", ErrInvalidLeadRequirement)
+	}
+
+	for _, floor := range []*int32{r.FloorMin, r.FloorMax} {
+		if floor != nil && (*floor < -maxRequirementFloorAbs || *floor > maxRequirementFloorAbs) {
+			return fmt.Errorf("%w: floor out of range", ErrInvalidLeadRequirement)
+		}
+	}
+	if r.FloorMin != nil && r.FloorMax != nil && *r.FloorMin > *r.FloorMax {
+		return fmt.Errorf("%w: floor_min exceeds floor_max", ErrInvalidLeadRequirement)
+	}
+
+	if err := validateRequirementList("districts", r.Districts); err != nil {
+		return err
+	}
+	if err := validateRequirementList("must_have_features", r.MustHaveFeatures); err != nil {
+		return err
+	}
+
+	if r.PropertyType != nil {
+		switch *r.PropertyType {
+		case PropertyTypeApartment, PropertyTypeHouse, PropertyTypeCommercial, PropertyTypeLand:
+		default:
+			return fmt.Errorf("%w: unknown property_type %q", ErrInvalidLeadRequirement, *r.PropertyType)
+		}
+	}
+
+	return nil
+}
+
+func validateRequirementList(field string, items []string) error {
+	if len(items) > maxRequirementListLen {
+		return fmt.Errorf("%w: %s must contain at most %d items", ErrInvalidLeadRequirement, field, maxRequirementListLen)
+	}
+	for _, item := range items {
+		if strings.TrimSpace(item) == "" || len(item) > maxRequirementItemLen {
+			return fmt.Errorf("%w: %s items must be non-empty and at most %d bytes", ErrInvalidLeadRequirement, field, maxRequirementItemLen)
+		}
+	}
+	return nil
+}
+
+// TargetPrice — ориентир по цене для скоринга: середина бюджета или заданная граница.
+func (r LeadRequirement) TargetPrice() *int64 {
+	switch {
+	case r.BudgetMin != nil && r.BudgetMax != nil:
+		mid := (*r.BudgetMin + *r.BudgetMax) / 2
+		return &mid
+	case r.BudgetMax != nil:
+		return r.BudgetMax
+	default:
+		return r.BudgetMin
+	}
+}
+
+// TargetRooms — ориентир по количеству комнат: нижняя граница диапазона, если задана.
+func (r LeadRequirement) TargetRooms() *int32 {
+	if r.RoomsMin != nil {
+		return r.RoomsMin
+	}
+	return r.RoomsMax
+}
+
+// TargetArea — ориентир по площади: середина диапазона или заданная граница.
+func (r LeadRequirement) TargetArea() *float64 {
+	switch {
+	case r.AreaMin != nil && r.AreaMax != nil:
+		mid := (*r.AreaMin + *r.AreaMax) / 2
+		return &mid
+	case r.AreaMin != nil:
+		return r.AreaMin
+	default:
+		return r.AreaMax
+	}
+}
+
+// TargetDistrict — первый (приоритетный) район.
+func (r LeadRequirement) TargetDistrict() *string {
+	if len(r.Districts) == 0 {
+		return nil
+	}
+	d := r.Districts[0]
+	return &d
+}
+
+// SoftCriteria возвращает критерии матчинга по требованиям или nil, если критериев нет.
+func (r LeadRequirement) SoftCriteria() *SoftCriteria {
+	criteria := &SoftCriteria{
+		TargetPrice:    r.TargetPrice(),
+		TargetDistrict: r.TargetDistrict(),
+		TargetRooms:    r.TargetRooms(),
+		TargetArea:     r.TargetArea(),
+	}
+	// Особенности, которые видны на фотографиях, становятся визуальными пожеланиями
+	for _, f := range r.MustHaveFeatures {
+		if visualFlagIndex(f) < 0 {
+			continue
+		}
+		if criteria.Visual == nil {
+			criteria.Visual = &VisualPreferences{}
+		}
+		criteria.Visual.Features = append(criteria.Visual.Features, f)
+	}
+	if criteria.TargetPrice == nil && criteria.TargetDistrict == nil &&
+		criteria.TargetRooms == nil && criteria.TargetArea == nil && criteria.Visual.IsEmpty() {
+		return nil
+	}
+	return criteria
+}
+
+// ParseLeadRequirement разбирает JSON требований лида и проверяет его по схеме.
+// Документ с полем version разбирается строго (неизвестные поля — ошибка);
+// документ без version считается старым свободным форматом и конвертируется
+// через UpgradeLegacyRequirement. Пустой ввод — пустые требования.
+func ParseLeadRequirement(data []byte) (LeadRequirement, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return NewLeadRequirement(), nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return LeadRequirement{}, fmt.Errorf("%w: must be a JSON object", ErrInvalidLeadRequirement)
+	}
+
+	var req LeadRequirement
+	if _, ok := raw["version"]; ok {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			return LeadRequirement{}, fmt.Errorf("%w: %v", ErrInvalidLeadRequirement, err)
+		}
+	} else {
+		var legacy map[string]interface{}
+		if err := json.Unmarshal(data, &legacy); err != nil {
+			return LeadRequirement{}, fmt.Errorf("%w: %v", ErrInvalidLeadRequirement, err)
+		}
+		req = UpgradeLegacyRequirement(legacy)
+	}
+
+	if err := req.Validate(); err != nil {
+		return LeadRequirement{}, err
+	}
+	return req, nil
+}
+
+// UpgradeLegacyRequirement конвертирует требования в старом свободном формате
+// (price/preferredPrice, roomNumber/rooms, area, district, propertyType; числа бывают строками)
+// в текущую версию. Точные значения становятся диапазонами из одного значения,
+// цена — верхней границей бюджета. Неизвестные ключи отбрасываются.
+// Значения разбираются так же, как в миграции, переводящей leads.requirement на версию 1, но
+// значения, не проходящие проверку схемы (например, отрицательная цена), здесь сохраняются,
+// и ParseLeadRequirement отклоняет документ; миграция такие значения пропускает.
+func UpgradeLegacyRequirement(legacy map[string]interface{}) LeadRequirement {
+	req := NewLeadRequirement()
+
+	if price, ok := legacyNumber(legacy, "price", "preferredPrice"); ok {
+		p := int64(price)
+		req.BudgetMax = &p
+	}
+	if rooms, ok := legacyNumber(legacy, "roomNumber", "rooms"); ok {
+		r := int32(rooms)
+		req.RoomsMin, req.RoomsMax = &r, &r
+	}
+	if area, ok := legacyNumber(legacy, "area"); ok {
+		req.AreaMin, req.AreaMax = &area, &area
+	}
+	if district, ok := legacy["district"].(string); ok && strings.TrimSpace(district) != "" {
+		req.Districts = []string{strings.TrimSpace(district)}
+	}
+	for _, key := range []string{"propertyType", "property_type"} {
+		if pt, ok := legacy[key].(string); ok && pt != "" {
+			t := PropertyType(strings.ToUpper(pt))
+			req.PropertyType = &t
+			break
+		}
+	}
+
+	return req
+}
+
+// legacyNumberPattern — число в строке после удаления пробелов (как в миграции на версию 1).
+var legacyNumberPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// legacyNumber возвращает первое числовое значение по одному из ключей;
+// строки вида "8 000 000" тоже считаются числами.
+func legacyNumber(legacy map[string]interface{}, keys ...string) (float64, bool) {
+	for _, key := range keys {
+		switch v := legacy[key].(type) {
+		case float64:
+			return v, true
+		case string:
+			v = strings.Join(strings.Fields(v), "")
+			if !legacyNumberPattern.MatchString(v) {
+				continue
+			}
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return f, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Map возвращает требования в виде JSON-объекта для AI/ML сервисов или nil, если требований нет.
+func (r LeadRequirement) Map() map[string]interface{} {
+	if r.IsEmpty() {
+		return nil
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseLeadRequirement тестирует разбор и проверку требований лида.
+func TestParseLeadRequirement(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		check   func(t *testing.T, r LeadRequirement)
+	}{
+		{
+			name:  "empty input",
+			input: "",
+			check: func(t *testing.T, r LeadRequirement) {
+				if r.Version != LeadRequirementVersion || !r.IsEmpty() {
+					t.Errorf("expected empty requirement of current version, got %+v", r)
+				}
+			},
+		},
+		{
+			name:  "versioned document",
+			input: `{"version": 1, "budget_min": 5000000, "budget_max": 9000000, "rooms_min": 2, "rooms_max": 3, "districts": ["Центральный"], "must_have_features": ["парковка"], "deadline": "2026-03-01T00:00:00Z"}`,
+			check: func(t *testing.T, r LeadRequirement) {
+				if r.BudgetMax == nil || *r.BudgetMax != 9000000 || r.Deadline == nil || len(r.MustHaveFeatures) != 1 {
+					t.Errorf("unexpected requirement: %+v", r)
+				}
+			},
+		},
+		{
+			name:  "legacy document is upgraded",
+			input: `{"roomNumber": 3, "preferredPrice": "8 000 000", "district": "Центральный", "view": "панорамный"}`,
+			check: func(t *testing.T, r LeadRequirement) {
+				if r.Version != LeadRequirementVersion {
+					t.Errorf("expected version %d, got %d", LeadRequirementVersion, r.Version)
+				}
+				if r.BudgetMax == nil || *r.BudgetMax != 8000000 || r.BudgetMin != nil {
+					t.Errorf("expected budget_max 8000000, got %v", r.BudgetMax)
+				}
+				if r.RoomsMin == nil || *r.RoomsMin != 3 || r.RoomsMax == nil || *r.RoomsMax != 3 {
+					t.Errorf("expected rooms 3..3, got %v..%v", r.RoomsMin, r.RoomsMax)
+				}
+				if len(r.Districts) != 1 || r.Districts[0] != "Центральный" {
+					t.Errorf("expected district, got %v", r.Districts)
+				}
+			},
+		},
+		{
+			name:  "legacy non-decimal number is dropped",
+			input: `{"area": "NaN", "price": "1e6"}`,
+			check: func(t *testing.T, r LeadRequirement) {
+				if r.AreaMin != nil || r.BudgetMax != nil {
+					t.Errorf("expected area and price to be dropped, got %v, %v", r.AreaMin, r.BudgetMax)
+				}
+			},
+		},
+		{name: "unknown field in versioned document", input: `{"version": 1, "price": 100}`, wantErr: true},
+		{name: "unsupported version", input: `{"version": 2}`, wantErr: true},
+		{name: "not an object", input: `[1, 2]`, wantErr: true},
+		{name: "inverted budget", input: `{"version": 1, "budget_min": 10, "budget_max": 5}`, wantErr: true},
+		{name: "inverted rooms", input: `{"version": 1, "rooms_min": 4, "rooms_max": 2}`, wantErr: true},
+		{name: "non-positive area", input: `{"version": 1, "area_min": 0}`, wantErr: true},
+		{name: "empty district", input: `{"version": 1, "districts": [" "]}`, wantErr: true},
+		{name: "unknown property type", input: `{"version": 1, "property_type": "CASTLE"}`, wantErr: true},
+		{name: "legacy negative price", input: `{"price": -1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseLeadRequirement([]byte(tt.input))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidLeadRequirement) {
+					t.Fatalf("expected ErrInvalidLeadRequirement, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, r)
+		})
+	}
+}
+
+// TestLeadRequirement_SoftCriteria тестирует ориентиры для скоринга.
+func TestLeadRequirement_SoftCriteria(t *testing.T) {
+	if c := NewLeadRequirement().SoftCriteria(); c != nil {
+		t.Errorf("expected nil criteria for empty requirement, got %+v", c)
+	}
+
+	r := LeadRequirement{
+		Version:   LeadRequirementVersion,
+		BudgetMin: ptr[int64](6000000),
+		BudgetMax: ptr[int64](10000000),
+		RoomsMin:  ptr[int32](2),
+		RoomsMax:  ptr[int32](4),
+		AreaMax:   ptr(70.0),
+		Districts: []string{"Арбат", "Хамовники"},
+	}
+	c := r.SoftCriteria()
+	if c == nil {
+		t.Fatal("expected criteria")
+	}
+	if *c.TargetPrice != 8000000 {
+		t.Errorf("expected target price 8000000, got %d", *c.TargetPrice)
+	}
+	if *c.TargetRooms != 2 {
+		t.Errorf("expected target rooms 2, got %d", *c.TargetRooms)
+	}
+	if *c.TargetArea != 70 {
+		t.Errorf("expected target area 70, got %v", *c.TargetArea)
+	}
+	if *c.TargetDistrict != "Арбат" {
+		t.Errorf("expected first district, got %s", *c.TargetDistrict)
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "strings"
+
+// LeadSubscription — параметры потоковой подписки на новые и опубликованные лиды.
+type LeadSubscription struct {
+	// Filter — те же условия, что и в ListLeads (пагинация игнорируется)
+	Filter LeadFilter
+	// Embedding — вектор объекта, с которым сравниваются лиды (nil — без семантического фильтра)
+	Embedding []float32
+	// MinSimilarity — минимальное косинусное сходство лида с Embedding
+	MinSimilarity float64
+}
+
+// Matches — true, если лид удовлетворяет условиям фильтра (аналог WHERE в ListLeads).
+func (f LeadFilter) Matches(l Lead) bool {
+	if f.Status != nil && l.Status != *f.Status {
+		return false
+	}
+	if f.OwnerUserID != nil && l.OwnerUserID != *f.OwnerUserID {
+		return false
+	}
+	if f.CreatedUserID != nil && l.CreatedUserID != *f.CreatedUserID {
+		return false
+	}
+	if f.VisibleToUserID != nil && l.Status == LeadStatusNew &&
+		l.OwnerUserID != *f.VisibleToUserID && l.CreatedUserID != *f.VisibleToUserID {
+		return false
+	}
+	if f.City != nil && (l.City == nil || !strings.EqualFold(*l.City, *f.City)) {
+		return false
+	}
+	if f.PropertyType != nil && l.PropertyType != *f.PropertyType {
+		return false
+	}
+	return true
+}
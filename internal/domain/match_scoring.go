@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MatchScores — взвешенная оценка соответствия объекта критериям лида.
+// Используется в обе стороны матчинга: объекты для лида и лиды для объекта.
+type MatchScores struct {
+	Total       float64
+	Price       float64
+	District    float64
+	Rooms       float64
+	Area        float64
+	Semantic    float64
+	Visual      float64
+	Explanation string
+}
+
+// ScoreMatch вычисляет scores объекта p относительно критериев лида c.
+// similarity — косинусная близость embedding'ов лида и объекта.
+func ScoreMatch(p Property, similarity float64, w MatchWeights, c *SoftCriteria) MatchScores {
+	// Semantic score (косинусная близость уже 0-1)
+	semantic := similarity
+	if semantic < 0 {
+		semantic = (semantic + 1) / 2
+	}
+
+	s := MatchScores{
+		Price:    PriceScore(p.Price, c),
+		District: DistrictScore(p.Address, c),
+		Rooms:    RoomsScore(p.Rooms, c),
+		Area:     AreaScore(p.Area, c),
+		Semantic: semantic,
+		Visual:   VisualScore(p, c),
+	}
+	s.Total = w.Price*s.Price + w.District*s.District + w.Rooms*s.Rooms + w.Area*s.Area +
+		w.Semantic*s.Semantic + w.Visual*s.Visual
+	s.Explanation = explainMatch(p, s, c)
+
+	return s
+}
+
+// PriceScore — близость цены объекта к желаемой (допуск ±20% даёт 0.7-1.0).
+func PriceScore(objPrice *int64, c *SoftCriteria) float64 {
+	if objPrice == nil || c == nil || c.TargetPrice == nil {
+		return 0.5
+	}
+	target := float64(*c.TargetPrice)
+	price := float64(*objPrice)
+	if target == 0 {
+		return 0.5
+	}
+	dev := absFloat(price-target) / target * 100
+	if dev <= 20 {
+		return 1.0 - (dev/20)*0.3
+	}
+	return max(0.0, 0.7-(dev-20)/100*0.7)
+}
+
+// DistrictScore — 1.0 для желаемого района, 0.7 для предпочтительного, иначе 0.3.
+func DistrictScore(address string, c *SoftCriteria) float64 {
+	if c == nil || address == "" {
+		return 0.3
+	}
+	addrLower := strings.ToLower(address)
+	if c.TargetDistrict != nil {
+		if strings.Contains(addrLower, strings.ToLower(*c.TargetDistrict)) {
+			return 1.0
+		}
+	}
+	for _, pref := range c.PreferredDistricts {
+		if strings.Contains(addrLower, strings.ToLower(pref)) {
+			return 0.7
+		}
+	}
+	return 0.3
+}
+
+// RoomsScore — соответствие количества комнат желаемому.
+func RoomsScore(objRooms *int32, c *SoftCriteria) float64 {
+	if objRooms == nil || c == nil || c.TargetRooms == nil {
+		return 0.5
+	}
+	diff := *objRooms - *c.TargetRooms
+	if diff < 0 {
+		diff = -diff
+	}
+	switch diff {
+	case 0:
+		return 1.0
+	case 1:
+		return 0.6
+	case 2:
+		return 0.3
+	default:
+		return 0.1
+	}
+}
+
+// AreaScore — близость площади объекта к желаемой (допуск ±15% даёт 0.7-1.0).
+func AreaScore(objArea *float64, c *SoftCriteria) float64 {
+	if objArea == nil || c == nil || c.TargetArea == nil || *c.TargetArea == 0 {
+		return 0.5
+	}
+	dev := absFloat(*objArea-*c.TargetArea) / *c.TargetArea * 100
+	if dev <= 15 {
+		return 1.0 - (dev/15)*0.3
+	}
+	return max(0.0, 0.7-(dev-15)/50*0.7)
+}
+
+// explainMatch — человекочитаемое объяснение совпадения.
+func explainMatch(p Property, s MatchScores, c *SoftCriteria) string {
+	var parts []string
+	if s.Price >= 0.7 && p.Price != nil {
+		parts = append(parts, fmt.Sprintf("цена %d₽ подходит", *p.Price))
+	}
+	if s.District >= 0.7 {
+		parts = append(parts, "район подходит")
+	}
+	if s.Rooms >= 0.7 && p.Rooms != nil {
+		parts = append(parts, fmt.Sprintf("%d комн.", *p.Rooms))
+	}
+	if s.Area >= 0.7 && p.Area != nil {
+		parts = append(parts, fmt.Sprintf("%.0f м²", *p.Area))
+	}
+	if s.Semantic >= 0.6 {
+		parts = append(parts, "описание соответствует")
+	}
+	// Визуальная оценка объясняется только там, где лид высказал пожелания к фотографиям
+	if c != nil && !c.Visual.IsEmpty() && len(p.VisualFeatures) >= VisualFeaturesLen && s.Visual >= 0.7 {
+		parts = append(parts, explainVisual(p, c.Visual))
+	}
+	if len(parts) == 0 {
+		return "частичное совпадение"
+	}
+	return strings.Join(parts, "; ")
+}
+
+// explainVisual — какие визуальные пожелания подтверждают фотографии объекта.
+func explainVisual(p Property, v *VisualPreferences) string {
+	var parts []string
+	if v.MinQuality != nil {
+		quality := p.VisualFeatures[VisualQuality]
+		if p.AverageQualityScore != nil {
+			quality = *p.AverageQualityScore
+		}
+		if quality >= *v.MinQuality {
+			parts = append(parts, "ремонт")
+		}
+	}
+	if v.GoodView && p.VisualFeatures[VisualGoodView] > 0 {
+		parts = append(parts, "вид")
+	}
+	for _, name := range v.Features {
+		if i := visualFlagIndex(name); i >= 0 && p.VisualFeatures[VisualFlagsOffset+i] > 0 {
+			parts = append(parts, name)
+		}
+	}
+	if len(parts) == 0 {
+		return "по фото подходит"
+	}
+	return "по фото подходит: " + strings.Join(parts, ", ")
+}
+
+func absFloat(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestPriceScore тестирует расчёт score по цене.
+func TestPriceScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		objPrice    *int64
+		targetPrice *int64
+		wantMin     float64
+		wantMax     float64
+	}{
+		{
+			name:        "exact match",
+			objPrice:    ptr[int64](10000000),
+			targetPrice: ptr[int64](10000000),
+			wantMin:     0.95,
+			wantMax:     1.0,
+		},
+		{
+			name:        "10% deviation",
+			objPrice:    ptr[int64](11000000),
+			targetPrice: ptr[int64](10000000),
+			wantMin:     0.8,
+			wantMax:     0.95,
+		},
+		{
+			name:        "30% deviation",
+			objPrice:    ptr[int64](13000000),
+			targetPrice: ptr[int64](10000000),
+			wantMin:     0.5,
+			wantMax:     0.75,
+		},
+		{
+			name:        "nil target",
+			objPrice:    ptr[int64](10000000),
+			targetPrice: nil,
+			wantMin:     0.5,
+			wantMax:     0.5,
+		},
+		{
+			name:        "nil object price",
+			objPrice:    nil,
+			targetPrice: ptr[int64](10000000),
+			wantMin:     0.5,
+			wantMax:     0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var criteria *SoftCriteria
+			if tt.targetPrice != nil {
+				criteria = &SoftCriteria{TargetPrice: tt.targetPrice}
+			}
+			score := PriceScore(tt.objPrice, criteria)
+			if score < tt.wantMin || score > tt.wantMax {
+				t.Errorf("PriceScore() = %v, want between %v and %v", score, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+// TestRoomsScore тестирует расчёт score по комнатам.
+func TestRoomsScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		objRooms    *int32
+		targetRooms *int32
+		want        float64
+	}{
+		{"exact match", ptr[int32](3), ptr[int32](3), 1.0},
+		{"diff 1", ptr[int32](3), ptr[int32](2), 0.6},
+		{"diff 2", ptr[int32](4), ptr[int32](2), 0.3},
+		{"diff 3+", ptr[int32](5), ptr[int32](1), 0.1},
+		{"nil target", ptr[int32](3), nil, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var criteria *SoftCriteria
+			if tt.targetRooms != nil {
+				criteria = &SoftCriteria{TargetRooms: tt.targetRooms}
+			}
+			score := RoomsScore(tt.objRooms, criteria)
+			if score != tt.want {
+				t.Errorf("RoomsScore() = %v, want %v", score, tt.want)
+			}
+		})
+	}
+}
+
+// TestDistrictScore тестирует расчёт score по району.
+func TestDistrictScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		target  *string
+		prefs   []string
+		want    float64
+	}{
+		{"exact match", "Центральный район", ptr[string]("Центральный"), nil, 1.0},
+		{"in preferred", "Арбат, Москва", nil, []string{"Арбат", "Тверской"}, 0.7},
+		{"no match", "Бирюлёво", ptr[string]("Центр"), []string{"Арбат"}, 0.3},
+		{"empty address", "", ptr[string]("Центр"), nil, 0.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria := &SoftCriteria{
+				TargetDistrict:     tt.target,
+				PreferredDistricts: tt.prefs,
+			}
+			score := DistrictScore(tt.address, criteria)
+			if score != tt.want {
+				t.Errorf("DistrictScore() = %v, want %v", score, tt.want)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// visualProperty — объект с проанализированными фотографиями.
+func visualProperty(quality float64, goodView bool, flags ...string) Property {
+	features := make([]float64, VisualFeaturesLen)
+	features[VisualQuality] = quality
+	if goodView {
+		features[VisualGoodView] = 1
+	}
+	for _, f := range flags {
+		features[VisualFlagsOffset+visualFlagIndex(f)] = 1
+	}
+	return Property{VisualFeatures: features, AverageQualityScore: &quality}
+}
+
+// TestVisualScore тестирует оценку фотографий объекта по визуальным пожеланиям.
+func TestVisualScore(t *testing.T) {
+	wantsRenovationAndView := &SoftCriteria{Visual: &VisualPreferences{MinQuality: ptr(0.7), GoodView: true}}
+
+	tests := []struct {
+		name     string
+		property Property
+		criteria *SoftCriteria
+		wantMin  float64
+		wantMax  float64
+	}{
+		{
+			name:     "no preferences",
+			property: visualProperty(0.9, true),
+			criteria: &SoftCriteria{TargetPrice: ptr[int64](10000000)},
+			wantMin:  0.5,
+			wantMax:  0.5,
+		},
+		{
+			name:     "not analyzed",
+			property: Property{},
+			criteria: wantsRenovationAndView,
+			wantMin:  0.5,
+			wantMax:  0.5,
+		},
+		{
+			name:     "renovation and view match",
+			property: visualProperty(0.85, true),
+			criteria: wantsRenovationAndView,
+			wantMin:  1.0,
+			wantMax:  1.0,
+		},
+		{
+			name:     "poor renovation, no view",
+			property: visualProperty(0.3, false),
+			criteria: wantsRenovationAndView,
+			wantMin:  0.1,
+			wantMax:  0.3,
+		},
+		{
+			name:     "half of features",
+			property: visualProperty(0.5, false, "balcony"),
+			criteria: &SoftCriteria{Visual: &VisualPreferences{Features: []string{"balcony", "parking"}}},
+			wantMin:  0.5,
+			wantMax:  0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := VisualScore(tt.property, tt.criteria)
+			if score < tt.wantMin || score > tt.wantMax {
+				t.Errorf("VisualScore() = %v, want between %v and %v", score, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+// TestScoreMatch_Visual — визуальная оценка влияет на итог и попадает в объяснение.
+func TestScoreMatch_Visual(t *testing.T) {
+	criteria := &SoftCriteria{Visual: &VisualPreferences{MinQuality: ptr(0.7), GoodView: true}}
+	w := MatchWeights{Visual: 0.5, Semantic: 0.5}
+
+	good := ScoreMatch(visualProperty(0.9, true), 0.5, w, criteria)
+	poor := ScoreMatch(visualProperty(0.3, false), 0.5, w, criteria)
+
+	if good.Visual != 1.0 {
+		t.Errorf("good.Visual = %v, want 1", good.Visual)
+	}
+	if good.Total <= poor.Total {
+		t.Errorf("good.Total = %v, want > poor.Total = %v", good.Total, poor.Total)
+	}
+	if want := "по фото подходит: ремонт, вид"; !strings.Contains(good.Explanation, want) {
+		t.Errorf("Explanation = %q, want to contain %q", good.Explanation, want)
+	}
+	if strings.Contains(poor.Explanation, "по фото") {
+		t.Errorf("Explanation = %q, want no visual part", poor.Explanation)
+	}
+}
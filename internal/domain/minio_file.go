@@ -9,3 +9,21 @@ type OperationError struct {
 	ObjectID string
 	Error    error
 }
+
+// StoredFile — загруженный объект: ключ в бакете и временная ссылка на него.
+type StoredFile struct {
+	Key string
+	URL string
+	// ContentType и Size — проверенные тип и размер содержимого (заполняются для загрузок через uploads)
+	ContentType string
+	Size        int64
+	// Variants — копии изображения разных размеров (для загрузок через uploads)
+	Variants []ImageVariant
+}
+
+// ObjectInfo — метаданные объекта в хранилище.
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType — тип уведомления.
+type NotificationType string
+
+const (
+	NotificationTypeUnspecified      NotificationType = ""
+	NotificationTypeSavedSearchMatch NotificationType = "SAVED_SEARCH_MATCH" // Новый объект по сохранённому поиску
+)
+
+func (t NotificationType) String() string {
+	return string(t)
+}
+
+// Notification — уведомление пользователя.
+type Notification struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Type   NotificationType
+	Title  string
+	Body   string
+	// Payload — JSON с данными уведомления (например, saved_search_id и property_id)
+	Payload   []byte
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NotificationFilter — фильтр выборки уведомлений пользователя.
+type NotificationFilter struct {
+	UserID     uuid.UUID
+	UnreadOnly bool
+	Pagination *PaginationParams
+}
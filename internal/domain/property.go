@@ -23,6 +23,13 @@ type Property struct {
 	CreatedUserID uuid.UUID
 	// Embedding — векторное представление для матчинга (pgvector)
 	Embedding     []float32
+	// Images — галерея; заполняется только там, где её запрашивают явно
+	Images        []PropertyImage
+	// VisualFeatures — признаки галереи из анализа фотографий (см. VisualQuality и др.);
+	// nil, пока фотографии не проанализированы. Заполняются при чтении одного объекта и в матчинге
+	VisualFeatures []float64
+	// AverageQualityScore — средняя оценка отделки по фотографиям (0-1)
+	AverageQualityScore *float64
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -75,6 +82,8 @@ type PropertyFilter struct {
 	Status        *PropertyStatus
 	OwnerUserID   *uuid.UUID
 	CreatedUserID *uuid.UUID
+	// VisibleToUserID — ограничивает выборку объектами, видимыми пользователю (чужие NEW скрыты)
+	VisibleToUserID *uuid.UUID
 
 	// Пагинация
 	Pagination    *PaginationParams
@@ -91,6 +100,7 @@ type MatchedProperty struct {
 	RoomsScore       *float64
 	AreaScore        *float64
 	SemanticScore    *float64
+	VisualScore      *float64
 	MatchExplanation *string
 }
 
@@ -100,7 +110,8 @@ type MatchWeights struct {
 	District float64 `json:"district"` // Вес района (default: 0.25)
 	Rooms    float64 `json:"rooms"`    // Вес комнат (default: 0.20)
 	Area     float64 `json:"area"`     // Вес площади (default: 0.10)
-	Semantic float64 `json:"semantic"` // Вес семантики (default: 0.15)
+	Semantic float64 `json:"semantic"` // Вес семантики (default: 0.10)
+	Visual   float64 `json:"visual"`   // Вес визуальных признаков по фотографиям (default: 0.05)
 }
 
 // DefaultWeights возвращает веса по умолчанию.
@@ -110,13 +121,14 @@ func DefaultWeights() MatchWeights {
 		District: 0.25,
 		Rooms:    0.20,
 		Area:     0.10,
-		Semantic: 0.15,
+		Semantic: 0.10,
+		Visual:   0.05,
 	}
 }
 
 // Normalize нормализует веса чтобы сумма = 1.
 func (w MatchWeights) Normalize() MatchWeights {
-	total := w.Price + w.District + w.Rooms + w.Area + w.Semantic
+	total := w.Price + w.District + w.Rooms + w.Area + w.Semantic + w.Visual
 	if total <= 0 {
 		return DefaultWeights()
 	}
@@ -126,6 +138,7 @@ func (w MatchWeights) Normalize() MatchWeights {
 		Rooms:    w.Rooms / total,
 		Area:     w.Area / total,
 		Semantic: w.Semantic / total,
+		Visual:   w.Visual / total,
 	}
 }
 
@@ -136,6 +149,7 @@ type SoftCriteria struct {
 	TargetRooms        *int32   // Желаемое кол-во комнат
 	TargetArea         *float64 // Желаемая площадь
 	PreferredDistricts []string // Список предпочтительных районов
+	Visual             *VisualPreferences // Пожелания к состоянию и виду по фотографиям
 }
 
 // HardFilters — жёсткие фильтры для критических полей матчинга.
@@ -194,11 +208,12 @@ type WeightPreset struct {
 // GetWeightPresets возвращает предустановленные наборы весов.
 func GetWeightPresets() []WeightPreset {
 	return []WeightPreset{
-		{ID: "balanced", Name: "Сбалансированный", Description: "Равномерное распределение", Weights: MatchWeights{Price: 0.25, District: 0.25, Rooms: 0.20, Area: 0.15, Semantic: 0.15}},
+		{ID: "balanced", Name: "Сбалансированный", Description: "Равномерное распределение", Weights: MatchWeights{Price: 0.25, District: 0.25, Rooms: 0.20, Area: 0.10, Semantic: 0.15, Visual: 0.05}},
 		{ID: "budget_first", Name: "Бюджет важнее", Description: "Приоритет на цену", Weights: MatchWeights{Price: 0.45, District: 0.20, Rooms: 0.15, Area: 0.10, Semantic: 0.10}},
-		{ID: "location_first", Name: "Локация важнее", Description: "Приоритет на район", Weights: MatchWeights{Price: 0.20, District: 0.40, Rooms: 0.15, Area: 0.10, Semantic: 0.15}},
+		{ID: "location_first", Name: "Локация важнее", Description: "Приоритет на район", Weights: MatchWeights{Price: 0.20, District: 0.40, Rooms: 0.15, Area: 0.10, Semantic: 0.10, Visual: 0.05}},
 		{ID: "family", Name: "Для семьи", Description: "Комнаты и площадь", Weights: MatchWeights{Price: 0.20, District: 0.20, Rooms: 0.30, Area: 0.20, Semantic: 0.10}},
-		{ID: "semantic", Name: "Умный поиск", Description: "Приоритет на семантику", Weights: MatchWeights{Price: 0.15, District: 0.15, Rooms: 0.15, Area: 0.10, Semantic: 0.45}},
+		{ID: "semantic", Name: "Умный поиск", Description: "Приоритет на семантику", Weights: MatchWeights{Price: 0.15, District: 0.15, Rooms: 0.15, Area: 0.10, Semantic: 0.35, Visual: 0.10}},
+		{ID: "visual_first", Name: "Состояние и вид", Description: "Приоритет на ремонт и вид по фотографиям", Weights: MatchWeights{Price: 0.20, District: 0.15, Rooms: 0.15, Area: 0.10, Semantic: 0.10, Visual: 0.30}},
 	}
 }
 
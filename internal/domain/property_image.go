@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PropertyImage — фотография из галереи объекта недвижимости.
+type PropertyImage struct {
+	ID         uuid.UUID
+	PropertyID uuid.UUID
+	// StorageKey — ключ объекта в MinIO
+	StorageKey string
+	// URL — временная ссылка на скачивание; заполняется при чтении, в БД не хранится
+	URL string
+	// Position — место в галерее, начиная с 0; первая фотография — обложка
+	Position  int
+	CreatedAt time.Time
+	// Analysis — результат компьютерного зрения; nil, пока фотография не проанализирована
+	Analysis *ImageAnalysis
+	// Variants — копии фотографии разных размеров; пусто для файлов, загруженных до обработки
+	Variants []ImageVariant
+}
+
+// ImageFeature — особенность, найденная на фотографии (балкон, панорамные окна и т.д.).
+type ImageFeature struct {
+	Name       string
+	Confidence float64
+	// Category — interior, exterior, view, amenity, premium
+	Category string
+}
+
+// ImageAnalysis — результат анализа одной фотографии, хранится в property_images.
+type ImageAnalysis struct {
+	DetectedFeatures []ImageFeature
+	RoomType         string
+	// QualityScore — оценка качества отделки (0-1)
+	QualityScore float64
+	ViewType     string
+	// Brightness — уровень освещённости (0-1)
+	Brightness float64
+	Tags       map[string]string
+	Confidence float64
+	// VisualFeatures — 16 признаков фотографии для эмбеддинга
+	VisualFeatures []float64
+	AnalyzedAt     time.Time
+}
+
+// ImageAnalysisStatus — состояние анализа галереи объекта.
+type ImageAnalysisStatus string
+
+const (
+	// ImageAnalysisNone — анализ ещё не запускался.
+	ImageAnalysisNone ImageAnalysisStatus = ""
+	// ImageAnalysisPending — анализ поставлен в очередь фонового воркера.
+	ImageAnalysisPending ImageAnalysisStatus = "PENDING"
+	// ImageAnalysisProcessing — воркер анализирует фотографии.
+	ImageAnalysisProcessing ImageAnalysisStatus = "PROCESSING"
+	// ImageAnalysisDone — все фотографии галереи проанализированы.
+	ImageAnalysisDone ImageAnalysisStatus = "DONE"
+	// ImageAnalysisFailed — часть фотографий проанализировать не удалось, см. Error.
+	ImageAnalysisFailed ImageAnalysisStatus = "FAILED"
+)
+
+// ImageAnalysisState — состояние анализа галереи, хранится в properties.
+type ImageAnalysisState struct {
+	Status     ImageAnalysisStatus
+	Error      string
+	AnalyzedAt *time.Time
+}
+
+// PropertyImageAnalysis — анализ галереи объекта: агрегаты по проанализированным фотографиям
+// и результаты по каждой фотографии.
+type PropertyImageAnalysis struct {
+	PropertyID uuid.UUID
+	ImageAnalysisState
+	// TotalImages — число проанализированных фотографий
+	TotalImages int
+	// PendingImages — фотографии галереи, которые ещё ждут анализа
+	PendingImages     int
+	AverageQuality    float64
+	DetectedRooms     []string
+	AllFeatures       []ImageFeature
+	ViewTypes         []string
+	OverallAssessment string
+	// VisualFeatures — 16 признаков галереи для эмбеддинга, хранятся в properties.visual_features
+	VisualFeatures []float64
+	Images         []PropertyImage
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReindexOptions — параметры массовой переиндексации embedding.
+type ReindexOptions struct {
+	// EntityType — какие сущности переиндексировать; пустое значение — лиды и объекты.
+	EntityType EmbeddingEntityType
+	// OnlyMissing — только записи без embedding.
+	OnlyMissing bool
+	// Since — только записи, изменённые начиная с этого момента.
+	Since *time.Time
+	// BatchSize — число сущностей в одном запросе ReindexBatch.
+	BatchSize int
+	// Concurrency — сколько запросов ReindexBatch выполняется одновременно.
+	Concurrency int
+	// Shadow — заполнить теневую колонку векторами целевой модели идущей миграции.
+	Shadow bool
+}
+
+// ReindexFilter — выборка одной keyset-страницы для переиндексации (по возрастанию ID).
+type ReindexFilter struct {
+	AfterID     *uuid.UUID
+	OnlyMissing bool
+	Shadow      bool
+	Since       *time.Time
+	Limit       int
+}
+
+// ReindexProgress — прогресс переиндексации одного типа сущностей.
+type ReindexProgress struct {
+	EntityType EmbeddingEntityType
+	// Total — сколько записей попало под фильтр на момент старта.
+	Total int
+	// Processed — сколько записей отправлено в ML сервис.
+	Processed int
+	Updated   int
+	Failed    int
+	Done      bool
+}
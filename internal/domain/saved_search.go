@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavedSearch — сохранённый поиск объектов, который периодически перезапускается планировщиком.
+// Ищет либо по лиду (векторный матчинг), либо только по фильтру объектов.
+type SavedSearch struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Name   string
+	// LeadID — лид для матчинга; nil — поиск только по Filter
+	LeadID *uuid.UUID
+	// Filter — фильтр объектов (учитываются город, тип, статус, цена и комнаты)
+	Filter PropertyFilter
+	// WeightPreset — ID пресета весов (см. GetWeightPresets); nil — веса по умолчанию
+	WeightPreset *string
+	// Limit — сколько лучших объектов учитывать при каждом запуске
+	Limit     int
+	Enabled   bool
+	LastRunAt *time.Time
+	// BaselineAt — когда записана исходная выдача; nil — первый успешный запуск ещё впереди
+	BaselineAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DefaultSavedSearchLimit — количество объектов в выдаче сохранённого поиска по умолчанию.
+const DefaultSavedSearchLimit = 20
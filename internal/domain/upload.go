@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UploadStatus — состояние загрузки файла в хранилище.
+type UploadStatus string
+
+const (
+	// UploadPending — ссылка на загрузку выдана, файл ещё не подтверждён.
+	UploadPending UploadStatus = "PENDING"
+	// UploadCompleted — файл загружен, тип и размер проверены.
+	UploadCompleted UploadStatus = "COMPLETED"
+)
+
+// UploadContentTypes — допустимые типы загружаемых файлов: имя типа в API → MIME.
+var UploadContentTypes = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"webp": "image/webp",
+}
+
+// Upload — файл, загружаемый в хранилище мимо gRPC: по ссылке из CreateUploadURL
+// или потоком UploadFileStream.
+type Upload struct {
+	ID     uuid.UUID
+	Key    string
+	UserID uuid.UUID
+	// FileName — имя файла у клиента (в ключ объекта не входит)
+	FileName string
+	// ContentType и Size — заявленные при выдаче ссылки, после проверки — реальные
+	ContentType string
+	Size        int64
+	Status      UploadStatus
+	CreatedAt   time.Time
+	// ExpiresAt — до какого момента действует ссылка на загрузку
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+	// Variants — копии изображения, построенные при подтверждении загрузки
+	Variants []ImageVariant
+}
+
+// UploadTicket — ссылка на загрузку файла напрямую в хранилище.
+type UploadTicket struct {
+	Upload
+	// URL — presigned PUT
+	URL string
+	// Headers — заголовки, которые нужно передать в PUT без изменений (входят в подпись)
+	Headers map[string]string
+}
@@ -18,6 +18,8 @@ type User struct {
 	AvatarURL    *string
 	Role         UserRole
 	Status       UserStatus
+	// TokenVersion — версия токенов; access-токены с меньшей версией считаются отозванными
+	TokenVersion int
 	CreatedAt    time.Time
 }
 
@@ -59,3 +61,69 @@ type UserFilter struct {
 	Role       *UserRole
 	Status     *UserStatus
 }
+
+// RefreshToken — refresh-токен сессии пользователя на конкретном устройстве.
+// Сам токен не хранится, только его хеш.
+type RefreshToken struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	TokenHash  string
+	Device     string
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *uuid.UUID
+	CreatedAt  time.Time
+}
+
+// IsActive — true, если токен не отозван и не истёк на момент now.
+func (t RefreshToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// TokenPair — пара токенов, выдаваемая при входе и обновлении сессии.
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// LoginClient — откуда пришла попытка входа: IP и User-Agent из метаданных запроса.
+type LoginClient struct {
+	IP        string
+	UserAgent string
+}
+
+// LoginFailureReason — причина неудачной попытки входа в журнале.
+type LoginFailureReason string
+
+const (
+	LoginFailureInvalidCredentials LoginFailureReason = "invalid_credentials"
+	LoginFailureLocked             LoginFailureReason = "locked"
+	LoginFailureIPBlocked          LoginFailureReason = "ip_blocked"
+	LoginFailureBanned             LoginFailureReason = "banned"
+)
+
+// LoginAttempt — запись журнала попыток входа.
+type LoginAttempt struct {
+	ID            uuid.UUID
+	Email         string
+	UserID        *uuid.UUID
+	Client        LoginClient
+	Success       bool
+	FailureReason LoginFailureReason
+	CreatedAt     time.Time
+}
+
+// LoginLockout — неудачные попытки входа подряд по email и блокировка, если она назначена.
+type LoginLockout struct {
+	Email        string
+	FailedCount  int
+	LastFailedAt time.Time
+	LockedUntil  *time.Time
+}
+
+// IsLocked — true, если вход по email заблокирован на момент now.
+func (l LoginLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
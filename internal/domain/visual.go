@@ -0,0 +1,111 @@
+package domain
+
+// Индексы признаков в visual_features (vector(16)) объекта и фотографии.
+const (
+	// VisualQuality — средняя оценка отделки (0-1)
+	VisualQuality = 0
+	// VisualRoomTypes — число типов помещений на фотографиях / 10
+	VisualRoomTypes = 1
+	// VisualPremium — число премиум-особенностей / 5
+	VisualPremium = 2
+	// VisualGoodView — 1, если на фотографиях хороший вид (парк, река, панорама)
+	VisualGoodView = 3
+	// VisualFlagsOffset — с этого индекса идут флаги VisualFeatureFlags
+	VisualFlagsOffset = 4
+	// VisualFeaturesLen — размерность visual_features
+	VisualFeaturesLen = VisualFlagsOffset + 12
+)
+
+// VisualFeatureFlags — особенности, наличие которых записано в visual_features[VisualFlagsOffset:].
+var VisualFeatureFlags = []string{
+	"balcony", "terrace", "panoramic_windows", "high_ceilings",
+	"modern_kitchen", "master_bedroom", "walk_in_closet", "bathroom_modern",
+	"parking", "security", "gym", "pool",
+}
+
+// GoodViewTypes — виды из окна, которые считаются хорошими.
+var GoodViewTypes = []string{"park", "river", "panorama"}
+
+// VisualPreferences — пожелания лида, которые проверяются по фотографиям объекта
+// («свежий ремонт», «вид на парк», «с балконом»).
+type VisualPreferences struct {
+	// MinQuality — ожидаемая оценка отделки (0-1); nil — не важна
+	MinQuality *float64
+	// GoodView — нужен хороший вид из окна
+	GoodView bool
+	// Features — желаемые особенности из VisualFeatureFlags
+	Features []string
+}
+
+// IsEmpty — true, если пожеланий нет.
+func (v *VisualPreferences) IsEmpty() bool {
+	return v == nil || (v.MinQuality == nil && !v.GoodView && len(v.Features) == 0)
+}
+
+// VisualScore — насколько фотографии объекта соответствуют визуальным пожеланиям лида:
+// среднее по заданным пожеланиям (качество отделки, вид, особенности). Если пожеланий нет
+// или фотографии объекта ещё не проанализированы, возвращает нейтральные 0.5.
+func VisualScore(p Property, c *SoftCriteria) float64 {
+	if c == nil || c.Visual.IsEmpty() || len(p.VisualFeatures) < VisualFeaturesLen {
+		return 0.5
+	}
+	v := c.Visual
+
+	var total float64
+	var parts int
+
+	if v.MinQuality != nil {
+		quality := p.VisualFeatures[VisualQuality]
+		if p.AverageQualityScore != nil {
+			quality = *p.AverageQualityScore
+		}
+		// Ниже ожидания — линейный штраф: на 0.5 хуже ожидания — 0
+		if quality >= *v.MinQuality {
+			total += 1.0
+		} else {
+			total += max(0.0, 1.0-(*v.MinQuality-quality)*2)
+		}
+		parts++
+	}
+
+	if v.GoodView {
+		if p.VisualFeatures[VisualGoodView] > 0 {
+			total += 1.0
+		} else {
+			total += 0.2
+		}
+		parts++
+	}
+
+	if len(v.Features) > 0 {
+		var found, known int
+		for _, name := range v.Features {
+			i := visualFlagIndex(name)
+			if i < 0 {
+				continue
+			}
+			known++
+			if p.VisualFeatures[VisualFlagsOffset+i] > 0 {
+				found++
+			}
+		}
+		if known > 0 {
+			total += float64(found) / float64(known)
+			parts++
+		}
+	}
+
+	if parts == 0 {
+		return 0.5
+	}
+	return total / float64(parts)
+}
+
+func visualFlagIndex(name string) int {
+	for i, flag := range VisualFeatureFlags {
+		if flag == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -2,12 +2,26 @@ package authgrpc
 
 import (
 	"context"
+	"lead_exchange/internal/lib/health"
 	pb "lead_exchange/pkg"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// HealthCheck — проверка доступности сервера.
-func (s *authServer) HealthCheck(ctx context.Context, _ *emptypb.Empty) (*pb.HealthCheckResponse, error) {
-	return &pb.HealthCheckResponse{Status: "ok"}, nil
+// HealthCheck — доступность сервера и его зависимостей по последнему прогону проверок.
+// Метод не требует токена, поэтому сам проверки не запускает.
+// Без HealthReporter отвечает статически, что сервер принимает запросы.
+func (s *authServer) HealthCheck(_ context.Context, _ *emptypb.Empty) (*pb.HealthCheckResponse, error) {
+	if s.health == nil {
+		return &pb.HealthCheckResponse{Status: health.StatusOK}, nil
+	}
+
+	report := s.health.Last()
+
+	checks := make(map[string]string, len(report.Checks))
+	for name, res := range report.Checks {
+		checks[name] = res.Status
+	}
+
+	return &pb.HealthCheckResponse{Status: report.Status, Checks: checks}, nil
 }
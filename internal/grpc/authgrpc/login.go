@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
 	"lead_exchange/internal/services/user"
 	pb "lead_exchange/pkg"
+	"time"
 
 	"lead_exchange/internal/repository"
 
@@ -19,11 +22,21 @@ func (s *authServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.AuthRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	_, token, err := s.authService.Login(ctx, in.GetEmail(), in.GetPassword())
+	client := domain.LoginClient{
+		IP:        middleware.ClientIP(ctx),
+		UserAgent: middleware.UserAgent(ctx),
+	}
+
+	_, tokens, err := s.authService.Login(ctx, in.GetEmail(), in.GetPassword(), in.GetDevice(), client)
 	if err != nil {
+		var locked *user.LockedError
 		switch {
+		case errors.As(err, &locked):
+			return nil, middleware.RetryAfterError(ctx, time.Until(locked.Until), "too many failed login attempts")
 		case errors.Is(err, user.ErrInvalidCredentials):
 			return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+		case errors.Is(err, user.ErrUserBanned):
+			return nil, status.Error(codes.PermissionDenied, "user is banned")
 		case errors.Is(err, repository.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
 		default:
@@ -31,5 +44,5 @@ func (s *authServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.AuthRe
 		}
 	}
 
-	return &pb.AuthResponse{Token: token}, nil
+	return tokenPairToProto(tokens), nil
 }
@@ -0,0 +1,35 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/user"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Logout — завершение сессии текущего устройства или всех сессий пользователя.
+func (s *authServer) Logout(ctx context.Context, in *pb.LogoutRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if err := s.authService.Logout(ctx, userID, in.GetRefreshToken(), in.GetAllDevices()); err != nil {
+		if errors.Is(err, user.ErrInvalidRefreshToken) {
+			return nil, status.Error(codes.InvalidArgument, "refresh_token is required unless all_devices is set")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to logout: %v", err))
+	}
+
+	return &emptypb.Empty{}, nil
+}
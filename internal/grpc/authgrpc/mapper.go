@@ -0,0 +1,15 @@
+package authgrpc
+
+import (
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+)
+
+func tokenPairToProto(p domain.TokenPair) *pb.AuthResponse {
+	return &pb.AuthResponse{
+		Token:                 p.AccessToken,
+		RefreshToken:          p.RefreshToken,
+		TokenExpiresAt:        p.AccessTokenExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		RefreshTokenExpiresAt: p.RefreshTokenExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,33 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/user"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RefreshToken — обновление пары токенов по refresh-токену.
+func (s *authServer) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.AuthResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := s.authService.RefreshToken(ctx, in.GetRefreshToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, user.ErrInvalidRefreshToken):
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+		case errors.Is(err, user.ErrUserBanned):
+			return nil, status.Error(codes.PermissionDenied, "user is banned")
+		default:
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to refresh token: %v", err))
+		}
+	}
+
+	return tokenPairToProto(tokens), nil
+}
@@ -2,6 +2,8 @@ package authgrpc
 
 import (
 	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/health"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -11,7 +13,16 @@ import (
 // AuthService описывает бизнес-логику авторизации и регистрации.
 type AuthService interface {
 	Register(ctx context.Context, email, password, firstName, lastName string) (uuid.UUID, error)
-	Login(ctx context.Context, email, password string) (uuid.UUID, string, error)
+	Login(ctx context.Context, email, password, device string, client domain.LoginClient) (uuid.UUID, domain.TokenPair, error)
+	RefreshToken(ctx context.Context, refreshToken string) (domain.TokenPair, error)
+	Logout(ctx context.Context, userID uuid.UUID, refreshToken string, allDevices bool) error
+	GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// HealthReporter — проверка зависимостей сервера (health.Checker).
+type HealthReporter interface {
+	// Last — отчёт последнего периодического прогона проверок
+	Last() health.Report
 }
 
 // authServer реализует gRPC AuthServiceServer.
@@ -19,11 +30,26 @@ type authServer struct {
 	pb.UnimplementedAuthServiceServer
 
 	authService AuthService
+	health      HealthReporter
+}
+
+// ServerOption — опция для конфигурации сервера.
+type ServerOption func(*authServer)
+
+// WithHealthReporter включает реальную проверку зависимостей в HealthCheck.
+func WithHealthReporter(h HealthReporter) ServerOption {
+	return func(s *authServer) {
+		s.health = h
+	}
 }
 
 // RegisterAuthServerGRPC регистрирует AuthServiceServer в gRPC сервере.
-func RegisterAuthServerGRPC(server *grpc.Server, authSvc AuthService) {
-	pb.RegisterAuthServiceServer(server, &authServer{
+func RegisterAuthServerGRPC(server *grpc.Server, authSvc AuthService, opts ...ServerOption) {
+	s := &authServer{
 		authService: authSvc,
-	})
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	pb.RegisterAuthServiceServer(server, s)
 }
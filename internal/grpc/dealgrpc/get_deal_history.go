@@ -0,0 +1,45 @@
+package dealgrpc
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDealHistory — история изменения статусов сделки.
+func (s *dealServer) GetDealHistory(ctx context.Context, in *pb.GetDealHistoryRequest) (*pb.GetDealHistoryResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.checkUserStatus(ctx); err != nil {
+		return nil, err
+	}
+
+	dealID, err := uuid.Parse(in.DealId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid deal_id: %v", err))
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	history, err := s.dealService.GetDealHistory(ctx, dealID, userID)
+	if err != nil {
+		return nil, dealErrorToStatus(err, "failed to get deal history")
+	}
+
+	protoHistory := make([]*pb.DealStatusChange, len(history))
+	for i, change := range history {
+		protoHistory[i] = dealStatusChangeDomainToProto(change)
+	}
+
+	return &pb.GetDealHistoryResponse{History: protoHistory}, nil
+}
@@ -1,10 +1,15 @@
 package dealgrpc
 
 import (
+	"errors"
+	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/deal"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func dealDomainToProto(d domain.Deal) *pb.Deal {
@@ -25,6 +30,15 @@ func dealDomainToProto(d domain.Deal) *pb.Deal {
 	}
 }
 
+func dealStatusChangeDomainToProto(c domain.DealStatusChange) *pb.DealStatusChange {
+	return &pb.DealStatusChange{
+		FromStatus:      dealStatusDomainToProto(c.FromStatus),
+		ToStatus:        dealStatusDomainToProto(c.ToStatus),
+		ChangedByUserId: c.ChangedByUserID.String(),
+		CreatedAt:       c.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
 func dealStatusDomainToProto(s domain.DealStatus) pb.DealStatus {
 	switch s {
 	case domain.DealStatusPending:
@@ -59,6 +73,21 @@ func protoDealStatusToDomain(s pb.DealStatus) domain.DealStatus {
 	}
 }
 
+// dealErrorToStatus переводит ошибки state machine сделки в gRPC-коды.
+func dealErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, deal.ErrDealNotFound):
+		return status.Error(codes.NotFound, fmt.Sprintf("deal not found: %v", err))
+	case errors.Is(err, deal.ErrNotDealParticipant), errors.Is(err, deal.ErrTransitionNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, deal.ErrInvalidStatusTransition), errors.Is(err, deal.ErrPriceChangeNotAllowed),
+		errors.Is(err, deal.ErrLeadAlreadyPurchased):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
 func parseUUID(s string) (uuid.UUID, error) {
 	if s == "" {
 		return uuid.Nil, nil
@@ -38,26 +38,15 @@ func (s *dealServer) UpdateDeal(ctx context.Context, in *pb.UpdateDealRequest) (
 		update.Price = in.Price
 	}
 
-	// Проверяем права доступа: только продавец или покупатель могут обновлять сделку
 	userID, ok := middleware.FromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user not found in context")
 	}
 
-	// Получаем текущую сделку для проверки прав
-	currentDeal, err := s.dealService.GetDeal(ctx, dealID)
+	// Права сторон и допустимость перехода проверяет state machine в сервисе
+	deal, err := s.dealService.UpdateDeal(ctx, dealID, userID, update)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("deal not found: %v", err))
-	}
-
-	// Проверяем, что пользователь является продавцом или покупателем
-	if currentDeal.SellerUserID != userID && (currentDeal.BuyerUserID == nil || *currentDeal.BuyerUserID != userID) {
-		return nil, status.Error(codes.PermissionDenied, "only seller or buyer can update deal")
-	}
-
-	deal, err := s.dealService.UpdateDeal(ctx, dealID, update)
-	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update deal: %v", err))
+		return nil, dealErrorToStatus(err, "failed to update deal")
 	}
 
 	return &pb.DealResponse{Deal: dealDomainToProto(deal)}, nil
@@ -0,0 +1,44 @@
+package embeddinggrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListEmbeddingJobs — задания outbox генерации embedding.
+func (s *serverAPI) ListEmbeddingJobs(ctx context.Context, in *pb.ListEmbeddingJobsRequest) (*pb.ListEmbeddingJobsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := domain.EmbeddingJobFilter{
+		Pagination: &domain.PaginationParams{
+			PageSize:  in.GetPageSize(),
+			PageToken: in.GetPageToken(),
+		},
+	}
+	if in.Status != nil && *in.Status != pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED {
+		st := protoJobStatusToDomain(*in.Status)
+		filter.Status = &st
+	}
+	if in.EntityType != nil && *in.EntityType != pb.EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED {
+		et := protoEntityTypeToDomain(*in.EntityType)
+		filter.EntityType = &et
+	}
+
+	result, err := s.embeddingService.ListJobs(ctx, filter)
+	if err != nil {
+		return nil, embeddingErrorToStatus(err, "failed to list embedding jobs")
+	}
+
+	resp := &pb.ListEmbeddingJobsResponse{NextPageToken: result.NextPageToken}
+	for _, j := range result.Items {
+		resp.Jobs = append(resp.Jobs, embeddingJobDomainToProto(j))
+	}
+
+	return resp, nil
+}
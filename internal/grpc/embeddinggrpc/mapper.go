@@ -0,0 +1,101 @@
+package embeddinggrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/embedding"
+	pb "lead_exchange/pkg"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func embeddingJobDomainToProto(j domain.EmbeddingJob) *pb.EmbeddingJob {
+	return &pb.EmbeddingJob{
+		JobId:      j.ID.String(),
+		EntityType: entityTypeDomainToProto(j.EntityType),
+		EntityId:   j.EntityID.String(),
+		Status:     jobStatusDomainToProto(j.Status),
+		Attempts:   int32(j.Attempts),
+		LastError:  lo.FromPtr(j.LastError),
+		NextRunAt:  j.NextRunAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:  j.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  j.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func reindexProgressDomainToProto(p domain.ReindexProgress) *pb.ReindexProgress {
+	return &pb.ReindexProgress{
+		EntityType: entityTypeDomainToProto(p.EntityType),
+		Total:      int32(p.Total),
+		Processed:  int32(p.Processed),
+		Updated:    int32(p.Updated),
+		Failed:     int32(p.Failed),
+		Done:       p.Done,
+	}
+}
+
+func jobStatusDomainToProto(s domain.EmbeddingJobStatus) pb.EmbeddingJobStatus {
+	switch s {
+	case domain.EmbeddingJobStatusPending:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PENDING
+	case domain.EmbeddingJobStatusProcessing:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PROCESSING
+	case domain.EmbeddingJobStatusDead:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DEAD
+	default:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func protoJobStatusToDomain(s pb.EmbeddingJobStatus) domain.EmbeddingJobStatus {
+	switch s {
+	case pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PENDING:
+		return domain.EmbeddingJobStatusPending
+	case pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PROCESSING:
+		return domain.EmbeddingJobStatusProcessing
+	case pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DEAD:
+		return domain.EmbeddingJobStatusDead
+	default:
+		return domain.EmbeddingJobStatusUnspecified
+	}
+}
+
+func entityTypeDomainToProto(t domain.EmbeddingEntityType) pb.EmbeddingEntityType {
+	switch t {
+	case domain.EmbeddingEntityLead:
+		return pb.EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_LEAD
+	case domain.EmbeddingEntityProperty:
+		return pb.EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_PROPERTY
+	default:
+		return pb.EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED
+	}
+}
+
+func protoEntityTypeToDomain(t pb.EmbeddingEntityType) domain.EmbeddingEntityType {
+	switch t {
+	case pb.EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_LEAD:
+		return domain.EmbeddingEntityLead
+	case pb.EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_PROPERTY:
+		return domain.EmbeddingEntityProperty
+	default:
+		return domain.EmbeddingEntityUnspecified
+	}
+}
+
+// embeddingErrorToStatus переводит ошибки сервиса в gRPC-коды.
+func embeddingErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, embedding.ErrJobNotFound):
+		return status.Error(codes.NotFound, "embedding job not found in dead-letter")
+	case errors.Is(err, embedding.ErrInvalidReindexOptions):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "reindex canceled")
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
@@ -0,0 +1,45 @@
+package embeddinggrpc
+
+import (
+	"fmt"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReindexAll — массовая переиндексация embedding с потоком прогресса.
+func (s *serverAPI) ReindexAll(in *pb.ReindexAllRequest, stream grpc.ServerStreamingServer[pb.ReindexProgress]) error {
+	if err := in.ValidateAll(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opts := domain.ReindexOptions{
+		EntityType:  protoEntityTypeToDomain(in.GetEntityType()),
+		OnlyMissing: in.GetOnlyMissing(),
+		BatchSize:   int(in.GetBatchSize()),
+		Concurrency: int(in.GetConcurrency()),
+		Shadow:      in.GetShadow(),
+	}
+	if in.Since != nil {
+		since, err := time.Parse(time.RFC3339, in.GetSince())
+		if err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid since: %v", err))
+		}
+		opts.Since = &since
+	}
+
+	// Прогресс вызывается последовательно, поэтому Send не пересекается.
+	// Ошибка отправки означает разрыв соединения, после чего отменяется и контекст потока.
+	_, err := s.embeddingService.ReindexAll(stream.Context(), opts, func(p domain.ReindexProgress) {
+		_ = stream.Send(reindexProgressDomainToProto(p))
+	})
+	if err != nil {
+		return embeddingErrorToStatus(err, "failed to reindex embeddings")
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package embeddinggrpc
+
+import (
+	"context"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryEmbeddingJob — вернуть задание из dead-letter в очередь.
+func (s *serverAPI) RetryEmbeddingJob(ctx context.Context, in *pb.RetryEmbeddingJobRequest) (*pb.EmbeddingJobResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	jobID, err := uuid.Parse(in.GetJobId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job_id format")
+	}
+
+	job, err := s.embeddingService.RetryJob(ctx, jobID)
+	if err != nil {
+		return nil, embeddingErrorToStatus(err, "failed to retry embedding job")
+	}
+
+	return &pb.EmbeddingJobResponse{Job: embeddingJobDomainToProto(job)}, nil
+}
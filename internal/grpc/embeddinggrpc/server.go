@@ -0,0 +1,29 @@
+package embeddinggrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// EmbeddingService описывает администрирование outbox генерации embedding.
+type EmbeddingService interface {
+	ListJobs(ctx context.Context, filter domain.EmbeddingJobFilter) (*domain.PaginatedResult[domain.EmbeddingJob], error)
+	RetryJob(ctx context.Context, jobID uuid.UUID) (domain.EmbeddingJob, error)
+	ReindexAll(ctx context.Context, opts domain.ReindexOptions, progress func(domain.ReindexProgress)) ([]domain.ReindexProgress, error)
+}
+
+// serverAPI реализует gRPC EmbeddingServiceServer.
+// Доступ только для администраторов ограничивается через middleware.MethodRoles.
+type serverAPI struct {
+	pb.UnimplementedEmbeddingServiceServer
+	embeddingService EmbeddingService
+}
+
+// RegisterEmbeddingServerGRPC регистрирует EmbeddingServiceServer в gRPC сервере.
+func RegisterEmbeddingServerGRPC(server *grpc.Server, svc EmbeddingService) {
+	pb.RegisterEmbeddingServiceServer(server, &serverAPI{embeddingService: svc})
+}
@@ -1,22 +1,46 @@
 package filegrpc
 
 import (
-	minio "lead_exchange/internal/lib/minio/core"
+	"context"
+	"io"
+	"lead_exchange/internal/domain"
 	pb "lead_exchange/pkg"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 )
 
+// UploadService описывает загрузку файлов мимо unary gRPC: по ссылке и потоком.
+type UploadService interface {
+	CreateUploadURL(ctx context.Context, userID uuid.UUID, fileName, contentType string, size int64) (domain.UploadTicket, error)
+	CompleteUpload(ctx context.Context, userID uuid.UUID, key string) (domain.StoredFile, error)
+	Upload(ctx context.Context, userID uuid.UUID, fileName, contentType string, size int64, r io.Reader) (domain.StoredFile, error)
+}
+
 // fileServer реализует gRPC FileServiceServer.
 type fileServer struct {
 	pb.UnimplementedFileServiceServer
 
-	minioClient minio.Client
+	uploadService UploadService
+}
+
+// ServerOption — опция для конфигурации сервера.
+type ServerOption func(*fileServer)
+
+// WithUploadService включает загрузку файлов; без него методы FileService возвращают Unavailable.
+func WithUploadService(svc UploadService) ServerOption {
+	return func(s *fileServer) {
+		s.uploadService = svc
+	}
 }
 
 // RegisterFileServerGRPC регистрирует FileServiceServer в gRPC сервере.
-func RegisterFileServerGRPC(server *grpc.Server, minioClient minio.Client) {
-	pb.RegisterFileServiceServer(server, &fileServer{
-		minioClient: minioClient,
-	})
+func RegisterFileServerGRPC(server *grpc.Server, opts ...ServerOption) {
+	s := &fileServer{}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	pb.RegisterFileServiceServer(server, s)
 }
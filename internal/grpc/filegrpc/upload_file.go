@@ -1,28 +1,51 @@
 package filegrpc
 
 import (
+	"bytes"
 	"context"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/upload"
 	desc "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// UploadFile — загрузка файла одним сообщением. Файл проходит те же проверки и обработку,
+// что и в UploadFileStream; без сервиса загрузок метод недоступен.
 func (s *fileServer) UploadFile(ctx context.Context, in *desc.UploadFileRequest) (*desc.UploadFileResponse, error) {
 	err := in.ValidateAll()
 	if err != nil {
 		return nil, err
 	}
-
-	file := domain.FileDataType{
-		FileName: in.FileName,
-		Data:     in.File,
+	if s.uploadService == nil {
+		return nil, status.Error(codes.Unavailable, "direct uploads are not configured")
 	}
 
-	url, err := s.minioClient.CreateOne(file)
+	stored, err := s.uploadBytes(ctx, in.FileName, in.File)
 	if err != nil {
 		return nil, err
 	}
+	return storedFileToProto(stored), nil
+}
+
+// uploadBytes загружает файл через сервис загрузок; тип определяется по содержимому.
+func (s *fileServer) uploadBytes(ctx context.Context, fileName string, data []byte) (domain.StoredFile, error) {
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return domain.StoredFile{}, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	contentType, ok := upload.DetectType(data)
+	if !ok {
+		return domain.StoredFile{}, status.Error(codes.InvalidArgument, upload.ErrContentMismatch.Error())
+	}
+
+	stored, err := s.uploadService.Upload(ctx, userID, fileName, contentType, int64(len(data)), bytes.NewReader(data))
+	if err != nil {
+		return domain.StoredFile{}, uploadErrorToStatus(err, "failed to upload file")
+	}
 
-	return &desc.UploadFileResponse{
-		Url: url,
-	}, nil
+	return stored, nil
 }
@@ -0,0 +1,84 @@
+package filegrpc
+
+import (
+	"errors"
+	"io"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UploadFileStream — потоковая загрузка: метаданные в первом сообщении, затем куски файла.
+func (s *fileServer) UploadFileStream(stream pb.FileService_UploadFileStreamServer) error {
+	if s.uploadService == nil {
+		return status.Error(codes.Unavailable, "direct uploads are not configured")
+	}
+
+	ctx := stream.Context()
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if err := first.ValidateAll(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain metadata")
+	}
+
+	r := &chunkReader{stream: stream}
+	stored, err := s.uploadService.Upload(ctx, userID, meta.GetFileName(), meta.GetContentType(), meta.GetSize(), r)
+	if err != nil {
+		if r.err != nil {
+			return r.err
+		}
+		return uploadErrorToStatus(err, "failed to upload file")
+	}
+
+	return stream.SendAndClose(storedFileToProto(stored))
+}
+
+// errUnexpectedMetadata — метаданные пришли не первым сообщением.
+var errUnexpectedMetadata = errors.New("metadata is allowed only in the first message")
+
+// chunkReader читает содержимое файла из сообщений потока. Ошибка чтения потока или проверки
+// сообщения сохраняется в err, чтобы вернуть клиенту её, а не ошибку хранилища.
+type chunkReader struct {
+	stream pb.FileService_UploadFileStreamServer
+	buf    []byte
+	err    error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			// io.EOF — клиент закончил поток; об этом Upload судит сам по размеру
+			if !errors.Is(err, io.EOF) {
+				r.err = err
+			}
+			return 0, err
+		}
+		if err := msg.ValidateAll(); err != nil {
+			r.err = status.Error(codes.InvalidArgument, err.Error())
+			return 0, r.err
+		}
+		if msg.GetMetadata() != nil {
+			r.err = status.Error(codes.InvalidArgument, errUnexpectedMetadata.Error())
+			return 0, r.err
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
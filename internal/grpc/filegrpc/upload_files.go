@@ -2,8 +2,10 @@ package filegrpc
 
 import (
 	"context"
-	"lead_exchange/internal/domain"
 	desc "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *fileServer) UploadFiles(ctx context.Context, in *desc.UploadFilesRequest) (*desc.UploadFilesResponse, error) {
@@ -11,21 +13,19 @@ func (s *fileServer) UploadFiles(ctx context.Context, in *desc.UploadFilesReques
 	if err != nil {
 		return nil, err
 	}
+	if s.uploadService == nil {
+		return nil, status.Error(codes.Unavailable, "direct uploads are not configured")
+	}
 
-	filesMap := make(map[string]domain.FileDataType)
+	resp := &desc.UploadFilesResponse{}
 	for _, f := range in.Files {
-		filesMap[f.FileName] = domain.FileDataType{
-			FileName: f.FileName,
-			Data:     f.File,
+		stored, err := s.uploadBytes(ctx, f.FileName, f.File)
+		if err != nil {
+			return nil, err
 		}
+		resp.Urls = append(resp.Urls, stored.URL)
+		resp.Keys = append(resp.Keys, stored.Key)
+		resp.Files = append(resp.Files, storedFileToProto(stored))
 	}
-
-	urls, err := s.minioClient.CreateMany(filesMap)
-	if err != nil {
-		return nil, err
-	}
-
-	return &desc.UploadFilesResponse{
-		Urls: urls,
-	}, nil
+	return resp, nil
 }
@@ -0,0 +1,116 @@
+package filegrpc
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/upload"
+	pb "lead_exchange/pkg"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateUploadURL — ссылка на загрузку файла напрямую в хранилище.
+func (s *fileServer) CreateUploadURL(ctx context.Context, in *pb.CreateUploadURLRequest) (*pb.CreateUploadURLResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.uploadService == nil {
+		return nil, status.Error(codes.Unavailable, "direct uploads are not configured")
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	ticket, err := s.uploadService.CreateUploadURL(ctx, userID, in.GetFileName(), in.GetContentType(), in.GetSize())
+	if err != nil {
+		return nil, uploadErrorToStatus(err, "failed to create upload url")
+	}
+
+	return &pb.CreateUploadURLResponse{
+		Key:       ticket.Key,
+		UploadUrl: ticket.URL,
+		Method:    http.MethodPut,
+		Headers:   ticket.Headers,
+		ExpiresAt: ticket.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+// CompleteUpload — подтверждение загрузки по ссылке.
+func (s *fileServer) CompleteUpload(ctx context.Context, in *pb.CompleteUploadRequest) (*pb.UploadFileResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.uploadService == nil {
+		return nil, status.Error(codes.Unavailable, "direct uploads are not configured")
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	stored, err := s.uploadService.CompleteUpload(ctx, userID, in.GetKey())
+	if err != nil {
+		return nil, uploadErrorToStatus(err, "failed to complete upload")
+	}
+
+	return storedFileToProto(stored), nil
+}
+
+func storedFileToProto(f domain.StoredFile) *pb.UploadFileResponse {
+	return &pb.UploadFileResponse{
+		Url:         f.URL,
+		Key:         f.Key,
+		ContentType: f.ContentType,
+		Size:        f.Size,
+		Variants:    imageVariantsToProto(f.Variants),
+	}
+}
+
+func imageVariantsToProto(variants []domain.ImageVariant) []*pb.ImageVariant {
+	result := make([]*pb.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, &pb.ImageVariant{
+			Name:        v.Name,
+			Format:      v.Format,
+			Key:         v.Key,
+			Url:         v.URL,
+			ContentType: v.ContentType,
+			Width:       int32(v.Width),
+			Height:      int32(v.Height),
+			Size:        v.Size,
+		})
+	}
+	return result
+}
+
+func uploadErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, upload.ErrUnsupportedType):
+		return status.Error(codes.InvalidArgument, upload.ErrUnsupportedType.Error())
+	case errors.Is(err, upload.ErrInvalidSize):
+		return status.Error(codes.InvalidArgument, upload.ErrInvalidSize.Error())
+	case errors.Is(err, upload.ErrFileTooLarge):
+		return status.Error(codes.InvalidArgument, upload.ErrFileTooLarge.Error())
+	case errors.Is(err, upload.ErrContentMismatch):
+		return status.Error(codes.InvalidArgument, upload.ErrContentMismatch.Error())
+	case errors.Is(err, upload.ErrInvalidImage):
+		return status.Error(codes.InvalidArgument, upload.ErrInvalidImage.Error())
+	case errors.Is(err, upload.ErrUploadNotFound):
+		return status.Error(codes.NotFound, upload.ErrUploadNotFound.Error())
+	case errors.Is(err, upload.ErrNotUploaded):
+		return status.Error(codes.FailedPrecondition, upload.ErrNotUploaded.Error())
+	case errors.Is(err, upload.ErrUploadExpired):
+		return status.Error(codes.FailedPrecondition, upload.ErrUploadExpired.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
@@ -67,6 +67,11 @@ func (s *serverAPI) ApplyClarificationAnswers(ctx context.Context, in *pb.ApplyC
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Ответы разбирает агент уточнения — тот же, что задаёт вопросы
+	if s.clarificationAgent == nil {
+		return nil, status.Error(codes.Unavailable, "clarification service is not available")
+	}
+
 	leadID, err := uuid.Parse(in.GetLeadId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
@@ -78,48 +83,14 @@ func (s *serverAPI) ApplyClarificationAnswers(ctx context.Context, in *pb.ApplyC
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("lead not found: %v", err))
 	}
 
-	// Парсим текущий requirement
-	var requirement map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirement); err != nil {
-			requirement = make(map[string]interface{})
-		}
-	} else {
-		requirement = make(map[string]interface{})
-	}
-
-	// Применяем ответы
+	// Применяем ответы к текущим требованиям
+	answers := make(map[string]interface{}, len(in.Answers))
 	for _, answer := range in.Answers {
-		field := answer.GetField()
-		value := answer.GetValue()
-
-		// Обрабатываем специальные поля
-		switch field {
-		case "price", "min_price", "max_price":
-			// Пытаемся парсить как число
-			var numValue int64
-			if _, err := fmt.Sscanf(value, "%d", &numValue); err == nil {
-				requirement[field] = numValue
-			} else {
-				requirement[field] = value
-			}
-		case "rooms", "min_rooms", "max_rooms":
-			var numValue int32
-			if _, err := fmt.Sscanf(value, "%d", &numValue); err == nil {
-				requirement[field] = numValue
-			} else {
-				requirement[field] = value
-			}
-		case "area", "min_area", "max_area":
-			var numValue float64
-			if _, err := fmt.Sscanf(value, "%f", &numValue); err == nil {
-				requirement[field] = numValue
-			} else {
-				requirement[field] = value
-			}
-		default:
-			requirement[field] = value
-		}
+		answers[answer.GetField()] = answer.GetValue()
+	}
+	requirement, err := s.clarificationAgent.ApplyClarificationAnswers(lead, answers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Сериализуем обновлённый requirement
@@ -136,6 +107,8 @@ func (s *serverAPI) ApplyClarificationAnswers(ctx context.Context, in *pb.ApplyC
 		Success:        true,
 		NewRequirement: newRequirement,
 		Message:        fmt.Sprintf("Applied %d clarification answers", len(in.Answers)),
+
+		StructuredRequirement: leadRequirementDomainToProto(requirement),
 	}, nil
 }
 
@@ -175,6 +148,7 @@ func (s *serverAPI) AnalyzeLeadIntent(ctx context.Context, in *pb.AnalyzeLeadInt
 			Rooms:    result.Weights.Rooms,
 			Area:     result.Weights.Area,
 			Semantic: result.Weights.Semantic,
+			Visual:   result.Weights.Visual,
 		},
 		LeadType:    result.LeadType,
 		Confidence:  result.Confidence,
@@ -191,6 +165,11 @@ func (s *serverAPI) AnalyzeLeadIntent(ctx context.Context, in *pb.AnalyzeLeadInt
 			TargetArea:          result.Criteria.TargetArea,
 			PreferredDistricts:  result.Criteria.PreferredDistricts,
 		}
+		if v := result.Criteria.Visual; !v.IsEmpty() {
+			resp.ExtractedCriteria.MinVisualQuality = v.MinQuality
+			resp.ExtractedCriteria.WantsGoodView = v.GoodView
+			resp.ExtractedCriteria.VisualFeatures = v.Features
+		}
 	}
 
 	return resp, nil
@@ -0,0 +1,143 @@
+package leadgrpc
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Политика видимости контактов лида: полные контакты видят владелец лида,
+// покупатель по завершённой сделке и администратор — в GetLead/ListLeads и других
+// выдачах лидов и через RevealLeadContacts (с записью в аудит). Остальным
+// пользователям отдаются замаскированные значения.
+
+// contactViewer — права пользователя на контакты лидов, собранные один раз на запрос.
+type contactViewer struct {
+	userID uuid.UUID
+	admin  bool
+	// bought — лиды, купленные пользователем по завершённым сделкам
+	bought map[uuid.UUID]bool
+}
+
+// reason — основание доступа к контактам лида; ok == false, если доступа нет.
+func (v contactViewer) reason(l domain.Lead) (domain.ContactAccessReason, bool) {
+	switch {
+	case l.OwnerUserID == v.userID:
+		return domain.ContactAccessOwner, true
+	case v.bought[l.ID]:
+		return domain.ContactAccessBuyer, true
+	case v.admin:
+		return domain.ContactAccessAdmin, true
+	default:
+		return "", false
+	}
+}
+
+// contactViewerFor собирает права userID на контакты: роль из claims токена (как в authz)
+// и завершённые сделки, где он покупатель. leadID сужает сделки до одного лида; nil — все сделки (для списков).
+func (s *serverAPI) contactViewerFor(ctx context.Context, userID uuid.UUID, leadID *uuid.UUID) (contactViewer, error) {
+	deals, err := s.dealService.ListDeals(ctx, domain.DealFilter{
+		LeadID:      leadID,
+		BuyerUserID: &userID,
+		Status:      lo.ToPtr(domain.DealStatusCompleted),
+	})
+	if err != nil {
+		return contactViewer{}, fmt.Errorf("failed to check deals: %w", err)
+	}
+
+	role, _ := middleware.RoleFromContext(ctx)
+
+	v := contactViewer{userID: userID, admin: role == domain.UserRoleAdmin, bought: make(map[uuid.UUID]bool, len(deals))}
+	for _, d := range deals {
+		v.bought[d.LeadID] = true
+	}
+	return v, nil
+}
+
+// leadDomainToProtoFor конвертирует лид в proto с учётом политики видимости контактов для viewer.
+func leadDomainToProtoFor(l domain.Lead, viewer contactViewer) *pb.Lead {
+	p := leadDomainToProto(l)
+	if _, ok := viewer.reason(l); !ok {
+		maskLeadContacts(p)
+	}
+	return p
+}
+
+// maskLeadContacts заменяет контактные данные лида на замаскированные.
+func maskLeadContacts(p *pb.Lead) {
+	p.ContactName = maskName(p.ContactName)
+	p.ContactPhone = maskPhone(p.ContactPhone)
+	p.ContactEmail = maskEmail(p.ContactEmail)
+	p.ContactsMasked = true
+}
+
+// contactAccessReason определяет, на каком основании userID может раскрыть контакты лида.
+// ok == false, если доступа нет.
+func (s *serverAPI) contactAccessReason(ctx context.Context, l domain.Lead, userID uuid.UUID) (domain.ContactAccessReason, bool, error) {
+	if l.OwnerUserID == userID {
+		return domain.ContactAccessOwner, true, nil
+	}
+
+	viewer, err := s.contactViewerFor(ctx, userID, &l.ID)
+	if err != nil {
+		return "", false, err
+	}
+
+	reason, ok := viewer.reason(l)
+	return reason, ok, nil
+}
+
+// maskPhone маскирует телефон, оставляя код страны, первую цифру кода оператора
+// и две последние цифры: "+7 (912) 345-67-12" → "+7 (9**) ***-**-12".
+func maskPhone(phone string) string {
+	digits := make([]rune, 0, len(phone))
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+
+	if len(digits) == 11 && (digits[0] == '7' || digits[0] == '8') {
+		return fmt.Sprintf("+7 (%c**) ***-**-%s", digits[1], string(digits[9:]))
+	}
+
+	if len(digits) < 4 {
+		return strings.Repeat("*", len(digits))
+	}
+
+	// Неизвестный формат: первая и две последние цифры
+	return string(digits[0]) + strings.Repeat("*", len(digits)-3) + string(digits[len(digits)-2:])
+}
+
+// maskEmail маскирует локальную часть адреса: "ivan.petrov@mail.ru" → "i***@mail.ru".
+func maskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+
+	local, domainPart, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return "***"
+	}
+
+	first, _ := utf8.DecodeRuneInString(local)
+	return string(first) + "***@" + domainPart
+}
+
+// maskName оставляет первую букву каждого слова: "Иван Петров" → "И*** П***".
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, w := range words {
+		first, _ := utf8.DecodeRuneInString(w)
+		words[i] = string(first) + "***"
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,156 @@
+package leadgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestMaskPhone(t *testing.T) {
+	tests := []struct {
+		phone string
+		want  string
+	}{
+		{"+7 (912) 345-67-12", "+7 (9**) ***-**-12"},
+		{"89123456712", "+7 (9**) ***-**-12"},
+		{"+79991234588", "+7 (9**) ***-**-88"},
+		{"+49 30 1234567", "4********67"},
+		{"123", "***"},
+	}
+
+	for _, tt := range tests {
+		if got := maskPhone(tt.phone); got != tt.want {
+			t.Errorf("maskPhone(%q) = %q, want %q", tt.phone, got, tt.want)
+		}
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"ivan.petrov@mail.ru", "i***@mail.ru"},
+		{"", ""},
+		{"broken", "***"},
+	}
+
+	for _, tt := range tests {
+		if got := maskEmail(tt.email); got != tt.want {
+			t.Errorf("maskEmail(%q) = %q, want %q", tt.email, got, tt.want)
+		}
+	}
+}
+
+func TestMaskName(t *testing.T) {
+	if got := maskName("Иван Петров"); got != "И*** П***" {
+		t.Errorf("maskName = %q, want %q", got, "И*** П***")
+	}
+}
+
+func TestLeadDomainToProtoFor(t *testing.T) {
+	ownerID, buyerID := uuid.New(), uuid.New()
+	lead := domain.Lead{
+		ID:           uuid.New(),
+		Title:        "Ищу квартиру",
+		ContactName:  "Иван Петров",
+		ContactPhone: "+7 (912) 345-67-12",
+		ContactEmail: lo.ToPtr("ivan@mail.ru"),
+		Status:       domain.LeadStatusPublished,
+		OwnerUserID:  ownerID,
+	}
+
+	tests := []struct {
+		name       string
+		user       uuid.UUID
+		role       domain.UserRole
+		wantMasked bool
+	}{
+		{"owner", ownerID, domain.UserRoleUser, false},
+		{"buyer of completed deal", buyerID, domain.UserRoleUser, false},
+		{"admin", uuid.New(), domain.UserRoleAdmin, false},
+		{"other user", uuid.New(), domain.UserRoleUser, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &serverAPI{
+				dealService: &mockDealService{buyer: buyerID, leadID: lead.ID},
+			}
+			ctx := middleware.WithUser(context.Background(), tt.user, tt.role, domain.UserStatusActive)
+			// Списки собирают права без привязки к лиду
+			viewer, err := s.contactViewerFor(ctx, tt.user, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := leadDomainToProtoFor(lead, viewer)
+			if got.ContactsMasked != tt.wantMasked {
+				t.Fatalf("ContactsMasked = %v, want %v", got.ContactsMasked, tt.wantMasked)
+			}
+			if tt.wantMasked {
+				if got.ContactPhone != "+7 (9**) ***-**-12" || got.ContactEmail != "i***@mail.ru" || got.ContactName != "И*** П***" {
+					t.Errorf("unexpected masked contacts: %q %q %q", got.ContactName, got.ContactPhone, got.ContactEmail)
+				}
+			} else if got.ContactPhone != lead.ContactPhone || got.ContactEmail != "ivan@mail.ru" || got.ContactName != lead.ContactName {
+				t.Errorf("expected full contacts, got %q %q %q", got.ContactName, got.ContactPhone, got.ContactEmail)
+			}
+		})
+	}
+}
+
+// mockDealService возвращает завершённую сделку buyer по лиду leadID.
+type mockDealService struct {
+	buyer  uuid.UUID
+	leadID uuid.UUID
+}
+
+func (m *mockDealService) ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error) {
+	if filter.BuyerUserID == nil || *filter.BuyerUserID != m.buyer {
+		return nil, nil
+	}
+	if filter.LeadID != nil && *filter.LeadID != m.leadID {
+		return nil, nil
+	}
+	return []domain.Deal{{LeadID: m.leadID, BuyerUserID: &m.buyer, Status: domain.DealStatusCompleted}}, nil
+}
+
+func TestContactAccessReason(t *testing.T) {
+	owner := uuid.New()
+	buyer := uuid.New()
+	lead := domain.Lead{ID: uuid.New(), OwnerUserID: owner}
+
+	tests := []struct {
+		name       string
+		user       uuid.UUID
+		role       domain.UserRole
+		wantReason domain.ContactAccessReason
+		wantOK     bool
+	}{
+		{"owner", owner, domain.UserRoleUser, domain.ContactAccessOwner, true},
+		{"buyer of completed deal", buyer, domain.UserRoleUser, domain.ContactAccessBuyer, true},
+		{"admin", uuid.New(), domain.UserRoleAdmin, domain.ContactAccessAdmin, true},
+		{"other user", uuid.New(), domain.UserRoleUser, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &serverAPI{
+				dealService: &mockDealService{buyer: buyer, leadID: lead.ID},
+			}
+			ctx := middleware.WithUser(context.Background(), tt.user, tt.role, domain.UserStatusActive)
+
+			reason, ok, err := s.contactAccessReason(ctx, lead, tt.user)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != tt.wantOK || reason != tt.wantReason {
+				t.Errorf("got (%s, %v), want (%s, %v)", reason, ok, tt.wantReason, tt.wantOK)
+			}
+		})
+	}
+}
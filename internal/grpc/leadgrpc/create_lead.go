@@ -2,7 +2,6 @@ package leadgrpc
 
 import (
 	"context"
-	"fmt"
 	"github.com/samber/lo"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/middleware"
@@ -23,10 +22,15 @@ func (s *leadServer) CreateLead(ctx context.Context, in *pb.CreateLeadRequest) (
 		return nil, status.Error(codes.Unauthenticated, "user not found in context")
 	}
 
+	requirement, err := leadRequirementFromRequest(in.Requirement, in.StructuredRequirement)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	lead := domain.Lead{
 		Title:         in.Title,
 		Description:   in.Description,
-		Requirement:   in.Requirement,
+		Requirement:   requirement,
 		ContactName:   in.ContactName,
 		ContactPhone:  in.ContactPhone,
 		ContactEmail:  lo.EmptyableToPtr(in.ContactEmail),
@@ -39,7 +43,7 @@ func (s *leadServer) CreateLead(ctx context.Context, in *pb.CreateLeadRequest) (
 
 	id, err := s.leadService.CreateLead(ctx, lead)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create lead: %v", err))
+		return nil, leadErrorToStatus(err, "failed to create lead")
 	}
 
 	lead.ID = id
@@ -2,7 +2,7 @@ package leadgrpc
 
 import (
 	"context"
-	"fmt"
+	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -16,6 +16,11 @@ func (s *leadServer) GetLead(ctx context.Context, in *pb.GetLeadRequest) (*pb.Le
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
 	id, err := uuid.Parse(in.GetLeadId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
@@ -23,8 +28,13 @@ func (s *leadServer) GetLead(ctx context.Context, in *pb.GetLeadRequest) (*pb.Le
 
 	lead, err := s.leadService.GetLead(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get lead: %v", err))
+		return nil, leadErrorToStatus(err, "failed to get lead")
+	}
+
+	viewer, err := s.contactViewerFor(ctx, userID, &lead.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.LeadResponse{Lead: leadDomainToProto(lead)}, nil
+	return &pb.LeadResponse{Lead: leadDomainToProtoFor(lead, viewer)}, nil
 }
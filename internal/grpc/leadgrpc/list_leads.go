@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -13,34 +14,14 @@ import (
 
 // ListLeads — получение списка лидов по фильтру с пагинацией.
 func (s *leadServer) ListLeads(ctx context.Context, in *pb.ListLeadsRequest) (*pb.ListLeadsResponse, error) {
-	filter := domain.LeadFilter{}
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
 
-	if in.Filter != nil {
-		if in.Filter.Status != nil {
-			statusStr := protoLeadStatusToDomain(*in.Filter.Status)
-			filter.Status = &statusStr
-		}
-		if in.Filter.OwnerUserId != nil {
-			id, err := uuid.Parse(*in.Filter.OwnerUserId)
-			if err != nil {
-				return nil, status.Error(codes.InvalidArgument, "invalid owner_user_id")
-			}
-			filter.OwnerUserID = &id
-		}
-		if in.Filter.CreatedUserId != nil {
-			id, err := uuid.Parse(*in.Filter.CreatedUserId)
-			if err != nil {
-				return nil, status.Error(codes.InvalidArgument, "invalid created_user_id")
-			}
-			filter.CreatedUserID = &id
-		}
-		if in.Filter.City != nil {
-			filter.City = in.Filter.City
-		}
-		if in.Filter.PropertyType != nil {
-			pt := protoPropertyTypeToDomain(*in.Filter.PropertyType)
-			filter.PropertyType = &pt
-		}
+	filter, err := leadFilterFromProto(in.Filter)
+	if err != nil {
+		return nil, err
 	}
 
 	// Параметры пагинации
@@ -64,9 +45,50 @@ func (s *leadServer) ListLeads(ctx context.Context, in *pb.ListLeadsRequest) (*p
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list leads: %v", err))
 	}
 
+	viewer, err := s.contactViewerFor(ctx, userID, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	resp := &pb.ListLeadsResponse{}
 	for _, l := range result.Items {
-		resp.Leads = append(resp.Leads, leadDomainToProto(l))
+		resp.Leads = append(resp.Leads, leadDomainToProtoFor(l, viewer))
 	}
 	return resp, nil
 }
+
+// leadFilterFromProto преобразует фильтр ListLeads в доменный фильтр.
+func leadFilterFromProto(in *pb.ListLeadsRequest_Filter) (domain.LeadFilter, error) {
+	filter := domain.LeadFilter{}
+	if in == nil {
+		return filter, nil
+	}
+
+	if in.Status != nil {
+		statusStr := protoLeadStatusToDomain(*in.Status)
+		filter.Status = &statusStr
+	}
+	if in.OwnerUserId != nil {
+		id, err := uuid.Parse(*in.OwnerUserId)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid owner_user_id")
+		}
+		filter.OwnerUserID = &id
+	}
+	if in.CreatedUserId != nil {
+		id, err := uuid.Parse(*in.CreatedUserId)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "invalid created_user_id")
+		}
+		filter.CreatedUserID = &id
+	}
+	if in.City != nil {
+		filter.City = in.City
+	}
+	if in.PropertyType != nil {
+		pt := protoPropertyTypeToDomain(*in.PropertyType)
+		filter.PropertyType = &pt
+	}
+
+	return filter, nil
+}
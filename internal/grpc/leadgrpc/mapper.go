@@ -1,19 +1,32 @@
 package leadgrpc
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/authz"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/services/lead"
+	"lead_exchange/internal/services/property"
 	pb "lead_exchange/pkg"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func leadDomainToProto(l domain.Lead) *pb.Lead {
+	requirementJSON, _ := json.Marshal(l.Requirement)
+
 	return &pb.Lead{
 		LeadId:        l.ID.String(),
 		Title:         l.Title,
 		Description:   l.Description,
-		Requirement:   l.Requirement,
+		Requirement:   requirementJSON,
 		ContactName:   l.ContactName,
 		ContactPhone:  l.ContactPhone,
 		ContactEmail:  lo.FromPtr(l.ContactEmail),
@@ -24,7 +37,76 @@ func leadDomainToProto(l domain.Lead) *pb.Lead {
 		CreatedUserId: l.CreatedUserID.String(),
 		CreatedAt:     l.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     l.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		StructuredRequirement: leadRequirementDomainToProto(l.Requirement),
+	}
+}
+
+func leadRequirementDomainToProto(r domain.LeadRequirement) *pb.LeadRequirement {
+	out := &pb.LeadRequirement{
+		Version:          int32(r.Version),
+		BudgetMin:        r.BudgetMin,
+		BudgetMax:        r.BudgetMax,
+		RoomsMin:         r.RoomsMin,
+		RoomsMax:         r.RoomsMax,
+		AreaMin:          r.AreaMin,
+		AreaMax:          r.AreaMax,
+		FloorMin:         r.FloorMin,
+		FloorMax:         r.FloorMax,
+		Districts:        r.Districts,
+		MustHaveFeatures: r.MustHaveFeatures,
+	}
+	if r.PropertyType != nil {
+		out.PropertyType = propertyTypeDomainToProto(*r.PropertyType)
+	}
+	if r.Deadline != nil {
+		deadline := r.Deadline.Format("2006-01-02T15:04:05Z07:00")
+		out.Deadline = &deadline
+	}
+	return out
+}
+
+func protoLeadRequirementToDomain(r *pb.LeadRequirement) (domain.LeadRequirement, error) {
+	out := domain.LeadRequirement{
+		Version:          int(r.GetVersion()),
+		BudgetMin:        r.BudgetMin,
+		BudgetMax:        r.BudgetMax,
+		RoomsMin:         r.RoomsMin,
+		RoomsMax:         r.RoomsMax,
+		AreaMin:          r.AreaMin,
+		AreaMax:          r.AreaMax,
+		FloorMin:         r.FloorMin,
+		FloorMax:         r.FloorMax,
+		Districts:        r.GetDistricts(),
+		MustHaveFeatures: r.GetMustHaveFeatures(),
+	}
+	if out.Version == 0 {
+		out.Version = domain.LeadRequirementVersion
+	}
+	if r.GetPropertyType() != pb.PropertyType_PROPERTY_TYPE_UNSPECIFIED {
+		pt := protoPropertyTypeToDomain(r.GetPropertyType())
+		out.PropertyType = &pt
 	}
+	if r.Deadline != nil {
+		deadline, err := time.Parse(time.RFC3339, r.GetDeadline())
+		if err != nil {
+			return domain.LeadRequirement{}, fmt.Errorf("%w: deadline must be RFC 3339", domain.ErrInvalidLeadRequirement)
+		}
+		out.Deadline = &deadline
+	}
+	if err := out.Validate(); err != nil {
+		return domain.LeadRequirement{}, err
+	}
+	return out, nil
+}
+
+// leadRequirementFromRequest возвращает требования из запроса: типизированные, если заданы,
+// иначе разобранные из JSON. Ошибки схемы оборачивают domain.ErrInvalidLeadRequirement.
+func leadRequirementFromRequest(raw []byte, structured *pb.LeadRequirement) (domain.LeadRequirement, error) {
+	if structured != nil {
+		return protoLeadRequirementToDomain(structured)
+	}
+	return domain.ParseLeadRequirement(raw)
 }
 
 func leadStatusDomainToProto(s domain.LeadStatus) pb.LeadStatus {
@@ -91,3 +173,27 @@ func protoPropertyTypeToDomain(t pb.PropertyType) domain.PropertyType {
 func parseUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
 }
+
+// leadErrorToStatus переводит ошибки сервиса и политик доступа в gRPC-коды.
+func leadErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, lead.ErrLeadNotFound):
+		return status.Error(codes.NotFound, "lead not found")
+	case errors.Is(err, domain.ErrInvalidLeadRequirement):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, authz.ErrForbidden), errors.Is(err, authz.ErrDeleteRequiresAdmin):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
+
+// propertyErrorToStatus — ошибка получения объекта для матчинга: NotFound только для несуществующего
+// (или невидимого пользователю) объекта, остальные ошибки логируются и отдаются как Internal.
+func (s *serverAPI) propertyErrorToStatus(op string, err error) error {
+	if errors.Is(err, property.ErrPropertyNotFound) {
+		return status.Error(codes.NotFound, "property not found")
+	}
+	s.log.Error("failed to get property", slog.String("op", op), sl.Err(err))
+	return status.Error(codes.Internal, "failed to get property")
+}
@@ -0,0 +1,39 @@
+package leadgrpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPropertyErrorToStatus(t *testing.T) {
+	s := &serverAPI{log: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "not found", err: property.ErrPropertyNotFound, wantCode: codes.NotFound},
+		{name: "hidden by authz", err: fmt.Errorf("authz.PropertyService.GetProperty: %w", property.ErrPropertyNotFound), wantCode: codes.NotFound},
+		{name: "database failure", err: errors.New("connection refused"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.propertyErrorToStatus("test", tt.err)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("code = %v, want %v", got, tt.wantCode)
+			}
+			if tt.wantCode == codes.Internal && status.Convert(err).Message() != "failed to get property" {
+				t.Errorf("internal error details leaked: %v", err)
+			}
+		})
+	}
+}
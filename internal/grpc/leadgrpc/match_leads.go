@@ -0,0 +1,96 @@
+package leadgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/lead"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MatchLeads — поиск подходящих лидов для объекта недвижимости (обратный матчинг).
+func (s *serverAPI) MatchLeads(ctx context.Context, in *pb.MatchLeadsRequest) (*pb.MatchLeadsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if s.propertyService == nil {
+		return nil, status.Error(codes.Unimplemented, "lead matching is not available")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid property_id: %v", err))
+	}
+
+	p, err := s.propertyService.GetProperty(ctx, propertyID)
+	if err != nil {
+		return nil, s.propertyErrorToStatus("leadgrpc.MatchLeads", err)
+	}
+
+	filter, err := leadFilterFromProto(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := 10
+	if in.Limit != nil {
+		limit = int(*in.Limit)
+	}
+
+	var weights *domain.MatchWeights
+	if in.WeightPreset != nil {
+		preset := domain.GetWeightPresetByID(*in.WeightPreset)
+		if preset == nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown weight_preset: %s", *in.WeightPreset))
+		}
+		weights = &preset.Weights
+	}
+
+	matches, err := s.leadService.MatchLeads(ctx, p, filter, limit, weights)
+	if err != nil {
+		if errors.Is(err, lead.ErrPropertyHasNoEmbedding) {
+			return nil, status.Error(codes.FailedPrecondition, "property has no embedding yet")
+		}
+		return nil, leadErrorToStatus(err, "failed to match leads")
+	}
+
+	viewer, err := s.contactViewerFor(ctx, userID, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.MatchLeadsResponse{}
+	for _, m := range matches {
+		resp.Matches = append(resp.Matches, matchedLeadToProto(m, viewer))
+	}
+
+	return resp, nil
+}
+
+// matchedLeadToProto конвертирует MatchedLead в protobuf; контакты маскируются по политике видимости.
+func matchedLeadToProto(m domain.MatchedLead, viewer contactViewer) *pb.MatchedLead {
+	return &pb.MatchedLead{
+		Lead:             leadDomainToProtoFor(m.Lead, viewer),
+		Similarity:       m.Similarity,
+		TotalScore:       m.TotalScore,
+		PriceScore:       m.PriceScore,
+		DistrictScore:    m.DistrictScore,
+		RoomsScore:       m.RoomsScore,
+		AreaScore:        m.AreaScore,
+		SemanticScore:    m.SemanticScore,
+		VisualScore:      m.VisualScore,
+		MatchExplanation: m.MatchExplanation,
+	}
+}
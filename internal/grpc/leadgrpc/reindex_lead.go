@@ -22,7 +22,7 @@ func (s *leadServer) ReindexLead(ctx context.Context, req *pb.ReindexLeadRequest
 
 	err = s.leadService.ReindexLead(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to reindex lead: %v", err)
+		return nil, leadErrorToStatus(err, "failed to reindex lead")
 	}
 
 	return &pb.ReindexLeadResponse{
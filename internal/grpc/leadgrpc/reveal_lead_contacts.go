@@ -0,0 +1,69 @@
+package leadgrpc
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevealLeadContacts — раскрытие полных контактов лида с записью в аудит.
+func (s *serverAPI) RevealLeadContacts(ctx context.Context, in *pb.RevealLeadContactsRequest) (*pb.RevealLeadContactsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	leadID, err := uuid.Parse(in.GetLeadId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
+	}
+
+	l, err := s.leadService.GetLead(ctx, leadID)
+	if err != nil {
+		return nil, leadErrorToStatus(err, "failed to get lead")
+	}
+
+	reason, allowed, err := s.contactAccessReason(ctx, l, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "contacts are available only to the owner, the buyer of a completed deal or an admin")
+	}
+
+	// Без записи в аудит контакты не раскрываем
+	if err := s.leadService.RecordContactReveal(ctx, leadID, userID, reason); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to record contact reveal: %v", err))
+	}
+
+	return &pb.RevealLeadContactsResponse{
+		ContactName:  l.ContactName,
+		ContactPhone: l.ContactPhone,
+		ContactEmail: lo.FromPtr(l.ContactEmail),
+		Reason:       contactAccessReasonDomainToProto(reason),
+	}, nil
+}
+
+func contactAccessReasonDomainToProto(r domain.ContactAccessReason) pb.ContactAccessReason {
+	switch r {
+	case domain.ContactAccessOwner:
+		return pb.ContactAccessReason_CONTACT_ACCESS_REASON_OWNER
+	case domain.ContactAccessBuyer:
+		return pb.ContactAccessReason_CONTACT_ACCESS_REASON_BUYER
+	case domain.ContactAccessAdmin:
+		return pb.ContactAccessReason_CONTACT_ACCESS_REASON_ADMIN
+	default:
+		return pb.ContactAccessReason_CONTACT_ACCESS_REASON_UNSPECIFIED
+	}
+}
@@ -3,6 +3,7 @@ package leadgrpc
 import (
 	"context"
 	"lead_exchange/internal/domain"
+	"log/slog"
 	"lead_exchange/internal/services/clarification"
 	"lead_exchange/internal/services/weights"
 	pb "lead_exchange/pkg"
@@ -18,12 +19,28 @@ type LeadService interface {
 	UpdateLead(ctx context.Context, id uuid.UUID, update domain.LeadFilter) (domain.Lead, error)
 	ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error)
 	ReindexLead(ctx context.Context, id uuid.UUID) error
+	RecordContactReveal(ctx context.Context, leadID, userID uuid.UUID, reason domain.ContactAccessReason) error
+	SubscribeLeads(ctx context.Context, sub domain.LeadSubscription) (<-chan domain.Lead, error)
+	MatchLeads(ctx context.Context, property domain.Property, filter domain.LeadFilter, limit int, weights *domain.MatchWeights) ([]domain.MatchedLead, error)
+}
+
+// PropertyService описывает получение объектов (для семантического фильтра SubscribeLeads и MatchLeads).
+type PropertyService interface {
+	GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error)
+}
+
+// DealService описывает работу со сделками (для проверки покупателя лида).
+type DealService interface {
+	ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error)
 }
 
 // serverAPI реализует gRPC LeadServiceServer с поддержкой AI-функций.
 type serverAPI struct {
 	pb.UnimplementedLeadServiceServer
+	log                *slog.Logger
 	leadService        LeadService
+	dealService        DealService
+	propertyService    PropertyService
 	clarificationAgent *clarification.Agent
 	weightsAnalyzer    *weights.Analyzer
 }
@@ -31,6 +48,13 @@ type serverAPI struct {
 // ServerOption — опция для конфигурации сервера.
 type ServerOption func(*serverAPI)
 
+// WithLogger задаёт логгер для внутренних ошибок, которые не отдаются клиенту (по умолчанию slog.Default()).
+func WithLogger(log *slog.Logger) ServerOption {
+	return func(s *serverAPI) {
+		s.log = log
+	}
+}
+
 // WithClarificationAgent добавляет агента уточнения.
 func WithClarificationAgent(agent *clarification.Agent) ServerOption {
 	return func(s *serverAPI) {
@@ -45,10 +69,19 @@ func WithWeightsAnalyzer(analyzer *weights.Analyzer) ServerOption {
 	}
 }
 
+// WithPropertyService добавляет сервис объектов для семантической подписки на лиды.
+func WithPropertyService(svc PropertyService) ServerOption {
+	return func(s *serverAPI) {
+		s.propertyService = svc
+	}
+}
+
 // RegisterLeadServerGRPC регистрирует LeadServiceServer в gRPC сервере.
-func RegisterLeadServerGRPC(server *grpc.Server, svc LeadService, opts ...ServerOption) {
+func RegisterLeadServerGRPC(server *grpc.Server, svc LeadService, dealSvc DealService, opts ...ServerOption) {
 	s := &serverAPI{
+		log:         slog.Default(),
 		leadService: svc,
+		dealService: dealSvc,
 	}
 
 	for _, opt := range opts {
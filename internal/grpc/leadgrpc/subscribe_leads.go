@@ -0,0 +1,92 @@
+package leadgrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/lead"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMinSimilarity — порог сходства для семантической подписки, если он не задан.
+const defaultMinSimilarity = 0.5
+
+// SubscribeLeads — поток новых и опубликованных лидов по фильтру.
+func (s *serverAPI) SubscribeLeads(in *pb.SubscribeLeadsRequest, stream grpc.ServerStreamingServer[pb.Lead]) error {
+	if err := in.ValidateAll(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := stream.Context()
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	filter, err := leadFilterFromProto(in.Filter)
+	if err != nil {
+		return err
+	}
+	if filter.Status == nil {
+		published := domain.LeadStatusPublished
+		filter.Status = &published
+	}
+
+	sub := domain.LeadSubscription{Filter: filter}
+
+	if in.SimilarToPropertyId != nil {
+		if s.propertyService == nil {
+			return status.Error(codes.Unimplemented, "semantic subscription is not available")
+		}
+
+		propertyID, err := uuid.Parse(*in.SimilarToPropertyId)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid similar_to_property_id: %v", err))
+		}
+
+		p, err := s.propertyService.GetProperty(ctx, propertyID)
+		if err != nil {
+			return s.propertyErrorToStatus("leadgrpc.SubscribeLeads", err)
+		}
+		if p.OwnerUserID != userID {
+			return status.Error(codes.PermissionDenied, "property does not belong to user")
+		}
+		if len(p.Embedding) == 0 {
+			return status.Error(codes.FailedPrecondition, "property has no embedding yet")
+		}
+
+		sub.Embedding = p.Embedding
+		sub.MinSimilarity = defaultMinSimilarity
+		if in.MinSimilarity != nil {
+			sub.MinSimilarity = *in.MinSimilarity
+		}
+	}
+
+	// Права на контакты фиксируются на момент подписки: в ленту попадают новые лиды
+	viewer, err := s.contactViewerFor(ctx, userID, nil)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	leads, err := s.leadService.SubscribeLeads(ctx, sub)
+	if err != nil {
+		if errors.Is(err, lead.ErrFeedUnavailable) {
+			return status.Error(codes.Unavailable, "lead feed is not available")
+		}
+		return leadErrorToStatus(err, "failed to subscribe to leads")
+	}
+
+	for l := range leads {
+		if err := stream.Send(leadDomainToProtoFor(l, viewer)); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
@@ -2,12 +2,11 @@ package leadgrpc
 
 import (
 	"context"
-	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
-	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,6 +17,11 @@ func (s *leadServer) UpdateLead(ctx context.Context, in *pb.UpdateLeadRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
 	id, err := uuid.Parse(in.GetLeadId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
@@ -26,10 +30,17 @@ func (s *leadServer) UpdateLead(ctx context.Context, in *pb.UpdateLeadRequest) (
 	filter := domain.LeadFilter{
 		Title:       in.Title,
 		Description: in.Description,
-		Requirement: lo.EmptyableToPtr(in.Requirement),
 		City:        in.City,
 	}
 
+	if in.StructuredRequirement != nil || len(in.Requirement) > 0 {
+		requirement, err := leadRequirementFromRequest(in.Requirement, in.StructuredRequirement)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Requirement = &requirement
+	}
+
 	if in.PropertyType != nil {
 		pt := protoPropertyTypeToDomain(*in.PropertyType)
 		filter.PropertyType = &pt
@@ -50,8 +61,13 @@ func (s *leadServer) UpdateLead(ctx context.Context, in *pb.UpdateLeadRequest) (
 
 	updated, err := s.leadService.UpdateLead(ctx, id, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update lead: %v", err))
+		return nil, leadErrorToStatus(err, "failed to update lead")
+	}
+
+	viewer, err := s.contactViewerFor(ctx, userID, &updated.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.LeadResponse{Lead: leadDomainToProto(updated)}, nil
+	return &pb.LeadResponse{Lead: leadDomainToProtoFor(updated, viewer)}, nil
 }
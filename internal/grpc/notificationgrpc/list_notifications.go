@@ -0,0 +1,48 @@
+package notificationgrpc
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListNotifications — уведомления текущего пользователя.
+func (s *serverAPI) ListNotifications(ctx context.Context, in *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	filter := domain.NotificationFilter{
+		UserID:     userID,
+		UnreadOnly: in.GetUnreadOnly(),
+		Pagination: &domain.PaginationParams{
+			PageSize:  in.GetPageSize(),
+			PageToken: in.GetPageToken(),
+		},
+	}
+
+	result, unread, err := s.notificationService.ListNotifications(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list notifications: %v", err))
+	}
+
+	resp := &pb.ListNotificationsResponse{
+		NextPageToken: result.NextPageToken,
+		UnreadCount:   unread,
+	}
+	for _, n := range result.Items {
+		resp.Notifications = append(resp.Notifications, notificationDomainToProto(n))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,27 @@
+package notificationgrpc
+
+import (
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+)
+
+func notificationDomainToProto(n domain.Notification) *pb.Notification {
+	return &pb.Notification{
+		NotificationId: n.ID.String(),
+		Type:           notificationTypeDomainToProto(n.Type),
+		Title:          n.Title,
+		Body:           n.Body,
+		Payload:        string(n.Payload),
+		Read:           n.ReadAt != nil,
+		CreatedAt:      n.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func notificationTypeDomainToProto(t domain.NotificationType) pb.NotificationType {
+	switch t {
+	case domain.NotificationTypeSavedSearchMatch:
+		return pb.NotificationType_NOTIFICATION_TYPE_SAVED_SEARCH_MATCH
+	default:
+		return pb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
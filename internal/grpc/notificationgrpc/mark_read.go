@@ -0,0 +1,45 @@
+package notificationgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/notification"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MarkRead — отметить уведомления текущего пользователя прочитанными.
+func (s *serverAPI) MarkRead(ctx context.Context, in *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	ids := make([]uuid.UUID, 0, len(in.GetNotificationIds()))
+	for _, raw := range in.GetNotificationIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid notification_id: %v", err))
+		}
+		ids = append(ids, id)
+	}
+
+	updated, err := s.notificationService.MarkRead(ctx, userID, ids, in.GetAll())
+	if err != nil {
+		if errors.Is(err, notification.ErrNothingToMark) {
+			return nil, status.Error(codes.InvalidArgument, "notification_ids or all must be set")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to mark notifications as read: %v", err))
+	}
+
+	return &pb.MarkReadResponse{Updated: int32(updated)}, nil
+}
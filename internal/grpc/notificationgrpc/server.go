@@ -0,0 +1,27 @@
+package notificationgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// NotificationService описывает бизнес-логику уведомлений.
+type NotificationService interface {
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) (*domain.PaginatedResult[domain.Notification], int32, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, all bool) (int64, error)
+}
+
+// serverAPI реализует gRPC NotificationServiceServer.
+type serverAPI struct {
+	pb.UnimplementedNotificationServiceServer
+	notificationService NotificationService
+}
+
+// RegisterNotificationServerGRPC регистрирует NotificationServiceServer в gRPC сервере.
+func RegisterNotificationServerGRPC(server *grpc.Server, svc NotificationService) {
+	pb.RegisterNotificationServiceServer(server, &serverAPI{notificationService: svc})
+}
@@ -64,6 +64,10 @@ func (s *serverAPI) MatchPropertiesAdvanced(ctx context.Context, in *pb.MatchPro
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to match properties: %v", err))
 	}
 
+	if err := s.attachImages(ctx, matchedProperties(matches)...); err != nil {
+		return nil, err
+	}
+
 	resp := &pb.MatchPropertiesResponse{}
 	for _, match := range matches {
 		pbMatch := matchedPropertyToProto(match)
@@ -170,12 +174,6 @@ func (s *serverAPI) GenerateListingContent(ctx context.Context, in *pb.GenerateL
 	}, nil
 }
 
-// AnalyzePropertyImages — анализ изображений объекта недвижимости.
-// Пока не реализовано, так как изображения не хранятся.
-func (s *serverAPI) AnalyzePropertyImages(ctx context.Context, in *pb.AnalyzePropertyImagesRequest) (*pb.AnalyzePropertyImagesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "image analysis is not yet available - images are not stored in the system")
-}
-
 // stringOrEmpty возвращает строку из optional string или пустую строку.
 func stringOrEmpty(s *string) string {
 	if s == nil {
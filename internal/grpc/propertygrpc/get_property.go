@@ -2,7 +2,6 @@ package propertygrpc
 
 import (
 	"context"
-	"fmt"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -23,7 +22,10 @@ func (s *propertyServer) GetProperty(ctx context.Context, in *pb.GetPropertyRequ
 
 	property, err := s.propertyService.GetProperty(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get property: %v", err))
+		return nil, propertyErrorToStatus(err, "failed to get property")
+	}
+	if err := s.attachImages(ctx, &property); err != nil {
+		return nil, err
 	}
 
 	return &pb.PropertyResponse{Property: propertyDomainToProto(property)}, nil
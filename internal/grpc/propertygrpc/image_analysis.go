@@ -0,0 +1,74 @@
+package propertygrpc
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/imageanalysis"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImageAnalysisService описывает анализ фотографий галереи.
+type ImageAnalysisService interface {
+	AnalyzeImages(ctx context.Context, propertyID uuid.UUID, force bool) (domain.PropertyImageAnalysis, error)
+	GetAnalysis(ctx context.Context, propertyID uuid.UUID) (domain.PropertyImageAnalysis, error)
+}
+
+// AnalyzePropertyImages — анализ фотографий галереи объекта.
+func (s *serverAPI) AnalyzePropertyImages(ctx context.Context, in *pb.AnalyzePropertyImagesRequest) (*pb.AnalyzePropertyImagesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.imageAnalysis == nil {
+		return nil, status.Error(codes.Unavailable, "image analysis is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	analysis, err := s.imageAnalysis.AnalyzeImages(ctx, propertyID, in.GetForce())
+	if err != nil {
+		return nil, imageAnalysisErrorToStatus(err, "failed to analyze images")
+	}
+
+	return imageAnalysisToProto(analysis), nil
+}
+
+// GetPropertyImageAnalysis — сохранённые результаты анализа фотографий объекта.
+func (s *serverAPI) GetPropertyImageAnalysis(ctx context.Context, in *pb.GetPropertyImageAnalysisRequest) (*pb.AnalyzePropertyImagesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.imageAnalysis == nil {
+		return nil, status.Error(codes.Unavailable, "image analysis is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	analysis, err := s.imageAnalysis.GetAnalysis(ctx, propertyID)
+	if err != nil {
+		return nil, imageAnalysisErrorToStatus(err, "failed to get image analysis")
+	}
+
+	return imageAnalysisToProto(analysis), nil
+}
+
+func imageAnalysisErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, imageanalysis.ErrNoImages):
+		return status.Error(codes.FailedPrecondition, imageanalysis.ErrNoImages.Error())
+	case errors.Is(err, imageanalysis.ErrVisionUnavailable):
+		return status.Error(codes.Unavailable, imageanalysis.ErrVisionUnavailable.Error())
+	default:
+		return propertyErrorToStatus(err, msg)
+	}
+}
@@ -0,0 +1,174 @@
+package propertygrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/propertyimage"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImageService описывает галерею фотографий объекта.
+type ImageService interface {
+	AddImages(ctx context.Context, propertyID uuid.UUID, keys []string) ([]domain.PropertyImage, error)
+	ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error)
+	ListImagesByProperties(ctx context.Context, propertyIDs []uuid.UUID) (map[uuid.UUID][]domain.PropertyImage, error)
+	ReorderImages(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error)
+	DeleteImage(ctx context.Context, propertyID, imageID uuid.UUID) ([]domain.PropertyImage, error)
+}
+
+// AddPropertyImages — добавление фотографий в галерею объекта.
+func (s *serverAPI) AddPropertyImages(ctx context.Context, in *pb.AddPropertyImagesRequest) (*pb.PropertyImagesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.imageService == nil {
+		return nil, status.Error(codes.Unavailable, "file storage is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	images, err := s.imageService.AddImages(ctx, propertyID, in.GetKeys())
+	if err != nil {
+		return nil, imageErrorToStatus(err, "failed to add images")
+	}
+
+	return propertyImagesToProto(images), nil
+}
+
+// ListPropertyImages — галерея объекта.
+func (s *serverAPI) ListPropertyImages(ctx context.Context, in *pb.ListPropertyImagesRequest) (*pb.PropertyImagesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.imageService == nil {
+		return nil, status.Error(codes.Unavailable, "file storage is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	images, err := s.imageService.ListImages(ctx, propertyID)
+	if err != nil {
+		return nil, imageErrorToStatus(err, "failed to list images")
+	}
+
+	return propertyImagesToProto(images), nil
+}
+
+// ReorderPropertyImages — изменение порядка фотографий.
+func (s *serverAPI) ReorderPropertyImages(ctx context.Context, in *pb.ReorderPropertyImagesRequest) (*pb.PropertyImagesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.imageService == nil {
+		return nil, status.Error(codes.Unavailable, "file storage is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+	imageIDs := make([]uuid.UUID, 0, len(in.GetImageIds()))
+	for _, raw := range in.GetImageIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid image_id format")
+		}
+		imageIDs = append(imageIDs, id)
+	}
+
+	images, err := s.imageService.ReorderImages(ctx, propertyID, imageIDs)
+	if err != nil {
+		return nil, imageErrorToStatus(err, "failed to reorder images")
+	}
+
+	return propertyImagesToProto(images), nil
+}
+
+// DeletePropertyImage — удаление фотографии из галереи.
+func (s *serverAPI) DeletePropertyImage(ctx context.Context, in *pb.DeletePropertyImageRequest) (*pb.PropertyImagesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if s.imageService == nil {
+		return nil, status.Error(codes.Unavailable, "file storage is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+	imageID, err := uuid.Parse(in.GetImageId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid image_id format")
+	}
+
+	images, err := s.imageService.DeleteImage(ctx, propertyID, imageID)
+	if err != nil {
+		return nil, imageErrorToStatus(err, "failed to delete image")
+	}
+
+	return propertyImagesToProto(images), nil
+}
+
+// attachImages заполняет галереи объектов одним запросом. Без ImageService (MinIO выключен)
+// объекты отдаются без фотографий.
+func (s *serverAPI) attachImages(ctx context.Context, properties ...*domain.Property) error {
+	if s.imageService == nil || len(properties) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(properties))
+	for _, p := range properties {
+		ids = append(ids, p.ID)
+	}
+
+	byProperty, err := s.imageService.ListImagesByProperties(ctx, ids)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to load property images: %v", err))
+	}
+	for _, p := range properties {
+		p.Images = byProperty[p.ID]
+	}
+
+	return nil
+}
+
+// matchedProperties — указатели на объекты в выдаче матчинга, чтобы заполнить их галереи.
+func matchedProperties(matches []domain.MatchedProperty) []*domain.Property {
+	properties := make([]*domain.Property, len(matches))
+	for i := range matches {
+		properties[i] = &matches[i].Property
+	}
+	return properties
+}
+
+func imageErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, propertyimage.ErrImageNotFound):
+		return status.Error(codes.NotFound, "image not found")
+	case errors.Is(err, propertyimage.ErrObjectNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, propertyimage.ErrUploadNotOwned):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, propertyimage.ErrUploadNotCompleted):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, propertyimage.ErrTooManyImages):
+		return status.Error(codes.FailedPrecondition, propertyimage.ErrTooManyImages.Error())
+	case errors.Is(err, propertyimage.ErrInvalidOrder):
+		return status.Error(codes.InvalidArgument, propertyimage.ErrInvalidOrder.Error())
+	default:
+		return propertyErrorToStatus(err, msg)
+	}
+}
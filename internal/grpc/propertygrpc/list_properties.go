@@ -76,6 +76,14 @@ func (s *propertyServer) ListProperties(ctx context.Context, in *pb.ListProperti
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list properties: %v", err))
 	}
 
+	items := make([]*domain.Property, len(result.Items))
+	for i := range result.Items {
+		items[i] = &result.Items[i]
+	}
+	if err := s.attachImages(ctx, items...); err != nil {
+		return nil, err
+	}
+
 	resp := &pb.ListPropertiesResponse{}
 	for _, p := range result.Items {
 		resp.Properties = append(resp.Properties, propertyDomainToProto(p))
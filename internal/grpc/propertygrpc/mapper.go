@@ -1,10 +1,16 @@
 package propertygrpc
 
 import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/authz"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/property"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func propertyDomainToProto(p domain.Property) *pb.Property {
@@ -31,10 +37,119 @@ func propertyDomainToProto(p domain.Property) *pb.Property {
 	if p.Rooms != nil {
 		prop.Rooms = p.Rooms
 	}
+	for _, img := range p.Images {
+		prop.Images = append(prop.Images, propertyImageToProto(img))
+	}
 
 	return prop
 }
 
+func propertyImageToProto(img domain.PropertyImage) *pb.PropertyImage {
+	return &pb.PropertyImage{
+		ImageId:   img.ID.String(),
+		Key:       img.StorageKey,
+		Url:       img.URL,
+		Position:  int32(img.Position),
+		CreatedAt: img.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Variants:  imageVariantsToProto(img.Variants),
+	}
+}
+
+func imageVariantsToProto(variants []domain.ImageVariant) []*pb.ImageVariant {
+	var result []*pb.ImageVariant
+	for _, v := range variants {
+		result = append(result, &pb.ImageVariant{
+			Name:        v.Name,
+			Format:      v.Format,
+			Key:         v.Key,
+			Url:         v.URL,
+			ContentType: v.ContentType,
+			Width:       int32(v.Width),
+			Height:      int32(v.Height),
+			Size:        v.Size,
+		})
+	}
+	return result
+}
+
+func propertyImagesToProto(images []domain.PropertyImage) *pb.PropertyImagesResponse {
+	resp := &pb.PropertyImagesResponse{}
+	for _, img := range images {
+		resp.Images = append(resp.Images, propertyImageToProto(img))
+	}
+	return resp
+}
+
+func imageFeaturesToProto(features []domain.ImageFeature) []*pb.ImageFeature {
+	var result []*pb.ImageFeature
+	for _, f := range features {
+		result = append(result, &pb.ImageFeature{Name: f.Name, Confidence: f.Confidence, Category: f.Category})
+	}
+	return result
+}
+
+func imageAnalysisStatusToProto(s domain.ImageAnalysisStatus) pb.ImageAnalysisStatus {
+	switch s {
+	case domain.ImageAnalysisNone:
+		return pb.ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_NOT_STARTED
+	case domain.ImageAnalysisPending:
+		return pb.ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_PENDING
+	case domain.ImageAnalysisProcessing:
+		return pb.ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_PROCESSING
+	case domain.ImageAnalysisDone:
+		return pb.ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_DONE
+	case domain.ImageAnalysisFailed:
+		return pb.ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_FAILED
+	default:
+		return pb.ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_UNSPECIFIED
+	}
+}
+
+func imageAnalysisToProto(a domain.PropertyImageAnalysis) *pb.AnalyzePropertyImagesResponse {
+	resp := &pb.AnalyzePropertyImagesResponse{
+		PropertyId:        a.PropertyID.String(),
+		Status:            imageAnalysisStatusToProto(a.Status),
+		TotalImages:       int32(a.TotalImages),
+		PendingImages:     int32(a.PendingImages),
+		AverageQuality:    a.AverageQuality,
+		DetectedRooms:     a.DetectedRooms,
+		AllFeatures:       imageFeaturesToProto(a.AllFeatures),
+		ViewTypes:         a.ViewTypes,
+		OverallAssessment: a.OverallAssessment,
+	}
+	if a.Error != "" {
+		resp.Error = &a.Error
+	}
+	if a.AnalyzedAt != nil {
+		analyzedAt := a.AnalyzedAt.Format("2006-01-02T15:04:05Z07:00")
+		resp.AnalyzedAt = &analyzedAt
+	}
+
+	for _, img := range a.Images {
+		if img.Analysis == nil {
+			continue
+		}
+		result := &pb.ImageAnalysisResult{
+			ImageId:          img.ID.String(),
+			DetectedFeatures: imageFeaturesToProto(img.Analysis.DetectedFeatures),
+			QualityScore:     img.Analysis.QualityScore,
+			Brightness:       img.Analysis.Brightness,
+			Confidence:       img.Analysis.Confidence,
+			Tags:             img.Analysis.Tags,
+			AnalyzedAt:       img.Analysis.AnalyzedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if img.Analysis.RoomType != "" {
+			result.RoomType = &img.Analysis.RoomType
+		}
+		if img.Analysis.ViewType != "" {
+			result.ViewType = &img.Analysis.ViewType
+		}
+		resp.ImageResults = append(resp.ImageResults, result)
+	}
+
+	return resp
+}
+
 func propertyTypeDomainToProto(t domain.PropertyType) pb.PropertyType {
 	switch t {
 	case domain.PropertyTypeApartment:
@@ -95,6 +210,48 @@ func protoPropertyStatusToDomain(s pb.PropertyStatus) domain.PropertyStatus {
 	}
 }
 
+// PropertyFilterFromProto конвертирует фильтр поиска объектов из protobuf.
+func PropertyFilterFromProto(in *pb.PropertyFilter) domain.PropertyFilter {
+	filter := domain.PropertyFilter{}
+	if in == nil {
+		return filter
+	}
+	if in.Status != nil {
+		st := protoPropertyStatusToDomain(*in.Status)
+		filter.Status = &st
+	}
+	if in.PropertyType != nil {
+		pt := protoPropertyTypeToDomain(*in.PropertyType)
+		filter.PropertyType = &pt
+	}
+	filter.City = in.City
+	filter.MinPrice = in.MinPrice
+	filter.MaxPrice = in.MaxPrice
+	filter.MinRooms = in.MinRooms
+	filter.MaxRooms = in.MaxRooms
+	return filter
+}
+
+// PropertyFilterToProto конвертирует фильтр поиска объектов в protobuf.
+func PropertyFilterToProto(f domain.PropertyFilter) *pb.PropertyFilter {
+	out := &pb.PropertyFilter{
+		City:     f.City,
+		MinPrice: f.MinPrice,
+		MaxPrice: f.MaxPrice,
+		MinRooms: f.MinRooms,
+		MaxRooms: f.MaxRooms,
+	}
+	if f.Status != nil {
+		st := propertyStatusDomainToProto(*f.Status)
+		out.Status = &st
+	}
+	if f.PropertyType != nil {
+		pt := propertyTypeDomainToProto(*f.PropertyType)
+		out.PropertyType = &pt
+	}
+	return out
+}
+
 // matchedPropertyToProto конвертирует MatchedProperty в protobuf.
 func matchedPropertyToProto(m domain.MatchedProperty) *pb.MatchedProperty {
 	result := &pb.MatchedProperty{
@@ -121,6 +278,9 @@ func matchedPropertyToProto(m domain.MatchedProperty) *pb.MatchedProperty {
 	if m.SemanticScore != nil {
 		result.SemanticScore = m.SemanticScore
 	}
+	if m.VisualScore != nil {
+		result.VisualScore = m.VisualScore
+	}
 	if m.MatchExplanation != nil {
 		result.MatchExplanation = m.MatchExplanation
 	}
@@ -132,3 +292,15 @@ func matchedPropertyToProto(m domain.MatchedProperty) *pb.MatchedProperty {
 func parseUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
 }
+
+// propertyErrorToStatus переводит ошибки сервиса и политик доступа в gRPC-коды.
+func propertyErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, property.ErrPropertyNotFound):
+		return status.Error(codes.NotFound, "property not found")
+	case errors.Is(err, authz.ErrForbidden), errors.Is(err, authz.ErrDeleteRequiresAdmin):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
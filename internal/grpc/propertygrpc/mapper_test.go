@@ -67,6 +67,29 @@ func TestPropertyDomainToProto(t *testing.T) {
 	if proto.Status != pb.PropertyStatus_PROPERTY_STATUS_PUBLISHED {
 		t.Errorf("expected Status PUBLISHED, got %v", proto.Status)
 	}
+	if len(proto.Images) != 0 {
+		t.Errorf("expected no images, got %d", len(proto.Images))
+	}
+}
+
+func TestPropertyDomainToProto_Images(t *testing.T) {
+	imageID := uuid.New()
+	property := domain.Property{
+		ID: uuid.New(),
+		Images: []domain.PropertyImage{
+			{ID: imageID, StorageKey: "photo-key", URL: "https://minio/photo-key?X-Amz-Signature=abc", Position: 0},
+		},
+	}
+
+	proto := propertyDomainToProto(property)
+
+	if len(proto.Images) != 1 {
+		t.Fatalf("expected 1 image, got %d", len(proto.Images))
+	}
+	img := proto.Images[0]
+	if img.ImageId != imageID.String() || img.Key != "photo-key" || img.Url != property.Images[0].URL || img.Position != 0 {
+		t.Errorf("unexpected image %+v", img)
+	}
 }
 
 func TestPropertyTypeDomainToProto(t *testing.T) {
@@ -15,7 +15,7 @@ import (
 
 // MatchProperties — поиск подходящих объектов недвижимости для лида.
 // Поддерживает взвешенное ранжирование через metadata:
-// - x-weights-preset: ID пресета (balanced, budget_first, location_first, family, semantic)
+// - x-weights-preset: ID пресета (balanced, budget_first, location_first, family, semantic, visual_first)
 // - x-use-weighted-ranking: "true" для включения
 // - x-criteria-json: JSON с SoftCriteria
 func (s *propertyServer) MatchProperties(ctx context.Context, in *pb.MatchPropertiesRequest) (*pb.MatchPropertiesResponse, error) {
@@ -92,6 +92,10 @@ func (s *propertyServer) MatchProperties(ctx context.Context, in *pb.MatchProper
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to match properties: %v", err))
 	}
 
+	if err := s.attachImages(ctx, matchedProperties(matches)...); err != nil {
+		return nil, err
+	}
+
 	resp := &pb.MatchPropertiesResponse{}
 	for _, match := range matches {
 		pbMatch := matchedPropertyToProto(match)
@@ -22,7 +22,7 @@ func (s *propertyServer) ReindexProperty(ctx context.Context, req *pb.ReindexPro
 
 	err = s.propertyService.ReindexProperty(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to reindex property: %v", err)
+		return nil, propertyErrorToStatus(err, "failed to reindex property")
 	}
 
 	return &pb.ReindexPropertyResponse{
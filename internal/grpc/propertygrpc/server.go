@@ -35,6 +35,8 @@ type serverAPI struct {
 	propertyService PropertyService
 	llmClient       llm.Client
 	visionClient    vision.Client
+	imageService    ImageService
+	imageAnalysis   ImageAnalysisService
 }
 
 // ServerOption — опция для конфигурации сервера.
@@ -54,6 +56,20 @@ func WithVisionClient(client vision.Client) ServerOption {
 	}
 }
 
+// WithImageService включает галерею фотографий объектов.
+func WithImageService(svc ImageService) ServerOption {
+	return func(s *serverAPI) {
+		s.imageService = svc
+	}
+}
+
+// WithImageAnalysisService включает анализ фотографий галереи компьютерным зрением.
+func WithImageAnalysisService(svc ImageAnalysisService) ServerOption {
+	return func(s *serverAPI) {
+		s.imageAnalysis = svc
+	}
+}
+
 // RegisterPropertyServerGRPC регистрирует PropertyServiceServer в gRPC сервере.
 func RegisterPropertyServerGRPC(server *grpc.Server, svc PropertyService, opts ...ServerOption) {
 	s := &serverAPI{
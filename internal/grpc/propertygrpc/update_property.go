@@ -2,7 +2,6 @@ package propertygrpc
 
 import (
 	"context"
-	"fmt"
 	"lead_exchange/internal/domain"
 	pb "lead_exchange/pkg"
 
@@ -61,7 +60,10 @@ func (s *propertyServer) UpdateProperty(ctx context.Context, in *pb.UpdateProper
 
 	updated, err := s.propertyService.UpdateProperty(ctx, id, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update property: %v", err))
+		return nil, propertyErrorToStatus(err, "failed to update property")
+	}
+	if err := s.attachImages(ctx, &updated); err != nil {
+		return nil, err
 	}
 
 	return &pb.PropertyResponse{Property: propertyDomainToProto(updated)}, nil
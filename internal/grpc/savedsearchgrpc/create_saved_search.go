@@ -0,0 +1,65 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/authz"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/grpc/propertygrpc"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/lead"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSavedSearch — сохранение поиска объектов по лиду или фильтру.
+func (s *serverAPI) CreateSavedSearch(ctx context.Context, in *pb.CreateSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	search := domain.SavedSearch{
+		UserID:       userID,
+		Name:         in.GetName(),
+		Filter:       propertygrpc.PropertyFilterFromProto(in.GetFilter()),
+		WeightPreset: in.WeightPreset,
+	}
+	if in.Limit != nil {
+		search.Limit = int(*in.Limit)
+	}
+
+	if in.LeadId != nil {
+		leadID, err := uuid.Parse(*in.LeadId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid lead_id: %v", err))
+		}
+		// Поиск можно сохранить только по видимому пользователю лиду
+		if _, err := s.leadService.GetLead(ctx, leadID); err != nil {
+			switch {
+			case errors.Is(err, lead.ErrLeadNotFound):
+				return nil, status.Error(codes.NotFound, "lead not found")
+			case errors.Is(err, authz.ErrForbidden):
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			default:
+				return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get lead: %v", err))
+			}
+		}
+		search.LeadID = &leadID
+	}
+
+	created, err := s.savedSearchService.CreateSavedSearch(ctx, search)
+	if err != nil {
+		return nil, savedSearchErrorToStatus(err, "failed to create saved search")
+	}
+
+	return &pb.SavedSearchResponse{SavedSearch: savedSearchDomainToProto(created)}, nil
+}
@@ -0,0 +1,35 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteSavedSearch — удаление сохранённого поиска текущего пользователя.
+func (s *serverAPI) DeleteSavedSearch(ctx context.Context, in *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	searchID, err := uuid.Parse(in.GetSavedSearchId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid saved_search_id: %v", err))
+	}
+
+	if err := s.savedSearchService.DeleteSavedSearch(ctx, userID, searchID); err != nil {
+		return nil, savedSearchErrorToStatus(err, "failed to delete saved search")
+	}
+
+	return &pb.DeleteSavedSearchResponse{}, nil
+}
@@ -0,0 +1,30 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSavedSearches — сохранённые поиски текущего пользователя.
+func (s *serverAPI) ListSavedSearches(ctx context.Context, in *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	searches, err := s.savedSearchService.ListSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, savedSearchErrorToStatus(err, "failed to list saved searches")
+	}
+
+	resp := &pb.ListSavedSearchesResponse{}
+	for _, search := range searches {
+		resp.SavedSearches = append(resp.SavedSearches, savedSearchDomainToProto(search))
+	}
+
+	return resp, nil
+}
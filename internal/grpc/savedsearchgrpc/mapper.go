@@ -0,0 +1,45 @@
+package savedsearchgrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/grpc/propertygrpc"
+	"lead_exchange/internal/services/savedsearch"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func savedSearchDomainToProto(s domain.SavedSearch) *pb.SavedSearch {
+	out := &pb.SavedSearch{
+		SavedSearchId: s.ID.String(),
+		Name:          s.Name,
+		Filter:        propertygrpc.PropertyFilterToProto(s.Filter),
+		WeightPreset:  s.WeightPreset,
+		Limit:         int32(s.Limit),
+		Enabled:       s.Enabled,
+		CreatedAt:     s.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if s.LeadID != nil {
+		leadID := s.LeadID.String()
+		out.LeadId = &leadID
+	}
+	if s.LastRunAt != nil {
+		out.LastRunAt = s.LastRunAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return out
+}
+
+// savedSearchErrorToStatus переводит ошибки сервиса сохранённых поисков в gRPC-коды.
+func savedSearchErrorToStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, savedsearch.ErrSavedSearchNotFound):
+		return status.Error(codes.NotFound, "saved search not found")
+	case errors.Is(err, savedsearch.ErrUnknownWeightPreset):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
@@ -0,0 +1,37 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// SavedSearchService описывает бизнес-логику сохранённых поисков.
+type SavedSearchService interface {
+	CreateSavedSearch(ctx context.Context, search domain.SavedSearch) (domain.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, searchID uuid.UUID) error
+}
+
+// LeadService нужен для проверки доступа к лиду, по которому сохраняется поиск.
+type LeadService interface {
+	GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+}
+
+// serverAPI реализует gRPC SavedSearchServiceServer.
+type serverAPI struct {
+	pb.UnimplementedSavedSearchServiceServer
+	savedSearchService SavedSearchService
+	leadService        LeadService
+}
+
+// RegisterSavedSearchServerGRPC регистрирует SavedSearchServiceServer в gRPC сервере.
+func RegisterSavedSearchServerGRPC(server *grpc.Server, svc SavedSearchService, leadSvc LeadService) {
+	pb.RegisterSavedSearchServiceServer(server, &serverAPI{
+		savedSearchService: svc,
+		leadService:        leadSvc,
+	})
+}
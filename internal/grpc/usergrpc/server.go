@@ -15,6 +15,7 @@ type UserService interface {
 	UpdateProfile(ctx context.Context, userID uuid.UUID, update domain.UserFilter) (domain.User, error)
 	UpdateUserStatus(ctx context.Context, userID uuid.UUID, status domain.UserStatus) (domain.User, error)
 	ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+	UnlockUser(ctx context.Context, userID uuid.UUID) (domain.User, error)
 }
 
 // userServer реализует gRPC UserServiceServer.
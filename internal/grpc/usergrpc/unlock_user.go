@@ -0,0 +1,35 @@
+package usergrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/repository"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnlockUser — снятие блокировки входа пользователя.
+func (s *userServer) UnlockUser(ctx context.Context, in *pb.UnlockUserRequest) (*pb.UserProfile, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	targetUserID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid user_id: %v", err))
+	}
+
+	user, err := s.userService.UnlockUser(ctx, targetUserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unlock user: %v", err))
+	}
+
+	return userDomainToProto(user), nil
+}
@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Pinger — зависимость с дешёвой проверкой доступности (*pgxpool.Pool, клиент MinIO).
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Querier — источник применённых миграций (*pgxpool.Pool).
+type Querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// PingCheck — проверка через Ping.
+func PingCheck(name string, critical bool, p Pinger) Check {
+	return Check{Name: name, Critical: critical, Run: p.Ping}
+}
+
+// MigrationsCheck сверяет файлы миграций goose в dir с таблицей goose_db_version:
+// экземпляр с непримененными миграциями не готов принимать трафик.
+func MigrationsCheck(db Querier, dir string) Check {
+	return Check{
+		Name:     "migrations",
+		Critical: true,
+		Run: func(ctx context.Context) error {
+			want, err := migrationVersions(dir)
+			if err != nil {
+				return err
+			}
+
+			applied, err := appliedVersions(ctx, db)
+			if err != nil {
+				return err
+			}
+
+			var pending []int64
+			for _, v := range want {
+				if _, ok := applied[v]; !ok {
+					pending = append(pending, v)
+				}
+			}
+			if len(pending) > 0 {
+				return fmt.Errorf("%d pending migrations, first: %d", len(pending), pending[0])
+			}
+			return nil
+		},
+	}
+}
+
+// migrationVersions — версии из имён файлов goose (<version>_<name>.sql) в порядке возрастания.
+func migrationVersions(dir string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+
+	var versions []int64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	slices.Sort(versions)
+	return versions, nil
+}
+
+func appliedVersions(ctx context.Context, db Querier) (map[int64]struct{}, error) {
+	rows, err := db.Query(ctx, "SELECT DISTINCT version_id FROM goose_db_version WHERE is_applied")
+	if err != nil {
+		return nil, fmt.Errorf("query goose_db_version: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]struct{})
+	for rows.Next() {
+		var v int64
+		if err := rows.Scan(&v); err != nil {
+			return nil, fmt.Errorf("scan goose_db_version: %w", err)
+		}
+		applied[v] = struct{}{}
+	}
+	return applied, rows.Err()
+}
@@ -0,0 +1,208 @@
+// Package health — проверки зависимостей сервера (Postgres, миграции, MinIO, AI-провайдеры)
+// для grpc.health.v1 и HTTP-проб /healthz и /readyz.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Статусы проверки и отчёта.
+const (
+	StatusOK       = "ok"
+	StatusFail     = "fail"
+	StatusDegraded = "degraded"
+)
+
+// Check — проверка одной зависимости. Отказ Critical-проверки делает экземпляр неготовым;
+// отказ некритичной (например, AI-провайдера) только помечает отчёт как degraded.
+type Check struct {
+	Name     string
+	Critical bool
+	Run      func(ctx context.Context) error
+}
+
+// CheckResult — результат одной проверки.
+type CheckResult struct {
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// Report — итог всех проверок. Status: ok, degraded (упали только некритичные) или fail.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Ready — true, если все критичные зависимости доступны.
+func (r Report) Ready() bool {
+	return r.Status != StatusFail
+}
+
+// Checker выполняет проверки и публикует итог в grpc.health.v1.
+type Checker struct {
+	log     *slog.Logger
+	timeout time.Duration
+	checks  []Check
+	server  *health.Server
+
+	mu   sync.RWMutex
+	last Report
+}
+
+// NewChecker создаёт Checker; timeout ограничивает каждую проверку.
+// До первого прогона gRPC health отвечает NOT_SERVING, а Last — отчётом со статусом fail.
+func NewChecker(log *slog.Logger, timeout time.Duration, checks ...Check) *Checker {
+	c := &Checker{
+		log:     log,
+		timeout: timeout,
+		checks:  checks,
+		server:  health.NewServer(),
+		last:    Report{Status: StatusFail, Checks: map[string]CheckResult{}},
+	}
+	c.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Server возвращает реализацию grpc.health.v1.Health для регистрации в gRPC сервере.
+func (c *Checker) Server() *health.Server {
+	return c.server
+}
+
+// Check выполняет все проверки параллельно и обновляет статус gRPC health.
+func (c *Checker) Check(ctx context.Context) Report {
+	results := make([]CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(c.checks))}
+	for i, check := range c.checks {
+		res := results[i]
+		report.Checks[check.Name] = res
+		if res.Status == StatusOK {
+			continue
+		}
+		if check.Critical {
+			report.Status = StatusFail
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+
+	c.publish(report)
+	return report
+}
+
+// Last возвращает отчёт последнего прогона. Публичные эндпоинты читают только его:
+// проверки ходят во внешние API, поэтому выполняются по расписанию в Run, а не на запрос.
+func (c *Checker) Last() Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.last
+}
+
+// Run периодически выполняет проверки, пока не отменён ctx. При остановке
+// gRPC health переводится в NOT_SERVING, чтобы балансировщик снял трафик заранее.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			c.server.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Run(ctx)
+
+	res := CheckResult{
+		Status:    StatusOK,
+		Critical:  check.Critical,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+	return res
+}
+
+func (c *Checker) publish(report Report) {
+	c.mu.Lock()
+	prev := c.last.Status
+	c.last = report
+	c.mu.Unlock()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !report.Ready() {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.server.SetServingStatus("", status)
+
+	if prev != report.Status {
+		attrs := []any{slog.String("status", report.Status)}
+		for name, res := range report.Checks {
+			if res.Status != StatusOK {
+				attrs = append(attrs, slog.String(name, res.Error))
+			}
+		}
+		c.log.Info("health status changed", attrs...)
+	}
+}
+
+// LivenessHandler — /healthz: процесс жив и обслуживает HTTP. Зависимости не проверяются,
+// чтобы оркестратор не перезапускал экземпляр из-за недоступной БД.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+	})
+}
+
+// ReadinessHandler — /readyz: отчёт последнего прогона Run; 503, если недоступна критичная
+// зависимость или проверок ещё не было.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		report := c.Last()
+
+		code := http.StatusOK
+		if !report.Ready() {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,203 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func ok(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestChecker_Check(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name        string
+		checks      []Check
+		wantStatus  string
+		wantServing healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:        "all healthy",
+			checks:      []Check{{Name: "postgres", Critical: true, Run: ok}, {Name: "llm", Run: ok}},
+			wantStatus:  StatusOK,
+			wantServing: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:        "optional dependency down",
+			checks:      []Check{{Name: "postgres", Critical: true, Run: ok}, {Name: "llm", Run: failing}},
+			wantStatus:  StatusDegraded,
+			wantServing: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:        "critical dependency down",
+			checks:      []Check{{Name: "postgres", Critical: true, Run: failing}, {Name: "llm", Run: failing}},
+			wantStatus:  StatusFail,
+			wantServing: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name: "check exceeds timeout",
+			checks: []Check{{Name: "minio", Critical: true, Run: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}}},
+			wantStatus:  StatusFail,
+			wantServing: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChecker(log, 50*time.Millisecond, tt.checks...)
+
+			report := c.Check(context.Background())
+			if report.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s (%+v)", report.Status, tt.wantStatus, report.Checks)
+			}
+			if len(report.Checks) != len(tt.checks) {
+				t.Errorf("checks = %d, want %d", len(report.Checks), len(tt.checks))
+			}
+
+			resp, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{})
+			if err != nil {
+				t.Fatalf("grpc health: %v", err)
+			}
+			if resp.Status != tt.wantServing {
+				t.Errorf("serving = %v, want %v", resp.Status, tt.wantServing)
+			}
+		})
+	}
+}
+
+func TestChecker_NotServingBeforeFirstCheck(t *testing.T) {
+	c := NewChecker(slog.New(slog.NewTextHandler(io.Discard, nil)), time.Second)
+
+	resp, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("grpc health: %v", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("serving = %v, want NOT_SERVING", resp.Status)
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	healthy := true
+	runs := 0
+	c := NewChecker(log, time.Second, Check{Name: "postgres", Critical: true, Run: func(context.Context) error {
+		runs++
+		if !healthy {
+			return errors.New("pool closed")
+		}
+		return nil
+	}})
+
+	// До первого прогона экземпляр не готов
+	rec := httptest.NewRecorder()
+	c.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("before first check: code = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	for _, tt := range []struct {
+		healthy  bool
+		wantCode int
+	}{{true, http.StatusOK}, {false, http.StatusServiceUnavailable}} {
+		healthy = tt.healthy
+		c.Check(context.Background())
+		runsBefore := runs
+
+		rec := httptest.NewRecorder()
+		c.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if rec.Code != tt.wantCode {
+			t.Errorf("healthy=%v: code = %d, want %d", tt.healthy, rec.Code, tt.wantCode)
+		}
+		// Запрос отдаёт сохранённый отчёт и сам проверки не запускает
+		if runs != runsBefore {
+			t.Errorf("healthy=%v: handler ran checks %d times", tt.healthy, runs-runsBefore)
+		}
+
+		var report Report
+		if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+			t.Fatalf("decode report: %v", err)
+		}
+		if _, ok := report.Checks["postgres"]; !ok {
+			t.Errorf("report lacks postgres check: %+v", report)
+		}
+	}
+}
+
+// fakeRows — pgx.Rows поверх списка версий.
+type fakeRows struct {
+	pgx.Rows
+	versions []int64
+	pos      int
+}
+
+func (r *fakeRows) Next() bool {
+	r.pos++
+	return r.pos <= len(r.versions)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	*dest[0].(*int64) = r.versions[r.pos-1]
+	return nil
+}
+
+func (r *fakeRows) Close()                        {}
+func (r *fakeRows) Err() error                    { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag { return pgconn.CommandTag{} }
+
+type fakeQuerier struct {
+	applied []int64
+}
+
+func (q *fakeQuerier) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return &fakeRows{versions: q.applied}, nil
+}
+
+func TestMigrationsCheck(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"20251112114136_leads.sql", "20251225120000_models.sql", "README.md", "draft.sql"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		applied []int64
+		wantErr bool
+	}{
+		{name: "up to date", applied: []int64{0, 20251112114136, 20251225120000}},
+		{name: "pending", applied: []int64{0, 20251112114136}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MigrationsCheck(&fakeQuerier{applied: tt.applied}, dir).Run(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := MigrationsCheck(&fakeQuerier{}, filepath.Join(dir, "missing")).Run(context.Background()); err == nil {
+		t.Error("missing migrations dir must fail the check")
+	}
+}
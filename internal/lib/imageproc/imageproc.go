@@ -0,0 +1,194 @@
+// Package imageproc готовит загруженные фотографии к показу: проверяет, что это
+// изображение допустимого типа, поворачивает по EXIF Orientation, удаляет метаданные
+// (перекодированием) и строит копии нескольких размеров в основном формате и в WebP.
+package imageproc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/HugoSmits86/nativewebp"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // декодер WebP для image.Decode
+)
+
+// Размеры копий.
+const (
+	SizeThumbnail = "thumbnail"
+	SizeCard      = "card"
+	SizeFull      = "full"
+)
+
+// Форматы копий.
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatWebP = "webp"
+)
+
+// Size — копия, вписанная в квадрат MaxSide×MaxSide. Меньшие изображения не увеличиваются.
+type Size struct {
+	Name    string
+	MaxSide int
+	// WebP — строить ли ещё и WebP-копию. Кодировщик WebP без cgo умеет только lossless,
+	// поэтому для полноразмерной фотографии WebP выходит больше JPEG и не строится
+	WebP bool
+}
+
+// Sizes — строящиеся копии, от большей к меньшей.
+var Sizes = []Size{
+	{Name: SizeFull, MaxSide: 2048},
+	{Name: SizeCard, MaxSide: 800, WebP: true},
+	{Name: SizeThumbnail, MaxSide: 320, WebP: true},
+}
+
+const (
+	// MaxPixels — ограничение на размер исходного изображения (защита от «бомб» с огромными размерами):
+	// декодированный растр занимает до 4 байт на пиксель, т.е. до 128 МБ
+	MaxPixels = 32_000_000
+	// jpegQuality — качество JPEG для всех копий
+	jpegQuality = 85
+)
+
+var (
+	// ErrUnsupportedFormat — содержимое не является изображением JPEG, PNG или WebP.
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	// ErrTooManyPixels — изображение больше MaxPixels.
+	ErrTooManyPixels = errors.New("image dimensions are too large")
+)
+
+// Rendition — одна копия изображения.
+type Rendition struct {
+	Size        string
+	Format      string
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+	// Primary — полноразмерная копия в основном формате; она заменяет исходный файл
+	Primary bool
+}
+
+// Key — ключ копии в хранилище, производный от ключа исходного файла: основная копия
+// лежит под самим ключом, остальные — под key/<размер>.<расширение>.
+func (r Rendition) Key(key string) string {
+	if r.Primary {
+		return key
+	}
+	return key + "/" + r.Size + "." + extension(r.Format)
+}
+
+// Process декодирует изображение и строит копии всех размеров из Sizes: в основном формате
+// (JPEG, или PNG для изображений с прозрачностью) и, где задано, в WebP. Метаданные исходного файла
+// (EXIF, в т.ч. координаты съёмки) в копии не попадают.
+func Process(data []byte) ([]Rendition, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if format != FormatJPEG && format != FormatPNG && format != FormatWebP {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooManyPixels, cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+
+	// Поворот применяется после первого уменьшения: так он дешевле, а результат тот же
+	orientation := 1
+	if format == FormatJPEG {
+		orientation = exifOrientation(data)
+	}
+	img := orient(fit(src, Sizes[0].MaxSide), orientation)
+
+	primary := FormatJPEG
+	if !img.Opaque() {
+		primary = FormatPNG
+	}
+
+	var renditions []Rendition
+	for i, size := range Sizes {
+		if i > 0 {
+			img = fit(img, size.MaxSide)
+		}
+		formats := []string{primary}
+		if size.WebP {
+			formats = append(formats, FormatWebP)
+		}
+		for _, f := range formats {
+			encoded, err := encode(img, f)
+			if err != nil {
+				return nil, fmt.Errorf("encode %s %s: %w", size.Name, f, err)
+			}
+			b := img.Bounds()
+			renditions = append(renditions, Rendition{
+				Size:        size.Name,
+				Format:      f,
+				ContentType: "image/" + f,
+				Width:       b.Dx(),
+				Height:      b.Dy(),
+				Data:        encoded,
+				Primary:     size.Name == SizeFull && f == primary,
+			})
+		}
+	}
+
+	return renditions, nil
+}
+
+// fit вписывает изображение в квадрат maxSide×maxSide с сохранением пропорций.
+func fit(src image.Image, maxSide int) *image.NRGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSide && h <= maxSide {
+		if n, ok := src.(*image.NRGBA); ok {
+			return n
+		}
+		dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+		draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Src)
+		return dst
+	}
+
+	if w >= h {
+		h = max(1, h*maxSide/w)
+		w = maxSide
+	} else {
+		w = max(1, w*maxSide/h)
+		h = maxSide
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), src, b, xdraw.Src, nil)
+	return dst
+}
+
+func encode(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case FormatJPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case FormatPNG:
+		err = png.Encode(&buf, img)
+	case FormatWebP:
+		err = nativewebp.Encode(&buf, img, nil)
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+	return buf.Bytes(), err
+}
+
+func extension(format string) string {
+	if format == FormatJPEG {
+		return "jpg"
+	}
+	return format
+}
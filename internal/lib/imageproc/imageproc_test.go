@@ -0,0 +1,199 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// halves — изображение w×h: левая половина красная, правая синяя.
+func halves(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("encode jpeg: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withExif вставляет после SOI сегмент APP1 с Orientation и посторонними данными (как GPS).
+func withExif(data []byte, orientation uint16) []byte {
+	tiff := []byte{'I', 'I', 0x2A, 0x00, 0x08, 0x00, 0x00, 0x00}
+	tiff = binary.LittleEndian.AppendUint16(tiff, 1)
+	tiff = binary.LittleEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.LittleEndian.AppendUint16(tiff, 3)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 1)
+	tiff = binary.LittleEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	tiff = append(tiff, "GPS 55.7558N 37.6173E"...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func find(renditions []Rendition, size, format string) (Rendition, bool) {
+	for _, r := range renditions {
+		if r.Size == size && r.Format == format {
+			return r, true
+		}
+	}
+	return Rendition{}, false
+}
+
+func TestProcess_Sizes(t *testing.T) {
+	renditions, err := Process(encodeJPEG(t, halves(3000, 1000)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		size, format string
+		w, h         int
+		key          string
+	}{
+		{size: SizeFull, format: FormatJPEG, w: 2048, h: 682, key: "k"},
+		{size: SizeCard, format: FormatJPEG, w: 800, h: 266, key: "k/card.jpg"},
+		{size: SizeCard, format: FormatWebP, w: 800, h: 266, key: "k/card.webp"},
+		{size: SizeThumbnail, format: FormatJPEG, w: 320, h: 106, key: "k/thumbnail.jpg"},
+		{size: SizeThumbnail, format: FormatWebP, w: 320, h: 106, key: "k/thumbnail.webp"},
+	}
+	if len(renditions) != len(tests) {
+		t.Errorf("got %d renditions, want %d", len(renditions), len(tests))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.size+"/"+tt.format, func(t *testing.T) {
+			r, ok := find(renditions, tt.size, tt.format)
+			if !ok {
+				t.Fatal("rendition not found")
+			}
+			if r.Width != tt.w || r.Height != tt.h || r.Key("k") != tt.key {
+				t.Errorf("got %dx%d %q, want %dx%d %q", r.Width, r.Height, r.Key("k"), tt.w, tt.h, tt.key)
+			}
+			cfg, format, err := image.DecodeConfig(bytes.NewReader(r.Data))
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if format != tt.format || cfg.Width != tt.w || cfg.Height != tt.h {
+				t.Errorf("decoded %s %dx%d", format, cfg.Width, cfg.Height)
+			}
+		})
+	}
+}
+
+func TestProcess_ExifOrientationAndStripping(t *testing.T) {
+	data := withExif(encodeJPEG(t, halves(40, 20)), 6)
+
+	renditions, err := Process(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	full, _ := find(renditions, SizeFull, FormatJPEG)
+
+	// Поворот на 90° по часовой: 40×20 → 20×40, левая (красная) половина оказывается сверху
+	if full.Width != 20 || full.Height != 40 {
+		t.Fatalf("size = %dx%d, want 20x40", full.Width, full.Height)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(full.Data))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if r, _, b, _ := img.At(10, 5).RGBA(); r < b {
+		t.Errorf("top is not red: r=%d b=%d", r>>8, b>>8)
+	}
+
+	for _, r := range renditions {
+		if bytes.Contains(r.Data, []byte("Exif")) || bytes.Contains(r.Data, []byte("GPS")) {
+			t.Errorf("%s/%s still contains EXIF", r.Size, r.Format)
+		}
+	}
+}
+
+func TestProcess_TransparentKeepsPNG(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	img.Set(1, 1, color.NRGBA{G: 255, A: 128})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+
+	renditions, err := Process(buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, r := range renditions {
+		if r.Format == FormatJPEG {
+			t.Errorf("%s: transparent image encoded as JPEG", r.Size)
+		}
+		if r.Primary && (r.Format != FormatPNG || r.Key("k") != "k") {
+			t.Errorf("primary = %s %q", r.Format, r.Key("k"))
+		}
+	}
+	// Маленькое изображение не увеличивается
+	if full, _ := find(renditions, SizeFull, FormatPNG); full.Width != 10 || full.Height != 10 {
+		t.Errorf("full = %dx%d, want 10x10", full.Width, full.Height)
+	}
+}
+
+func TestProcess_Rejects(t *testing.T) {
+	var gifData bytes.Buffer
+	if err := gif.Encode(&gifData, image.NewPaletted(image.Rect(0, 0, 2, 2), color.Palette{color.Black}), nil); err != nil {
+		t.Fatalf("encode gif: %v", err)
+	}
+
+	// PNG с заголовком 20000×20000 — до декодирования пикселей дело доходить не должно
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewGray(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	huge := bytes.Clone(pngData.Bytes())
+	binary.BigEndian.PutUint32(huge[16:], 20000)
+	binary.BigEndian.PutUint32(huge[20:], 20000)
+	binary.BigEndian.PutUint32(huge[29:], crc32.ChecksumIEEE(huge[12:29]))
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "gif", data: gifData.Bytes(), wantErr: ErrUnsupportedFormat},
+		{name: "garbage", data: []byte("definitely not an image"), wantErr: ErrUnsupportedFormat},
+		{name: "truncated jpeg", data: encodeJPEG(t, halves(40, 20))[:200], wantErr: ErrUnsupportedFormat},
+		{name: "too many pixels", data: huge, wantErr: ErrTooManyPixels},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Process(tt.data); !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
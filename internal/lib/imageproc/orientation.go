@@ -0,0 +1,121 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+// exifOrientation возвращает значение тега Orientation (1-8) из EXIF JPEG-файла;
+// 1 (без поворота), если тега нет или EXIF не удалось разобрать.
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// Сегменты JPEG: FF <маркер> <длина, 2 байта, включая себя> <данные>
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// SOS — дальше идут данные изображения, метаданных не будет
+		if marker == 0xDA {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return 1
+		}
+		if marker == 0xE1 {
+			if o := tiffOrientation(data[i+4 : end]); o != 0 {
+				return o
+			}
+		}
+		i = end
+	}
+	return 1
+}
+
+// tiffOrientation ищет Orientation (0x0112) в IFD0 сегмента APP1 "Exif\0\0"; 0 — не найден.
+func tiffOrientation(app1 []byte) int {
+	if !bytes.HasPrefix(app1, []byte("Exif\x00\x00")) {
+		return 0
+	}
+	tiff := app1[6:]
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd+2 > len(tiff) {
+		return 0
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < entries; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			o := int(order.Uint16(tiff[entry+8:]))
+			if o >= 1 && o <= 8 {
+				return o
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// orient поворачивает и отражает изображение так, как его нужно показывать
+// по значению EXIF Orientation.
+func orient(src *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for dy := 0; dy < dh; dy++ {
+		for dx := 0; dx < dw; dx++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // отражение по горизонтали
+				sx, sy = w-1-dx, dy
+			case 3: // поворот на 180°
+				sx, sy = w-1-dx, h-1-dy
+			case 4: // отражение по вертикали
+				sx, sy = dx, h-1-dy
+			case 5: // транспонирование
+				sx, sy = dy, dx
+			case 6: // поворот на 90° по часовой
+				sx, sy = dy, h-1-dx
+			case 7: // транспонирование по побочной диагонали
+				sx, sy = w-1-dy, h-1-dx
+			case 8: // поворот на 90° против часовой
+				sx, sy = w-1-dy, dx
+			}
+			si := src.PixOffset(src.Rect.Min.X+sx, src.Rect.Min.Y+sy)
+			di := dst.PixOffset(dx, dy)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
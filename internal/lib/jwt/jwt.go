@@ -13,6 +13,9 @@ func NewToken(user domain.User, secret string, duration time.Duration) (string,
 
 	claims := token.Claims.(jwt.MapClaims)
 	claims["uid"] = user.ID.String()
+	claims["role"] = string(user.Role)
+	claims["status"] = string(user.Status)
+	claims["ver"] = user.TokenVersion
 	claims["exp"] = time.Now().Add(duration).Unix()
 
 	tokenString, err := token.SignedString([]byte(secret))
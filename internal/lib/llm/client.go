@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/resilience"
 	"log/slog"
 )
 
@@ -25,6 +27,8 @@ type Client interface {
 	EnrichDescription(ctx context.Context, req EnrichDescriptionRequest) (*EnrichDescriptionResponse, error)
 	// IsEnabled проверяет, включен ли сервис.
 	IsEnabled() bool
+	// Ping проверяет доступность провайдера (для readiness), минуя повторы и circuit breaker.
+	Ping(ctx context.Context) error
 }
 
 // GenerateListingRequest — запрос на генерацию контента для листинга.
@@ -77,6 +81,7 @@ type WeightRecommendation struct {
 	Rooms    float64 `json:"rooms"`
 	Area     float64 `json:"area"`
 	Semantic float64 `json:"semantic"`
+	Visual   float64 `json:"visual,omitempty"`
 }
 
 // ExtractedCriteria — извлечённые критерии из текста лида.
@@ -138,6 +143,7 @@ type EnrichDescriptionResponse struct {
 
 type client struct {
 	httpClient *http.Client
+	transport  *resilience.Transport
 	baseURL    string
 	apiKey     string
 	model      string
@@ -150,14 +156,14 @@ func NewClient(cfg config.LLMConfig, log *slog.Logger) Client {
 		return &noopClient{log: log}
 	}
 
+	httpClient, transport := resilience.NewClient(metrics.ServiceLLM, cfg.Resilience, cfg.Timeout, metrics.GetAIMetrics(log), log)
 	return &client{
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
-		baseURL: cfg.BaseURL,
-		apiKey:  cfg.APIKey,
-		model:   cfg.Model,
-		log:     log,
+		httpClient: httpClient,
+		transport:  transport,
+		baseURL:    cfg.BaseURL,
+		apiKey:     cfg.APIKey,
+		model:      cfg.Model,
+		log:        log,
 	}
 }
 
@@ -317,8 +323,35 @@ func (c *client) EnrichDescription(ctx context.Context, req EnrichDescriptionReq
 	return &result, nil
 }
 
+// IsEnabled возвращает false, пока circuit breaker отключил LLM: сервисы уходят на эвристики.
 func (c *client) IsEnabled() bool {
-	return true
+	return c.transport.Ready()
+}
+
+// Ping запрашивает список моделей (GET /models). Проба идёт мимо resilience-слоя:
+// частые проверки не должны расходовать лимит запросов и открывать breaker.
+func (c *client) Ping(ctx context.Context) error {
+	const op = "llm.Client.Ping"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/models", nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status code %d", op, resp.StatusCode)
+	}
+	return nil
 }
 
 // ChatCompletionRequest — запрос к Chat Completion API.
@@ -341,6 +374,9 @@ type ChatCompletionResponse struct {
 	Choices []struct {
 		Message ChatMessage `json:"message"`
 	} `json:"choices"`
+	Usage struct {
+		TotalTokens int `json:"total_tokens"`
+	} `json:"usage"`
 }
 
 type simplifiedResponse struct {
@@ -382,6 +418,7 @@ func (c *client) sendChatRequest(ctx context.Context, req ChatCompletionRequest)
 	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
 		return nil, fmt.Errorf("%s: failed to decode response: %w", op, err)
 	}
+	metrics.GetAIMetrics(c.log).RecordTokens(metrics.ServiceLLM, chatResp.Usage.TotalTokens)
 
 	if len(chatResp.Choices) == 0 {
 		return nil, fmt.Errorf("%s: no choices in response", op)
@@ -563,3 +600,7 @@ func (c *noopClient) IsEnabled() bool {
 	return false
 }
 
+func (c *noopClient) Ping(ctx context.Context) error {
+	return nil
+}
+
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	aiCallsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "calls_total"),
+		"Вызовы AI-сервиса.", []string{"service"}, nil)
+	aiErrorsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "errors_total"),
+		"Неудачные вызовы AI-сервиса.", []string{"service"}, nil)
+	aiTokensDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "tokens_total"),
+		"Токены, израсходованные AI-сервисом.", []string{"service"}, nil)
+	aiRetriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "retries_total"),
+		"Повторы запросов к AI-сервису.", []string{"service"}, nil)
+	aiRateLimitedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "rate_limited_total"),
+		"Запросы, ожидавшие лимита запросов.", []string{"service"}, nil)
+	aiShortCircuitedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "short_circuited_total"),
+		"Вызовы, отклонённые открытым circuit breaker.", []string{"service"}, nil)
+	aiCircuitOpensDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "circuit_opens_total"),
+		"Открытия circuit breaker.", []string{"service"}, nil)
+	aiCircuitStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ai", "circuit_state"),
+		"Текущее состояние circuit breaker (1 — активное состояние).", []string{"service", "state"}, nil)
+)
+
+// circuitStates — возможные состояния breaker (см. resilience.Breaker).
+var circuitStates = []string{"closed", "open", "half_open"}
+
+// latencyHistogram возвращает гистограмму задержек, создавая её при первом вызове:
+// AIMetrics создаётся и через GetAIMetrics, и литералом в тестах.
+func (m *AIMetrics) latencyHistogram() *prometheus.HistogramVec {
+	m.latencyOnce.Do(func() {
+		m.latency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "ai",
+			Name:      "call_duration_seconds",
+			Help:      "Длительность вызовов AI-сервиса, включая повторы.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"service"})
+	})
+	return m.latency
+}
+
+// Describe реализует prometheus.Collector.
+func (m *AIMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.latencyHistogram().Describe(ch)
+	ch <- aiCallsDesc
+	ch <- aiErrorsDesc
+	ch <- aiTokensDesc
+	ch <- aiRetriesDesc
+	ch <- aiRateLimitedDesc
+	ch <- aiShortCircuitedDesc
+	ch <- aiCircuitOpensDesc
+	ch <- aiCircuitStateDesc
+}
+
+// Collect реализует prometheus.Collector: счётчики берутся из тех же данных, что и GetStats.
+func (m *AIMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.latencyHistogram().Collect(ch)
+
+	for _, service := range []ServiceType{ServiceLLM, ServiceReranker, ServiceVision, ServiceEmbedding, ServiceEmbeddingNext} {
+		s := m.getServiceStats(service)
+		label := string(service)
+
+		counter := func(desc *prometheus.Desc, v int64) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(v), label)
+		}
+		counter(aiCallsDesc, s.CallsTotal)
+		counter(aiErrorsDesc, s.ErrorsTotal)
+		counter(aiRetriesDesc, s.RetriesTotal)
+		counter(aiRateLimitedDesc, s.RateLimitedTotal)
+		counter(aiShortCircuitedDesc, s.ShortCircuitedTotal)
+		counter(aiCircuitOpensDesc, s.CircuitOpensTotal)
+		if service == ServiceLLM {
+			counter(aiTokensDesc, s.TokensUsedTotal)
+		}
+
+		// Сервис без resilience-слоя (выключен) состояния breaker не имеет
+		if s.CircuitState == "" {
+			continue
+		}
+		for _, state := range circuitStates {
+			var v float64
+			if state == s.CircuitState {
+				v = 1
+			}
+			ch <- prometheus.MustNewConstMetric(aiCircuitStateDesc, prometheus.GaugeValue, v, label, state)
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // AIMetrics — метрики для AI-вызовов (LLM, Reranker, Vision).
@@ -14,31 +16,51 @@ type AIMetrics struct {
 	log *slog.Logger
 
 	// Счётчики вызовов
-	llmCallsTotal       int64
-	rerankerCallsTotal  int64
-	visionCallsTotal    int64
-	embeddingCallsTotal int64
+	llmCallsTotal           int64
+	rerankerCallsTotal      int64
+	visionCallsTotal        int64
+	embeddingCallsTotal     int64
+	embeddingNextCallsTotal int64
 
 	// Счётчики ошибок
-	llmErrorsTotal       int64
-	rerankerErrorsTotal  int64
-	visionErrorsTotal    int64
-	embeddingErrorsTotal int64
+	llmErrorsTotal           int64
+	rerankerErrorsTotal      int64
+	visionErrorsTotal        int64
+	embeddingErrorsTotal     int64
+	embeddingNextErrorsTotal int64
 
 	// Суммарная задержка (для расчёта среднего)
-	llmLatencyTotalMs       int64
-	rerankerLatencyTotalMs  int64
-	visionLatencyTotalMs    int64
-	embeddingLatencyTotalMs int64
+	llmLatencyTotalMs           int64
+	rerankerLatencyTotalMs      int64
+	visionLatencyTotalMs        int64
+	embeddingLatencyTotalMs     int64
+	embeddingNextLatencyTotalMs int64
 
 	// Последние задержки (для мониторинга)
-	llmLastLatencyMs       int64
-	rerankerLastLatencyMs  int64
-	visionLastLatencyMs    int64
-	embeddingLastLatencyMs int64
+	llmLastLatencyMs           int64
+	rerankerLastLatencyMs      int64
+	visionLastLatencyMs        int64
+	embeddingLastLatencyMs     int64
+	embeddingNextLastLatencyMs int64
 
 	// Счётчики токенов (для LLM)
 	llmTokensUsedTotal int64
+
+	// Состояние resilience-слоя по сервисам (повторы, лимиты, circuit breaker); защищено mu
+	resilience map[ServiceType]*resilienceStats
+
+	// Гистограмма задержек для Prometheus; создаётся при первом обращении
+	latencyOnce sync.Once
+	latency     *prometheus.HistogramVec
+}
+
+// resilienceStats — счётчики resilience-слоя одного сервиса.
+type resilienceStats struct {
+	retries        int64
+	rateLimited    int64
+	shortCircuited int64
+	circuitOpens   int64
+	circuitState   string
 }
 
 var (
@@ -62,11 +84,15 @@ const (
 	ServiceReranker  ServiceType = "reranker"
 	ServiceVision    ServiceType = "vision"
 	ServiceEmbedding ServiceType = "embedding"
+	// ServiceEmbeddingNext — ML сервис новой модели во время миграции embedding:
+	// у него свой breaker, и его сбои не отключают основную модель.
+	ServiceEmbeddingNext ServiceType = "embedding_next"
 )
 
 // RecordCall записывает вызов AI-сервиса.
 func (m *AIMetrics) RecordCall(service ServiceType, latency time.Duration, err error, tokensUsed int) {
 	latencyMs := latency.Milliseconds()
+	m.latencyHistogram().WithLabelValues(string(service)).Observe(latency.Seconds())
 
 	switch service {
 	case ServiceLLM:
@@ -100,6 +126,13 @@ func (m *AIMetrics) RecordCall(service ServiceType, latency time.Duration, err e
 		if err != nil {
 			atomic.AddInt64(&m.embeddingErrorsTotal, 1)
 		}
+	case ServiceEmbeddingNext:
+		atomic.AddInt64(&m.embeddingNextCallsTotal, 1)
+		atomic.AddInt64(&m.embeddingNextLatencyTotalMs, latencyMs)
+		atomic.StoreInt64(&m.embeddingNextLastLatencyMs, latencyMs)
+		if err != nil {
+			atomic.AddInt64(&m.embeddingNextErrorsTotal, 1)
+		}
 	}
 
 	// Логируем вызов
@@ -117,6 +150,58 @@ func (m *AIMetrics) RecordCall(service ServiceType, latency time.Duration, err e
 	}
 }
 
+// RecordTokens добавляет токены, израсходованные вызовом LLM. Сам вызов учитывает
+// resilience-слой, а число токенов известно только после разбора ответа.
+func (m *AIMetrics) RecordTokens(service ServiceType, tokens int) {
+	if service == ServiceLLM && tokens > 0 {
+		atomic.AddInt64(&m.llmTokensUsedTotal, int64(tokens))
+	}
+}
+
+// RecordRetry записывает повтор запроса к сервису.
+func (m *AIMetrics) RecordRetry(service ServiceType) {
+	m.updateResilience(service, func(r *resilienceStats) { r.retries++ })
+}
+
+// RecordRateLimited записывает запрос, который ждал токена лимита запросов.
+func (m *AIMetrics) RecordRateLimited(service ServiceType) {
+	m.updateResilience(service, func(r *resilienceStats) { r.rateLimited++ })
+}
+
+// RecordShortCircuit записывает вызов, отклонённый открытым circuit breaker без обращения к сервису.
+func (m *AIMetrics) RecordShortCircuit(service ServiceType) {
+	m.updateResilience(service, func(r *resilienceStats) { r.shortCircuited++ })
+}
+
+// SetCircuitState записывает состояние circuit breaker сервиса (closed, open, half_open).
+func (m *AIMetrics) SetCircuitState(service ServiceType, state string) {
+	m.updateResilience(service, func(r *resilienceStats) {
+		if state == "open" && r.circuitState != "open" {
+			r.circuitOpens++
+		}
+		r.circuitState = state
+	})
+
+	if m.log != nil && state == "open" {
+		m.log.Warn("AI service circuit opened", slog.String("service", string(service)))
+	}
+}
+
+func (m *AIMetrics) updateResilience(service ServiceType, update func(r *resilienceStats)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.resilience == nil {
+		m.resilience = make(map[ServiceType]*resilienceStats)
+	}
+	r, ok := m.resilience[service]
+	if !ok {
+		r = &resilienceStats{}
+		m.resilience[service] = r
+	}
+	update(r)
+}
+
 // AICallTimer помогает измерять время вызовов.
 type AICallTimer struct {
 	metrics   *AIMetrics
@@ -141,10 +226,11 @@ func (t *AICallTimer) Stop(err error, tokensUsed int) {
 
 // Stats — текущая статистика по AI-сервисам.
 type Stats struct {
-	LLM       ServiceStats `json:"llm"`
-	Reranker  ServiceStats `json:"reranker"`
-	Vision    ServiceStats `json:"vision"`
-	Embedding ServiceStats `json:"embedding"`
+	LLM           ServiceStats `json:"llm"`
+	Reranker      ServiceStats `json:"reranker"`
+	Vision        ServiceStats `json:"vision"`
+	Embedding     ServiceStats `json:"embedding"`
+	EmbeddingNext ServiceStats `json:"embedding_next"`
 }
 
 // ServiceStats — статистика по одному сервису.
@@ -155,15 +241,22 @@ type ServiceStats struct {
 	AvgLatencyMs     float64 `json:"avg_latency_ms"`
 	LastLatencyMs    int64   `json:"last_latency_ms"`
 	TokensUsedTotal  int64   `json:"tokens_used_total,omitempty"`
+	// Resilience-слой: повторы, ожидания лимита, отклонённые breaker вызовы и его состояние
+	RetriesTotal        int64  `json:"retries_total"`
+	RateLimitedTotal    int64  `json:"rate_limited_total"`
+	ShortCircuitedTotal int64  `json:"short_circuited_total"`
+	CircuitOpensTotal   int64  `json:"circuit_opens_total"`
+	CircuitState        string `json:"circuit_state,omitempty"`
 }
 
 // GetStats возвращает текущую статистику.
 func (m *AIMetrics) GetStats() Stats {
 	return Stats{
-		LLM:       m.getServiceStats(ServiceLLM),
-		Reranker:  m.getServiceStats(ServiceReranker),
-		Vision:    m.getServiceStats(ServiceVision),
-		Embedding: m.getServiceStats(ServiceEmbedding),
+		LLM:           m.getServiceStats(ServiceLLM),
+		Reranker:      m.getServiceStats(ServiceReranker),
+		Vision:        m.getServiceStats(ServiceVision),
+		Embedding:     m.getServiceStats(ServiceEmbedding),
+		EmbeddingNext: m.getServiceStats(ServiceEmbeddingNext),
 	}
 }
 
@@ -192,6 +285,11 @@ func (m *AIMetrics) getServiceStats(service ServiceType) ServiceStats {
 		errors = atomic.LoadInt64(&m.embeddingErrorsTotal)
 		latencyTotal = atomic.LoadInt64(&m.embeddingLatencyTotalMs)
 		lastLatency = atomic.LoadInt64(&m.embeddingLastLatencyMs)
+	case ServiceEmbeddingNext:
+		calls = atomic.LoadInt64(&m.embeddingNextCallsTotal)
+		errors = atomic.LoadInt64(&m.embeddingNextErrorsTotal)
+		latencyTotal = atomic.LoadInt64(&m.embeddingNextLatencyTotalMs)
+		lastLatency = atomic.LoadInt64(&m.embeddingNextLastLatencyMs)
 	}
 
 	var errorRate, avgLatency float64
@@ -200,7 +298,7 @@ func (m *AIMetrics) getServiceStats(service ServiceType) ServiceStats {
 		avgLatency = float64(latencyTotal) / float64(calls)
 	}
 
-	return ServiceStats{
+	stats := ServiceStats{
 		CallsTotal:      calls,
 		ErrorsTotal:     errors,
 		ErrorRate:       errorRate,
@@ -208,6 +306,18 @@ func (m *AIMetrics) getServiceStats(service ServiceType) ServiceStats {
 		LastLatencyMs:   lastLatency,
 		TokensUsedTotal: tokens,
 	}
+
+	m.mu.RLock()
+	if r, ok := m.resilience[service]; ok {
+		stats.RetriesTotal = r.retries
+		stats.RateLimitedTotal = r.rateLimited
+		stats.ShortCircuitedTotal = r.shortCircuited
+		stats.CircuitOpensTotal = r.circuitOpens
+		stats.CircuitState = r.circuitState
+	}
+	m.mu.RUnlock()
+
+	return stats
 }
 
 // Reset сбрасывает все метрики.
@@ -216,19 +326,29 @@ func (m *AIMetrics) Reset() {
 	atomic.StoreInt64(&m.rerankerCallsTotal, 0)
 	atomic.StoreInt64(&m.visionCallsTotal, 0)
 	atomic.StoreInt64(&m.embeddingCallsTotal, 0)
+	atomic.StoreInt64(&m.embeddingNextCallsTotal, 0)
 	atomic.StoreInt64(&m.llmErrorsTotal, 0)
 	atomic.StoreInt64(&m.rerankerErrorsTotal, 0)
 	atomic.StoreInt64(&m.visionErrorsTotal, 0)
 	atomic.StoreInt64(&m.embeddingErrorsTotal, 0)
+	atomic.StoreInt64(&m.embeddingNextErrorsTotal, 0)
 	atomic.StoreInt64(&m.llmLatencyTotalMs, 0)
 	atomic.StoreInt64(&m.rerankerLatencyTotalMs, 0)
 	atomic.StoreInt64(&m.visionLatencyTotalMs, 0)
 	atomic.StoreInt64(&m.embeddingLatencyTotalMs, 0)
+	atomic.StoreInt64(&m.embeddingNextLatencyTotalMs, 0)
 	atomic.StoreInt64(&m.llmLastLatencyMs, 0)
 	atomic.StoreInt64(&m.rerankerLastLatencyMs, 0)
 	atomic.StoreInt64(&m.visionLastLatencyMs, 0)
 	atomic.StoreInt64(&m.embeddingLastLatencyMs, 0)
+	atomic.StoreInt64(&m.embeddingNextLastLatencyMs, 0)
 	atomic.StoreInt64(&m.llmTokensUsedTotal, 0)
+
+	m.mu.Lock()
+	m.resilience = nil
+	m.mu.Unlock()
+
+	m.latencyHistogram().Reset()
 }
 
 // WrapWithMetrics оборачивает функцию для автоматического сбора метрик.
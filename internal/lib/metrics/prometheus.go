@@ -0,0 +1,169 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"lead_exchange/internal/lib/logger/sl"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace — префикс всех метрик сервиса.
+const namespace = "lead_exchange"
+
+// statusScrapeTimeout — ограничение на запросы бизнес-метрик к БД во время scrape.
+const statusScrapeTimeout = 5 * time.Second
+
+// Prometheus — реестр метрик, отдаваемых на /metrics: gRPC, пул соединений, AI-сервисы и бизнес-показатели.
+type Prometheus struct {
+	registry    *prometheus.Registry
+	rpcDuration *prometheus.HistogramVec
+}
+
+// NewPrometheus создаёт реестр с метриками процесса, Go runtime и гистограммой RPC.
+func NewPrometheus() *Prometheus {
+	p := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_handling_seconds",
+			Help:      "Длительность обработки gRPC-запросов.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+	}
+
+	p.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		p.rpcDuration,
+	)
+
+	return p
+}
+
+// MustRegister регистрирует дополнительные коллекторы; паникует при конфликте имён.
+func (p *Prometheus) MustRegister(cs ...prometheus.Collector) {
+	p.registry.MustRegister(cs...)
+}
+
+// Handler возвращает HTTP handler для /metrics.
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRPC записывает длительность RPC с итоговым кодом статуса.
+func (p *Prometheus) ObserveRPC(method, code string, d time.Duration) {
+	p.rpcDuration.WithLabelValues(method, code).Observe(d.Seconds())
+}
+
+// PoolStater — источник статистики пула соединений (*pgxpool.Pool).
+type PoolStater interface {
+	Stat() *pgxpool.Stat
+}
+
+type poolCollector struct {
+	pool PoolStater
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+}
+
+// NewPoolCollector экспортирует статистику pgxpool.
+func NewPoolCollector(pool PoolStater) prometheus.Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &poolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_conns", "Соединения, выданные из пула."),
+		idleConns:            desc("idle_conns", "Свободные соединения в пуле."),
+		constructingConns:    desc("constructing_conns", "Соединения, которые устанавливаются."),
+		totalConns:           desc("total_conns", "Все соединения пула."),
+		maxConns:             desc("max_conns", "Максимальный размер пула."),
+		acquireCount:         desc("acquire_total", "Успешные получения соединения из пула."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Суммарное время ожидания соединения."),
+		canceledAcquireCount: desc("canceled_acquire_total", "Получения соединения, отменённые контекстом."),
+		emptyAcquireCount:    desc("empty_acquire_total", "Получения соединения, которым пришлось ждать из-за пустого пула."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.constructingConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.canceledAcquireCount
+	ch <- c.emptyAcquireCount
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(s.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, s.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+}
+
+type statusCollector[S ~string] struct {
+	name  string
+	desc  *prometheus.Desc
+	count func(ctx context.Context) (map[S]int64, error)
+	log   *slog.Logger
+}
+
+// NewStatusCollector экспортирует gauge name{status} по результату count, который
+// выполняется при каждом scrape. Ошибка count логируется, метрика в этом scrape пропускается.
+func NewStatusCollector[S ~string](
+	name, help string,
+	count func(ctx context.Context) (map[S]int64, error),
+	log *slog.Logger,
+) prometheus.Collector {
+	return &statusCollector[S]{
+		name:  name,
+		desc:  prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, []string{"status"}, nil),
+		count: count,
+		log:   log,
+	}
+}
+
+func (c *statusCollector[S]) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *statusCollector[S]) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), statusScrapeTimeout)
+	defer cancel()
+
+	counts, err := c.count(ctx)
+	if err != nil {
+		c.log.Warn("failed to collect status metric", slog.String("metric", c.name), sl.Err(err))
+		return
+	}
+
+	for status, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), string(status))
+	}
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type testStatus string
+
+func TestPrometheus_Handler(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// Пул без MinConns не подключается к БД при создании
+	pool, err := pgxpool.New(context.Background(), "postgres://user@localhost:1/db?pool_max_conns=7")
+	if err != nil {
+		t.Fatalf("pgxpool.New: %v", err)
+	}
+	defer pool.Close()
+
+	ai := &AIMetrics{}
+	ai.RecordCall(ServiceLLM, 300*time.Millisecond, nil, 0)
+	ai.RecordCall(ServiceLLM, time.Second, errors.New("boom"), 0)
+	ai.RecordTokens(ServiceLLM, 42)
+	ai.SetCircuitState(ServiceLLM, "open")
+
+	p := NewPrometheus()
+	p.MustRegister(
+		ai,
+		NewPoolCollector(pool),
+		NewStatusCollector("leads", "Лиды по статусам.", func(ctx context.Context) (map[testStatus]int64, error) {
+			return map[testStatus]int64{"NEW": 3, "PUBLISHED": 5}, nil
+		}, log),
+		NewStatusCollector("deals", "Сделки по статусам.", func(ctx context.Context) (map[testStatus]int64, error) {
+			return nil, errors.New("db is down")
+		}, log),
+	)
+	p.ObserveRPC("/leadexchange.v1.LeadService/GetLead", "OK", 20*time.Millisecond)
+	p.ObserveRPC("/leadexchange.v1.LeadService/GetLead", "NotFound", 5*time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	p.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if rec.Code != 200 {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		`lead_exchange_grpc_server_handling_seconds_count{code="OK",method="/leadexchange.v1.LeadService/GetLead"} 1`,
+		`lead_exchange_grpc_server_handling_seconds_count{code="NotFound",method="/leadexchange.v1.LeadService/GetLead"} 1`,
+		`lead_exchange_db_pool_max_conns 7`,
+		`lead_exchange_leads{status="PUBLISHED"} 5`,
+		`lead_exchange_ai_calls_total{service="llm"} 2`,
+		`lead_exchange_ai_errors_total{service="llm"} 1`,
+		`lead_exchange_ai_tokens_total{service="llm"} 42`,
+		`lead_exchange_ai_call_duration_seconds_count{service="llm"} 2`,
+		`lead_exchange_ai_circuit_state{service="llm",state="open"} 1`,
+		`lead_exchange_ai_circuit_opens_total{service="llm"} 1`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics output lacks %q", want)
+		}
+	}
+
+	// Ошибка подсчёта не ломает scrape: метрика просто пропускается
+	if strings.Contains(body, "lead_exchange_deals{") {
+		t.Error("deals must be skipped when count fails")
+	}
+}
+
+func TestAIMetrics_Collect(t *testing.T) {
+	m := &AIMetrics{}
+	m.RecordCall(ServiceVision, 100*time.Millisecond, nil, 0)
+	m.RecordRetry(ServiceVision)
+
+	// Для каждого из 5 сервисов — 6 счётчиков, плюс токены LLM и гистограмма vision
+	if got := testutil.CollectAndCount(m); got != 5*6+1+1 {
+		t.Errorf("collected %d metrics", got)
+	}
+	if got := testutil.CollectAndCount(m, "lead_exchange_ai_retries_total"); got != 5 {
+		t.Errorf("retries series = %d, want 5", got)
+	}
+
+	m.Reset()
+	if got := testutil.CollectAndCount(m, "lead_exchange_ai_call_duration_seconds"); got != 0 {
+		t.Errorf("histogram series after reset = %d, want 0", got)
+	}
+}
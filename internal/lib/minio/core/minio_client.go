@@ -2,8 +2,12 @@ package minio
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -11,11 +15,24 @@ import (
 
 // Client интерфейс для взаимодействия с Minio
 type Client interface {
-	InitMinio(MinioConfig config.MinioConfig) error              // Метод для инициализации подключения к Minio
-	CreateOne(file domain.FileDataType) (string, error)          // Метод для создания одного объекта в бакете Minio
-	CreateMany(map[string]domain.FileDataType) ([]string, error) // Метод для создания нескольких объектов в бакете Minio
+	InitMinio(MinioConfig config.MinioConfig) error                         // Метод для инициализации подключения к Minio
+	CreateOne(file domain.FileDataType) (domain.StoredFile, error)          // Метод для создания одного объекта в бакете Minio
+	CreateMany(map[string]domain.FileDataType) ([]domain.StoredFile, error) // Метод для создания нескольких объектов в бакете Minio
+	Ping(ctx context.Context) error                                         // Проверка доступности Minio и бакета (для readiness)
+
+	Stat(ctx context.Context, key string) (domain.ObjectInfo, error)               // Метаданные объекта; ErrObjectNotFound, если его нет
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) // Временная ссылка на скачивание объекта
+	Remove(ctx context.Context, key string) error                                  // Удаление объекта; отсутствующий объект не считается ошибкой
+	Get(ctx context.Context, key string) ([]byte, error)                           // Содержимое объекта; ErrObjectNotFound, если его нет
+
+	PresignPut(ctx context.Context, key, contentType string, size int64, ttl time.Duration) (string, error) // Ссылка на загрузку с подписанными Content-Type и Content-Length
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error                 // Загрузка объекта потоком ровно из size байт
+	ReadHead(ctx context.Context, key string, n int64) ([]byte, error)                                      // Первые n байт объекта (для определения типа)
 }
 
+// ErrObjectNotFound — объекта с таким ключом нет в бакете.
+var ErrObjectNotFound = errors.New("object not found")
+
 // minioClient реализация интерфейса MinioClient
 type minioClient struct {
 	mc          *minio.Client
@@ -61,3 +78,15 @@ func (m *minioClient) InitMinio(MinioConfig config.MinioConfig) error {
 
 	return nil
 }
+
+// Ping проверяет, что Minio отвечает и бакет существует.
+func (m *minioClient) Ping(ctx context.Context) error {
+	exists, err := m.mc.BucketExists(ctx, m.minioConfig.BucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %s does not exist", m.minioConfig.BucketName)
+	}
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"lead_exchange/internal/domain"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -14,9 +17,9 @@ import (
 
 // CreateOne создает один объект в бакете Minio.
 // Метод принимает структуру fileData, которая содержит имя файла и его данные.
-// В случае успешной загрузки данных в бакет, метод возвращает nil, иначе возвращает ошибку.
+// В случае успешной загрузки метод возвращает ключ объекта и временную ссылку на него.
 // Все операции выполняются в контексте задачи.
-func (m *minioClient) CreateOne(file domain.FileDataType) (string, error) {
+func (m *minioClient) CreateOne(file domain.FileDataType) (domain.StoredFile, error) {
 	// Генерация уникального идентификатора для нового объекта.
 	objectID := uuid.New().String()
 
@@ -26,34 +29,35 @@ func (m *minioClient) CreateOne(file domain.FileDataType) (string, error) {
 	// Загрузка данных в бакет Minio с использованием контекста для возможности отмены операции.
 	_, err := m.mc.PutObject(context.Background(), m.minioConfig.BucketName, objectID, reader, int64(len(file.Data)), minio.PutObjectOptions{})
 	if err != nil {
-		return "", fmt.Errorf("ошибка при создании объекта %s: %v", file.FileName, err)
+		return domain.StoredFile{}, fmt.Errorf("ошибка при создании объекта %s: %v", file.FileName, err)
 	}
 
 	// Получение URL для загруженного объекта
 	url, err := m.mc.PresignedGetObject(context.Background(), m.minioConfig.BucketName, objectID, time.Second*24*60*60, nil)
 	if err != nil {
-		return "", fmt.Errorf("ошибка при создании URL для объекта %s: %v", file.FileName, err)
+		return domain.StoredFile{}, fmt.Errorf("ошибка при создании URL для объекта %s: %v", file.FileName, err)
 	}
 
-	return url.String(), nil
+	return domain.StoredFile{Key: objectID, URL: url.String()}, nil
 }
 
 // CreateMany создает несколько объектов в хранилище MinIO из переданных данных.
+// Каждый объект получает собственный ключ; имя файла в ключ не входит.
 // Если происходит ошибка при создании объекта, метод возвращает ошибку,
 // указывающую на неудачные объекты.
-func (m *minioClient) CreateMany(data map[string]domain.FileDataType) ([]string, error) {
-	urls := make([]string, 0, len(data)) // Массив для хранения URL-адресов
+func (m *minioClient) CreateMany(data map[string]domain.FileDataType) ([]domain.StoredFile, error) {
+	files := make([]domain.StoredFile, 0, len(data)) // Массив для хранения загруженных объектов
 
 	ctx, cancel := context.WithCancel(context.Background()) // Создание контекста с возможностью отмены операции.
 	defer cancel()                                          // Отложенный вызов функции отмены контекста при завершении функции CreateMany.
 
-	// Создание канала для передачи URL-адресов с размером, равным количеству переданных данных.
-	urlCh := make(chan string, len(data))
+	// Создание канала для передачи загруженных объектов с размером, равным количеству переданных данных.
+	fileCh := make(chan domain.StoredFile, len(data))
 
 	var wg sync.WaitGroup // WaitGroup для ожидания завершения всех горутин.
 
 	// Запуск горутин для создания каждого объекта.
-	for objectID, file := range data {
+	for _, file := range data {
 		wg.Add(1) // Увеличение счетчика WaitGroup перед запуском каждой горутины.
 		go func(objectID string, file domain.FileDataType) {
 			defer wg.Done()                                                                                                                                // Уменьшение счетчика WaitGroup после завершения горутины.
@@ -70,20 +74,119 @@ func (m *minioClient) CreateMany(data map[string]domain.FileDataType) ([]string,
 				return
 			}
 
-			urlCh <- url.String() // Отправка URL-адреса в канал с URL-адресами.
-		}(objectID, file) // Передача данных объекта в анонимную горутину.
+			fileCh <- domain.StoredFile{Key: objectID, URL: url.String()} // Отправка объекта в канал.
+		}(uuid.New().String(), file) // Передача ключа и данных объекта в анонимную горутину.
 	}
 
-	// Ожидание завершения всех горутин и закрытие канала с URL-адресами.
+	// Ожидание завершения всех горутин и закрытие канала с объектами.
 	go func() {
-		wg.Wait()    // Блокировка до тех пор, пока счетчик WaitGroup не станет равным 0.
-		close(urlCh) // Закрытие канала с URL-адресами после завершения всех горутин.
+		wg.Wait()     // Блокировка до тех пор, пока счетчик WaitGroup не станет равным 0.
+		close(fileCh) // Закрытие канала с объектами после завершения всех горутин.
 	}()
 
-	// Сбор URL-адресов из канала.
-	for url := range urlCh {
-		urls = append(urls, url) // Добавление URL-адреса в массив URL-адресов.
+	// Сбор объектов из канала.
+	for f := range fileCh {
+		files = append(files, f) // Добавление объекта в массив.
+	}
+
+	return files, nil
+}
+
+// Stat возвращает размер и тип содержимого объекта.
+func (m *minioClient) Stat(ctx context.Context, key string) (domain.ObjectInfo, error) {
+	info, err := m.mc.StatObject(ctx, m.minioConfig.BucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return domain.ObjectInfo{}, fmt.Errorf("%s: %w", key, ErrObjectNotFound)
+		}
+		return domain.ObjectInfo{}, err
+	}
+
+	return domain.ObjectInfo{
+		Key:         key,
+		Size:        info.Size,
+		ContentType: info.ContentType,
+	}, nil
+}
+
+// PresignGet выдаёт ссылку на скачивание объекта, действующую ttl.
+func (m *minioClient) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	url, err := m.mc.PresignedGetObject(ctx, m.minioConfig.BucketName, key, ttl, nil)
+	if err != nil {
+		return "", fmt.Errorf("ошибка при создании URL для объекта %s: %w", key, err)
+	}
+	return url.String(), nil
+}
+
+// Remove удаляет объект из бакета.
+func (m *minioClient) Remove(ctx context.Context, key string) error {
+	return m.mc.RemoveObject(ctx, m.minioConfig.BucketName, key, minio.RemoveObjectOptions{})
+}
+
+// Get скачивает объект целиком.
+func (m *minioClient) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := m.mc.GetObject(ctx, m.minioConfig.BucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	// GetObject ленивый: ошибка об отсутствии объекта приходит при чтении
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%s: %w", key, ErrObjectNotFound)
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// PresignPut выдаёт ссылку на загрузку объекта методом PUT, действующую ttl.
+// Content-Type и Content-Length входят в подпись: загрузить по ссылке можно только файл
+// заявленного типа и ровно заявленного размера.
+func (m *minioClient) PresignPut(ctx context.Context, key, contentType string, size int64, ttl time.Duration) (string, error) {
+	headers := http.Header{}
+	headers.Set("Content-Type", contentType)
+	headers.Set("Content-Length", strconv.FormatInt(size, 10))
+
+	url, err := m.mc.PresignHeader(ctx, http.MethodPut, m.minioConfig.BucketName, key, ttl, nil, headers)
+	if err != nil {
+		return "", fmt.Errorf("ошибка при создании ссылки на загрузку %s: %w", key, err)
+	}
+	return url.String(), nil
+}
+
+// Put загружает объект из r. Поток должен содержать ровно size байт.
+func (m *minioClient) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := m.mc.PutObject(ctx, m.minioConfig.BucketName, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("ошибка при создании объекта %s: %w", key, err)
+	}
+	return nil
+}
+
+// ReadHead читает первые n байт объекта; объект короче n возвращается целиком.
+func (m *minioClient) ReadHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, n-1); err != nil {
+		return nil, err
+	}
+
+	obj, err := m.mc.GetObject(ctx, m.minioConfig.BucketName, key, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%s: %w", key, ErrObjectNotFound)
+		}
+		return nil, err
 	}
 
-	return urls, nil
+	return data, nil
 }
@@ -9,6 +9,8 @@ import (
 	"net/http"
 
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/resilience"
 	"log/slog"
 )
 
@@ -18,6 +20,8 @@ type Client interface {
 	Reindex(ctx context.Context, req ReindexRequest) (*ReindexResponse, error)
 	ReindexBatch(ctx context.Context, req ReindexBatchRequest) (*ReindexBatchResponse, error)
 	GetModelInfo(ctx context.Context) (*ModelInfo, error)
+	// Ping — проверка доступности для health; идёт мимо resilience-слоя
+	Ping(ctx context.Context) error
 }
 
 type client struct {
@@ -26,18 +30,47 @@ type client struct {
 	log        *slog.Logger
 }
 
-// NewClient создаёт новый клиент для ML сервиса.
+// NewClient создаёт клиент ML сервиса для провайдера cfg.Provider.
+// Паникует при неизвестном провайдере: без embedding матчинг работать не может.
 func NewClient(cfg config.MLConfig, log *slog.Logger) Client {
+	return newClient(cfg, metrics.ServiceEmbedding, log)
+}
+
+// NewNextClient создаёт клиент ML сервиса новой модели для миграции embedding
+// или возвращает nil, если миграция не настроена (ML_NEXT_BASE_URL пуст).
+// Breaker и метрики клиента учитываются отдельно от основного (metrics.ServiceEmbeddingNext).
+func NewNextClient(cfg config.MLConfig, log *slog.Logger) Client {
+	if !cfg.Enabled || cfg.NextBaseURL == "" {
+		return nil
+	}
+
+	cfg.BaseURL = cfg.NextBaseURL
+	return newClient(cfg, metrics.ServiceEmbeddingNext, log)
+}
+
+// newClient создаёт клиент, чей resilience-слой учитывается под ключом service.
+func newClient(cfg config.MLConfig, service metrics.ServiceType, log *slog.Logger) Client {
 	if !cfg.Enabled {
 		return &noopClient{log: log}
 	}
 
+	switch cfg.Provider {
+	case ProviderRemote, "":
+	case ProviderHashing:
+		return NewProviderClient(NewHashingEmbedder(cfg.Dimensions), log)
+	case ProviderOpenAI:
+		return NewProviderClient(newOpenAIEmbedder(cfg, service, log), log)
+	default:
+		panic(fmt.Sprintf("unknown ML provider %q", cfg.Provider))
+	}
+
+	// Ошибка embedding не подменяется нулевым вектором: при открытом breaker вызов сразу
+	// завершается ErrCircuitOpen, а задание outbox повторяется позже
+	httpClient, _ := resilience.NewClient(service, cfg.Resilience, cfg.Timeout, metrics.GetAIMetrics(log), log)
 	return &client{
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
-		baseURL: cfg.BaseURL,
-		log:     log,
+		httpClient: httpClient,
+		baseURL:    cfg.BaseURL,
+		log:        log,
 	}
 }
 
@@ -168,6 +201,29 @@ func (c *client) GetModelInfo(ctx context.Context) (*ModelInfo, error) {
 	return &result, nil
 }
 
+// Ping запрашивает информацию о модели (GET /model-info). Проба идёт мимо resilience-слоя:
+// частые проверки не должны расходовать лимит запросов и открывать breaker embedding.
+func (c *client) Ping(ctx context.Context) error {
+	const op = "ml.Client.Ping"
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/model-info", nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status code %d", op, resp.StatusCode)
+	}
+	return nil
+}
+
 // Reindex отправляет запрос на переиндексацию одного объекта.
 func (c *client) Reindex(ctx context.Context, req ReindexRequest) (*ReindexResponse, error) {
 	const op = "ml.Client.Reindex"
@@ -265,6 +321,10 @@ func (c *noopClient) GetModelInfo(ctx context.Context) (*ModelInfo, error) {
 	}, nil
 }
 
+func (c *noopClient) Ping(ctx context.Context) error {
+	return nil
+}
+
 func (c *noopClient) Reindex(ctx context.Context, req ReindexRequest) (*ReindexResponse, error) {
 	c.log.Warn("ML service is disabled, returning empty reindex response")
 	embedding := make([]float64, 384)
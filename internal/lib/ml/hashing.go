@@ -0,0 +1,139 @@
+package ml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// DefaultHashingDimensions — размерность HashingEmbedder по умолчанию, совпадает с размерностью колонок embedding.
+const DefaultHashingDimensions = 384
+
+const (
+	// hashingWordWeight и hashingTrigramWeight — вклад слова и каждой его символьной триграммы.
+	// Триграммы сближают словоформы («квартира» и «квартиру»), но слово целиком весит больше.
+	hashingWordWeight    = 1.0
+	hashingTrigramWeight = 0.3
+)
+
+// ErrEmptyText — в тексте нет ни одного признака (пустой, из пробелов, знаков или служебных слов).
+// Его вектор был бы нулевым, а косинусное расстояние до нулевого вектора не определено (NaN).
+var ErrEmptyText = errors.New("text has nothing to embed")
+
+// hashingStopWords — служебные слова, которые есть почти в любом тексте и только зашумляют вектор.
+var hashingStopWords = map[string]bool{
+	"и": true, "в": true, "во": true, "на": true, "с": true, "со": true, "к": true, "по": true,
+	"до": true, "для": true, "от": true, "из": true, "за": true, "о": true, "об": true, "у": true,
+	"а": true, "но": true, "или": true, "не": true, "это": true,
+}
+
+// HashingEmbedder — детерминированный embedder на чистом Go (feature hashing).
+// Слова и их символьные триграммы хешируются в фиксированное число измерений со знаком,
+// частоты сглаживаются логарифмом, вектор нормируется по L2. Смысл слов он не понимает,
+// но тексты с общей лексикой получают высокое косинусное сходство — этого достаточно
+// для локальной разработки и тестов матчинга без сети.
+type HashingEmbedder struct {
+	dimensions int
+}
+
+// NewHashingEmbedder создаёт embedder размерности dimensions (DefaultHashingDimensions, если dimensions <= 0).
+func NewHashingEmbedder(dimensions int) *HashingEmbedder {
+	if dimensions <= 0 {
+		dimensions = DefaultHashingDimensions
+	}
+	return &HashingEmbedder{dimensions: dimensions}
+}
+
+// Embed считает векторы локально. Ошибку возвращает при отменённом контексте
+// и ErrEmptyText, если хотя бы в одном тексте нет признаков.
+func (e *HashingEmbedder) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	const op = "ml.HashingEmbedder.Embed"
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	embeddings := make([][]float64, len(texts))
+	for i, text := range texts {
+		vec, ok := e.embed(text)
+		if !ok {
+			return nil, fmt.Errorf("%s: text %d: %w", op, i, ErrEmptyText)
+		}
+		embeddings[i] = vec
+	}
+	return embeddings, nil
+}
+
+// ModelInfo возвращает модель вида "hashing-384": смена размерности — это смена модели.
+func (e *HashingEmbedder) ModelInfo(ctx context.Context) (*ModelInfo, error) {
+	return &ModelInfo{
+		Model:      fmt.Sprintf("hashing-%d", e.dimensions),
+		Dimensions: e.dimensions,
+	}, nil
+}
+
+// embed возвращает нормированный вектор текста; false — признаков нет и вектор был бы нулевым.
+func (e *HashingEmbedder) embed(text string) ([]float64, bool) {
+	// Частоты признаков; префикс отделяет слово «дом» от триграммы «дом»
+	counts := make(map[string]int)
+	for _, word := range tokenize(text) {
+		counts["w:"+word]++
+
+		runes := []rune("^" + word + "$")
+		for i := 0; i+3 <= len(runes); i++ {
+			counts["t:"+string(runes[i:i+3])]++
+		}
+	}
+
+	vec := make([]float64, e.dimensions)
+	for feature, tf := range counts {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+
+		weight := hashingWordWeight
+		if strings.HasPrefix(feature, "t:") {
+			weight = hashingTrigramWeight
+		}
+		weight *= 1 + math.Log(float64(tf))
+		// Старший бит хеша задаёт знак: коллизии разных признаков в среднем гасят друг друга
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vec[sum%uint64(e.dimensions)] += weight
+	}
+
+	var norm float64
+	for _, v := range vec {
+		norm += v * v
+	}
+	if norm == 0 {
+		return nil, false
+	}
+	norm = math.Sqrt(norm)
+	for i := range vec {
+		vec[i] /= norm
+	}
+	return vec, true
+}
+
+// tokenize разбивает текст на слова в нижнем регистре без служебных слов; «ё» приводится к «е».
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := fields[:0]
+	for _, f := range fields {
+		f = strings.ReplaceAll(f, "ё", "е")
+		if hashingStopWords[f] {
+			continue
+		}
+		words = append(words, f)
+	}
+	return words
+}
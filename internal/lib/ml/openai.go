@@ -0,0 +1,172 @@
+package ml
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/resilience"
+	"log/slog"
+)
+
+// OpenAIEmbedder считает embedding через OpenAI-совместимый эндпоинт {BaseURL}/embeddings
+// (OpenAI, Azure OpenAI, vLLM, Ollama, LM Studio и др.).
+type OpenAIEmbedder struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+	model      string
+	// dimensions — размерность из конфига, передаётся в запросе; 0 — размерность модели по умолчанию.
+	dimensions int
+
+	// probed — размерность, узнанная пробным запросом, если в конфиге она не задана.
+	mu     sync.Mutex
+	probed int
+}
+
+// NewOpenAIEmbedder создаёт провайдер по cfg.BaseURL, cfg.APIKey, cfg.Model и cfg.Dimensions.
+func NewOpenAIEmbedder(cfg config.MLConfig, log *slog.Logger) *OpenAIEmbedder {
+	return newOpenAIEmbedder(cfg, metrics.ServiceEmbedding, log)
+}
+
+func newOpenAIEmbedder(cfg config.MLConfig, service metrics.ServiceType, log *slog.Logger) *OpenAIEmbedder {
+	httpClient, _ := resilience.NewClient(service, cfg.Resilience, cfg.Timeout, metrics.GetAIMetrics(log), log)
+	return &OpenAIEmbedder{
+		httpClient: httpClient,
+		baseURL:    cfg.BaseURL,
+		apiKey:     cfg.APIKey,
+		model:      cfg.Model,
+		dimensions: cfg.Dimensions,
+	}
+}
+
+type openAIEmbeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+	// Dimensions поддерживают не все модели, поэтому передаётся только если задан в конфиге
+	Dimensions int `json:"dimensions,omitempty"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float64 `json:"embedding"`
+	} `json:"data"`
+	Model string `json:"model"`
+}
+
+// Embed отправляет все тексты одним запросом и возвращает векторы в порядке texts.
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	const op = "ml.OpenAIEmbedder.Embed"
+
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	reqBody, err := json.Marshal(openAIEmbeddingRequest{
+		Model:      e.model,
+		Input:      texts,
+		Dimensions: e.dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to marshal request: %w", op, err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/embeddings", bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to create request: %w", op, err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	resp, err := e.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to send request: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%s: unexpected status code %d: %s", op, resp.StatusCode, string(body))
+	}
+
+	var result openAIEmbeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("%s: failed to decode response: %w", op, err)
+	}
+
+	if len(result.Data) != len(texts) {
+		return nil, fmt.Errorf("%s: expected %d embeddings, got %d", op, len(texts), len(result.Data))
+	}
+
+	// Порядок data не гарантирован — сопоставляем по index
+	sort.Slice(result.Data, func(i, j int) bool { return result.Data[i].Index < result.Data[j].Index })
+	embeddings := make([][]float64, len(texts))
+	for i, d := range result.Data {
+		if d.Index != i || len(d.Embedding) == 0 {
+			return nil, fmt.Errorf("%s: missing embedding for input %d", op, i)
+		}
+		embeddings[i] = d.Embedding
+	}
+
+	return embeddings, nil
+}
+
+// Ping запрашивает список моделей (GET /models) мимо resilience-слоя, как llm.Client.Ping:
+// пробы health не расходуют лимит запросов и не открывают breaker.
+func (e *OpenAIEmbedder) Ping(ctx context.Context) error {
+	const op = "ml.OpenAIEmbedder.Ping"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.baseURL+"/models", nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if e.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status code %d", op, resp.StatusCode)
+	}
+	return nil
+}
+
+// ModelInfo возвращает модель из конфига. Если размерность не задана, она определяется
+// по вектору пробного текста и запоминается.
+func (e *OpenAIEmbedder) ModelInfo(ctx context.Context) (*ModelInfo, error) {
+	const op = "ml.OpenAIEmbedder.ModelInfo"
+
+	if e.dimensions > 0 {
+		return &ModelInfo{Model: e.model, Dimensions: e.dimensions}, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.probed == 0 {
+		embeddings, err := e.Embed(ctx, []string{"model info"})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		e.probed = len(embeddings[0])
+	}
+
+	return &ModelInfo{Model: e.model, Dimensions: e.probed}, nil
+}
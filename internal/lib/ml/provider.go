@@ -0,0 +1,185 @@
+package ml
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ProviderRemote — ML сервис по BaseURL: сам готовит текст и считает embedding.
+	ProviderRemote = "remote"
+	// ProviderHashing — локальный детерминированный embedder без сети (см. HashingEmbedder).
+	ProviderHashing = "hashing"
+	// ProviderOpenAI — OpenAI-совместимый эндпоинт /embeddings.
+	ProviderOpenAI = "openai"
+)
+
+// EmbeddingProvider считает embedding для готовых текстов.
+// Подготовку текста из полей сущности берёт на себя providerClient.
+type EmbeddingProvider interface {
+	// Embed возвращает по одному вектору на каждый текст в том же порядке.
+	Embed(ctx context.Context, texts []string) ([][]float64, error)
+	// ModelInfo возвращает название модели и размерность векторов.
+	ModelInfo(ctx context.Context) (*ModelInfo, error)
+}
+
+// providerClient реализует Client поверх EmbeddingProvider.
+type providerClient struct {
+	provider EmbeddingProvider
+	log      *slog.Logger
+}
+
+// NewProviderClient создаёт Client, который готовит текст сам и считает embedding через provider.
+func NewProviderClient(provider EmbeddingProvider, log *slog.Logger) Client {
+	return &providerClient{provider: provider, log: log}
+}
+
+func (c *providerClient) PrepareAndEmbed(ctx context.Context, req PrepareAndEmbedRequest) (*PrepareAndEmbedResponse, error) {
+	const op = "ml.providerClient.PrepareAndEmbed"
+
+	text := PrepareText(req)
+	embeddings, err := c.provider.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &PrepareAndEmbedResponse{
+		Embedding:    embeddings[0],
+		Dimensions:   len(embeddings[0]),
+		PreparedText: text,
+	}, nil
+}
+
+func (c *providerClient) Reindex(ctx context.Context, req ReindexRequest) (*ReindexResponse, error) {
+	const op = "ml.providerClient.Reindex"
+
+	text := reindexText(req)
+	embeddings, err := c.provider.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &ReindexResponse{
+		EntityID:     req.EntityID,
+		EntityType:   req.EntityType,
+		Embedding:    embeddings[0],
+		PreparedText: text,
+	}, nil
+}
+
+// ReindexBatch считает всю пачку одним вызовом провайдера.
+func (c *providerClient) ReindexBatch(ctx context.Context, req ReindexBatchRequest) (*ReindexBatchResponse, error) {
+	const op = "ml.providerClient.ReindexBatch"
+
+	texts := make([]string, len(req.Entities))
+	for i, e := range req.Entities {
+		texts[i] = reindexText(e)
+	}
+
+	embeddings, err := c.provider.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	results := make([]ReindexResponse, len(req.Entities))
+	for i, e := range req.Entities {
+		results[i] = ReindexResponse{
+			EntityID:     e.EntityID,
+			EntityType:   e.EntityType,
+			Embedding:    embeddings[i],
+			PreparedText: texts[i],
+		}
+	}
+
+	return &ReindexBatchResponse{
+		Results: results,
+		Total:   len(req.Entities),
+		Success: len(req.Entities),
+	}, nil
+}
+
+func (c *providerClient) GetModelInfo(ctx context.Context) (*ModelInfo, error) {
+	const op = "ml.providerClient.GetModelInfo"
+
+	info, err := c.provider.ModelInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return info, nil
+}
+
+// Ping проверяет провайдер, если у него есть сетевая зависимость (см. pinger);
+// локальные провайдеры доступны всегда.
+func (c *providerClient) Ping(ctx context.Context) error {
+	const op = "ml.providerClient.Ping"
+
+	p, ok := c.provider.(pinger)
+	if !ok {
+		return nil
+	}
+	if err := p.Ping(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// pinger — провайдер с проверкой доступности в обход resilience-слоя.
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// PrepareText собирает текст для embedding из полей запроса: заголовок, описание,
+// затем структурированные характеристики и требования лида в детерминированном порядке.
+func PrepareText(req PrepareAndEmbedRequest) string {
+	parts := make([]string, 0, 8)
+	for _, s := range []string{req.Title, req.Description} {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	if req.Price != nil {
+		parts = append(parts, "Цена: "+strconv.FormatInt(*req.Price, 10)+" руб")
+	}
+	if req.Rooms != nil {
+		parts = append(parts, "Комнат: "+strconv.Itoa(int(*req.Rooms)))
+	}
+	if req.Area != nil {
+		parts = append(parts, "Площадь: "+strconv.FormatFloat(*req.Area, 'f', -1, 64)+" м²")
+	}
+	if req.District != nil && *req.District != "" {
+		parts = append(parts, "Район: "+*req.District)
+	}
+	if req.Address != nil && *req.Address != "" {
+		parts = append(parts, "Адрес: "+*req.Address)
+	}
+
+	keys := make([]string, 0, len(req.Requirement))
+	for k := range req.Requirement {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v := req.Requirement[k]; v != nil {
+			parts = append(parts, fmt.Sprintf("%s: %v", k, v))
+		}
+	}
+
+	return strings.Join(parts, ". ")
+}
+
+func reindexText(req ReindexRequest) string {
+	return PrepareText(PrepareAndEmbedRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		Price:       req.Price,
+		District:    req.District,
+		Rooms:       req.Rooms,
+		Area:        req.Area,
+		Address:     req.Address,
+	})
+}
@@ -0,0 +1,242 @@
+package ml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/resilience"
+)
+
+func cosine(a, b []float64) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += a[i] * b[i]
+		na += a[i] * a[i]
+		nb += b[i] * b[i]
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+func TestNewClient_Provider(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name string
+		cfg  config.MLConfig
+		want string
+	}{
+		{name: "disabled", cfg: config.MLConfig{Enabled: false, Provider: ProviderHashing}, want: "*ml.noopClient"},
+		{name: "remote by default", cfg: config.MLConfig{Enabled: true}, want: "*ml.client"},
+		{name: "hashing", cfg: config.MLConfig{Enabled: true, Provider: ProviderHashing}, want: "*ml.providerClient"},
+		{name: "openai", cfg: config.MLConfig{Enabled: true, Provider: ProviderOpenAI}, want: "*ml.providerClient"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf("%T", NewClient(tt.cfg, log)); got != tt.want {
+				t.Errorf("client = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown provider", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for unknown provider")
+			}
+		}()
+		NewClient(config.MLConfig{Enabled: true, Provider: "word2vec"}, log)
+	})
+}
+
+func TestNewNextClient_SeparateBreaker(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(ModelInfo{Model: "current", Dimensions: 384})
+	}))
+	defer healthy.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+
+	cfg := config.MLConfig{
+		Enabled:     true,
+		BaseURL:     healthy.URL,
+		NextBaseURL: broken.URL,
+		Timeout:     5 * time.Second,
+		Resilience:  config.ResilienceConfig{BreakerThreshold: 1, BreakerCooldown: time.Hour},
+	}
+	current, next := NewClient(cfg, log), NewNextClient(cfg, log)
+
+	if _, err := next.GetModelInfo(context.Background()); err == nil {
+		t.Fatal("expected error from broken next model")
+	}
+	// Сбои новой модели не отключают основную
+	if _, err := current.GetModelInfo(context.Background()); err != nil {
+		t.Fatalf("current model: %v", err)
+	}
+
+	stats := metrics.GetAIMetrics(log).GetStats()
+	if stats.EmbeddingNext.CircuitState != resilience.StateOpen {
+		t.Errorf("next model circuit = %q, want %q", stats.EmbeddingNext.CircuitState, resilience.StateOpen)
+	}
+	if stats.Embedding.CircuitState != resilience.StateClosed {
+		t.Errorf("current model circuit = %q, want %q", stats.Embedding.CircuitState, resilience.StateClosed)
+	}
+}
+
+func TestHashingEmbedder(t *testing.T) {
+	e := NewHashingEmbedder(0)
+	ctx := context.Background()
+
+	texts := []string{
+		"Светлая 2-комнатная квартира в центре Москвы, рядом с метро",
+		"Ищу светлую двухкомнатную квартиру в центре Москвы у метро",
+		"Офис 100 кв.м в бизнес-центре, отличный ремонт",
+	}
+	first, err := e.Embed(ctx, texts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, _ := e.Embed(ctx, texts)
+
+	for i := range texts {
+		if len(first[i]) != DefaultHashingDimensions {
+			t.Fatalf("text %d: dimensions = %d, want %d", i, len(first[i]), DefaultHashingDimensions)
+		}
+		if !slices.Equal(first[i], second[i]) {
+			t.Errorf("text %d: embedding is not deterministic", i)
+		}
+	}
+
+	if n := cosine(first[0], first[0]); math.Abs(n-1) > 1e-9 {
+		t.Errorf("embedding is not L2-normalized: %f", n)
+	}
+	if apartment, office := cosine(first[0], first[1]), cosine(first[0], first[2]); apartment <= office {
+		t.Errorf("similar texts: %.3f, different texts: %.3f", apartment, office)
+	}
+
+	for _, text := range []string{"", "   \n\t", "—, !", "и в на"} {
+		if _, err := e.Embed(ctx, []string{texts[0], text}); !errors.Is(err, ErrEmptyText) {
+			t.Errorf("Embed(%q) error = %v, want %v", text, err, ErrEmptyText)
+		}
+	}
+
+	info, _ := NewHashingEmbedder(128).ModelInfo(ctx)
+	if info.Model != "hashing-128" || info.Dimensions != 128 {
+		t.Errorf("model info = %+v", info)
+	}
+}
+
+func TestProviderClient_ReindexBatch(t *testing.T) {
+	c := NewProviderClient(NewHashingEmbedder(16), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	rooms := int32(2)
+
+	resp, err := c.ReindexBatch(context.Background(), ReindexBatchRequest{Entities: []ReindexRequest{
+		{EntityID: "a", EntityType: "lead", Title: "Квартира", Rooms: &rooms},
+		{EntityID: "b", EntityType: "property", Title: "Офис"},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Total != 2 || resp.Success != 2 || len(resp.Results) != 2 {
+		t.Fatalf("response = %+v", resp)
+	}
+	if resp.Results[0].EntityID != "a" || resp.Results[0].PreparedText != "Квартира. Комнат: 2" {
+		t.Errorf("result 0 = %+v", resp.Results[0])
+	}
+	if len(resp.Results[1].Embedding) != 16 {
+		t.Errorf("dimensions = %d, want 16", len(resp.Results[1].Embedding))
+	}
+}
+
+func TestOpenAIEmbedder(t *testing.T) {
+	var requests []openAIEmbeddingRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/embeddings" || r.Method != http.MethodPost {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer test-key" {
+			t.Errorf("unexpected auth header: %s", auth)
+		}
+
+		var req openAIEmbeddingRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+
+		// Отвечаем в обратном порядке: клиент должен сопоставлять по index
+		var resp openAIEmbeddingResponse
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			resp.Data = append(resp.Data, struct {
+				Index     int       `json:"index"`
+				Embedding []float64 `json:"embedding"`
+			}{Index: i, Embedding: []float64{float64(i), 1, 0}})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	e := NewOpenAIEmbedder(config.MLConfig{
+		BaseURL: server.URL,
+		APIKey:  "test-key",
+		Model:   "text-embedding-3-small",
+		Timeout: 5 * time.Second,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	embeddings, err := e.Embed(ctx, []string{"первый", "второй"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if embeddings[0][0] != 0 || embeddings[1][0] != 1 {
+		t.Errorf("embeddings are out of order: %v", embeddings)
+	}
+
+	// Размерность не задана в конфиге — определяется один раз пробным запросом
+	for range 2 {
+		info, err := e.ModelInfo(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.Model != "text-embedding-3-small" || info.Dimensions != 3 {
+			t.Errorf("model info = %+v", info)
+		}
+	}
+	if len(requests) != 2 {
+		t.Errorf("requests = %d, want 2", len(requests))
+	}
+	if requests[0].Model != "text-embedding-3-small" || requests[0].Dimensions != 0 {
+		t.Errorf("request = %+v", requests[0])
+	}
+}
+
+func TestOpenAIEmbedder_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"invalid api key"}`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	e := NewOpenAIEmbedder(config.MLConfig{BaseURL: server.URL, Timeout: 5 * time.Second}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if _, err := e.Embed(context.Background(), []string{"текст"}); err == nil {
+		t.Error("expected error for non-200 response")
+	}
+}
@@ -0,0 +1,53 @@
+package ml
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Switch — Client, реализацию которого можно заменить на лету.
+// Используется для переключения на новую модель embedding без перезапуска сервера.
+type Switch struct {
+	current atomic.Pointer[clientRef]
+}
+
+type clientRef struct {
+	client Client
+}
+
+// NewSwitch создаёт переключатель с исходным клиентом.
+func NewSwitch(c Client) *Switch {
+	s := &Switch{}
+	s.Set(c)
+	return s
+}
+
+// Set заменяет клиент; уже начатые запросы завершаются старым клиентом.
+func (s *Switch) Set(c Client) {
+	s.current.Store(&clientRef{client: c})
+}
+
+// Current возвращает текущий клиент.
+func (s *Switch) Current() Client {
+	return s.current.Load().client
+}
+
+func (s *Switch) PrepareAndEmbed(ctx context.Context, req PrepareAndEmbedRequest) (*PrepareAndEmbedResponse, error) {
+	return s.Current().PrepareAndEmbed(ctx, req)
+}
+
+func (s *Switch) Reindex(ctx context.Context, req ReindexRequest) (*ReindexResponse, error) {
+	return s.Current().Reindex(ctx, req)
+}
+
+func (s *Switch) ReindexBatch(ctx context.Context, req ReindexBatchRequest) (*ReindexBatchResponse, error) {
+	return s.Current().ReindexBatch(ctx, req)
+}
+
+func (s *Switch) GetModelInfo(ctx context.Context) (*ModelInfo, error) {
+	return s.Current().GetModelInfo(ctx)
+}
+
+func (s *Switch) Ping(ctx context.Context) error {
+	return s.Current().Ping(ctx)
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/resilience"
 	"log/slog"
 )
 
@@ -50,6 +52,7 @@ type Usage struct {
 
 type client struct {
 	httpClient *http.Client
+	transport  *resilience.Transport
 	baseURL    string
 	apiKey     string
 	model      string
@@ -62,14 +65,14 @@ func NewClient(cfg config.RerankerConfig, log *slog.Logger) Client {
 		return &noopClient{log: log}
 	}
 
+	httpClient, transport := resilience.NewClient(metrics.ServiceReranker, cfg.Resilience, cfg.Timeout, metrics.GetAIMetrics(log), log)
 	return &client{
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
-		baseURL: cfg.BaseURL,
-		apiKey:  cfg.APIKey,
-		model:   cfg.Model,
-		log:     log,
+		httpClient: httpClient,
+		transport:  transport,
+		baseURL:    cfg.BaseURL,
+		apiKey:     cfg.APIKey,
+		model:      cfg.Model,
+		log:        log,
 	}
 }
 
@@ -126,8 +129,9 @@ func (c *client) Rerank(ctx context.Context, req RerankRequest) (*RerankResponse
 	return &result, nil
 }
 
+// IsEnabled возвращает false, пока circuit breaker отключил reranker: поиск обходится без него.
 func (c *client) IsEnabled() bool {
-	return true
+	return c.transport.Ready()
 }
 
 // noopClient — заглушка для случая, когда Reranker отключен.
@@ -172,14 +176,19 @@ func NewJinaClient(apiKey string, log *slog.Logger) Client {
 		return &noopClient{log: log}
 	}
 
-	return &client{
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+	return NewClient(config.RerankerConfig{
+		Enabled: true,
+		BaseURL: "https://api.jina.ai/v1",
+		APIKey:  apiKey,
+		Model:   "jina-reranker-v2-base-multilingual",
+		Timeout: 30 * time.Second,
+		Resilience: config.ResilienceConfig{
+			MaxRetries:       2,
+			RetryBaseDelay:   200 * time.Millisecond,
+			RetryMaxDelay:    5 * time.Second,
+			BreakerThreshold: 5,
+			BreakerCooldown:  30 * time.Second,
 		},
-		baseURL: "https://api.jina.ai/v1",
-		apiKey:  apiKey,
-		model:   "jina-reranker-v2-base-multilingual",
-		log:     log,
-	}
+	}, log)
 }
 
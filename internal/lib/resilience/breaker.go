@@ -0,0 +1,134 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+// Состояния circuit breaker.
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half_open"
+)
+
+// Breaker — circuit breaker по числу неудачных вызовов подряд.
+// После threshold неудач провайдер считается недоступным на cooldown, затем пропускается
+// один пробный вызов: успех закрывает breaker, неудача снова открывает его.
+type Breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	onChange  func(state string)
+
+	failures int
+	openedAt time.Time
+	state    string
+	probing  bool
+}
+
+// NewBreaker создаёт breaker; threshold <= 0 отключает его (Allow всегда true).
+// onChange (если задан) вызывается при каждой смене состояния.
+func NewBreaker(threshold int, cooldown time.Duration, onChange func(state string)) *Breaker {
+	if onChange == nil {
+		onChange = func(string) {}
+	}
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		onChange:  onChange,
+		state:     StateClosed,
+	}
+}
+
+// State возвращает текущее состояние; открытый breaker по истечении cooldown считается half_open.
+func (b *Breaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState()
+}
+
+// Ready сообщает, примет ли breaker вызов прямо сейчас, не резервируя пробный вызов.
+// Клиенты используют его в IsEnabled, чтобы сервисы уходили на эвристики, пока провайдер недоступен.
+func (b *Breaker) Ready() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState() {
+	case StateOpen:
+		return false
+	case StateHalfOpen:
+		return !b.probing
+	}
+	return true
+}
+
+// Allow резервирует вызов. В half_open пропускается только один пробный вызов за раз.
+func (b *Breaker) Allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState() {
+	case StateOpen:
+		return false
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		b.setState(StateHalfOpen)
+	}
+	return true
+}
+
+// Done фиксирует результат вызова, разрешённого Allow.
+func (b *Breaker) Done(success bool) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		b.setState(StateClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		b.setState(StateOpen)
+	}
+}
+
+// Cancel освобождает вызов, разрешённый Allow, не учитывая его результат
+// (например, запрос отменила вызывающая сторона).
+func (b *Breaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *Breaker) currentState() string {
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return StateHalfOpen
+	}
+	return b.state
+}
+
+func (b *Breaker) setState(state string) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	b.onChange(state)
+}
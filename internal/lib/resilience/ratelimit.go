@@ -0,0 +1,83 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket — лимит запросов к провайдеру: rate токенов в секунду, не больше burst в запасе.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket создаёт лимит; rate <= 0 — без ограничения (возвращает nil).
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve забирает токен и возвращает, сколько нужно подождать до его появления.
+// Запас может уйти в минус: следующие запросы встают в очередь за уже зарезервированными.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// release возвращает зарезервированный токен, который так и не был использован.
+func (b *tokenBucket) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// wait ждёт токен; возвращает true, если пришлось ждать, и ошибку контекста, если не дождались.
+// Токен отменённого ожидания возвращается, чтобы запросы в очереди за ним не ждали лишнего.
+func (b *tokenBucket) wait(ctx context.Context) (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+
+	d := b.reserve()
+	if d <= 0 {
+		return false, nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true, nil
+	case <-ctx.Done():
+		b.release()
+		return true, ctx.Err()
+	}
+}
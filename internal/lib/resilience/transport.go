@@ -0,0 +1,280 @@
+// Package resilience — общий HTTP middleware для клиентов AI-провайдеров (ml, llm, reranker, vision):
+// таймаут на попытку, повторы с jitter, token-bucket лимит запросов и circuit breaker.
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/tracing"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrCircuitOpen — провайдер отключён circuit breaker, запрос не отправлялся.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Transport — http.RoundTripper с защитой вызовов одного провайдера.
+// Один логический запрос (RoundTrip) — это до MaxRetries+1 попыток; breaker и метрики
+// учитывают его итог, а не отдельные попытки.
+type Transport struct {
+	service metrics.ServiceType
+	base    http.RoundTripper
+	cfg     config.ResilienceConfig
+	timeout time.Duration
+	limiter *tokenBucket
+	breaker *Breaker
+	metrics *metrics.AIMetrics
+	log     *slog.Logger
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewTransport создаёт Transport для service. timeout — ограничение одной попытки
+// (вместо http.Client.Timeout, который распространялся бы на все повторы сразу).
+// m может быть nil — тогда состояние не экспортируется.
+func NewTransport(
+	service metrics.ServiceType,
+	cfg config.ResilienceConfig,
+	timeout time.Duration,
+	m *metrics.AIMetrics,
+	log *slog.Logger,
+) *Transport {
+	t := &Transport{
+		service: service,
+		base:    otelhttp.NewTransport(http.DefaultTransport),
+		cfg:     cfg,
+		timeout: timeout,
+		limiter: newTokenBucket(cfg.RateLimit, cfg.RateBurst),
+		metrics: m,
+		log:     log.With(slog.String("service", string(service))),
+		sleep:   sleep,
+	}
+
+	t.breaker = NewBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown, func(state string) {
+		t.log.Info("circuit breaker state changed", slog.String("state", state))
+		if t.metrics != nil {
+			t.metrics.SetCircuitState(service, state)
+		}
+	})
+	if m != nil {
+		m.SetCircuitState(service, StateClosed)
+	}
+
+	return t
+}
+
+// NewClient создаёт http.Client поверх NewTransport.
+func NewClient(
+	service metrics.ServiceType,
+	cfg config.ResilienceConfig,
+	timeout time.Duration,
+	m *metrics.AIMetrics,
+	log *slog.Logger,
+) (*http.Client, *Transport) {
+	t := NewTransport(service, cfg, timeout, m, log)
+	return &http.Client{Transport: t}, t
+}
+
+// Ready сообщает, доступен ли провайдер (breaker не открыт). nil Transport всегда готов.
+func (t *Transport) Ready() bool {
+	if t == nil {
+		return true
+	}
+	return t.breaker.Ready()
+}
+
+// RoundTrip выполняет запрос с повторами. Повторяются сетевые ошибки, таймауты попытки, 429 и 5xx;
+// тело запроса перечитывается через req.GetBody.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Спан логического вызова; каждая попытка — дочерний HTTP-спан
+	ctx, span := tracing.Start(req.Context(), "ai."+string(t.service),
+		attribute.String("ai.service", string(t.service)),
+		attribute.String("http.url", req.URL.String()),
+	)
+	req = req.WithContext(ctx)
+
+	if !t.breaker.Allow() {
+		if t.metrics != nil {
+			t.metrics.RecordShortCircuit(t.service)
+		}
+		err := fmt.Errorf("%s: %w", t.service, ErrCircuitOpen)
+		tracing.End(span, err)
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := t.roundTrip(ctx, req)
+
+	failed := err != nil || retryableStatus(resp.StatusCode)
+	// Отмена запроса вызывающей стороной ничего не говорит о здоровье провайдера
+	if err != nil && ctx.Err() != nil {
+		t.breaker.Cancel()
+	} else {
+		t.breaker.Done(!failed)
+	}
+
+	callErr := err
+	if callErr == nil {
+		span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+		if failed {
+			callErr = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+	}
+	tracing.End(span, callErr)
+
+	if t.metrics != nil {
+		t.metrics.RecordCall(t.service, time.Since(start), callErr, 0)
+	}
+
+	return resp, err
+}
+
+func (t *Transport) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		waited, err := t.limiter.wait(ctx)
+		if waited && t.metrics != nil {
+			t.metrics.RecordRateLimited(t.service)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		attemptReq, err := t.prepareAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.attempt(attemptReq)
+
+		last := attempt >= t.cfg.MaxRetries || ctx.Err() != nil
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if last {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if err == nil {
+			delay = max(delay, retryAfter(resp, t.cfg.RetryMaxDelay))
+			// Тело неудачного ответа не нужно, но его надо дочитать, чтобы переиспользовать соединение
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+
+		t.log.Debug("retrying AI provider request",
+			slog.Int("attempt", attempt+1),
+			slog.Duration("delay", delay),
+			slog.Any("error", err),
+		)
+		if t.metrics != nil {
+			t.metrics.RecordRetry(t.service)
+		}
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt+1),
+			attribute.String("delay", delay.String()),
+		))
+
+		if err := t.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// prepareAttempt возвращает запрос для попытки attempt с заново открытым телом.
+func (t *Transport) prepareAttempt(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("resilience: request body cannot be replayed")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// attempt выполняет одну попытку с собственным таймаутом. Контекст попытки отменяется
+// при закрытии тела ответа, чтобы таймаут распространялся и на чтение тела.
+func (t *Transport) attempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff — «full jitter»: случайная задержка от 0 до min(RetryMaxDelay, RetryBaseDelay*2^attempt).
+func (t *Transport) backoff(attempt int) time.Duration {
+	ceiling := t.cfg.RetryBaseDelay << attempt
+	if ceiling <= 0 || ceiling > t.cfg.RetryMaxDelay {
+		ceiling = t.cfg.RetryMaxDelay
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling)
+}
+
+// retryableStatus — ответы, после которых имеет смысл повторить запрос.
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// retryAfter читает заголовок Retry-After (в секундах), ограничивая его limit.
+func retryAfter(resp *http.Response, limit time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return min(time.Duration(seconds)*time.Second, limit)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,240 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/metrics"
+)
+
+func newTestTransport(cfg config.ResilienceConfig, m *metrics.AIMetrics) (*http.Client, *Transport, *[]time.Duration) {
+	client, t := NewClient(metrics.ServiceLLM, cfg, time.Second, m, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	var sleeps []time.Duration
+	t.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return ctx.Err()
+	}
+	return client, t, &sleeps
+}
+
+func post(t *testing.T, client *http.Client, url string) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	return client.Do(req)
+}
+
+func TestTransport_Retry(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    []int
+		maxRetries  int
+		wantStatus  int
+		wantCalls   int32
+		wantRetries int64
+	}{
+		{name: "success", statuses: []int{200}, maxRetries: 2, wantStatus: 200, wantCalls: 1},
+		{name: "recovers after 503 and 429", statuses: []int{503, 429, 200}, maxRetries: 2, wantStatus: 200, wantCalls: 3, wantRetries: 2},
+		{name: "gives up after max retries", statuses: []int{500, 500, 500, 500}, maxRetries: 2, wantStatus: 500, wantCalls: 3, wantRetries: 2},
+		{name: "client error is not retried", statuses: []int{400, 200}, maxRetries: 2, wantStatus: 400, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "payload" {
+					t.Errorf("attempt %d: body = %q", calls.Load(), body)
+				}
+				w.WriteHeader(tt.statuses[calls.Add(1)-1])
+			}))
+			defer server.Close()
+
+			m := &metrics.AIMetrics{}
+			client, _, _ := newTestTransport(config.ResilienceConfig{
+				MaxRetries:     tt.maxRetries,
+				RetryBaseDelay: 10 * time.Millisecond,
+				RetryMaxDelay:  100 * time.Millisecond,
+			}, m)
+
+			resp, err := post(t, client, server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus || calls.Load() != tt.wantCalls {
+				t.Errorf("status = %d, calls = %d", resp.StatusCode, calls.Load())
+			}
+			stats := m.GetStats().LLM
+			if stats.RetriesTotal != tt.wantRetries || stats.CallsTotal != 1 {
+				t.Errorf("retries = %d, calls = %d", stats.RetriesTotal, stats.CallsTotal)
+			}
+		})
+	}
+}
+
+func TestTransport_RetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _, sleeps := newTestTransport(config.ResilienceConfig{
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  2 * time.Second,
+	}, nil)
+
+	resp, err := post(t, client, server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	// Retry-After уважается, но не дольше RetryMaxDelay
+	if len(*sleeps) != 1 || (*sleeps)[0] != 2*time.Second {
+		t.Errorf("sleeps = %v", *sleeps)
+	}
+}
+
+func TestTransport_CircuitBreaker(t *testing.T) {
+	var healthy atomic.Bool
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	m := &metrics.AIMetrics{}
+	client, tr, _ := newTestTransport(config.ResilienceConfig{
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Minute,
+	}, m)
+	now := time.Now()
+	tr.breaker.now = func() time.Time { return now }
+
+	for range 2 {
+		resp, err := post(t, client, server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if tr.Ready() {
+		t.Fatal("breaker must open after threshold failures")
+	}
+	if _, err := post(t, client, server.URL); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("open breaker must not call provider, calls = %d", calls.Load())
+	}
+
+	stats := m.GetStats().LLM
+	if stats.CircuitState != StateOpen || stats.CircuitOpensTotal != 1 || stats.ShortCircuitedTotal != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+
+	// После cooldown пробный запрос проходит и закрывает breaker
+	now = now.Add(time.Minute)
+	healthy.Store(true)
+	if !tr.Ready() {
+		t.Fatal("breaker must accept probe after cooldown")
+	}
+	resp, err := post(t, client, server.URL)
+	if err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := m.GetStats().LLM.CircuitState; got != StateClosed {
+		t.Errorf("circuit state = %s, want closed", got)
+	}
+}
+
+func TestTransport_CanceledContextDoesNotTripBreaker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, tr, _ := newTestTransport(config.ResilienceConfig{BreakerThreshold: 1, BreakerCooldown: time.Minute}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := client.Do(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+
+	if !tr.Ready() {
+		t.Error("canceled request must not open breaker")
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(2, 2)
+	b.now = func() time.Time { return now }
+
+	// Запас burst расходуется без ожидания, дальше — по 1/rate секунды на запрос
+	want := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i, w := range want {
+		if got := b.reserve(); got != w {
+			t.Errorf("reserve %d = %v, want %v", i, got, w)
+		}
+	}
+
+	now = now.Add(2 * time.Second)
+	if got := b.reserve(); got != 0 {
+		t.Errorf("after refill reserve = %v, want 0", got)
+	}
+
+	if newTokenBucket(0, 10) != nil {
+		t.Error("zero rate must disable limiter")
+	}
+}
+
+func TestTokenBucket_CanceledWaitReleasesToken(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(1, 1)
+	b.now = func() time.Time { return now }
+
+	b.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if waited, err := b.wait(ctx); !waited || !errors.Is(err, context.Canceled) {
+		t.Fatalf("wait = %v, %v; want true, %v", waited, err, context.Canceled)
+	}
+
+	// Токен отменённого ожидания вернулся: следующий запрос ждёт одну секунду, а не две
+	if got := b.reserve(); got != time.Second {
+		t.Errorf("reserve after canceled wait = %v, want %v", got, time.Second)
+	}
+}
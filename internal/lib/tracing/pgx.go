@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxStatementLength — длина SQL в атрибуте спана; длинные запросы обрезаются.
+const maxStatementLength = 2000
+
+// QueryTracer — pgx.QueryTracer, открывающий спан на каждый SQL-запрос.
+// Параметры запроса в спан не пишутся: в них бывают контакты и embedding.
+type QueryTracer struct{}
+
+// NewQueryTracer создаёт трейсер для pgx.ConnConfig.Tracer.
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{}
+}
+
+// TraceQueryStart реализует pgx.QueryTracer.
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = Start(ctx, "db.query "+operationName(data.SQL),
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", truncate(data.SQL, maxStatementLength)),
+	)
+	return ctx
+}
+
+// TraceQueryEnd реализует pgx.QueryTracer.
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	End(span, data.Err)
+}
+
+// operationName — первое слово запроса (SELECT, INSERT, WITH, ...), чтобы имена спанов не зависели от параметров.
+func operationName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/metrics"
+	"lead_exchange/internal/lib/resilience"
 	"log/slog"
 )
 
@@ -71,6 +75,7 @@ type PropertyImageAnalysis struct {
 
 type client struct {
 	httpClient *http.Client
+	transport  *resilience.Transport
 	baseURL    string
 	apiKey     string
 	log        *slog.Logger
@@ -82,13 +87,13 @@ func NewClient(cfg config.VisionConfig, log *slog.Logger) Client {
 		return &noopClient{log: log}
 	}
 
+	httpClient, transport := resilience.NewClient(metrics.ServiceVision, cfg.Resilience, cfg.Timeout, metrics.GetAIMetrics(log), log)
 	return &client{
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
-		baseURL: cfg.BaseURL,
-		apiKey:  cfg.APIKey,
-		log:     log,
+		httpClient: httpClient,
+		transport:  transport,
+		baseURL:    cfg.BaseURL,
+		apiKey:     cfg.APIKey,
+		log:        log,
 	}
 }
 
@@ -138,7 +143,7 @@ func (c *client) AnalyzeImages(ctx context.Context, images [][]byte) (*PropertyI
 		analyses = append(analyses, analysis)
 	}
 
-	return c.aggregateAnalyses(analyses), nil
+	return Aggregate(analyses), nil
 }
 
 // AnalyzeImageURL анализирует изображение по URL.
@@ -163,8 +168,9 @@ func (c *client) AnalyzeImageURL(ctx context.Context, imageURL string) (*ImageAn
 	return resp, nil
 }
 
+// IsEnabled возвращает false, пока circuit breaker отключил CV API.
 func (c *client) IsEnabled() bool {
-	return true
+	return c.transport.Ready()
 }
 
 type visionRequest struct {
@@ -212,8 +218,9 @@ func (c *client) sendRequest(ctx context.Context, endpoint string, req visionReq
 	return &result, nil
 }
 
-// aggregateAnalyses агрегирует результаты анализа нескольких изображений.
-func (c *client) aggregateAnalyses(analyses []*ImageAnalysis) *PropertyImageAnalysis {
+// Aggregate агрегирует результаты анализа нескольких изображений. Комнаты, виды и особенности
+// отсортированы по имени, так что результат не зависит от порядка обхода.
+func Aggregate(analyses []*ImageAnalysis) *PropertyImageAnalysis {
 	if len(analyses) == 0 {
 		return &PropertyImageAnalysis{}
 	}
@@ -255,26 +262,29 @@ func (c *client) aggregateAnalyses(analyses []*ImageAnalysis) *PropertyImageAnal
 	for room := range roomsSet {
 		result.DetectedRooms = append(result.DetectedRooms, room)
 	}
+	slices.Sort(result.DetectedRooms)
 
 	for view := range viewsSet {
 		result.ViewTypes = append(result.ViewTypes, view)
 	}
+	slices.Sort(result.ViewTypes)
 
 	for _, f := range featuresMap {
 		result.AllFeatures = append(result.AllFeatures, f)
 	}
+	slices.SortFunc(result.AllFeatures, func(a, b Feature) int { return strings.Compare(a.Name, b.Name) })
 
 	// Генерируем общую оценку
-	result.OverallAssessment = c.generateAssessment(result)
+	result.OverallAssessment = generateAssessment(result)
 
 	// Генерируем embedding features
-	result.EmbeddingFeatures = c.generateEmbeddingFeatures(result)
+	result.EmbeddingFeatures = generateEmbeddingFeatures(result)
 
 	return result
 }
 
 // generateAssessment генерирует текстовую оценку объекта.
-func (c *client) generateAssessment(analysis *PropertyImageAnalysis) string {
+func generateAssessment(analysis *PropertyImageAnalysis) string {
 	var parts []string
 
 	if analysis.AverageQuality >= 0.8 {
@@ -305,7 +315,7 @@ func (c *client) generateAssessment(analysis *PropertyImageAnalysis) string {
 	if len(analysis.ViewTypes) > 0 {
 		hasGoodView := false
 		for _, v := range analysis.ViewTypes {
-			if v == "park" || v == "river" || v == "panorama" {
+			if slices.Contains(domain.GoodViewTypes, v) {
 				hasGoodView = true
 				break
 			}
@@ -318,55 +328,39 @@ func (c *client) generateAssessment(analysis *PropertyImageAnalysis) string {
 	return strings.Join(parts, ", ")
 }
 
-// generateEmbeddingFeatures генерирует числовые признаки для эмбеддинга.
-func (c *client) generateEmbeddingFeatures(analysis *PropertyImageAnalysis) []float64 {
-	// Генерируем вектор из 16 признаков
-	features := make([]float64, 16)
+// generateEmbeddingFeatures генерирует числовые признаки для эмбеддинга (раскладка — domain.VisualQuality и др.).
+func generateEmbeddingFeatures(analysis *PropertyImageAnalysis) []float64 {
+	features := make([]float64, domain.VisualFeaturesLen)
 
-	// 0: Quality score
-	features[0] = analysis.AverageQuality
+	features[domain.VisualQuality] = analysis.AverageQuality
 
-	// 1: Number of rooms (normalized)
-	features[1] = float64(len(analysis.DetectedRooms)) / 10.0
-	if features[1] > 1.0 {
-		features[1] = 1.0
-	}
+	// Число типов помещений (нормализованное)
+	features[domain.VisualRoomTypes] = min(float64(len(analysis.DetectedRooms))/10.0, 1.0)
 
-	// 2: Number of premium features (normalized)
+	// Число премиум-особенностей (нормализованное)
 	premiumCount := 0
 	for _, f := range analysis.AllFeatures {
 		if f.Category == "premium" {
 			premiumCount++
 		}
 	}
-	features[2] = float64(premiumCount) / 5.0
-	if features[2] > 1.0 {
-		features[2] = 1.0
-	}
+	features[domain.VisualPremium] = min(float64(premiumCount)/5.0, 1.0)
 
-	// 3: Has good view
 	for _, v := range analysis.ViewTypes {
-		if v == "park" || v == "river" || v == "panorama" {
-			features[3] = 1.0
+		if slices.Contains(domain.GoodViewTypes, v) {
+			features[domain.VisualGoodView] = 1.0
 			break
 		}
 	}
 
-	// 4-15: Feature presence flags
-	featureNames := []string{
-		"balcony", "terrace", "panoramic_windows", "high_ceilings",
-		"modern_kitchen", "master_bedroom", "walk_in_closet", "bathroom_modern",
-		"parking", "security", "gym", "pool",
-	}
-
+	// Флаги наличия особенностей
 	featureSet := make(map[string]bool)
 	for _, f := range analysis.AllFeatures {
 		featureSet[f.Name] = true
 	}
-
-	for i, name := range featureNames {
+	for i, name := range domain.VisualFeatureFlags {
 		if featureSet[name] {
-			features[4+i] = 1.0
+			features[domain.VisualFlagsOffset+i] = 1.0
 		}
 	}
 
@@ -0,0 +1,124 @@
+package vision_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/vision"
+	"lead_exchange/internal/lib/vision/visiontest"
+)
+
+func newTestClient(t *testing.T, srv *visiontest.Server) vision.Client {
+	t.Helper()
+	return vision.NewClient(config.VisionConfig{
+		Enabled: true,
+		BaseURL: srv.URL,
+		Timeout: 5 * time.Second,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestClient_AnalyzeImage(t *testing.T) {
+	srv := visiontest.NewServer()
+	defer srv.Close()
+	c := newTestClient(t, srv)
+
+	img := []byte("kitchen photo")
+	got, err := c.AnalyzeImage(context.Background(), img)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := visiontest.Analyze(img)
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("AnalyzeImage() = %+v, want %+v", *got, want)
+	}
+	if srv.Requests() != 1 {
+		t.Errorf("requests = %d, want 1", srv.Requests())
+	}
+}
+
+func TestClient_AnalyzeImages_SkipsFailed(t *testing.T) {
+	srv := visiontest.NewServer()
+	defer srv.Close()
+	c := newTestClient(t, srv)
+
+	ok1, ok2, broken := []byte("photo 1"), []byte("photo 2"), []byte("broken")
+	srv.FailOn(broken)
+
+	got, err := c.AnalyzeImages(context.Background(), [][]byte{ok1, broken, ok2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.TotalImages != 2 {
+		t.Errorf("TotalImages = %d, want 2", got.TotalImages)
+	}
+
+	a1, a2 := visiontest.Analyze(ok1), visiontest.Analyze(ok2)
+	want := vision.Aggregate([]*vision.ImageAnalysis{&a1, &a2})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AnalyzeImages() = %+v, want %+v", got, want)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	analyses := []*vision.ImageAnalysis{
+		{
+			RoomType: "kitchen", ViewType: "park", QualityScore: 0.9,
+			DetectedFeatures: []vision.Feature{
+				{Name: "modern_kitchen", Confidence: 0.7, Category: "interior"},
+				{Name: "balcony", Confidence: 0.6, Category: "exterior"},
+			},
+		},
+		{
+			RoomType: "bedroom", ViewType: "city", QualityScore: 0.8,
+			DetectedFeatures: []vision.Feature{
+				{Name: "balcony", Confidence: 0.9, Category: "exterior"},
+				{Name: "panoramic_windows", Confidence: 0.8, Category: "premium"},
+			},
+		},
+	}
+
+	got := vision.Aggregate(analyses)
+
+	if got.TotalImages != 2 {
+		t.Errorf("TotalImages = %d, want 2", got.TotalImages)
+	}
+	if got.AverageQuality < 0.849 || got.AverageQuality > 0.851 {
+		t.Errorf("AverageQuality = %v, want 0.85", got.AverageQuality)
+	}
+	if want := []string{"bedroom", "kitchen"}; !slices.Equal(got.DetectedRooms, want) {
+		t.Errorf("DetectedRooms = %v, want %v", got.DetectedRooms, want)
+	}
+	if want := []string{"city", "park"}; !slices.Equal(got.ViewTypes, want) {
+		t.Errorf("ViewTypes = %v, want %v", got.ViewTypes, want)
+	}
+	wantFeatures := []vision.Feature{
+		{Name: "balcony", Confidence: 0.9, Category: "exterior"},
+		{Name: "modern_kitchen", Confidence: 0.7, Category: "interior"},
+		{Name: "panoramic_windows", Confidence: 0.8, Category: "premium"},
+	}
+	if !reflect.DeepEqual(got.AllFeatures, wantFeatures) {
+		t.Errorf("AllFeatures = %v, want %v", got.AllFeatures, wantFeatures)
+	}
+	if len(got.EmbeddingFeatures) != 16 {
+		t.Fatalf("len(EmbeddingFeatures) = %d, want 16", len(got.EmbeddingFeatures))
+	}
+	if got.EmbeddingFeatures[3] != 1 {
+		t.Errorf("good view flag = %v, want 1", got.EmbeddingFeatures[3])
+	}
+	if got.OverallAssessment != "отличное состояние, 2 типов помещений, 1 премиум-особенностей, хороший вид" {
+		t.Errorf("OverallAssessment = %q", got.OverallAssessment)
+	}
+
+	reversed := slices.Clone(analyses)
+	slices.Reverse(reversed)
+	if again := vision.Aggregate(reversed); !reflect.DeepEqual(got.AllFeatures, again.AllFeatures) {
+		t.Errorf("aggregate depends on order: %v vs %v", got.AllFeatures, again.AllFeatures)
+	}
+}
@@ -0,0 +1,127 @@
+// Package visiontest — детерминированный фейковый CV API для тестов.
+//
+// Результат анализа зависит только от содержимого изображения (или URL): одинаковые
+// байты всегда дают одинаковые комнату, качество, вид и особенности.
+package visiontest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"lead_exchange/internal/lib/vision"
+)
+
+var (
+	rooms = []string{"kitchen", "bedroom", "living_room", "bathroom", "hallway"}
+	views = []string{"", "city", "park", "yard", "river"}
+	// features — особенности, которые может «увидеть» сервер; включаются битами хэша
+	features = []vision.Feature{
+		{Name: "balcony", Category: "exterior"},
+		{Name: "panoramic_windows", Category: "premium"},
+		{Name: "high_ceilings", Category: "premium"},
+		{Name: "modern_kitchen", Category: "interior"},
+		{Name: "walk_in_closet", Category: "interior"},
+		{Name: "parking", Category: "amenity"},
+	}
+)
+
+// Server — httptest.Server с эндпоинтами /analyze и /analyze-url, как у CV API.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests int
+	failing  map[[sha256.Size]byte]bool
+}
+
+// NewServer запускает фейковый CV API; закрывается через Close.
+func NewServer() *Server {
+	s := &Server{failing: make(map[[sha256.Size]byte]bool)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /analyze", s.handle(func(req request) ([]byte, bool) {
+		data, err := base64.StdEncoding.DecodeString(req.Image)
+		return data, err == nil && len(data) > 0
+	}))
+	mux.HandleFunc("POST /analyze-url", s.handle(func(req request) ([]byte, bool) {
+		return []byte(req.URL), req.URL != ""
+	}))
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Analyze — результат, который сервер вернёт для data.
+func Analyze(data []byte) vision.ImageAnalysis {
+	return analyze(sha256.Sum256(data))
+}
+
+// FailOn заставляет сервер отвечать 500 на изображение data.
+func (s *Server) FailOn(data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing[sha256.Sum256(data)] = true
+}
+
+// Requests — сколько запросов анализа получил сервер.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+type request struct {
+	Image string `json:"image"`
+	URL   string `json:"url"`
+}
+
+func (s *Server) handle(input func(request) ([]byte, bool)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		data, ok := input(req)
+		if !ok {
+			http.Error(w, "empty image", http.StatusBadRequest)
+			return
+		}
+
+		sum := sha256.Sum256(data)
+		s.mu.Lock()
+		s.requests++
+		failing := s.failing[sum]
+		s.mu.Unlock()
+
+		if failing {
+			http.Error(w, "analysis failed", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(analyze(sum))
+	}
+}
+
+func analyze(sum [sha256.Size]byte) vision.ImageAnalysis {
+	a := vision.ImageAnalysis{
+		RoomType:     rooms[int(sum[0])%len(rooms)],
+		ViewType:     views[int(sum[1])%len(views)],
+		QualityScore: float64(sum[2]) / 255,
+		Brightness:   float64(sum[3]) / 255,
+		Confidence:   0.5 + float64(sum[4])/510,
+		Tags:         map[string]string{"source": "visiontest"},
+	}
+	for i, f := range features {
+		if sum[5]&(1<<i) != 0 {
+			f.Confidence = 0.5 + float64(sum[6+i])/510
+			a.DetectedFeatures = append(a.DetectedFeatures, f)
+		}
+	}
+	return a
+}
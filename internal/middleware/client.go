@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ClientIP — адрес клиента. Запросы через HTTP gateway приходят с loopback-адреса,
+// а адрес клиента gateway дописывает последним элементом x-forwarded-for;
+// предыдущие элементы задаёт сам клиент, поэтому им не доверяем.
+func ClientIP(ctx context.Context) string {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+	}
+
+	if ip := net.ParseIP(addr); ip == nil || ip.IsLoopback() {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+				parts := strings.Split(fwd[len(fwd)-1], ",")
+				if last := strings.TrimSpace(parts[len(parts)-1]); last != "" {
+					return last
+				}
+			}
+		}
+	}
+
+	if addr == "" {
+		return "unknown"
+	}
+	return addr
+}
+
+// UserAgent — User-Agent клиента: HTTP-заголовок, переданный gateway, или user-agent gRPC-клиента.
+func UserAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if v := md.Get(key); len(v) > 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// RetryAfterError — ResourceExhausted с google.rpc.RetryInfo и заголовком retry-after
+// (через gateway уходит в HTTP-заголовок Grpc-Metadata-Retry-After).
+func RetryAfterError(ctx context.Context, retryAfter time.Duration, msg string) error {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(seconds)))
+
+	st := status.Newf(codes.ResourceExhausted, "%s, retry after %ds", msg, seconds)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(seconds) * time.Second),
+	}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -3,33 +3,60 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"lead_exchange/internal/domain"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type ctxKey string
 
-const userIDKey ctxKey = "userID"
+const (
+	userIDKey     ctxKey = "userID"
+	userRoleKey   ctxKey = "userRole"
+	userStatusKey ctxKey = "userStatus"
+)
 
 func FromContext(ctx context.Context) (uuid.UUID, bool) {
 	id, ok := ctx.Value(userIDKey).(uuid.UUID)
 	return id, ok
 }
 
-func JWTUnaryInterceptor(secret string, disableAuth bool) grpc.UnaryServerInterceptor {
-	// Список методов, для которых токен не нужен
-	whitelist := map[string]struct{}{
-		"/leadexchange.v1.AuthService/Login":       {},
-		"/leadexchange.v1.AuthService/Register":    {},
-		"/leadexchange.v1.AuthService/HealthCheck": {},
-	}
+// RoleFromContext — роль пользователя из claims токена.
+func RoleFromContext(ctx context.Context) (domain.UserRole, bool) {
+	role, ok := ctx.Value(userRoleKey).(domain.UserRole)
+	return role, ok
+}
 
-	// Тестовый user ID для использования когда auth отключен
-	testUserID := uuid.MustParse("8c6f9c70-9312-4f17-94b0-2a2b9230f5d1")
+// StatusFromContext — статус пользователя из claims токена (на момент выдачи токена).
+func StatusFromContext(ctx context.Context) (domain.UserStatus, bool) {
+	st, ok := ctx.Value(userStatusKey).(domain.UserStatus)
+	return st, ok
+}
+
+// WithUser кладёт в контекст ID, роль и статус пользователя — так же, как JWT middleware
+// после проверки токена (для вызовов сервисов в обход gRPC, например в тестах).
+func WithUser(ctx context.Context, uid uuid.UUID, role domain.UserRole, st domain.UserStatus) context.Context {
+	ctx = context.WithValue(ctx, userIDKey, uid)
+	ctx = context.WithValue(ctx, userRoleKey, role)
+	return context.WithValue(ctx, userStatusKey, st)
+}
+
+// TokenVersionSource — источник текущей версии токенов пользователя.
+// Access-токен с версией ниже текущей считается отозванным.
+type TokenVersionSource interface {
+	GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// JWTUnaryInterceptor проверяет access-токен и кладёт пользователя в контекст.
+// Если versions не nil, дополнительно проверяется, что токен не отозван.
+func JWTUnaryInterceptor(secret string, disableAuth bool, versions TokenVersionSource) grpc.UnaryServerInterceptor {
+	authenticate := newAuthenticator(secret, disableAuth, versions)
 
 	return func(
 		ctx context.Context,
@@ -37,38 +64,87 @@ func JWTUnaryInterceptor(secret string, disableAuth bool) grpc.UnaryServerInterc
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
+		ctx, err := authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// JWTStreamInterceptor — то же, что JWTUnaryInterceptor, для потоковых RPC.
+func JWTStreamInterceptor(secret string, disableAuth bool, versions TokenVersionSource) grpc.StreamServerInterceptor {
+	authenticate := newAuthenticator(secret, disableAuth, versions)
+
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream подменяет контекст потока контекстом с пользователем.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// newAuthenticator возвращает функцию, которая проверяет токен из метаданных
+// и возвращает контекст с пользователем.
+func newAuthenticator(secret string, disableAuth bool, versions TokenVersionSource) func(ctx context.Context, method string) (context.Context, error) {
+	// Список методов, для которых токен не нужен
+	whitelist := map[string]struct{}{
+		"/leadexchange.v1.AuthService/Login":        {},
+		"/leadexchange.v1.AuthService/Register":     {},
+		"/leadexchange.v1.AuthService/RefreshToken": {},
+		"/leadexchange.v1.AuthService/HealthCheck":  {},
+		"/grpc.health.v1.Health/Check":              {},
+		"/grpc.health.v1.Health/List":               {},
+		"/grpc.health.v1.Health/Watch":              {},
+	}
+
+	// Тестовый пользователь для использования когда auth отключен. Роль обычного пользователя:
+	// отключение проверки токенов не должно давать права администратора
+	testUserID := uuid.MustParse("8c6f9c70-9312-4f17-94b0-2a2b9230f5d1")
+
+	return func(ctx context.Context, method string) (context.Context, error) {
 		// Если auth отключен, используем тестовый user ID
 		if disableAuth {
-			ctx = context.WithValue(ctx, userIDKey, testUserID)
-			return handler(ctx, req)
+			return WithUser(ctx, testUserID, domain.UserRoleUser, domain.UserStatusActive), nil
 		}
 
-		if _, ok := whitelist[info.FullMethod]; ok {
-			return handler(ctx, req)
+		if _, ok := whitelist[method]; ok {
+			return ctx, nil
 		}
 
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
-			return nil, fmt.Errorf("missing metadata")
+			return nil, status.Error(codes.Unauthenticated, "missing metadata")
 		}
 
 		authHeaders := md.Get("authorization")
 		if len(authHeaders) == 0 {
-			return nil, fmt.Errorf("missing authorization header")
+			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
 		}
 
 		parts := strings.SplitN(authHeaders[0], " ", 2)
 		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-			return nil, fmt.Errorf("invalid authorization header format")
+			return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
 		}
 
 		tokenString := parts[1]
 
-		if tokenString == "test" {
-			ctx = context.WithValue(ctx, userIDKey, testUserID)
-			return handler(ctx, req)
-		}
-
 		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -77,26 +153,51 @@ func JWTUnaryInterceptor(secret string, disableAuth bool) grpc.UnaryServerInterc
 		})
 
 		if err != nil || !token.Valid {
-			return nil, fmt.Errorf("invalid token: %v", err)
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 		}
 
 		claims, ok := token.Claims.(jwt.MapClaims)
 		if !ok {
-			return nil, fmt.Errorf("invalid token claims")
+			return nil, status.Error(codes.Unauthenticated, "invalid token claims")
 		}
 
 		uidStr, ok := claims["uid"].(string)
 		if !ok {
-			return nil, fmt.Errorf("uid not found in token")
+			return nil, status.Error(codes.Unauthenticated, "uid not found in token")
 		}
 
 		uid, err := uuid.Parse(uidStr)
 		if err != nil {
-			return nil, fmt.Errorf("invalid uid in token")
+			return nil, status.Error(codes.Unauthenticated, "invalid uid in token")
 		}
 
-		// Передаём userID в контекст
-		ctx = context.WithValue(ctx, userIDKey, uid)
-		return handler(ctx, req)
+		if versions != nil {
+			// Токены без claim ver выпущены до появления отзыва и имеют версию 0
+			var tokenVersion int
+			if v, ok := claims["ver"].(float64); ok {
+				tokenVersion = int(v)
+			}
+
+			current, err := versions.GetTokenVersion(ctx, uid)
+			if err != nil {
+				return nil, status.Errorf(codes.Unauthenticated, "failed to verify token: %v", err)
+			}
+			if tokenVersion < current {
+				return nil, status.Error(codes.Unauthenticated, "token revoked")
+			}
+		}
+
+		// Токены, выпущенные до появления claim role, считаются токенами обычного пользователя
+		role := domain.UserRoleUser
+		if r, ok := claims["role"].(string); ok && r != "" {
+			role = domain.UserRole(r)
+		}
+		userStatus := domain.UserStatusActive
+		if st, ok := claims["status"].(string); ok && st != "" {
+			userStatus = domain.UserStatus(st)
+		}
+
+		// Передаём userID, роль и статус в контекст
+		return WithUser(ctx, uid, role, userStatus), nil
 	}
 }
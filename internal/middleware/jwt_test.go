@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/jwt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type stubTokenVersions map[uuid.UUID]int
+
+func (s stubTokenVersions) GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	return s[userID], nil
+}
+
+func TestJWTUnaryInterceptor_TokenVersion(t *testing.T) {
+	const secret = "secret"
+	user := domain.User{ID: uuid.New(), Role: domain.UserRoleUser, Status: domain.UserStatusActive, TokenVersion: 1}
+
+	token, err := jwt.NewToken(user, secret, time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.UserService/GetProfile"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		name     string
+		current  int
+		wantCode codes.Code
+	}{
+		{"current version accepted", 1, codes.OK},
+		{"bumped version revokes token", 2, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions := stubTokenVersions{user.ID: tt.current}
+			_, err := JWTUnaryInterceptor(secret, false, versions)(ctx, nil, info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("expected code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+		})
+	}
+}
+
+func TestJWTUnaryInterceptor_RefreshTokenWhitelisted(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.AuthService/RefreshToken"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	if _, err := JWTUnaryInterceptor("secret", false, stubTokenVersions{})(context.Background(), nil, info, handler); err != nil {
+		t.Errorf("expected RefreshToken to be callable without token, got %v", err)
+	}
+}
+
+func TestJWTUnaryInterceptor_TestToken(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.UserService/GetProfile"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer test"))
+
+	tests := []struct {
+		name        string
+		disableAuth bool
+		wantCode    codes.Code
+		wantRole    domain.UserRole
+	}{
+		{name: "rejected when auth is enabled", disableAuth: false, wantCode: codes.Unauthenticated},
+		{name: "auth disabled gives non-admin user", disableAuth: true, wantCode: codes.OK, wantRole: domain.UserRoleUser},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var role domain.UserRole
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				role, _ = RoleFromContext(ctx)
+				return "ok", nil
+			}
+
+			_, err := JWTUnaryInterceptor("secret", tt.disableAuth, nil)(ctx, nil, info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("expected code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+			if role != tt.wantRole {
+				t.Errorf("role = %q, want %q", role, tt.wantRole)
+			}
+		})
+	}
+}
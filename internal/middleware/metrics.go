@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCObserver — приёмник длительности RPC (metrics.Prometheus).
+type RPCObserver interface {
+	ObserveRPC(method, code string, d time.Duration)
+}
+
+// MetricsUnaryInterceptor записывает длительность каждого RPC с кодом статуса.
+// Должен стоять первым в цепочке, чтобы учитывать и отказы авторизации.
+func MetricsUnaryInterceptor(observer RPCObserver) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observer.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor — то же, что MetricsUnaryInterceptor, для потоковых RPC:
+// длительность считается до завершения потока.
+func MetricsStreamInterceptor(observer RPCObserver) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observer.ObserveRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
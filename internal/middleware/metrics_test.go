@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type observation struct {
+	method string
+	code   string
+}
+
+type fakeObserver struct {
+	observed []observation
+}
+
+func (o *fakeObserver) ObserveRPC(method, code string, _ time.Duration) {
+	o.observed = append(o.observed, observation{method: method, code: code})
+}
+
+func TestMetricsUnaryInterceptor(t *testing.T) {
+	const method = "/leadexchange.v1.LeadService/GetLead"
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+	}{
+		{"success", nil, "OK"},
+		{"status error", status.Error(codes.NotFound, "lead not found"), "NotFound"},
+		{"plain error", context.DeadlineExceeded, "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observer := &fakeObserver{}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, tt.err }
+
+			_, err := MetricsUnaryInterceptor(observer)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			if err != tt.err {
+				t.Errorf("interceptor must return handler error as is, got %v", err)
+			}
+			if len(observer.observed) != 1 || observer.observed[0] != (observation{method, tt.wantCode}) {
+				t.Errorf("observed = %+v", observer.observed)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"lead_exchange/internal/lib/logger/sl"
+
+	"google.golang.org/grpc"
+)
+
+// RateLimit — бюджет вызовов метода: не больше Requests за окно Window на пользователя (или IP).
+type RateLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// MaxRateLimitWindow — самое длинное допустимое окно: хранилища удаляют счётчики старше него.
+const MaxRateLimitWindow = 24 * time.Hour
+
+// MethodRateLimits — бюджеты по умолчанию: подбор пароля и методы, расходующие платные LLM/reranker/CV API.
+// Методы, которых нет в карте, не ограничиваются.
+var MethodRateLimits = map[string]RateLimit{
+	"/leadexchange.v1.AuthService/Login":    {Requests: 10, Window: time.Minute},
+	"/leadexchange.v1.AuthService/Register": {Requests: 5, Window: time.Hour},
+
+	"/leadexchange.v1.PropertyService/MatchPropertiesAdvanced": {Requests: 30, Window: time.Minute},
+	"/leadexchange.v1.PropertyService/GenerateListingContent":  {Requests: 10, Window: time.Minute},
+	"/leadexchange.v1.PropertyService/AnalyzePropertyImages":   {Requests: 10, Window: time.Minute},
+	"/leadexchange.v1.LeadService/GetClarificationQuestions":   {Requests: 20, Window: time.Minute},
+	"/leadexchange.v1.LeadService/AnalyzeLeadIntent":           {Requests: 20, Window: time.Minute},
+	"/leadexchange.v1.FileService/CreateUploadURL":             {Requests: 60, Window: time.Minute},
+}
+
+// RateLimitStore — счётчики фиксированных окон. Incr учитывает запрос по ключу в окне,
+// начавшемся в windowStart, и возвращает число запросов в этом окне.
+type RateLimitStore interface {
+	Incr(ctx context.Context, key string, windowStart time.Time) (int, error)
+}
+
+// ParseRateLimits разбирает переопределения бюджетов из строки вида
+// "/leadexchange.v1.AuthService/Login=5/1m,/leadexchange.v1.PropertyService/MatchPropertiesAdvanced=0/1m"
+// и накладывает их на base. Requests = 0 снимает ограничение с метода.
+func ParseRateLimits(base map[string]RateLimit, s string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit, len(base))
+	for method, limit := range base {
+		limits[method] = limit
+	}
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		method, budget, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("rate limit %q: expected method=requests/window", entry)
+		}
+		requests, window, ok := strings.Cut(budget, "/")
+		if !ok {
+			return nil, fmt.Errorf("rate limit %q: expected requests/window", entry)
+		}
+
+		n, err := strconv.Atoi(requests)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("rate limit %q: invalid requests", entry)
+		}
+		d, err := time.ParseDuration(window)
+		if err != nil || d <= 0 || d > MaxRateLimitWindow {
+			return nil, fmt.Errorf("rate limit %q: invalid window", entry)
+		}
+
+		if n == 0 {
+			delete(limits, method)
+			continue
+		}
+		limits[method] = RateLimit{Requests: n, Window: d}
+	}
+
+	return limits, nil
+}
+
+// RateLimitUnaryInterceptor ограничивает вызовы методов из limits. Ключ — ID пользователя
+// из контекста, для неаутентифицированных методов (Login) — IP клиента. Превышение бюджета —
+// ResourceExhausted с метаданными retry-after (секунды) и RetryInfo в деталях статуса.
+// Должен стоять в цепочке после JWTUnaryInterceptor. Ошибка хранилища не блокирует запрос.
+func RateLimitUnaryInterceptor(store RateLimitStore, limits map[string]RateLimit, log *slog.Logger) grpc.UnaryServerInterceptor {
+	now := time.Now
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		limit, ok := limits[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		t := now()
+		windowStart := t.Truncate(limit.Window)
+		key := info.FullMethod + "|" + rateLimitSubject(ctx)
+
+		hits, err := store.Incr(ctx, key, windowStart)
+		if err != nil {
+			log.Warn("rate limit store failed, request allowed",
+				slog.String("method", info.FullMethod),
+				sl.Err(err),
+			)
+			return handler(ctx, req)
+		}
+
+		if hits > limit.Requests {
+			retryAfter := windowStart.Add(limit.Window).Sub(t)
+			return nil, RetryAfterError(ctx, retryAfter, "rate limit exceeded for "+info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitSubject — пользователь из контекста или IP клиента.
+func rateLimitSubject(ctx context.Context) string {
+	if userID, ok := FromContext(ctx); ok {
+		return "user:" + userID.String()
+	}
+	return "ip:" + ClientIP(ctx)
+}
+
+// MemoryRateLimitStore — счётчики в памяти процесса: для одного экземпляра сервера.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	windows   map[string]memoryWindow
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type memoryWindow struct {
+	start time.Time
+	hits  int
+}
+
+// memorySweepInterval — как часто из памяти удаляются закончившиеся окна.
+const memorySweepInterval = time.Minute
+
+// NewMemoryRateLimitStore создаёт хранилище счётчиков в памяти.
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		windows: make(map[string]memoryWindow),
+		now:     time.Now,
+	}
+}
+
+// Incr реализует RateLimitStore.
+func (s *MemoryRateLimitStore) Incr(_ context.Context, key string, windowStart time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+
+	w := s.windows[key]
+	if w.start.Before(windowStart) {
+		w = memoryWindow{start: windowStart}
+	}
+	w.hits++
+	s.windows[key] = w
+
+	return w.hits, nil
+}
+
+// sweep удаляет окна старше MaxRateLimitWindow: они точно закончились.
+func (s *MemoryRateLimitStore) sweep() {
+	now := s.now()
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	cutoff := now.Add(-MaxRateLimitWindow)
+	for key, w := range s.windows {
+		if w.start.Before(cutoff) {
+			delete(s.windows, key)
+		}
+	}
+}
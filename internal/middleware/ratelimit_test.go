@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"lead_exchange/internal/domain"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type failingStore struct{}
+
+func (failingStore) Incr(context.Context, string, time.Time) (int, error) {
+	return 0, errors.New("connection refused")
+}
+
+func withPeer(ctx context.Context, addr string) context.Context {
+	host, port, _ := net.SplitHostPort(addr)
+	ip := net.ParseIP(host)
+	p, _ := net.LookupPort("tcp", port)
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: ip, Port: p}})
+}
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	const method = "/leadexchange.v1.AuthService/Login"
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	limits := map[string]RateLimit{method: {Requests: 2, Window: time.Hour}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	interceptor := RateLimitUnaryInterceptor(NewMemoryRateLimitStore(), limits, log)
+
+	call := func(ctx context.Context) error {
+		_, err := interceptor(ctx, nil, info, handler)
+		return err
+	}
+
+	alice := withPeer(context.Background(), "203.0.113.5:5000")
+	for i := range 2 {
+		if err := call(alice); err != nil {
+			t.Fatalf("call %d: unexpected error: %v", i, err)
+		}
+	}
+
+	err := call(alice)
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("code = %v, want ResourceExhausted", st.Code())
+	}
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	if retry == nil || retry.RetryDelay.AsDuration() <= 0 || retry.RetryDelay.AsDuration() > time.Hour {
+		t.Errorf("retry info = %v", retry)
+	}
+
+	// Другой IP и аутентифицированный пользователь считаются отдельно
+	if err := call(withPeer(context.Background(), "198.51.100.7:5000")); err != nil {
+		t.Errorf("other IP must not be limited: %v", err)
+	}
+	user := WithUser(alice, uuid.New(), domain.UserRoleUser, domain.UserStatusActive)
+	if err := call(user); err != nil {
+		t.Errorf("user key must not share IP budget: %v", err)
+	}
+
+	// Методы без бюджета не ограничиваются
+	for range 5 {
+		if _, err := interceptor(alice, nil, &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.LeadService/GetLead"}, handler); err != nil {
+			t.Fatalf("unlimited method: %v", err)
+		}
+	}
+
+	// Недоступное хранилище не блокирует запросы
+	if _, err := RateLimitUnaryInterceptor(failingStore{}, limits, log)(alice, nil, info, handler); err != nil {
+		t.Errorf("store failure must fail open, got %v", err)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "direct gRPC client",
+			ctx:  withPeer(context.Background(), "203.0.113.5:5000"),
+			want: "203.0.113.5",
+		},
+		{
+			name: "gateway appends real client address last",
+			ctx: metadata.NewIncomingContext(
+				withPeer(context.Background(), "127.0.0.1:5000"),
+				metadata.Pairs("x-forwarded-for", "10.0.0.1, 203.0.113.5"),
+			),
+			want: "203.0.113.5",
+		},
+		{
+			name: "forwarded header from remote peer is ignored",
+			ctx: metadata.NewIncomingContext(
+				withPeer(context.Background(), "198.51.100.7:5000"),
+				metadata.Pairs("x-forwarded-for", "10.0.0.1"),
+			),
+			want: "198.51.100.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClientIP(tt.ctx); got != tt.want {
+				t.Errorf("ClientIP = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryRateLimitStore_WindowReset(t *testing.T) {
+	s := NewMemoryRateLimitStore()
+	window := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for want := 1; want <= 3; want++ {
+		if got, _ := s.Incr(context.Background(), "k", window); got != want {
+			t.Errorf("hits = %d, want %d", got, want)
+		}
+	}
+	if got, _ := s.Incr(context.Background(), "k", window.Add(time.Minute)); got != 1 {
+		t.Errorf("new window hits = %d, want 1", got)
+	}
+}
+
+func TestParseRateLimits(t *testing.T) {
+	base := map[string]RateLimit{
+		"/a.S/Login": {Requests: 10, Window: time.Minute},
+		"/a.S/Match": {Requests: 30, Window: time.Minute},
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		want    map[string]RateLimit
+		wantErr bool
+	}{
+		{name: "empty keeps defaults", in: "", want: base},
+		{
+			name: "override and disable",
+			in:   "/a.S/Login=5/30s, /a.S/Match=0/1m,/a.S/New=1/1h",
+			want: map[string]RateLimit{
+				"/a.S/Login": {Requests: 5, Window: 30 * time.Second},
+				"/a.S/New":   {Requests: 1, Window: time.Hour},
+			},
+		},
+		{name: "missing window", in: "/a.S/Login=5", wantErr: true},
+		{name: "bad duration", in: "/a.S/Login=5/soon", wantErr: true},
+		{name: "window too long", in: "/a.S/Login=5/48h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRateLimits(base, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("limits = %v, want %v", got, tt.want)
+			}
+			for method, limit := range tt.want {
+				if got[method] != limit {
+					t.Errorf("%s = %v, want %v", method, got[method], limit)
+				}
+			}
+		})
+	}
+	if base["/a.S/Match"].Requests != 30 {
+		t.Error("base map must not be modified")
+	}
+}
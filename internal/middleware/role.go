@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MethodRoles — минимальная роль, необходимая для вызова метода.
+// Методы, которых нет в карте, доступны любому аутентифицированному пользователю.
+var MethodRoles = map[string]domain.UserRole{
+	"/leadexchange.v1.UserService/UpdateUserStatus": domain.UserRoleAdmin,
+	"/leadexchange.v1.UserService/ListUsers":        domain.UserRoleAdmin,
+	"/leadexchange.v1.UserService/UnlockUser":       domain.UserRoleAdmin,
+
+	"/leadexchange.v1.EmbeddingService/ListEmbeddingJobs": domain.UserRoleAdmin,
+	"/leadexchange.v1.EmbeddingService/RetryEmbeddingJob": domain.UserRoleAdmin,
+	"/leadexchange.v1.EmbeddingService/ReindexAll":        domain.UserRoleAdmin,
+}
+
+// hasRole — true, если роль пользователя удовлетворяет требуемой.
+// Администратор имеет доступ ко всем методам.
+func hasRole(role, required domain.UserRole) bool {
+	return role == domain.UserRoleAdmin || role == required
+}
+
+// checkMethodRole возвращает PermissionDenied, если роли пользователя недостаточно для метода.
+func checkMethodRole(ctx context.Context, methodRoles map[string]domain.UserRole, method string) error {
+	required, ok := methodRoles[method]
+	if !ok {
+		return nil
+	}
+
+	role, ok := RoleFromContext(ctx)
+	if !ok || !hasRole(role, required) {
+		return status.Errorf(codes.PermissionDenied, "method %s requires role %s", method, required)
+	}
+
+	return nil
+}
+
+// RoleUnaryInterceptor проверяет роль пользователя из контекста по карте methodRoles.
+// Должен стоять в цепочке после JWTUnaryInterceptor.
+func RoleUnaryInterceptor(methodRoles map[string]domain.UserRole) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkMethodRole(ctx, methodRoles, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RoleStreamInterceptor — то же, что RoleUnaryInterceptor, для потоковых RPC.
+// Должен стоять в цепочке после JWTStreamInterceptor.
+func RoleStreamInterceptor(methodRoles map[string]domain.UserRole) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkMethodRole(ss.Context(), methodRoles, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/jwt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoleUnaryInterceptor(t *testing.T) {
+	const method = "/leadexchange.v1.UserService/UpdateUserStatus"
+	interceptor := RoleUnaryInterceptor(MethodRoles)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+	}{
+		{"admin allowed", WithUser(context.Background(), uuid.New(), domain.UserRoleAdmin, domain.UserStatusActive), method, codes.OK},
+		{"user denied", WithUser(context.Background(), uuid.New(), domain.UserRoleUser, domain.UserStatusActive), method, codes.PermissionDenied},
+		{"no role denied", context.Background(), method, codes.PermissionDenied},
+		{"unlisted method allowed", WithUser(context.Background(), uuid.New(), domain.UserRoleUser, domain.UserStatusActive), "/leadexchange.v1.UserService/GetProfile", codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("expected code %v, got %v (%v)", tt.wantCode, got, err)
+			}
+		})
+	}
+}
+
+func TestJWTUnaryInterceptor_RoleClaims(t *testing.T) {
+	const secret = "secret"
+	user := domain.User{ID: uuid.New(), Role: domain.UserRoleAdmin, Status: domain.UserStatusActive}
+
+	token, err := jwt.NewToken(user, secret, time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.UserService/GetProfile"}
+
+	var gotCtx context.Context
+	_, err = JWTUnaryInterceptor(secret, false, nil)(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		gotCtx = ctx
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if uid, _ := FromContext(gotCtx); uid != user.ID {
+		t.Errorf("expected user ID %s, got %s", user.ID, uid)
+	}
+	if role, _ := RoleFromContext(gotCtx); role != domain.UserRoleAdmin {
+		t.Errorf("expected role ADMIN, got %q", role)
+	}
+	if st, _ := StatusFromContext(gotCtx); st != domain.UserStatusActive {
+		t.Errorf("expected status ACTIVE, got %q", st)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
 )
 
 type DealRepository struct {
@@ -23,10 +24,16 @@ func NewDealRepository(db *pgxpool.Pool, log *slog.Logger) *DealRepository {
 	return &DealRepository{db: db, log: log}
 }
 
-// CreateDeal — создаёт новую сделку.
+// CreateDeal — создаёт новую сделку и записывает начальный статус в историю.
 func (r *DealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid.UUID, error) {
 	const op = "DealRepository.CreateDeal"
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		INSERT INTO deals (
 			lead_id, seller_user_id, buyer_user_id,
@@ -37,7 +44,7 @@ func (r *DealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid
 	`
 
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		deal.LeadID,
 		deal.SellerUserID,
 		deal.BuyerUserID,
@@ -48,6 +55,18 @@ func (r *DealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid
 		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := insertStatusChange(ctx, tx, domain.DealStatusChange{
+		DealID:          id,
+		ToStatus:        deal.Status,
+		ChangedByUserID: deal.SellerUserID,
+	}); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: commit: %w", op, err)
+	}
+
 	return id, nil
 }
 
@@ -208,3 +227,234 @@ func (r *DealRepository) ListDeals(ctx context.Context, filter domain.DealFilter
 
 	return deals, rows.Err()
 }
+
+// ChangeStatus — атомарно переводит сделку из change.FromStatus в change.ToStatus
+// и записывает переход в deal_status_history. Если buyerUserID не nil, он
+// проставляется покупателем сделки, если price не nil — новой ценой сделки. Возвращает
+// ErrDealStatusConflict, если текущий статус сделки уже не равен change.FromStatus.
+func (r *DealRepository) ChangeStatus(ctx context.Context, change domain.DealStatusChange, buyerUserID *uuid.UUID, price *float64) error {
+	const op = "DealRepository.ChangeStatus"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateStatusTx(ctx, tx, change, buyerUserID, price); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// CompleteDeal — атомарно завершает сделку и передаёт лид покупателю:
+// переводит сделку ACCEPTED → COMPLETED, помечает лид как PURCHASED,
+// назначает покупателя владельцем лида и отменяет остальные PENDING-сделки
+// по этому лиду. Строка лида блокируется на время транзакции, поэтому два
+// покупателя не могут завершить сделки по одному лиду одновременно.
+// Возвращает ErrLeadAlreadyPurchased, если лид уже куплен.
+func (r *DealRepository) CompleteDeal(ctx context.Context, change domain.DealStatusChange, buyerUserID uuid.UUID) error {
+	const op = "DealRepository.CompleteDeal"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var leadID uuid.UUID
+	var leadStatus domain.LeadStatus
+	err = tx.QueryRow(ctx, `
+		SELECT l.lead_id, l.status
+		FROM leads l
+		JOIN deals d ON d.lead_id = l.lead_id
+		WHERE d.deal_id = $1
+		FOR UPDATE OF l
+	`, change.DealID).Scan(&leadID, &leadStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repository.ErrDealNotFound)
+		}
+		return fmt.Errorf("%s: lock lead: %w", op, err)
+	}
+
+	if leadStatus == domain.LeadStatusPurchased {
+		return fmt.Errorf("%s: %w", op, repository.ErrLeadAlreadyPurchased)
+	}
+
+	if err := updateStatusTx(ctx, tx, change, nil, nil); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE leads
+		SET status = $1, owner_user_id = $2, updated_at = NOW()
+		WHERE lead_id = $3
+	`, domain.LeadStatusPurchased.String(), buyerUserID, leadID)
+	if err != nil {
+		return fmt.Errorf("%s: transfer lead: %w", op, err)
+	}
+
+	// Остальные предложения по лиду теряют смысл — отменяем их от имени продавца
+	rows, err := tx.Query(ctx, `
+		UPDATE deals
+		SET status = $1, updated_at = NOW()
+		WHERE lead_id = $2 AND deal_id <> $3 AND status = $4
+		RETURNING deal_id, seller_user_id
+	`, domain.DealStatusCancelled.String(), leadID, change.DealID, domain.DealStatusPending.String())
+	if err != nil {
+		return fmt.Errorf("%s: cancel pending deals: %w", op, err)
+	}
+
+	var cancelled []domain.DealStatusChange
+	for rows.Next() {
+		c := domain.DealStatusChange{
+			FromStatus: domain.DealStatusPending,
+			ToStatus:   domain.DealStatusCancelled,
+		}
+		if err := rows.Scan(&c.DealID, &c.ChangedByUserID); err != nil {
+			rows.Close()
+			return fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		cancelled = append(cancelled, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: cancel pending deals: %w", op, err)
+	}
+
+	for _, c := range cancelled {
+		if err := insertStatusChange(ctx, tx, c); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: commit: %w", op, err)
+	}
+
+	r.log.Info("lead transferred to buyer",
+		slog.String("lead_id", leadID.String()),
+		slog.String("deal_id", change.DealID.String()),
+		slog.Int("cancelled_deals", len(cancelled)),
+	)
+
+	return nil
+}
+
+// GetStatusHistory — возвращает историю статусов сделки в хронологическом порядке.
+func (r *DealRepository) GetStatusHistory(ctx context.Context, dealID uuid.UUID) ([]domain.DealStatusChange, error) {
+	const op = "DealRepository.GetStatusHistory"
+
+	query := `
+		SELECT
+			history_id, deal_id, COALESCE(from_status, ''), to_status,
+			changed_by_user_id, created_at
+		FROM deal_status_history
+		WHERE deal_id = $1
+		ORDER BY created_at, history_id
+	`
+
+	rows, err := r.db.Query(ctx, query, dealID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var history []domain.DealStatusChange
+	for rows.Next() {
+		var c domain.DealStatusChange
+		if err := rows.Scan(
+			&c.ID,
+			&c.DealID,
+			&c.FromStatus,
+			&c.ToStatus,
+			&c.ChangedByUserID,
+			&c.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		history = append(history, c)
+	}
+
+	return history, rows.Err()
+}
+
+// CountByStatus — число сделок в каждом статусе (для метрик).
+func (r *DealRepository) CountByStatus(ctx context.Context) (map[domain.DealStatus]int64, error) {
+	const op = "DealRepository.CountByStatus"
+
+	rows, err := r.db.Query(ctx, "SELECT status, COUNT(*) FROM deals GROUP BY status")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.DealStatus]int64)
+	for rows.Next() {
+		var (
+			status domain.DealStatus
+			count  int64
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return counts, nil
+}
+
+// updateStatusTx — переводит сделку из change.FromStatus в change.ToStatus и пишет историю.
+// Непустые buyerUserID и price обновляются той же командой.
+func updateStatusTx(ctx context.Context, tx pgx.Tx, change domain.DealStatusChange, buyerUserID *uuid.UUID, price *float64) error {
+	query := `
+		UPDATE deals
+		SET status = $1, buyer_user_id = COALESCE($2, buyer_user_id), price = COALESCE($5, price), updated_at = NOW()
+		WHERE deal_id = $3 AND status = $4
+	`
+
+	tag, err := tx.Exec(ctx, query,
+		change.ToStatus.String(),
+		buyerUserID,
+		change.DealID,
+		change.FromStatus.String(),
+		price,
+	)
+	if err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrDealStatusConflict
+	}
+
+	return insertStatusChange(ctx, tx, change)
+}
+
+// insertStatusChange — добавляет запись в deal_status_history в рамках транзакции.
+func insertStatusChange(ctx context.Context, tx pgx.Tx, change domain.DealStatusChange) error {
+	var fromStatus *string
+	if change.FromStatus != domain.DealStatusUnspecified {
+		fromStatus = lo.ToPtr(change.FromStatus.String())
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO deal_status_history (deal_id, from_status, to_status, changed_by_user_id)
+		VALUES ($1, $2, $3, $4)
+	`, change.DealID, fromStatus, change.ToStatus.String(), change.ChangedByUserID)
+	if err != nil {
+		return fmt.Errorf("insert status history: %w", err)
+	}
+
+	return nil
+}
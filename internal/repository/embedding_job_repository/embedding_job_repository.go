@@ -0,0 +1,300 @@
+package embedding_job_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmbeddingJobRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewEmbeddingJobRepository(db *pgxpool.Pool, log *slog.Logger) *EmbeddingJobRepository {
+	return &EmbeddingJobRepository{db: db, log: log}
+}
+
+const embeddingJobColumns = `
+	job_id, entity_type, entity_id, status, attempts, last_error,
+	next_run_at, created_at, updated_at
+`
+
+// leaseHeld — условие, что задание всё ещё принадлежит захвату $2 и его lease не истёк.
+const leaseHeld = `job_id = $1 AND status = 'PROCESSING' AND locked_by = $2 AND locked_until > NOW()`
+
+func scanEmbeddingJob(row pgx.Row) (domain.EmbeddingJob, error) {
+	var j domain.EmbeddingJob
+	err := row.Scan(
+		&j.ID,
+		&j.EntityType,
+		&j.EntityID,
+		&j.Status,
+		&j.Attempts,
+		&j.LastError,
+		&j.NextRunAt,
+		&j.CreatedAt,
+		&j.UpdatedAt,
+	)
+	return j, err
+}
+
+// ClaimJobs — забирает до limit готовых к выполнению заданий и помечает их PROCESSING на время lease.
+// Задания, чей lease истёк (воркер упал), забираются повторно. FOR UPDATE SKIP LOCKED
+// не даёт двум экземплярам сервера взять одно задание. Attempts увеличивается при захвате.
+// Каждый захват получает свой токен (LockedBy): повторный захват задания после истечения lease,
+// даже тем же экземпляром, лишает прежнего исполнителя права фиксировать результат.
+func (r *EmbeddingJobRepository) ClaimJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.EmbeddingJob, error) {
+	const op = "EmbeddingJobRepository.ClaimJobs"
+
+	lockedBy := uuid.New()
+
+	query := `
+		WITH due AS (
+			SELECT job_id
+			FROM embedding_jobs
+			WHERE (status = 'PENDING' AND next_run_at <= NOW())
+			   OR (status = 'PROCESSING' AND locked_until < NOW())
+			ORDER BY next_run_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE embedding_jobs j
+		SET status = 'PROCESSING',
+			attempts = j.attempts + 1,
+			locked_until = NOW() + make_interval(secs => $2),
+			locked_by = $3,
+			updated_at = NOW()
+		FROM due
+		WHERE j.job_id = due.job_id
+		RETURNING j.job_id, j.entity_type, j.entity_id, j.status, j.attempts, j.last_error,
+			j.next_run_at, j.created_at, j.updated_at
+	`
+
+	rows, err := r.db.Query(ctx, query, limit, lease.Seconds(), lockedBy)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var jobs []domain.EmbeddingJob
+	for rows.Next() {
+		j, err := scanEmbeddingJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		j.LockedBy = lockedBy
+		jobs = append(jobs, j)
+	}
+
+	return jobs, rows.Err()
+}
+
+// CompleteJob — удаляет успешно выполненное задание.
+// Возвращает ErrEmbeddingJobLeaseLost, если lease захвата lockedBy истёк или задание перезабрано.
+func (r *EmbeddingJobRepository) CompleteJob(ctx context.Context, jobID, lockedBy uuid.UUID) error {
+	const op = "EmbeddingJobRepository.CompleteJob"
+
+	tag, err := r.db.Exec(ctx, `DELETE FROM embedding_jobs WHERE `+leaseHeld, jobID, lockedBy)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobLeaseLost)
+	}
+
+	return nil
+}
+
+// RescheduleJob — возвращает задание в очередь с повтором в nextRunAt.
+// Если для сущности уже поставлено новое ожидающее задание, текущее удаляется — повтор выполнит новое.
+// Возвращает ErrEmbeddingJobLeaseLost, если lease захвата lockedBy истёк или задание перезабрано.
+func (r *EmbeddingJobRepository) RescheduleJob(ctx context.Context, jobID, lockedBy uuid.UUID, lastError string, nextRunAt time.Time) error {
+	const op = "EmbeddingJobRepository.RescheduleJob"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка строки не даёт ClaimJobs перезабрать задание до конца транзакции
+	var held bool
+	err = tx.QueryRow(ctx, `SELECT TRUE FROM embedding_jobs WHERE `+leaseHeld+` FOR UPDATE`, jobID, lockedBy).Scan(&held)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobLeaseLost)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		DELETE FROM embedding_jobs j
+		WHERE j.job_id = $1 AND EXISTS (
+			SELECT 1 FROM embedding_jobs p
+			WHERE p.entity_type = j.entity_type AND p.entity_id = j.entity_id AND p.status = 'PENDING'
+		)
+	`, jobID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		if _, err := tx.Exec(ctx, `
+			UPDATE embedding_jobs
+			SET status = 'PENDING', last_error = $2, next_run_at = $3, locked_until = NULL, locked_by = NULL, updated_at = NOW()
+			WHERE job_id = $1
+		`, jobID, lastError, nextRunAt); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// MarkDead — переводит задание, исчерпавшее попытки, в dead-letter.
+// Возвращает ErrEmbeddingJobLeaseLost, если lease захвата lockedBy истёк или задание перезабрано.
+func (r *EmbeddingJobRepository) MarkDead(ctx context.Context, jobID, lockedBy uuid.UUID, lastError string) error {
+	const op = "EmbeddingJobRepository.MarkDead"
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE embedding_jobs
+		SET status = 'DEAD', last_error = $3, locked_until = NULL, locked_by = NULL, updated_at = NOW()
+		WHERE `+leaseHeld, jobID, lockedBy, lastError)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobLeaseLost)
+	}
+
+	return nil
+}
+
+// RetryJob — возвращает DEAD-задание в очередь со сброшенным счётчиком попыток.
+// Если у сущности уже есть ожидающее задание, DEAD-задание удаляется и возвращается ожидающее.
+func (r *EmbeddingJobRepository) RetryJob(ctx context.Context, jobID uuid.UUID) (domain.EmbeddingJob, error) {
+	const op = "EmbeddingJobRepository.RetryJob"
+
+	query := `
+		WITH dead AS (
+			DELETE FROM embedding_jobs
+			WHERE job_id = $1 AND status = 'DEAD'
+			RETURNING entity_type, entity_id
+		)
+		INSERT INTO embedding_jobs (entity_type, entity_id)
+		SELECT entity_type, entity_id FROM dead
+		ON CONFLICT (entity_type, entity_id) WHERE status = 'PENDING'
+		DO UPDATE SET next_run_at = NOW(), updated_at = NOW()
+		RETURNING ` + embeddingJobColumns
+
+	job, err := scanEmbeddingJob(r.db.QueryRow(ctx, query, jobID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobNotFound)
+		}
+		return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+// ListJobs — задания по фильтру от новых к старым с cursor-based пагинацией.
+func (r *EmbeddingJobRepository) ListJobs(ctx context.Context, filter domain.EmbeddingJobFilter) (*domain.PaginatedResult[domain.EmbeddingJob], error) {
+	const op = "EmbeddingJobRepository.ListJobs"
+
+	pageSize := int(domain.DefaultPageSize)
+	var cursor *domain.PageCursor
+	if filter.Pagination != nil {
+		pageSize = int(domain.NormalizePageSize(filter.Pagination.PageSize))
+		if filter.Pagination.PageToken != "" {
+			var err error
+			cursor, err = domain.DecodePageCursor(filter.Pagination.PageToken)
+			if err != nil {
+				r.log.Warn("failed to decode page cursor, starting from beginning", "error", err)
+				cursor = nil
+			}
+		}
+	}
+
+	whereClauses := []string{"TRUE"}
+	params := []interface{}{}
+	paramCount := 1
+
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramCount))
+		params = append(params, (*filter.Status).String())
+		paramCount++
+	}
+	if filter.EntityType != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("entity_type = $%d", paramCount))
+		params = append(params, (*filter.EntityType).String())
+		paramCount++
+	}
+	if cursor != nil {
+		whereClauses = append(whereClauses,
+			fmt.Sprintf("(created_at, job_id) < ($%d, $%d)", paramCount, paramCount+1))
+		params = append(params, cursor.LastCreatedAt, cursor.LastID)
+		paramCount += 2
+	}
+
+	query := `SELECT ` + embeddingJobColumns + `
+		FROM embedding_jobs
+		WHERE ` + strings.Join(whereClauses, " AND ") + fmt.Sprintf(`
+		ORDER BY created_at DESC, job_id DESC
+		LIMIT $%d`, paramCount)
+	params = append(params, pageSize+1)
+
+	rows, err := r.db.Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var jobs []domain.EmbeddingJob
+	for rows.Next() {
+		j, err := scanEmbeddingJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		jobs = append(jobs, j)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	hasMore := len(jobs) > pageSize
+	if hasMore {
+		jobs = jobs[:pageSize]
+	}
+
+	var nextPageToken string
+	if hasMore && len(jobs) > 0 {
+		last := jobs[len(jobs)-1]
+		nextCursor := &domain.PageCursor{
+			LastID:        last.ID,
+			LastCreatedAt: last.CreatedAt,
+		}
+		nextPageToken = nextCursor.Encode()
+	}
+
+	return &domain.PaginatedResult[domain.EmbeddingJob]{
+		Items:         jobs,
+		NextPageToken: nextPageToken,
+		HasMore:       hasMore,
+	}, nil
+}
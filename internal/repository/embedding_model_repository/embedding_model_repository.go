@@ -0,0 +1,276 @@
+package embedding_model_repository
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmbeddingModelRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewEmbeddingModelRepository(db *pgxpool.Pool, log *slog.Logger) *EmbeddingModelRepository {
+	return &EmbeddingModelRepository{db: db, log: log}
+}
+
+// rowQuerier — пул соединений или транзакция pgx.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// embeddingTable — таблица с embedding и её ivfflat-индекс.
+type embeddingTable struct {
+	entityType domain.EmbeddingEntityType
+	name       string
+	index      string
+}
+
+var embeddingTables = []embeddingTable{
+	{entityType: domain.EmbeddingEntityLead, name: "leads", index: "leads_embedding_idx"},
+	{entityType: domain.EmbeddingEntityProperty, name: "properties", index: "properties_embedding_idx"},
+}
+
+// GetState возвращает состояние моделей embedding.
+func (r *EmbeddingModelRepository) GetState(ctx context.Context) (domain.EmbeddingModelState, error) {
+	const op = "EmbeddingModelRepository.GetState"
+
+	state, err := getState(ctx, r.db, "")
+	if err != nil {
+		return domain.EmbeddingModelState{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return state, nil
+}
+
+func getState(ctx context.Context, q rowQuerier, suffix string) (domain.EmbeddingModelState, error) {
+	var s domain.EmbeddingModelState
+	err := q.QueryRow(ctx, `
+		SELECT active_model, active_dimensions, next_model, next_dimensions,
+		       migration_started_at, cutover_at, updated_at
+		FROM embedding_model_state`+suffix).Scan(
+		&s.ActiveModel,
+		&s.ActiveDimensions,
+		&s.NextModel,
+		&s.NextDimensions,
+		&s.MigrationStartedAt,
+		&s.CutoverAt,
+		&s.UpdatedAt,
+	)
+	return s, err
+}
+
+// AdoptActiveModel записывает активную модель, если она ещё не известна, и отмечает ею
+// векторы, записанные до версионирования. Возвращает false, если активная модель уже задана.
+func (r *EmbeddingModelRepository) AdoptActiveModel(ctx context.Context, model string, dimensions int) (bool, error) {
+	const op = "EmbeddingModelRepository.AdoptActiveModel"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE embedding_model_state
+		SET active_model = $1, active_dimensions = $2, updated_at = NOW()
+		WHERE active_model IS NULL
+	`, model, dimensions)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	for _, t := range embeddingTables {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %s SET embedding_model = $1
+			WHERE embedding IS NOT NULL AND embedding_model IS NULL
+		`, t.name), model); err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return true, nil
+}
+
+// StartMigration начинает миграцию на модель model: теневые колонки очищаются от векторов
+// других моделей и получают размерность новой модели. Повторный вызов для той же модели
+// сохраняет уже записанные векторы.
+func (r *EmbeddingModelRepository) StartMigration(ctx context.Context, model string, dimensions int) error {
+	const op = "EmbeddingModelRepository.StartMigration"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE embedding_model_state
+		SET next_model = $1, next_dimensions = $2, migration_started_at = NOW(), updated_at = NOW()
+	`, model, dimensions); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, t := range embeddingTables {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %s SET embedding_next = NULL, embedding_next_model = NULL
+			WHERE embedding_next IS NOT NULL
+			  AND (embedding_next_model IS DISTINCT FROM $1 OR vector_dims(embedding_next) <> $2)
+		`, t.name), model, dimensions); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(
+			`ALTER TABLE %s ALTER COLUMN embedding_next TYPE vector(%d)`, t.name, dimensions,
+		)); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ModelStats — распределение сохранённых векторов по моделям и размерностям.
+func (r *EmbeddingModelRepository) ModelStats(ctx context.Context) ([]domain.EmbeddingModelStats, error) {
+	const op = "EmbeddingModelRepository.ModelStats"
+
+	var stats []domain.EmbeddingModelStats
+	for _, t := range embeddingTables {
+		rows, err := r.db.Query(ctx, fmt.Sprintf(`
+			SELECT embedding_model, vector_dims(embedding), COUNT(*)
+			FROM %s
+			WHERE embedding IS NOT NULL
+			GROUP BY 1, 2
+		`, t.name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for rows.Next() {
+			s := domain.EmbeddingModelStats{EntityType: t.entityType}
+			if err := rows.Scan(&s.Model, &s.Dimensions, &s.Count); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+			}
+			stats = append(stats, s)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("%s: rows error: %w", op, err)
+		}
+	}
+
+	return stats, nil
+}
+
+// ShadowCoverage — сколько записей уже имеют вектор целевой модели в теневой колонке.
+func (r *EmbeddingModelRepository) ShadowCoverage(ctx context.Context) ([]domain.EmbeddingCoverage, error) {
+	const op = "EmbeddingModelRepository.ShadowCoverage"
+
+	coverage, err := shadowCoverage(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return coverage, nil
+}
+
+func shadowCoverage(ctx context.Context, q rowQuerier) ([]domain.EmbeddingCoverage, error) {
+	coverage := make([]domain.EmbeddingCoverage, 0, len(embeddingTables))
+	for _, t := range embeddingTables {
+		c := domain.EmbeddingCoverage{EntityType: t.entityType}
+		err := q.QueryRow(ctx, fmt.Sprintf(`
+			SELECT COUNT(*),
+			       COUNT(*) FILTER (
+			           WHERE embedding_next IS NOT NULL
+			             AND embedding_next_model = (SELECT next_model FROM embedding_model_state)
+			       )
+			FROM %s
+		`, t.name)).Scan(&c.Total, &c.Embedded)
+		if err != nil {
+			return nil, err
+		}
+		coverage = append(coverage, c)
+	}
+	return coverage, nil
+}
+
+// Cutover атомарно переключает поиск на векторы целевой модели: в одной транзакции
+// проверяет полное покрытие, меняет местами основную и теневую колонки и перестраивает индексы.
+// На время переключения (включая построение индексов) leads и properties недоступны.
+func (r *EmbeddingModelRepository) Cutover(ctx context.Context, model string) error {
+	const op = "EmbeddingModelRepository.Cutover"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка строки состояния сериализует переключение между экземплярами сервера
+	state, err := getState(ctx, tx, " FOR UPDATE")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if state.NextModel == nil || *state.NextModel != model || state.NextDimensions == nil {
+		return fmt.Errorf("%s: %w", op, repository.ErrNoEmbeddingMigration)
+	}
+
+	// Замена колонок всё равно берёт ACCESS EXCLUSIVE; берём её сразу, чтобы покрытие
+	// не изменилось между проверкой и переключением
+	if _, err := tx.Exec(ctx, `LOCK TABLE leads, properties IN ACCESS EXCLUSIVE MODE`); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	coverage, err := shadowCoverage(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, c := range coverage {
+		if !c.Complete() {
+			return fmt.Errorf("%s: %s %d/%d: %w", op, c.EntityType, c.Embedded, c.Total, repository.ErrEmbeddingCoverageIncomplete)
+		}
+	}
+
+	for _, t := range embeddingTables {
+		for _, stmt := range []string{
+			fmt.Sprintf(`DROP INDEX IF EXISTS %s`, t.index),
+			fmt.Sprintf(`ALTER TABLE %s DROP COLUMN embedding`, t.name),
+			fmt.Sprintf(`ALTER TABLE %s RENAME COLUMN embedding_next TO embedding`, t.name),
+			fmt.Sprintf(`ALTER TABLE %s DROP COLUMN embedding_model`, t.name),
+			fmt.Sprintf(`ALTER TABLE %s RENAME COLUMN embedding_next_model TO embedding_model`, t.name),
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN embedding_next vector, ADD COLUMN embedding_next_model TEXT`, t.name),
+			fmt.Sprintf(`CREATE INDEX %s ON %s USING ivfflat (embedding vector_cosine_ops) WITH (lists = 100)`, t.index, t.name),
+		} {
+			if _, err := tx.Exec(ctx, stmt); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE embedding_model_state
+		SET active_model = next_model, active_dimensions = next_dimensions,
+		    next_model = NULL, next_dimensions = NULL,
+		    cutover_at = NOW(), updated_at = NOW()
+	`); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
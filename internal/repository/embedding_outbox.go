@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Execer — пул соединений или транзакция pgx.
+type Execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// EnqueueEmbeddingJob ставит задание на генерацию embedding в outbox embedding_jobs.
+// Вызывается в той же транзакции, что создаёт или изменяет сущность; если для сущности
+// уже есть ожидающее задание, новое не создаётся (воркер всё равно читает актуальные данные).
+// Вектор новой модели в теневой колонке при этом сбрасывается как устаревший: пока его
+// не пересчитают, переключение на новую модель не выполнится.
+func EnqueueEmbeddingJob(ctx context.Context, db Execer, entityType domain.EmbeddingEntityType, entityID uuid.UUID) error {
+	_, err := db.Exec(ctx, `
+		INSERT INTO embedding_jobs (entity_type, entity_id)
+		VALUES ($1, $2)
+		ON CONFLICT (entity_type, entity_id) WHERE status = 'PENDING' DO NOTHING
+	`, entityType.String(), entityID)
+	if err != nil {
+		return err
+	}
+
+	var query string
+	switch entityType {
+	case domain.EmbeddingEntityLead:
+		query = `UPDATE leads SET embedding_next = NULL, embedding_next_model = NULL
+			WHERE lead_id = $1 AND embedding_next IS NOT NULL`
+	case domain.EmbeddingEntityProperty:
+		query = `UPDATE properties SET embedding_next = NULL, embedding_next_model = NULL
+			WHERE property_id = $1 AND embedding_next IS NOT NULL`
+	default:
+		return nil
+	}
+
+	_, err = db.Exec(ctx, query, entityID)
+	return err
+}
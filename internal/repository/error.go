@@ -9,4 +9,30 @@ var (
 	ErrDealNotFound     = errors.New("deal not found")
 	ErrPropertyNotFound = errors.New("property not found")
 	ErrNoFieldsToUpdate = errors.New("no fields to update")
+	// ErrDealStatusConflict — статус сделки изменился параллельно с текущим переходом.
+	ErrDealStatusConflict = errors.New("deal status changed concurrently")
+	// ErrLeadAlreadyPurchased — лид уже передан покупателю по другой сделке.
+	ErrLeadAlreadyPurchased = errors.New("lead already purchased")
+	// ErrRefreshTokenNotFound — refresh-токен не найден.
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	// ErrRefreshTokenRevoked — refresh-токен уже отозван.
+	ErrRefreshTokenRevoked = errors.New("refresh token revoked")
+	// ErrSavedSearchNotFound — сохранённый поиск не найден.
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	// ErrEmbeddingJobNotFound — задание на генерацию embedding не найдено или не в статусе DEAD.
+	ErrEmbeddingJobNotFound = errors.New("embedding job not found")
+	// ErrEmbeddingJobLeaseLost — lease задания истёк или задание перезабрано другим воркером.
+	ErrEmbeddingJobLeaseLost = errors.New("embedding job lease lost")
+	// ErrNoEmbeddingMigration — миграция на новую модель embedding не идёт (или уже переключена).
+	ErrNoEmbeddingMigration = errors.New("no embedding model migration in progress")
+	// ErrEmbeddingCoverageIncomplete — не у всех записей есть вектор целевой модели.
+	ErrEmbeddingCoverageIncomplete = errors.New("embedding coverage is incomplete")
+	// ErrPropertyImageNotFound — фотографии нет в галерее объекта.
+	ErrPropertyImageNotFound = errors.New("property image not found")
+	// ErrPropertyImageLimit — в галерее не осталось места.
+	ErrPropertyImageLimit = errors.New("property image limit exceeded")
+	// ErrPropertyImageOrder — новый порядок не совпадает с набором фотографий галереи.
+	ErrPropertyImageOrder = errors.New("image order must list every image of the property exactly once")
+	// ErrUploadNotFound — загрузки с таким ключом нет.
+	ErrUploadNotFound = errors.New("upload not found")
 )
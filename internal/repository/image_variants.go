@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"encoding/json"
+	"lead_exchange/internal/domain"
+)
+
+// imageVariant — элемент uploads.variants в JSONB.
+type imageVariant struct {
+	Name        string `json:"name"`
+	Format      string `json:"format"`
+	Key         string `json:"key"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+}
+
+// ImageVariantsToJSON — копии изображения для записи в JSONB (ссылки не сохраняются).
+func ImageVariantsToJSON(variants []domain.ImageVariant) ([]byte, error) {
+	items := make([]imageVariant, 0, len(variants))
+	for _, v := range variants {
+		items = append(items, imageVariant{
+			Name:        v.Name,
+			Format:      v.Format,
+			Key:         v.Key,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			Size:        v.Size,
+		})
+	}
+	return json.Marshal(items)
+}
+
+// ImageVariantsFromJSON — разбирает JSONB с копиями изображения; пустое значение — нет копий.
+func ImageVariantsFromJSON(data []byte) ([]domain.ImageVariant, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var items []imageVariant
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	var variants []domain.ImageVariant
+	for _, v := range items {
+		variants = append(variants, domain.ImageVariant{
+			Name:        v.Name,
+			Format:      v.Format,
+			Key:         v.Key,
+			ContentType: v.ContentType,
+			Width:       v.Width,
+			Height:      v.Height,
+			Size:        v.Size,
+		})
+	}
+	return variants, nil
+}
@@ -0,0 +1,53 @@
+package lead_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// leadEventsChannel — канал Postgres NOTIFY, в который пишет триггер leads_notify_event.
+const leadEventsChannel = "lead_events"
+
+// leadEventPayload — payload уведомления триггера notify_lead_event.
+type leadEventPayload struct {
+	LeadID uuid.UUID `json:"lead_id"`
+}
+
+// ListenLeadEvents — подписывается на уведомления о событиях лидов (LISTEN lead_events)
+// и вызывает handle для каждого события. Блокируется до отмены ctx или ошибки соединения.
+// Использует отдельное соединение из пула на всё время подписки.
+func (r *LeadRepository) ListenLeadEvents(ctx context.Context, handle func(leadID uuid.UUID)) error {
+	const op = "LeadRepository.ListenLeadEvents"
+
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: failed to acquire connection: %w", op, err)
+	}
+	defer func() {
+		// Соединение возвращается в пул, поэтому снимаем подписку
+		_, _ = conn.Exec(context.Background(), "UNLISTEN "+leadEventsChannel)
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+leadEventsChannel); err != nil {
+		return fmt.Errorf("%s: failed to listen: %w", op, err)
+	}
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var payload leadEventPayload
+		if err := json.Unmarshal([]byte(n.Payload), &payload); err != nil {
+			r.log.Warn("invalid lead event payload", "payload", n.Payload, "error", err)
+			continue
+		}
+
+		handle(payload.LeadID)
+	}
+}
@@ -24,10 +24,16 @@ func NewLeadRepository(db *pgxpool.Pool, log *slog.Logger) *LeadRepository {
 	return &LeadRepository{db: db, log: log}
 }
 
-// CreateLead — создаёт нового лида.
+// CreateLead — создаёт нового лида и в той же транзакции ставит задание на генерацию embedding.
 func (r *LeadRepository) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID, error) {
 	const op = "LeadRepository.CreateLead"
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		INSERT INTO leads (
 			title, description, requirement,
@@ -39,7 +45,7 @@ func (r *LeadRepository) CreateLead(ctx context.Context, lead domain.Lead) (uuid
 	`
 
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		lead.Title,
 		lead.Description,
 		lead.Requirement,
@@ -55,6 +61,14 @@ func (r *LeadRepository) CreateLead(ctx context.Context, lead domain.Lead) (uuid
 		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := repository.EnqueueEmbeddingJob(ctx, tx, domain.EmbeddingEntityLead, id); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to enqueue embedding job: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
 	return id, nil
 }
 
@@ -111,6 +125,7 @@ func (r *LeadRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Lead
 }
 
 // UpdateLead — частичное обновление данных лида.
+// Если изменились данные, влияющие на matching, в той же транзакции ставится задание на пересчёт embedding.
 func (r *LeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error {
 	const op = "LeadRepository.UpdateLead"
 
@@ -158,7 +173,13 @@ func (r *LeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, updat
 	query := fmt.Sprintf(`UPDATE leads SET %s WHERE lead_id = $%d`, strings.Join(setClauses, ", "), paramCount)
 	params = append(params, leadID)
 
-	tag, err := r.db.Exec(ctx, query, params...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, query, params...)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -167,6 +188,16 @@ func (r *LeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, updat
 		return fmt.Errorf("%s: %w", op, repository.ErrLeadNotFound)
 	}
 
+	if update.Title != nil || update.Description != nil || update.Requirement != nil {
+		if err := repository.EnqueueEmbeddingJob(ctx, tx, domain.EmbeddingEntityLead, leadID); err != nil {
+			return fmt.Errorf("%s: failed to enqueue embedding job: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -221,6 +252,12 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		baseParams = append(baseParams, *filter.CreatedUserID)
 		paramCount++
 	}
+	if filter.VisibleToUserID != nil {
+		baseWhereClauses = append(baseWhereClauses,
+			fmt.Sprintf("(status <> 'NEW' OR owner_user_id = $%d OR created_user_id = $%d)", paramCount, paramCount))
+		baseParams = append(baseParams, *filter.VisibleToUserID)
+		paramCount++
+	}
 	if filter.City != nil {
 		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("LOWER(city) = LOWER($%d)", paramCount))
 		baseParams = append(baseParams, *filter.City)
@@ -344,8 +381,10 @@ func (r *LeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UUID,
 	const op = "LeadRepository.UpdateEmbedding"
 
 	query := `
-		UPDATE leads 
-		SET embedding = $1::vector, updated_at = NOW()
+		UPDATE leads
+		SET embedding = $1::vector,
+		    embedding_model = (SELECT active_model FROM embedding_model_state),
+		    updated_at = NOW()
 		WHERE lead_id = $2
 	`
 
@@ -362,6 +401,51 @@ func (r *LeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UUID,
 	return nil
 }
 
+// CreateContactReveal — записывает факт раскрытия контактов лида в аудит.
+func (r *LeadRepository) CreateContactReveal(ctx context.Context, reveal domain.LeadContactReveal) error {
+	const op = "LeadRepository.CreateContactReveal"
+
+	query := `
+		INSERT INTO lead_contact_reveals (lead_id, user_id, reason)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := r.db.Exec(ctx, query, reveal.LeadID, reveal.UserID, reveal.Reason.String()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CountByStatus — число лидов в каждом статусе (для метрик).
+func (r *LeadRepository) CountByStatus(ctx context.Context) (map[domain.LeadStatus]int64, error) {
+	const op = "LeadRepository.CountByStatus"
+
+	rows, err := r.db.Query(ctx, "SELECT status, COUNT(*) FROM leads GROUP BY status")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.LeadStatus]int64)
+	for rows.Next() {
+		var (
+			status domain.LeadStatus
+			count  int64
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return counts, nil
+}
+
 func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	return errors.As(err, &pgErr) && pgErr.Code == "23505"
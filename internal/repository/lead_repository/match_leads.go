@@ -0,0 +1,155 @@
+package lead_repository
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"strings"
+)
+
+// Требования лида из JSONB requirement (схема domain.LeadRequirement).
+const (
+	requirementBudgetMinExpr = `(requirement->>'budget_min')::numeric`
+	requirementBudgetMaxExpr = `(requirement->>'budget_max')::numeric`
+	requirementRoomsMinExpr  = `(requirement->>'rooms_min')::int`
+	requirementRoomsMaxExpr  = `(requirement->>'rooms_max')::int`
+	requirementTypeExpr      = `requirement->>'property_type'`
+)
+
+// MatchLeads находит лиды для объекта по косинусному расстоянию (индекс leads_embedding_idx).
+// Жёсткие фильтры обратны фильтрам MatchPropertiesWithHardFilters: лид проходит,
+// если объект укладывается в его требования с допуском (комнаты ±1 к диапазону, бюджет ±20%).
+func (r *LeadRepository) MatchLeads(
+	ctx context.Context,
+	propertyEmbedding []float32,
+	filter domain.LeadFilter,
+	hardFilters *domain.LeadHardFilters,
+	limit int,
+) ([]domain.MatchedLead, error) {
+	const op = "LeadRepository.MatchLeads"
+
+	query := `
+		SELECT
+			lead_id, title, description, requirement,
+			contact_name, contact_phone, contact_email,
+			city, status, owner_user_id, created_user_id,
+			embedding::text, created_at, updated_at,
+			1 - (embedding <=> $1::vector) as similarity
+		FROM leads
+		WHERE embedding IS NOT NULL
+	`
+
+	whereClauses := []string{}
+	params := []interface{}{repository.VectorToString(propertyEmbedding)}
+	paramCount := 2
+
+	// ===== ЖЁСТКИЕ ФИЛЬТРЫ (требования лида к объекту) =====
+	if hardFilters != nil {
+		if hardFilters.City != nil && *hardFilters.City != "" {
+			whereClauses = append(whereClauses, fmt.Sprintf("(city IS NULL OR LOWER(city) = LOWER($%d))", paramCount))
+			params = append(params, *hardFilters.City)
+			paramCount++
+		}
+		if hardFilters.PropertyType != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("(%s IS NULL OR %s = $%d)", requirementTypeExpr, requirementTypeExpr, paramCount))
+			params = append(params, (*hardFilters.PropertyType).String())
+			paramCount++
+		}
+		if hardFilters.Rooms != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf(
+				"(%s IS NULL OR $%d >= %s - 1) AND (%s IS NULL OR $%d <= %s + 1)",
+				requirementRoomsMinExpr, paramCount, requirementRoomsMinExpr,
+				requirementRoomsMaxExpr, paramCount, requirementRoomsMaxExpr))
+			params = append(params, *hardFilters.Rooms)
+			paramCount++
+		}
+		if hardFilters.Price != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf(
+				"(%s IS NULL OR $%d >= %s * 0.8) AND (%s IS NULL OR $%d <= %s * 1.2)",
+				requirementBudgetMinExpr, paramCount, requirementBudgetMinExpr,
+				requirementBudgetMaxExpr, paramCount, requirementBudgetMaxExpr))
+			params = append(params, *hardFilters.Price)
+			paramCount++
+		}
+	}
+
+	// ===== ФИЛЬТРЫ ИЗ LeadFilter =====
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramCount))
+		params = append(params, (*filter.Status).String())
+		paramCount++
+	}
+	if filter.City != nil && (hardFilters == nil || hardFilters.City == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("LOWER(city) = LOWER($%d)", paramCount))
+		params = append(params, *filter.City)
+		paramCount++
+	}
+	if filter.OwnerUserID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("owner_user_id = $%d", paramCount))
+		params = append(params, *filter.OwnerUserID)
+		paramCount++
+	}
+	if filter.VisibleToUserID != nil {
+		whereClauses = append(whereClauses,
+			fmt.Sprintf("(status <> 'NEW' OR owner_user_id = $%d OR created_user_id = $%d)", paramCount, paramCount))
+		params = append(params, *filter.VisibleToUserID)
+		paramCount++
+	}
+
+	if len(whereClauses) > 0 {
+		query += " AND " + strings.Join(whereClauses, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY embedding <=> $1::vector LIMIT $%d", paramCount)
+	params = append(params, limit)
+
+	rows, err := r.db.Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var matches []domain.MatchedLead
+	for rows.Next() {
+		var l domain.Lead
+		var embeddingStr *string
+		var similarity float64
+
+		if err := rows.Scan(
+			&l.ID,
+			&l.Title,
+			&l.Description,
+			&l.Requirement,
+			&l.ContactName,
+			&l.ContactPhone,
+			&l.ContactEmail,
+			&l.City,
+			&l.Status,
+			&l.OwnerUserID,
+			&l.CreatedUserID,
+			&embeddingStr,
+			&l.CreatedAt,
+			&l.UpdatedAt,
+			&similarity,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+
+		if embeddingStr != nil && *embeddingStr != "" {
+			vec, err := repository.StringToVector(*embeddingStr)
+			if err != nil {
+				r.log.Warn("failed to parse embedding", "error", err)
+			} else {
+				l.Embedding = vec
+			}
+		}
+
+		matches = append(matches, domain.MatchedLead{
+			Lead:       l,
+			Similarity: similarity,
+		})
+	}
+
+	return matches, rows.Err()
+}
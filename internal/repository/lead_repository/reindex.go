@@ -0,0 +1,84 @@
+package lead_repository
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ListForReindex возвращает страницу лидов для массовой переиндексации в порядке lead_id.
+// Следующая страница запрашивается с AfterID последнего лида, поэтому обновление
+// updated_at при записи embedding не сдвигает выборку.
+func (r *LeadRepository) ListForReindex(ctx context.Context, filter domain.ReindexFilter) ([]domain.Lead, error) {
+	const op = "LeadRepository.ListForReindex"
+
+	where, params := repository.ReindexWhere(filter, "lead_id")
+	params = append(params, filter.Limit)
+
+	query := `
+		SELECT lead_id, title, description, requirement, updated_at
+		FROM leads` + where + fmt.Sprintf(" ORDER BY lead_id LIMIT $%d", len(params))
+
+	rows, err := r.db.Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var leads []domain.Lead
+	for rows.Next() {
+		var l domain.Lead
+		if err := rows.Scan(&l.ID, &l.Title, &l.Description, &l.Requirement, &l.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		leads = append(leads, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return leads, nil
+}
+
+// CountForReindex — число лидов под фильтром переиндексации (AfterID не учитывается).
+func (r *LeadRepository) CountForReindex(ctx context.Context, filter domain.ReindexFilter) (int, error) {
+	const op = "LeadRepository.CountForReindex"
+
+	filter.AfterID = nil
+	where, params := repository.ReindexWhere(filter, "lead_id")
+
+	var count int
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM leads"+where, params...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// UpdateShadowEmbedding записывает embedding целевой модели идущей миграции в теневую колонку.
+// Поиск эту колонку не читает, поэтому updated_at не меняется.
+func (r *LeadRepository) UpdateShadowEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error {
+	const op = "LeadRepository.UpdateShadowEmbedding"
+
+	query := `
+		UPDATE leads
+		SET embedding_next = $1::vector,
+		    embedding_next_model = (SELECT next_model FROM embedding_model_state)
+		WHERE lead_id = $2
+	`
+
+	tag, err := r.db.Exec(ctx, query, repository.VectorToString(embedding), leadID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrLeadNotFound)
+	}
+
+	return nil
+}
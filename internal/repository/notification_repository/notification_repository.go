@@ -0,0 +1,146 @@
+package notification_repository
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotificationRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewNotificationRepository(db *pgxpool.Pool, log *slog.Logger) *NotificationRepository {
+	return &NotificationRepository{db: db, log: log}
+}
+
+// ListNotifications — уведомления пользователя от новых к старым с cursor-based пагинацией.
+func (r *NotificationRepository) ListNotifications(ctx context.Context, filter domain.NotificationFilter) (*domain.PaginatedResult[domain.Notification], error) {
+	const op = "NotificationRepository.ListNotifications"
+
+	pageSize := int(domain.DefaultPageSize)
+	var cursor *domain.PageCursor
+	if filter.Pagination != nil {
+		pageSize = int(domain.NormalizePageSize(filter.Pagination.PageSize))
+		if filter.Pagination.PageToken != "" {
+			var err error
+			cursor, err = domain.DecodePageCursor(filter.Pagination.PageToken)
+			if err != nil {
+				r.log.Warn("failed to decode page cursor, starting from beginning", "error", err)
+				cursor = nil
+			}
+		}
+	}
+
+	whereClauses := []string{"user_id = $1"}
+	params := []interface{}{filter.UserID}
+	paramCount := 2
+
+	if filter.UnreadOnly {
+		whereClauses = append(whereClauses, "read_at IS NULL")
+	}
+	if cursor != nil {
+		whereClauses = append(whereClauses,
+			fmt.Sprintf("(created_at, notification_id) < ($%d, $%d)", paramCount, paramCount+1))
+		params = append(params, cursor.LastCreatedAt, cursor.LastID)
+		paramCount += 2
+	}
+
+	query := `
+		SELECT notification_id, user_id, type, title, body, payload, read_at, created_at
+		FROM notifications
+		WHERE ` + strings.Join(whereClauses, " AND ") + fmt.Sprintf(`
+		ORDER BY created_at DESC, notification_id DESC
+		LIMIT $%d`, paramCount)
+	params = append(params, pageSize+1)
+
+	rows, err := r.db.Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var notifications []domain.Notification
+	for rows.Next() {
+		var n domain.Notification
+		if err := rows.Scan(
+			&n.ID,
+			&n.UserID,
+			&n.Type,
+			&n.Title,
+			&n.Body,
+			&n.Payload,
+			&n.ReadAt,
+			&n.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	hasMore := len(notifications) > pageSize
+	if hasMore {
+		notifications = notifications[:pageSize]
+	}
+
+	var nextPageToken string
+	if hasMore && len(notifications) > 0 {
+		last := notifications[len(notifications)-1]
+		nextCursor := &domain.PageCursor{
+			LastID:        last.ID,
+			LastCreatedAt: last.CreatedAt,
+		}
+		nextPageToken = nextCursor.Encode()
+	}
+
+	return &domain.PaginatedResult[domain.Notification]{
+		Items:         notifications,
+		NextPageToken: nextPageToken,
+		HasMore:       hasMore,
+	}, nil
+}
+
+// CountUnread — количество непрочитанных уведомлений пользователя.
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int32, error) {
+	const op = "NotificationRepository.CountUnread"
+
+	var count int32
+	err := r.db.QueryRow(ctx,
+		`SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// MarkRead — отмечает прочитанными уведомления пользователя из ids (или все, если all).
+// Возвращает количество изменённых уведомлений.
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, all bool) (int64, error) {
+	const op = "NotificationRepository.MarkRead"
+
+	query := `UPDATE notifications SET read_at = NOW() WHERE user_id = $1 AND read_at IS NULL`
+	params := []interface{}{userID}
+	if !all {
+		query += ` AND notification_id = ANY($2)`
+		params = append(params, ids)
+	}
+
+	tag, err := r.db.Exec(ctx, query, params...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
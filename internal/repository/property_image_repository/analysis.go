@@ -0,0 +1,327 @@
+package property_image_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// imageFeature — элемент detected_features в JSONB.
+type imageFeature struct {
+	Name       string  `json:"name"`
+	Confidence float64 `json:"confidence"`
+	Category   string  `json:"category"`
+}
+
+// scanPropertyImage читает строку propertyImageColumns. Результат анализа заполняется,
+// только если фотография проанализирована (analyzed_at не NULL); копии — если файл
+// загружен через uploads.
+func scanPropertyImage(row pgx.Row) (domain.PropertyImage, error) {
+	var (
+		img            domain.PropertyImage
+		featuresJSON   []byte
+		roomType       *string
+		qualityScore   *float64
+		viewType       *string
+		brightness     *float64
+		tagsJSON       []byte
+		visualFeatures *string
+		confidence     *float64
+		analyzedAt     *time.Time
+		variantsJSON   []byte
+	)
+	if err := row.Scan(
+		&img.ID, &img.PropertyID, &img.StorageKey, &img.Position, &img.CreatedAt,
+		&featuresJSON, &roomType, &qualityScore, &viewType, &brightness, &tagsJSON,
+		&visualFeatures, &confidence, &analyzedAt, &variantsJSON,
+	); err != nil {
+		return domain.PropertyImage{}, err
+	}
+
+	variants, err := repository.ImageVariantsFromJSON(variantsJSON)
+	if err != nil {
+		return domain.PropertyImage{}, fmt.Errorf("failed to decode variants: %w", err)
+	}
+	img.Variants = variants
+
+	if analyzedAt == nil {
+		return img, nil
+	}
+
+	a := &domain.ImageAnalysis{AnalyzedAt: *analyzedAt}
+	if roomType != nil {
+		a.RoomType = *roomType
+	}
+	if qualityScore != nil {
+		a.QualityScore = *qualityScore
+	}
+	if viewType != nil {
+		a.ViewType = *viewType
+	}
+	if brightness != nil {
+		a.Brightness = *brightness
+	}
+	if confidence != nil {
+		a.Confidence = *confidence
+	}
+
+	if len(featuresJSON) > 0 {
+		var features []imageFeature
+		if err := json.Unmarshal(featuresJSON, &features); err != nil {
+			return domain.PropertyImage{}, fmt.Errorf("failed to decode detected_features: %w", err)
+		}
+		for _, f := range features {
+			a.DetectedFeatures = append(a.DetectedFeatures, domain.ImageFeature(f))
+		}
+	}
+	if len(tagsJSON) > 0 {
+		if err := json.Unmarshal(tagsJSON, &a.Tags); err != nil {
+			return domain.PropertyImage{}, fmt.Errorf("failed to decode tags: %w", err)
+		}
+	}
+	if visualFeatures != nil {
+		vec, err := repository.StringToVector(*visualFeatures)
+		if err != nil {
+			return domain.PropertyImage{}, fmt.Errorf("failed to decode visual_features: %w", err)
+		}
+		a.VisualFeatures = float64s(vec)
+	}
+
+	img.Analysis = a
+	return img, nil
+}
+
+// SaveAnalysis — сохраняет результат анализа фотографии.
+func (r *PropertyImageRepository) SaveAnalysis(ctx context.Context, imageID uuid.UUID, a domain.ImageAnalysis) error {
+	const op = "PropertyImageRepository.SaveAnalysis"
+
+	features := make([]imageFeature, 0, len(a.DetectedFeatures))
+	for _, f := range a.DetectedFeatures {
+		features = append(features, imageFeature(f))
+	}
+	featuresJSON, err := json.Marshal(features)
+	if err != nil {
+		return fmt.Errorf("%s: failed to encode features: %w", op, err)
+	}
+	tags := a.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("%s: failed to encode tags: %w", op, err)
+	}
+	var visualFeatures *string
+	if len(a.VisualFeatures) > 0 {
+		v := repository.VectorToString(float32s(a.VisualFeatures))
+		visualFeatures = &v
+	}
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE property_images
+		SET detected_features = $2,
+			room_type = NULLIF($3, ''),
+			quality_score = $4,
+			view_type = NULLIF($5, ''),
+			brightness = $6,
+			tags = $7,
+			visual_features = $8::vector,
+			analysis_confidence = $9,
+			analyzed_at = $10,
+			updated_at = NOW()
+		WHERE image_id = $1
+	`, imageID, featuresJSON, a.RoomType, a.QualityScore, a.ViewType, a.Brightness, tagsJSON,
+		visualFeatures, a.Confidence, a.AnalyzedAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrPropertyImageNotFound)
+	}
+
+	return nil
+}
+
+// GetAnalysisState — состояние анализа галереи объекта.
+func (r *PropertyImageRepository) GetAnalysisState(ctx context.Context, propertyID uuid.UUID) (domain.ImageAnalysisState, error) {
+	const op = "PropertyImageRepository.GetAnalysisState"
+
+	var (
+		state     domain.ImageAnalysisState
+		status    *string
+		errorText *string
+	)
+	err := r.db.QueryRow(ctx, `
+		SELECT image_analysis_status, image_analysis_error, images_analyzed_at
+		FROM properties
+		WHERE property_id = $1
+	`, propertyID).Scan(&status, &errorText, &state.AnalyzedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ImageAnalysisState{}, fmt.Errorf("%s: %w", op, repository.ErrPropertyNotFound)
+		}
+		return domain.ImageAnalysisState{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if status != nil {
+		state.Status = domain.ImageAnalysisStatus(*status)
+	}
+	if errorText != nil {
+		state.Error = *errorText
+	}
+
+	return state, nil
+}
+
+// StartAnalysis — переводит галерею в status (PENDING — в очередь воркера, PROCESSING — анализ
+// в текущем запросе с арендой lease). Возвращает false, если галерея уже в очереди или её
+// анализ ещё не истёк по аренде. При force сбрасывает результаты всех фотографий.
+func (r *PropertyImageRepository) StartAnalysis(
+	ctx context.Context, propertyID uuid.UUID, status domain.ImageAnalysisStatus, force bool, lease time.Duration,
+) (bool, error) {
+	const op = "PropertyImageRepository.StartAnalysis"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockProperty(ctx, tx, propertyID); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var busy bool
+	if err := tx.QueryRow(ctx, `
+		SELECT COALESCE(image_analysis_status = 'PENDING'
+			OR (image_analysis_status = 'PROCESSING' AND image_analysis_locked_until > NOW()), false)
+		FROM properties
+		WHERE property_id = $1
+	`, propertyID).Scan(&busy); err != nil {
+		return false, fmt.Errorf("%s: failed to read status: %w", op, err)
+	}
+	if busy {
+		return false, nil
+	}
+
+	if force {
+		if _, err := tx.Exec(ctx, `
+			UPDATE property_images
+			SET analyzed_at = NULL, updated_at = NOW()
+			WHERE property_id = $1
+		`, propertyID); err != nil {
+			return false, fmt.Errorf("%s: failed to reset analyses: %w", op, err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE properties
+		SET image_analysis_status = $2,
+			image_analysis_error = NULL,
+			image_analysis_locked_until = CASE
+				WHEN $2 = 'PROCESSING' THEN NOW() + make_interval(secs => $3)
+			END
+		WHERE property_id = $1
+	`, propertyID, string(status), lease.Seconds()); err != nil {
+		return false, fmt.Errorf("%s: failed to update status: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return true, nil
+}
+
+// ClaimPendingAnalyses — забирает до limit галерей из очереди (и с истёкшей арендой) в PROCESSING.
+func (r *PropertyImageRepository) ClaimPendingAnalyses(ctx context.Context, limit int, lease time.Duration) ([]uuid.UUID, error) {
+	const op = "PropertyImageRepository.ClaimPendingAnalyses"
+
+	rows, err := r.db.Query(ctx, `
+		WITH due AS (
+			SELECT property_id
+			FROM properties
+			WHERE image_analysis_status = 'PENDING'
+			   OR (image_analysis_status = 'PROCESSING' AND image_analysis_locked_until < NOW())
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE properties p
+		SET image_analysis_status = 'PROCESSING',
+			image_analysis_locked_until = NOW() + make_interval(secs => $2)
+		FROM due
+		WHERE p.property_id = due.property_id
+		RETURNING p.property_id
+	`, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+// FinishAnalysis — сохраняет агрегаты галереи в properties и завершает анализ со статусом
+// summary.Status. Если галерею за это время поставили в очередь заново, статус не меняется.
+func (r *PropertyImageRepository) FinishAnalysis(ctx context.Context, summary domain.PropertyImageAnalysis) error {
+	const op = "PropertyImageRepository.FinishAnalysis"
+
+	var visualFeatures *string
+	if len(summary.VisualFeatures) > 0 {
+		v := repository.VectorToString(float32s(summary.VisualFeatures))
+		visualFeatures = &v
+	}
+	var averageQuality *float64
+	if summary.TotalImages > 0 {
+		averageQuality = &summary.AverageQuality
+	}
+
+	tag, err := r.db.Exec(ctx, `
+		UPDATE properties
+		SET visual_features = $2::vector,
+			visual_assessment = NULLIF($3, ''),
+			average_quality_score = $4,
+			image_analysis_status = CASE
+				WHEN image_analysis_status = 'PENDING' THEN image_analysis_status ELSE $5
+			END,
+			image_analysis_error = NULLIF($6, ''),
+			image_analysis_locked_until = NULL,
+			images_analyzed_at = NOW()
+		WHERE property_id = $1
+	`, summary.PropertyID, visualFeatures, summary.OverallAssessment, averageQuality,
+		string(summary.Status), summary.Error)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrPropertyNotFound)
+	}
+
+	return nil
+}
+
+func float32s(v []float64) []float32 {
+	out := make([]float32, len(v))
+	for i, x := range v {
+		out[i] = float32(x)
+	}
+	return out
+}
+
+func float64s(v []float32) []float64 {
+	out := make([]float64, len(v))
+	for i, x := range v {
+		out[i] = float64(x)
+	}
+	return out
+}
@@ -0,0 +1,280 @@
+package property_image_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PropertyImageRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewPropertyImageRepository(db *pgxpool.Pool, log *slog.Logger) *PropertyImageRepository {
+	return &PropertyImageRepository{db: db, log: log}
+}
+
+const propertyImageColumns = `image_id, property_id, storage_path, position, created_at,
+	detected_features, room_type, quality_score, view_type, brightness, tags,
+	visual_features::text, analysis_confidence, analyzed_at,
+	(SELECT u.variants FROM uploads u WHERE u.storage_key = property_images.storage_path)`
+
+// querier — общий интерфейс пула и транзакции для чтения галереи.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func scanPropertyImages(rows pgx.Rows) ([]domain.PropertyImage, error) {
+	defer rows.Close()
+
+	var images []domain.PropertyImage
+	for rows.Next() {
+		img, err := scanPropertyImage(rows)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+	}
+	return images, rows.Err()
+}
+
+func listByProperty(ctx context.Context, q querier, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+propertyImageColumns+`
+		FROM property_images
+		WHERE property_id = $1 AND storage_path IS NOT NULL
+		ORDER BY position, created_at
+	`, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	return scanPropertyImages(rows)
+}
+
+// lockProperty блокирует строку объекта до конца транзакции, чтобы изменения галереи
+// одного объекта выполнялись по очереди.
+func lockProperty(ctx context.Context, tx pgx.Tx, propertyID uuid.UUID) error {
+	var id uuid.UUID
+	err := tx.QueryRow(ctx, `SELECT property_id FROM properties WHERE property_id = $1 FOR UPDATE`, propertyID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repository.ErrPropertyNotFound
+	}
+	return err
+}
+
+// AddImages — добавляет ключи в конец галереи. Ключи, которые уже есть в галерее, пропускаются.
+// Если в галерее окажется больше maxImages фотографий, возвращает ErrPropertyImageLimit.
+func (r *PropertyImageRepository) AddImages(ctx context.Context, propertyID uuid.UUID, keys []string, maxImages int) ([]domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.AddImages"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockProperty(ctx, tx, propertyID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	existing, err := listByProperty(ctx, tx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list images: %w", op, err)
+	}
+
+	seen := make(map[string]bool, len(existing)+len(keys))
+	for _, img := range existing {
+		seen[img.StorageKey] = true
+	}
+	var added []string
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			added = append(added, key)
+		}
+	}
+
+	if len(existing)+len(added) > maxImages {
+		return nil, fmt.Errorf("%s: %w", op, repository.ErrPropertyImageLimit)
+	}
+
+	// Позиции после удалений плотные (0..n-1), поэтому новые фотографии встают с len(existing)
+	for i, key := range added {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO property_images (property_id, storage_path, position)
+			VALUES ($1, $2, $3)
+		`, propertyID, key, len(existing)+i); err != nil {
+			return nil, fmt.Errorf("%s: failed to insert image: %w", op, err)
+		}
+	}
+
+	images, err := listByProperty(ctx, tx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list images: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return images, nil
+}
+
+// ListByProperty — галерея объекта в порядке показа.
+func (r *PropertyImageRepository) ListByProperty(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.ListByProperty"
+
+	images, err := listByProperty(ctx, r.db, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return images, nil
+}
+
+// ListByProperties — галереи нескольких объектов одним запросом.
+func (r *PropertyImageRepository) ListByProperties(ctx context.Context, propertyIDs []uuid.UUID) (map[uuid.UUID][]domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.ListByProperties"
+
+	result := make(map[uuid.UUID][]domain.PropertyImage, len(propertyIDs))
+	if len(propertyIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+propertyImageColumns+`
+		FROM property_images
+		WHERE property_id = ANY($1) AND storage_path IS NOT NULL
+		ORDER BY property_id, position, created_at
+	`, propertyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	images, err := scanPropertyImages(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, img := range images {
+		result[img.PropertyID] = append(result[img.PropertyID], img)
+	}
+
+	return result, nil
+}
+
+// Reorder — расставляет фотографии в порядке imageIDs. Список должен содержать каждую
+// фотографию галереи ровно один раз, иначе возвращается ErrPropertyImageOrder.
+func (r *PropertyImageRepository) Reorder(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.Reorder"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockProperty(ctx, tx, propertyID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	existing, err := listByProperty(ctx, tx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list images: %w", op, err)
+	}
+
+	if len(existing) != len(imageIDs) {
+		return nil, fmt.Errorf("%s: %w", op, repository.ErrPropertyImageOrder)
+	}
+	pending := make(map[uuid.UUID]bool, len(existing))
+	for _, img := range existing {
+		pending[img.ID] = true
+	}
+	for _, id := range imageIDs {
+		if !pending[id] {
+			return nil, fmt.Errorf("%s: %w", op, repository.ErrPropertyImageOrder)
+		}
+		delete(pending, id)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE property_images p
+		SET position = o.ord - 1, updated_at = NOW()
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(image_id, ord)
+		WHERE p.image_id = o.image_id AND p.property_id = $1
+	`, propertyID, imageIDs); err != nil {
+		return nil, fmt.Errorf("%s: failed to update positions: %w", op, err)
+	}
+
+	images, err := listByProperty(ctx, tx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list images: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return images, nil
+}
+
+// Delete — удаляет фотографию из галереи и сдвигает следующие за ней. Возвращает удалённую запись.
+func (r *PropertyImageRepository) Delete(ctx context.Context, propertyID, imageID uuid.UUID) (domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.Delete"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return domain.PropertyImage{}, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockProperty(ctx, tx, propertyID); err != nil {
+		return domain.PropertyImage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	img, err := scanPropertyImage(tx.QueryRow(ctx, `
+		DELETE FROM property_images
+		WHERE property_id = $1 AND image_id = $2 AND storage_path IS NOT NULL
+		RETURNING `+propertyImageColumns,
+		propertyID, imageID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PropertyImage{}, fmt.Errorf("%s: %w", op, repository.ErrPropertyImageNotFound)
+		}
+		return domain.PropertyImage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE property_images
+		SET position = position - 1, updated_at = NOW()
+		WHERE property_id = $1 AND position > $2
+	`, propertyID, img.Position); err != nil {
+		return domain.PropertyImage{}, fmt.Errorf("%s: failed to shift positions: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.PropertyImage{}, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return img, nil
+}
+
+// IsKeyReferenced — true, если ключ ещё есть в галерее какого-либо объекта.
+func (r *PropertyImageRepository) IsKeyReferenced(ctx context.Context, key string) (bool, error) {
+	const op = "PropertyImageRepository.IsKeyReferenced"
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM property_images WHERE storage_path = $1)`, key).Scan(&exists); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return exists, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
 	"lead_exchange/internal/repository"
 	"log/slog"
 	"strings"
@@ -24,10 +25,16 @@ func NewPropertyRepository(db *pgxpool.Pool, log *slog.Logger) *PropertyReposito
 	return &PropertyRepository{db: db, log: log}
 }
 
-// CreateProperty — создаёт новый объект недвижимости.
+// CreateProperty — создаёт новый объект недвижимости и в той же транзакции ставит задание на генерацию embedding.
 func (r *PropertyRepository) CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error) {
 	const op = "PropertyRepository.CreateProperty"
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		INSERT INTO properties (
 			title, description, address, city, property_type,
@@ -39,7 +46,7 @@ func (r *PropertyRepository) CreateProperty(ctx context.Context, property domain
 	`
 
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		property.Title,
 		property.Description,
 		property.Address,
@@ -56,6 +63,14 @@ func (r *PropertyRepository) CreateProperty(ctx context.Context, property domain
 		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := repository.EnqueueEmbeddingJob(ctx, tx, domain.EmbeddingEntityProperty, id); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to enqueue embedding job: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
 	return id, nil
 }
 
@@ -68,7 +83,8 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 			property_id, title, description, address, city, property_type,
 			area, price, rooms,
 			status, owner_user_id, created_user_id,
-			embedding::text, created_at, updated_at
+			embedding::text, created_at, updated_at,
+			visual_features::text, average_quality_score
 		FROM properties
 		WHERE property_id = $1
 	`
@@ -77,6 +93,7 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 	var propertyTypeStr string
 	var statusStr string
 	var embeddingStr *string
+	var visualStr *string
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&p.ID,
 		&p.Title,
@@ -93,6 +110,8 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 		&embeddingStr,
 		&p.CreatedAt,
 		&p.UpdatedAt,
+		&visualStr,
+		&p.AverageQualityScore,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -113,11 +132,13 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 			p.Embedding = vec
 		}
 	}
+	p.VisualFeatures = r.parseVisualFeatures(visualStr)
 
 	return p, nil
 }
 
 // UpdateProperty — частичное обновление данных объекта недвижимости.
+// Если изменились данные, влияющие на matching, в той же транзакции ставится задание на пересчёт embedding.
 func (r *PropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid.UUID, update domain.PropertyFilter) error {
 	const op = "PropertyRepository.UpdateProperty"
 
@@ -185,7 +206,13 @@ func (r *PropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid
 	query := fmt.Sprintf(`UPDATE properties SET %s WHERE property_id = $%d`, strings.Join(setClauses, ", "), paramCount)
 	params = append(params, propertyID)
 
-	tag, err := r.db.Exec(ctx, query, params...)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, query, params...)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -194,6 +221,17 @@ func (r *PropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid
 		return fmt.Errorf("%s: %w", op, repository.ErrPropertyNotFound)
 	}
 
+	if update.Title != nil || update.Description != nil || update.Address != nil ||
+		update.Price != nil || update.Rooms != nil || update.Area != nil {
+		if err := repository.EnqueueEmbeddingJob(ctx, tx, domain.EmbeddingEntityProperty, propertyID); err != nil {
+			return fmt.Errorf("%s: failed to enqueue embedding job: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -248,6 +286,12 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 		baseParams = append(baseParams, *filter.CreatedUserID)
 		paramCount++
 	}
+	if filter.VisibleToUserID != nil {
+		baseWhereClauses = append(baseWhereClauses,
+			fmt.Sprintf("(status <> 'NEW' OR owner_user_id = $%d OR created_user_id = $%d)", paramCount, paramCount))
+		baseParams = append(baseParams, *filter.VisibleToUserID)
+		paramCount++
+	}
 	if filter.PropertyType != nil {
 		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("property_type = $%d", paramCount))
 		baseParams = append(baseParams, (*filter.PropertyType).String())
@@ -400,8 +444,10 @@ func (r *PropertyRepository) UpdateEmbedding(ctx context.Context, propertyID uui
 	const op = "PropertyRepository.UpdateEmbedding"
 
 	query := `
-		UPDATE properties 
-		SET embedding = $1::vector, updated_at = NOW()
+		UPDATE properties
+		SET embedding = $1::vector,
+		    embedding_model = (SELECT active_model FROM embedding_model_state),
+		    updated_at = NOW()
 		WHERE property_id = $2
 	`
 
@@ -442,6 +488,7 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 			area, price, rooms,
 			status, owner_user_id, created_user_id,
 			embedding::text, created_at, updated_at,
+			visual_features::text, average_quality_score,
 			1 - (embedding <=> $1::vector) as similarity
 		FROM properties
 		WHERE embedding IS NOT NULL
@@ -546,6 +593,7 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 		var propertyTypeStr string
 		var statusStr string
 		var embeddingStr *string
+		var visualStr *string
 		var similarity float64
 
 		if err := rows.Scan(
@@ -564,6 +612,8 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 			&embeddingStr,
 			&p.CreatedAt,
 			&p.UpdatedAt,
+			&visualStr,
+			&p.AverageQualityScore,
 			&similarity,
 		); err != nil {
 			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
@@ -580,6 +630,7 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 				p.Embedding = vec
 			}
 		}
+		p.VisualFeatures = r.parseVisualFeatures(visualStr)
 
 		matches = append(matches, domain.MatchedProperty{
 			Property:   p,
@@ -590,6 +641,23 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 	return matches, rows.Err()
 }
 
+// parseVisualFeatures разбирает visual_features; nil — фотографии объекта ещё не проанализированы.
+func (r *PropertyRepository) parseVisualFeatures(s *string) []float64 {
+	if s == nil || *s == "" {
+		return nil
+	}
+	vec, err := repository.StringToVector(*s)
+	if err != nil {
+		r.log.Warn("failed to parse visual_features", sl.Err(err))
+		return nil
+	}
+	features := make([]float64, len(vec))
+	for i, v := range vec {
+		features[i] = float64(v)
+	}
+	return features
+}
+
 func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	return errors.As(err, &pgErr) && pgErr.Code == "23505"
@@ -665,6 +733,7 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 			p.area, p.price, p.rooms,
 			p.status, p.owner_user_id, p.created_user_id,
 			p.embedding::text, p.created_at, p.updated_at,
+			p.visual_features::text, p.average_quality_score,
 			c.rrf_score,
 			c.vector_similarity,
 			c.fts_score
@@ -738,6 +807,7 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 		var propertyTypeStr string
 		var statusStr string
 		var embeddingStr *string
+		var visualStr *string
 		var rrfScore float64
 		var vectorSimilarity float64
 		var ftsScore float64
@@ -758,6 +828,8 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 			&embeddingStr,
 			&p.CreatedAt,
 			&p.UpdatedAt,
+			&visualStr,
+			&p.AverageQualityScore,
 			&rrfScore,
 			&vectorSimilarity,
 			&ftsScore,
@@ -776,6 +848,7 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 				p.Embedding = vec
 			}
 		}
+		p.VisualFeatures = r.parseVisualFeatures(visualStr)
 
 		matches = append(matches, domain.MatchedProperty{
 			Property:   p,
@@ -0,0 +1,82 @@
+package property_repository
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ListForReindex возвращает страницу объектов для массовой переиндексации в порядке property_id.
+func (r *PropertyRepository) ListForReindex(ctx context.Context, filter domain.ReindexFilter) ([]domain.Property, error) {
+	const op = "PropertyRepository.ListForReindex"
+
+	where, params := repository.ReindexWhere(filter, "property_id")
+	params = append(params, filter.Limit)
+
+	query := `
+		SELECT property_id, title, description, address, area, price, rooms, updated_at
+		FROM properties` + where + fmt.Sprintf(" ORDER BY property_id LIMIT $%d", len(params))
+
+	rows, err := r.db.Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var properties []domain.Property
+	for rows.Next() {
+		var p domain.Property
+		if err := rows.Scan(&p.ID, &p.Title, &p.Description, &p.Address, &p.Area, &p.Price, &p.Rooms, &p.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		properties = append(properties, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return properties, nil
+}
+
+// CountForReindex — число объектов под фильтром переиндексации (AfterID не учитывается).
+func (r *PropertyRepository) CountForReindex(ctx context.Context, filter domain.ReindexFilter) (int, error) {
+	const op = "PropertyRepository.CountForReindex"
+
+	filter.AfterID = nil
+	where, params := repository.ReindexWhere(filter, "property_id")
+
+	var count int
+	if err := r.db.QueryRow(ctx, "SELECT COUNT(*) FROM properties"+where, params...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// UpdateShadowEmbedding записывает embedding целевой модели идущей миграции в теневую колонку.
+// Поиск эту колонку не читает, поэтому updated_at не меняется.
+func (r *PropertyRepository) UpdateShadowEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error {
+	const op = "PropertyRepository.UpdateShadowEmbedding"
+
+	query := `
+		UPDATE properties
+		SET embedding_next = $1::vector,
+		    embedding_next_model = (SELECT next_model FROM embedding_model_state)
+		WHERE property_id = $2
+	`
+
+	tag, err := r.db.Exec(ctx, query, repository.VectorToString(embedding), propertyID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrPropertyNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package rate_limit_repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"lead_exchange/internal/lib/logger/sl"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// sweepInterval — как часто удаляются окна, которые давно закончились.
+const sweepInterval = 10 * time.Minute
+
+// sweepAge — окна старше самого длинного допустимого окна (middleware.MaxRateLimitWindow) закончились.
+const sweepAge = 24 * time.Hour
+
+// RateLimitRepository — общие для всех экземпляров сервера счётчики rate limit.
+type RateLimitRepository struct {
+	db        *pgxpool.Pool
+	log       *slog.Logger
+	lastSweep atomic.Int64
+}
+
+func NewRateLimitRepository(db *pgxpool.Pool, log *slog.Logger) *RateLimitRepository {
+	return &RateLimitRepository{db: db, log: log}
+}
+
+// Incr учитывает запрос в окне window, начавшемся в windowStart, и возвращает число
+// запросов по ключу в этом окне. Более старое окно сбрасывается; окно из прошлого
+// (часы экземпляра отстают) не откатывает уже начатое.
+func (r *RateLimitRepository) Incr(ctx context.Context, key string, windowStart time.Time) (int, error) {
+	const op = "RateLimitRepository.Incr"
+
+	query := `
+		INSERT INTO rate_limits (bucket_key, window_start, hits)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (bucket_key) DO UPDATE SET
+			hits = CASE
+				WHEN rate_limits.window_start >= EXCLUDED.window_start THEN rate_limits.hits + 1
+				ELSE 1
+			END,
+			window_start = GREATEST(rate_limits.window_start, EXCLUDED.window_start)
+		RETURNING hits
+	`
+
+	var hits int
+	if err := r.db.QueryRow(ctx, query, key, windowStart).Scan(&hits); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	r.maybeSweep(ctx)
+
+	return hits, nil
+}
+
+// maybeSweep не чаще sweepInterval удаляет давно закончившиеся окна, чтобы таблица не росла
+// ключами разовых IP. Ошибка только логируется: на учёт запросов она не влияет.
+func (r *RateLimitRepository) maybeSweep(ctx context.Context) {
+	now := time.Now()
+	last := r.lastSweep.Load()
+	if now.Sub(time.Unix(0, last)) < sweepInterval || !r.lastSweep.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	if _, err := r.db.Exec(ctx, "DELETE FROM rate_limits WHERE window_start < $1", now.Add(-sweepAge)); err != nil {
+		r.log.Warn("failed to sweep rate limits", sl.Err(err))
+	}
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"fmt"
+	"lead_exchange/internal/domain"
+	"strings"
+)
+
+// ReindexWhere собирает WHERE для выборки сущностей на переиндексацию.
+// idColumn — первичный ключ таблицы, по нему идёт keyset-пагинация.
+// С filter.Shadow «без embedding» означает без вектора целевой модели в теневой колонке.
+func ReindexWhere(filter domain.ReindexFilter, idColumn string) (string, []interface{}) {
+	var clauses []string
+	var params []interface{}
+
+	switch {
+	case filter.OnlyMissing && filter.Shadow:
+		clauses = append(clauses,
+			"(embedding_next IS NULL OR embedding_next_model IS DISTINCT FROM (SELECT next_model FROM embedding_model_state))")
+	case filter.OnlyMissing:
+		clauses = append(clauses, "embedding IS NULL")
+	}
+	if filter.Since != nil {
+		params = append(params, *filter.Since)
+		clauses = append(clauses, fmt.Sprintf("updated_at >= $%d", len(params)))
+	}
+	if filter.AfterID != nil {
+		params = append(params, *filter.AfterID)
+		clauses = append(clauses, fmt.Sprintf("%s > $%d", idColumn, len(params)))
+	}
+
+	if len(clauses) == 0 {
+		return "", params
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), params
+}
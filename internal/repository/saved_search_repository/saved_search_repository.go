@@ -0,0 +1,277 @@
+package saved_search_repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SavedSearchRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewSavedSearchRepository(db *pgxpool.Pool, log *slog.Logger) *SavedSearchRepository {
+	return &SavedSearchRepository{db: db, log: log}
+}
+
+// storedFilter — сериализуемая часть PropertyFilter, которая хранится в saved_searches.filter.
+type storedFilter struct {
+	City         *string                `json:"city,omitempty"`
+	PropertyType *domain.PropertyType   `json:"property_type,omitempty"`
+	Status       *domain.PropertyStatus `json:"status,omitempty"`
+	MinPrice     *int64                 `json:"min_price,omitempty"`
+	MaxPrice     *int64                 `json:"max_price,omitempty"`
+	MinRooms     *int32                 `json:"min_rooms,omitempty"`
+	MaxRooms     *int32                 `json:"max_rooms,omitempty"`
+}
+
+func filterToJSON(f domain.PropertyFilter) ([]byte, error) {
+	return json.Marshal(storedFilter{
+		City:         f.City,
+		PropertyType: f.PropertyType,
+		Status:       f.Status,
+		MinPrice:     f.MinPrice,
+		MaxPrice:     f.MaxPrice,
+		MinRooms:     f.MinRooms,
+		MaxRooms:     f.MaxRooms,
+	})
+}
+
+func filterFromJSON(data []byte) (domain.PropertyFilter, error) {
+	var sf storedFilter
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &sf); err != nil {
+			return domain.PropertyFilter{}, err
+		}
+	}
+	return domain.PropertyFilter{
+		City:         sf.City,
+		PropertyType: sf.PropertyType,
+		Status:       sf.Status,
+		MinPrice:     sf.MinPrice,
+		MaxPrice:     sf.MaxPrice,
+		MinRooms:     sf.MinRooms,
+		MaxRooms:     sf.MaxRooms,
+	}, nil
+}
+
+const savedSearchColumns = `
+	saved_search_id, user_id, name, lead_id, filter, weight_preset,
+	result_limit, enabled, last_run_at, baseline_at, created_at, updated_at
+`
+
+func scanSavedSearch(row pgx.Row) (domain.SavedSearch, error) {
+	var (
+		s          domain.SavedSearch
+		filterJSON []byte
+	)
+	if err := row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.Name,
+		&s.LeadID,
+		&filterJSON,
+		&s.WeightPreset,
+		&s.Limit,
+		&s.Enabled,
+		&s.LastRunAt,
+		&s.BaselineAt,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	); err != nil {
+		return domain.SavedSearch{}, err
+	}
+
+	filter, err := filterFromJSON(filterJSON)
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("invalid filter: %w", err)
+	}
+	s.Filter = filter
+
+	return s, nil
+}
+
+// CreateSavedSearch — сохраняет новый поиск пользователя.
+func (r *SavedSearchRepository) CreateSavedSearch(ctx context.Context, s domain.SavedSearch) (domain.SavedSearch, error) {
+	const op = "SavedSearchRepository.CreateSavedSearch"
+
+	filterJSON, err := filterToJSON(s.Filter)
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("%s: failed to marshal filter: %w", op, err)
+	}
+
+	query := `
+		INSERT INTO saved_searches (user_id, name, lead_id, filter, weight_preset, result_limit, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + savedSearchColumns
+
+	created, err := scanSavedSearch(r.db.QueryRow(ctx, query,
+		s.UserID, s.Name, s.LeadID, filterJSON, s.WeightPreset, s.Limit, s.Enabled,
+	))
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// ListByUser — возвращает сохранённые поиски пользователя.
+func (r *SavedSearchRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error) {
+	const op = "SavedSearchRepository.ListByUser"
+
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var searches []domain.SavedSearch
+	for rows.Next() {
+		s, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		searches = append(searches, s)
+	}
+
+	return searches, rows.Err()
+}
+
+// DeleteSavedSearch — удаляет сохранённый поиск пользователя.
+func (r *SavedSearchRepository) DeleteSavedSearch(ctx context.Context, userID, searchID uuid.UUID) error {
+	const op = "SavedSearchRepository.DeleteSavedSearch"
+
+	tag, err := r.db.Exec(ctx, `DELETE FROM saved_searches WHERE saved_search_id = $1 AND user_id = $2`, searchID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrSavedSearchNotFound)
+	}
+
+	return nil
+}
+
+// ClaimDue — забирает до limit включённых поисков, которые не запускались с момента before,
+// и отмечает их запущенными. FOR UPDATE SKIP LOCKED не даёт двум экземплярам сервера
+// обработать один поиск. LastRunAt в результате — время предыдущего запуска (nil для первого);
+// первый ли это запуск, определяет BaselineAt, который ставит только успешный SaveRunResults.
+func (r *SavedSearchRepository) ClaimDue(ctx context.Context, before time.Time, limit int) ([]domain.SavedSearch, error) {
+	const op = "SavedSearchRepository.ClaimDue"
+
+	query := `
+		WITH due AS (
+			SELECT saved_search_id, last_run_at AS prev_run_at
+			FROM saved_searches
+			WHERE enabled AND (last_run_at IS NULL OR last_run_at < $1)
+			ORDER BY last_run_at NULLS FIRST
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE saved_searches s
+		SET last_run_at = NOW()
+		FROM due
+		WHERE s.saved_search_id = due.saved_search_id
+		RETURNING s.saved_search_id, s.user_id, s.name, s.lead_id, s.filter, s.weight_preset,
+			s.result_limit, s.enabled, due.prev_run_at, s.baseline_at, s.created_at, s.updated_at
+	`
+
+	rows, err := r.db.Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var searches []domain.SavedSearch
+	for rows.Next() {
+		s, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		searches = append(searches, s)
+	}
+
+	return searches, rows.Err()
+}
+
+// SaveRunResults — атомарно записывает найденные объекты и уведомления о новых из них
+// и отмечает, что исходная выдача поиска записана (baseline_at).
+// Уведомление из notifications создаётся только для объекта, которого ещё не было среди найденных.
+// Возвращает количество созданных уведомлений.
+func (r *SavedSearchRepository) SaveRunResults(
+	ctx context.Context,
+	searchID uuid.UUID,
+	propertyIDs []uuid.UUID,
+	notifications map[uuid.UUID]domain.Notification,
+) (int, error) {
+	const op = "SavedSearchRepository.SaveRunResults"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		INSERT INTO saved_search_hits (saved_search_id, property_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING
+		RETURNING property_id
+	`, searchID, propertyIDs)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to insert hits: %w", op, err)
+	}
+
+	var newIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		newIDs = append(newIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	created := 0
+	for _, id := range newIDs {
+		n, ok := notifications[id]
+		if !ok {
+			continue
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO notifications (user_id, type, title, body, payload)
+			VALUES ($1, $2, $3, $4, $5)
+		`, n.UserID, n.Type.String(), n.Title, n.Body, n.Payload); err != nil {
+			return 0, fmt.Errorf("%s: failed to insert notification: %w", op, err)
+		}
+		created++
+	}
+
+	// Пустая выдача — тоже исходная: объекты, появившиеся позже, будут новыми
+	if _, err := tx.Exec(ctx, `
+		UPDATE saved_searches SET baseline_at = COALESCE(baseline_at, NOW()) WHERE saved_search_id = $1
+	`, searchID); err != nil {
+		return 0, fmt.Errorf("%s: failed to record baseline: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,151 @@
+package upload_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UploadRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewUploadRepository(db *pgxpool.Pool, log *slog.Logger) *UploadRepository {
+	return &UploadRepository{db: db, log: log}
+}
+
+const uploadColumns = `upload_id, storage_key, user_id, file_name, content_type, size,
+	status, created_at, expires_at, completed_at, variants`
+
+func scanUpload(row pgx.Row) (domain.Upload, error) {
+	var u domain.Upload
+	var status string
+	var variantsJSON []byte
+	if err := row.Scan(
+		&u.ID, &u.Key, &u.UserID, &u.FileName, &u.ContentType, &u.Size,
+		&status, &u.CreatedAt, &u.ExpiresAt, &u.CompletedAt, &variantsJSON,
+	); err != nil {
+		return domain.Upload{}, err
+	}
+	u.Status = domain.UploadStatus(status)
+
+	variants, err := repository.ImageVariantsFromJSON(variantsJSON)
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("failed to decode variants: %w", err)
+	}
+	u.Variants = variants
+	return u, nil
+}
+
+// Create — регистрирует загрузку. Статус берётся из u.Status (по умолчанию PENDING).
+func (r *UploadRepository) Create(ctx context.Context, u domain.Upload) (domain.Upload, error) {
+	const op = "UploadRepository.Create"
+
+	if u.Status == "" {
+		u.Status = domain.UploadPending
+	}
+	variantsJSON, err := repository.ImageVariantsToJSON(u.Variants)
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("%s: failed to encode variants: %w", op, err)
+	}
+
+	created, err := scanUpload(r.db.QueryRow(ctx, `
+		INSERT INTO uploads (storage_key, user_id, file_name, content_type, size, status, expires_at, completed_at, variants)
+		VALUES ($1, $2, $3, $4, $5, $6, $7,
+			CASE WHEN $6 = 'COMPLETED' THEN NOW() END, $8)
+		RETURNING `+uploadColumns,
+		u.Key, u.UserID, u.FileName, u.ContentType, u.Size, string(u.Status), u.ExpiresAt, variantsJSON,
+	))
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// GetByKey — загрузка по ключу объекта.
+func (r *UploadRepository) GetByKey(ctx context.Context, key string) (domain.Upload, error) {
+	const op = "UploadRepository.GetByKey"
+
+	u, err := scanUpload(r.db.QueryRow(ctx, `SELECT `+uploadColumns+` FROM uploads WHERE storage_key = $1`, key))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Upload{}, fmt.Errorf("%s: %w", op, repository.ErrUploadNotFound)
+		}
+		return domain.Upload{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return u, nil
+}
+
+// Complete — отмечает загрузку завершённой и сохраняет проверенные тип и размер и копии изображения.
+// Повторный вызов для завершённой загрузки ничего не меняет и возвращает её как есть.
+func (r *UploadRepository) Complete(ctx context.Context, key, contentType string, size int64, variants []domain.ImageVariant) (domain.Upload, error) {
+	const op = "UploadRepository.Complete"
+
+	variantsJSON, err := repository.ImageVariantsToJSON(variants)
+	if err != nil {
+		return domain.Upload{}, fmt.Errorf("%s: failed to encode variants: %w", op, err)
+	}
+
+	u, err := scanUpload(r.db.QueryRow(ctx, `
+		UPDATE uploads
+		SET status = 'COMPLETED',
+			content_type = CASE WHEN status = 'PENDING' THEN $2 ELSE content_type END,
+			size = CASE WHEN status = 'PENDING' THEN $3 ELSE size END,
+			variants = CASE WHEN status = 'PENDING' THEN $4 ELSE variants END,
+			completed_at = COALESCE(completed_at, NOW())
+		WHERE storage_key = $1
+		RETURNING `+uploadColumns,
+		key, contentType, size, variantsJSON,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Upload{}, fmt.Errorf("%s: %w", op, repository.ErrUploadNotFound)
+		}
+		return domain.Upload{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return u, nil
+}
+
+// Delete — удаляет запись о загрузке (файл не прошёл проверку и удалён из хранилища).
+func (r *UploadRepository) Delete(ctx context.Context, key string) error {
+	const op = "UploadRepository.Delete"
+
+	if _, err := r.db.Exec(ctx, `DELETE FROM uploads WHERE storage_key = $1`, key); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListExpired — ключи неподтверждённых загрузок, ссылка на которые истекла до before,
+// от самых старых (по частичному индексу idx_uploads_pending_expires).
+func (r *UploadRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	const op = "UploadRepository.ListExpired"
+
+	rows, err := r.db.Query(ctx, `
+		SELECT storage_key FROM uploads
+		WHERE status = 'PENDING' AND expires_at < $1
+		ORDER BY expires_at
+		LIMIT $2`,
+		before, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,112 @@
+package user_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateLoginAttempt — записывает попытку входа в журнал.
+func (r *UserRepository) CreateLoginAttempt(ctx context.Context, attempt domain.LoginAttempt) error {
+	const op = "UserRepository.CreateLoginAttempt"
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO login_attempts (email, user_id, ip, user_agent, success, failure_reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, attempt.Email, attempt.UserID, attempt.Client.IP, attempt.Client.UserAgent, attempt.Success, string(attempt.FailureReason))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// CountIPLoginFailures — число попыток входа с неверными данными с адреса ip после since.
+func (r *UserRepository) CountIPLoginFailures(ctx context.Context, ip string, since time.Time) (int, error) {
+	const op = "UserRepository.CountIPLoginFailures"
+
+	var n int
+	err := r.db.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM login_attempts
+		WHERE ip = $1 AND NOT success AND failure_reason = $2 AND created_at > $3
+	`, ip, string(domain.LoginFailureInvalidCredentials), since).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
+
+// GetLoginLockout — состояние неудачных попыток по email; без записи возвращает пустое состояние.
+func (r *UserRepository) GetLoginLockout(ctx context.Context, email string) (domain.LoginLockout, error) {
+	const op = "UserRepository.GetLoginLockout"
+
+	l := domain.LoginLockout{Email: email}
+	err := r.db.QueryRow(ctx, `
+		SELECT failed_count, last_failed_at, locked_until
+		FROM login_lockouts
+		WHERE email = $1
+	`, email).Scan(&l.FailedCount, &l.LastFailedAt, &l.LockedUntil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return l, nil
+		}
+		return domain.LoginLockout{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return l, nil
+}
+
+// RegisterLoginFailure — атомарно учитывает неудачную попытку по email. Если предыдущая неудача
+// была раньше window, счётчик начинается заново. Когда счётчик достигает maxFailures (> 0),
+// вход блокируется на lockout.
+func (r *UserRepository) RegisterLoginFailure(
+	ctx context.Context, email string, maxFailures int, window, lockout time.Duration,
+) (domain.LoginLockout, error) {
+	const op = "UserRepository.RegisterLoginFailure"
+
+	l := domain.LoginLockout{Email: email}
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO login_lockouts AS l (email, failed_count, last_failed_at, locked_until)
+		VALUES (
+			$1, 1, NOW(),
+			CASE WHEN $2::int > 0 AND $2::int <= 1 THEN NOW() + $4::float8 * INTERVAL '1 second' END
+		)
+		ON CONFLICT (email) DO UPDATE SET
+			failed_count = CASE
+				WHEN l.last_failed_at < NOW() - $3::float8 * INTERVAL '1 second' THEN 1
+				ELSE l.failed_count + 1
+			END,
+			last_failed_at = NOW(),
+			locked_until = CASE
+				WHEN $2::int > 0 AND (CASE
+					WHEN l.last_failed_at < NOW() - $3::float8 * INTERVAL '1 second' THEN 1
+					ELSE l.failed_count + 1
+				END) >= $2::int
+					THEN NOW() + $4::float8 * INTERVAL '1 second'
+				ELSE l.locked_until
+			END
+		RETURNING failed_count, last_failed_at, locked_until
+	`, email, maxFailures, window.Seconds(), lockout.Seconds()).Scan(&l.FailedCount, &l.LastFailedAt, &l.LockedUntil)
+	if err != nil {
+		return domain.LoginLockout{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return l, nil
+}
+
+// ResetLoginFailures — сбрасывает счётчик неудачных попыток и снимает блокировку по email.
+func (r *UserRepository) ResetLoginFailures(ctx context.Context, email string) error {
+	const op = "UserRepository.ResetLoginFailures"
+
+	if _, err := r.db.Exec(ctx, `DELETE FROM login_lockouts WHERE email = $1`, email); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package user_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateRefreshToken — сохраняет хеш нового refresh-токена.
+func (r *UserRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (uuid.UUID, error) {
+	const op = "UserRepository.CreateRefreshToken"
+
+	query := `
+		INSERT INTO refresh_tokens (user_id, token_hash, device, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING token_id
+	`
+
+	var id uuid.UUID
+	if err := r.db.QueryRow(ctx, query, token.UserID, token.TokenHash, token.Device, token.ExpiresAt).Scan(&id); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetRefreshTokenByHash — получает refresh-токен по хешу.
+func (r *UserRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	const op = "UserRepository.GetRefreshTokenByHash"
+
+	query := `
+		SELECT token_id, user_id, token_hash, device, expires_at, revoked_at, replaced_by, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var t domain.RefreshToken
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&t.TokenHash,
+		&t.Device,
+		&t.ExpiresAt,
+		&t.RevokedAt,
+		&t.ReplacedBy,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RefreshToken{}, fmt.Errorf("%s: %w", op, repository.ErrRefreshTokenNotFound)
+		}
+		return domain.RefreshToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return t, nil
+}
+
+// RotateRefreshToken — атомарно отзывает старый токен и сохраняет новый.
+// Если старый токен уже отозван (параллельное обновление), возвращает ErrRefreshTokenRevoked.
+func (r *UserRepository) RotateRefreshToken(ctx context.Context, oldID uuid.UUID, next domain.RefreshToken) (uuid.UUID, error) {
+	const op = "UserRepository.RotateRefreshToken"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var newID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		INSERT INTO refresh_tokens (user_id, token_hash, device, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING token_id
+	`, next.UserID, next.TokenHash, next.Device, next.ExpiresAt).Scan(&newID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to insert token: %w", op, err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW(), replaced_by = $2
+		WHERE token_id = $1 AND revoked_at IS NULL
+	`, oldID, newID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to revoke token: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, repository.ErrRefreshTokenRevoked)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return newID, nil
+}
+
+// RevokeRefreshToken — отзывает refresh-токен пользователя. Повторный отзыв не считается ошибкой.
+func (r *UserRepository) RevokeRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string) error {
+	const op = "UserRepository.RevokeRefreshToken"
+
+	_, err := r.db.Exec(ctx, `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND token_hash = $2 AND revoked_at IS NULL
+	`, userID, tokenHash)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeUserSessions — отзывает все сессии пользователя: увеличивает token_version
+// (access-токены становятся недействительными) и отзывает все refresh-токены.
+func (r *UserRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserRepository.RevokeUserSessions"
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin tx: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE users SET token_version = token_version + 1 WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to bump token version: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrUserNotFound)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, userID); err != nil {
+		return fmt.Errorf("%s: failed to revoke refresh tokens: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetTokenVersion — текущая версия токенов пользователя.
+func (r *UserRepository) GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	const op = "UserRepository.GetTokenVersion"
+
+	var version int
+	err := r.db.QueryRow(ctx, `SELECT token_version FROM users WHERE user_id = $1`, userID).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, repository.ErrUserNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
@@ -53,7 +53,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User
 	query := `
 		SELECT 
 			user_id, email, password_hash, first_name, last_name,
-			phone, agency_name, avatar_url, role, status, created_at
+			phone, agency_name, avatar_url, role, status, token_version, created_at
 		FROM users
 		WHERE user_id = $1
 	`
@@ -70,6 +70,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User
 		&u.AvatarURL,
 		&u.Role,
 		&u.Status,
+		&u.TokenVersion,
 		&u.CreatedAt,
 	)
 
@@ -90,7 +91,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.U
 	query := `
 		SELECT 
 			user_id, email, password_hash, first_name, last_name,
-			phone, agency_name, avatar_url, role, status, created_at
+			phone, agency_name, avatar_url, role, status, token_version, created_at
 		FROM users
 		WHERE email = $1
 	`
@@ -107,6 +108,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.U
 		&u.AvatarURL,
 		&u.Role,
 		&u.Status,
+		&u.TokenVersion,
 		&u.CreatedAt,
 	)
 
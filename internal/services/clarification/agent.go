@@ -2,9 +2,11 @@ package clarification
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
+	"strconv"
+	"strings"
 
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/llm"
@@ -163,15 +165,10 @@ func (a *Agent) determinePriority(missingFields []string, qualityScore float64)
 func (a *Agent) generateQuestionsWithLLM(ctx context.Context, lead domain.Lead, missingFields []string) ([]Question, error) {
 	const op = "clarification.Agent.generateQuestionsWithLLM"
 
-	var reqMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		json.Unmarshal(lead.Requirement, &reqMap)
-	}
-
 	req := llm.ClarificationRequest{
 		Title:         lead.Title,
 		Description:   lead.Description,
-		Requirement:   reqMap,
+		Requirement:   lead.Requirement.Map(),
 		MissingFields: missingFields,
 	}
 
@@ -288,85 +285,108 @@ func (a *Agent) generateFallbackQuestions(missingFields []string) []Question {
 }
 
 // ApplyClarificationAnswers применяет ответы на уточняющие вопросы к лиду.
-// Возвращает обновлённый requirement JSON.
-func (a *Agent) ApplyClarificationAnswers(lead domain.Lead, answers map[string]interface{}) (json.RawMessage, error) {
+// Ответ — число (в том числе строкой, «8 000 000») или один из SuggestedOptions.
+// Точные значения (price, roomNumber/rooms, area) становятся диапазонами из одного значения,
+// бюджет — верхней границей; поля min_*/max_* задают одну границу.
+// Нераспознанный ответ — ошибка domain.ErrInvalidLeadRequirement; ответы на неизвестные поля игнорируются.
+func (a *Agent) ApplyClarificationAnswers(lead domain.Lead, answers map[string]interface{}) (domain.LeadRequirement, error) {
 	const op = "clarification.Agent.ApplyClarificationAnswers"
 
-	var reqMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &reqMap); err != nil {
-			reqMap = make(map[string]interface{})
-		}
-	} else {
-		reqMap = make(map[string]interface{})
+	req := lead.Requirement
+	if req.Version == 0 {
+		req.Version = domain.LeadRequirementVersion
 	}
 
-	// Применяем ответы
 	for field, value := range answers {
 		switch field {
-		case "price":
-			// Парсим бюджет из текстового ответа
-			if priceStr, ok := value.(string); ok {
-				if price := a.parsePriceRange(priceStr); price > 0 {
-					reqMap["price"] = price
-				}
-			} else if price, ok := value.(float64); ok {
-				reqMap["price"] = int64(price)
+		case "price", "max_price", "min_price":
+			v, err := answerNumber(field, value, a.parsePriceRange)
+			if err != nil {
+				return domain.LeadRequirement{}, fmt.Errorf("%s: %w", op, err)
 			}
-		case "roomNumber":
-			// Парсим количество комнат
-			if roomsStr, ok := value.(string); ok {
-				if rooms := a.parseRooms(roomsStr); rooms > 0 {
-					reqMap["roomNumber"] = rooms
-				}
-			} else if rooms, ok := value.(float64); ok {
-				reqMap["roomNumber"] = int32(rooms)
+			price := int64(v)
+			if field == "min_price" {
+				req.BudgetMin = &price
+			} else {
+				req.BudgetMax = &price
 			}
-		case "area":
-			// Парсим площадь
-			if areaStr, ok := value.(string); ok {
-				if area := a.parseArea(areaStr); area > 0 {
-					reqMap["area"] = area
-				}
-			} else if area, ok := value.(float64); ok {
-				reqMap["area"] = area
+		case "roomNumber", "rooms", "min_rooms", "max_rooms":
+			v, err := answerNumber(field, value, a.parseRooms)
+			if err != nil {
+				return domain.LeadRequirement{}, fmt.Errorf("%s: %w", op, err)
+			}
+			rooms := int32(v)
+			switch field {
+			case "min_rooms":
+				req.RoomsMin = &rooms
+			case "max_rooms":
+				req.RoomsMax = &rooms
+			default:
+				req.RoomsMin, req.RoomsMax = &rooms, &rooms
+			}
+		case "area", "min_area", "max_area":
+			area, err := answerNumber(field, value, a.parseArea)
+			if err != nil {
+				return domain.LeadRequirement{}, fmt.Errorf("%s: %w", op, err)
+			}
+			switch field {
+			case "min_area":
+				req.AreaMin = &area
+			case "max_area":
+				req.AreaMax = &area
+			default:
+				req.AreaMin, req.AreaMax = &area, &area
 			}
 		case "district":
-			if district, ok := value.(string); ok && district != "" && district != "Любой" {
-				reqMap["district"] = district
+			if district, ok := value.(string); ok {
+				district = strings.TrimSpace(district)
+				if district != "" && district != "Любой" {
+					req.Districts = []string{district}
+				}
 			}
-		default:
-			// Другие поля добавляем как есть
-			reqMap[field] = value
 		}
 	}
 
-	return json.Marshal(reqMap)
+	if err := req.Validate(); err != nil {
+		return domain.LeadRequirement{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return req, nil
 }
 
-// parsePriceRange парсит бюджет из текстового ответа.
-func (a *Agent) parsePriceRange(text string) int64 {
-	priceRanges := map[string]int64{
-		"до 5 млн":    5000000,
-		"5-10 млн":    7500000,
-		"10-15 млн":   12500000,
-		"15-25 млн":   20000000,
-		"от 25 млн":   30000000,
+// answerNumber переводит ответ в число: JSON-число, число строкой или вариант ответа, распознанный option.
+func answerNumber(field string, value interface{}, option func(string) (float64, bool)) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		text := strings.Join(strings.Fields(v), "")
+		if n, err := strconv.ParseFloat(text, 64); err == nil && !math.IsNaN(n) && !math.IsInf(n, 0) {
+			return n, nil
+		}
+		if n, ok := option(v); ok {
+			return n, nil
+		}
 	}
+	return 0, fmt.Errorf("%w: %s must be a number or one of the suggested options", domain.ErrInvalidLeadRequirement, field)
+}
 
-	for pattern, price := range priceRanges {
-		if containsIgnoreCase(text, pattern) {
-			return price
-		}
+// parsePriceRange парсит бюджет из варианта ответа.
+func (a *Agent) parsePriceRange(text string) (float64, bool) {
+	priceRanges := map[string]float64{
+		"до 5 млн":  5000000,
+		"5-10 млн":  7500000,
+		"10-15 млн": 12500000,
+		"15-25 млн": 20000000,
+		"от 25 млн": 30000000,
 	}
 
-	return 0
+	return matchOption(text, priceRanges)
 }
 
-// parseRooms парсит количество комнат из текстового ответа.
-func (a *Agent) parseRooms(text string) int32 {
-	roomPatterns := map[string]int32{
-		"студия":    0, // или 1, зависит от логики
+// parseRooms парсит количество комнат из варианта ответа; студия — 0 комнат.
+func (a *Agent) parseRooms(text string) (float64, bool) {
+	roomPatterns := map[string]float64{
+		"студия":    0,
 		"1 комнат":  1,
 		"2 комнат":  2,
 		"3 комнат":  3,
@@ -374,70 +394,29 @@ func (a *Agent) parseRooms(text string) int32 {
 		"4 комнат":  4,
 	}
 
-	for pattern, rooms := range roomPatterns {
-		if containsIgnoreCase(text, pattern) {
-			return rooms
-		}
-	}
-
-	return 0
+	return matchOption(text, roomPatterns)
 }
 
-// parseArea парсит площадь из текстового ответа.
-func (a *Agent) parseArea(text string) float64 {
+// parseArea парсит площадь из варианта ответа.
+func (a *Agent) parseArea(text string) (float64, bool) {
 	areaRanges := map[string]float64{
-		"до 40":    35.0,
-		"40-60":    50.0,
-		"60-80":    70.0,
-		"80-100":   90.0,
-		"от 100":   120.0,
-	}
-
-	for pattern, area := range areaRanges {
-		if containsIgnoreCase(text, pattern) {
-			return area
-		}
-	}
-
-	return 0
-}
-
-func containsIgnoreCase(s, substr string) bool {
-	// Простая проверка вхождения без учёта регистра
-	sl := toLower(s)
-	substrL := toLower(substr)
-	return contains(sl, substrL)
-}
-
-func toLower(s string) string {
-	result := make([]byte, len(s))
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 'A' && c <= 'Z' {
-			c += 32
-		}
-		result[i] = c
+		"до 40":  35.0,
+		"40-60":  50.0,
+		"60-80":  70.0,
+		"80-100": 90.0,
+		"от 100": 120.0,
 	}
-	return string(result)
-}
 
-func contains(s, substr string) bool {
-	return len(substr) <= len(s) && findSubstring(s, substr) >= 0
+	return matchOption(text, areaRanges)
 }
 
-func findSubstring(s, substr string) int {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		match := true
-		for j := 0; j < len(substr); j++ {
-			if s[i+j] != substr[j] {
-				match = false
-				break
-			}
-		}
-		if match {
-			return i
+// matchOption — значение первого шаблона, входящего в текст без учёта регистра.
+func matchOption(text string, patterns map[string]float64) (float64, bool) {
+	text = strings.ToLower(text)
+	for pattern, v := range patterns {
+		if strings.Contains(text, pattern) {
+			return v, true
 		}
 	}
-	return -1
+	return 0, false
 }
-
@@ -2,7 +2,7 @@ package clarification
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/llm"
@@ -52,6 +52,10 @@ func (m *MockLLMClient) IsEnabled() bool {
 	return m.IsEnabledValue
 }
 
+func (m *MockLLMClient) Ping(ctx context.Context) error {
+	return nil
+}
+
 func TestAgent_AnalyzeShortLead_NeedsClarification(t *testing.T) {
 	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	llmClient := &MockLLMClient{IsEnabledValue: false}
@@ -100,13 +104,12 @@ func TestAgent_AnalyzeCompleteLead_NoClarification(t *testing.T) {
 		"roomNumber": float64(3),
 		"area":       float64(80),
 	}
-	reqJSON, _ := json.Marshal(requirement)
 
 	lead := domain.Lead{
 		ID:          uuid.New(),
 		Title:       "Ищу 3-комнатную квартиру в центре",
 		Description: "Семья из 4 человек, нужна квартира около 80 кв.м. Бюджет до 15 млн. Важно чтобы был балкон и парковка.",
-		Requirement: reqJSON,
+		Requirement: domain.UpgradeLegacyRequirement(requirement),
 	}
 
 	result, err := agent.AnalyzeAndGenerateQuestions(context.Background(), lead)
@@ -295,3 +298,93 @@ func TestAgent_DeterminePriority(t *testing.T) {
 	}
 }
 
+
+func TestAgent_ApplyClarificationAnswers(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	llmClient := &MockLLMClient{IsEnabledValue: false}
+	agent := NewAgent(log, llmClient, weights.NewAnalyzer(log, llmClient, config.SearchConfig{}))
+
+	tests := []struct {
+		name    string
+		answers map[string]interface{}
+		wantErr bool
+		check   func(t *testing.T, r domain.LeadRequirement)
+	}{
+		{
+			name:    "numeric strings",
+			answers: map[string]interface{}{"price": "8 000 000", "rooms": "2", "area": "54.5"},
+			check: func(t *testing.T, r domain.LeadRequirement) {
+				if r.BudgetMax == nil || *r.BudgetMax != 8000000 || r.BudgetMin != nil {
+					t.Errorf("budget = %v..%v, want ..8000000", r.BudgetMin, r.BudgetMax)
+				}
+				if r.RoomsMin == nil || *r.RoomsMin != 2 || r.RoomsMax == nil || *r.RoomsMax != 2 {
+					t.Errorf("rooms = %v..%v, want 2..2", r.RoomsMin, r.RoomsMax)
+				}
+				if r.AreaMin == nil || *r.AreaMin != 54.5 || r.AreaMax == nil || *r.AreaMax != 54.5 {
+					t.Errorf("area = %v..%v, want 54.5..54.5", r.AreaMin, r.AreaMax)
+				}
+			},
+		},
+		{
+			name:    "suggested options",
+			answers: map[string]interface{}{"price": "5-10 млн ₽", "roomNumber": "Студия", "area": "от 100 м²", "district": "Центральный"},
+			check: func(t *testing.T, r domain.LeadRequirement) {
+				if r.BudgetMax == nil || *r.BudgetMax != 7500000 {
+					t.Errorf("budget_max = %v, want 7500000", r.BudgetMax)
+				}
+				if r.RoomsMin == nil || *r.RoomsMin != 0 {
+					t.Errorf("rooms_min = %v, want 0 for studio", r.RoomsMin)
+				}
+				if r.AreaMin == nil || *r.AreaMin != 120 {
+					t.Errorf("area_min = %v, want 120", r.AreaMin)
+				}
+				if len(r.Districts) != 1 || r.Districts[0] != "Центральный" {
+					t.Errorf("districts = %v", r.Districts)
+				}
+			},
+		},
+		{
+			name:    "bounds",
+			answers: map[string]interface{}{"min_price": "5000000", "max_price": 9000000.0, "min_rooms": "1", "max_rooms": "3"},
+			check: func(t *testing.T, r domain.LeadRequirement) {
+				if r.BudgetMin == nil || *r.BudgetMin != 5000000 || r.BudgetMax == nil || *r.BudgetMax != 9000000 {
+					t.Errorf("budget = %v..%v, want 5000000..9000000", r.BudgetMin, r.BudgetMax)
+				}
+				if r.RoomsMin == nil || *r.RoomsMin != 1 || r.RoomsMax == nil || *r.RoomsMax != 3 {
+					t.Errorf("rooms = %v..%v, want 1..3", r.RoomsMin, r.RoomsMax)
+				}
+			},
+		},
+		{
+			name:    "any district and unknown fields are ignored",
+			answers: map[string]interface{}{"district": "Любой", "view": "панорамный"},
+			check: func(t *testing.T, r domain.LeadRequirement) {
+				if len(r.Districts) != 0 {
+					t.Errorf("districts = %v, want none", r.Districts)
+				}
+			},
+		},
+		{name: "not a number", answers: map[string]interface{}{"price": "дорого"}, wantErr: true},
+		{name: "NaN", answers: map[string]interface{}{"area": "NaN"}, wantErr: true},
+		{name: "negative price", answers: map[string]interface{}{"price": "-5"}, wantErr: true},
+		{name: "inverted rooms", answers: map[string]interface{}{"min_rooms": "4", "max_rooms": "2"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lead := domain.Lead{ID: uuid.New(), Requirement: domain.NewLeadRequirement()}
+
+			r, err := agent.ApplyClarificationAnswers(lead, tt.answers)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidLeadRequirement) {
+					t.Fatalf("expected ErrInvalidLeadRequirement, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, r)
+		})
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"log/slog"
 
 	"github.com/google/uuid"
-	"github.com/samber/lo"
 )
 
 type DealRepository interface {
@@ -18,6 +17,9 @@ type DealRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Deal, error)
 	UpdateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) error
 	ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error)
+	ChangeStatus(ctx context.Context, change domain.DealStatusChange, buyerUserID *uuid.UUID, price *float64) error
+	CompleteDeal(ctx context.Context, change domain.DealStatusChange, buyerUserID uuid.UUID) error
+	GetStatusHistory(ctx context.Context, dealID uuid.UUID) ([]domain.DealStatusChange, error)
 }
 
 type Service struct {
@@ -27,6 +29,16 @@ type Service struct {
 
 var (
 	ErrDealNotFound = errors.New("deal not found")
+	// ErrInvalidStatusTransition — переход статуса не предусмотрен state machine сделки.
+	ErrInvalidStatusTransition = errors.New("invalid deal status transition")
+	// ErrTransitionNotAllowed — переход существует, но недоступен этой стороне сделки.
+	ErrTransitionNotAllowed = errors.New("deal status transition is not allowed for this party")
+	// ErrNotDealParticipant — пользователь не является продавцом или покупателем сделки.
+	ErrNotDealParticipant = errors.New("user is not a deal participant")
+	// ErrPriceChangeNotAllowed — цену может менять только продавец, пока сделка в статусе PENDING.
+	ErrPriceChangeNotAllowed = errors.New("deal price can be changed only by the seller while pending")
+	// ErrLeadAlreadyPurchased — лид уже куплен по другой сделке.
+	ErrLeadAlreadyPurchased = errors.New("lead already purchased")
 )
 
 func New(log *slog.Logger, repo DealRepository) *Service {
@@ -70,18 +82,46 @@ func (s *Service) GetDeal(ctx context.Context, id uuid.UUID) (domain.Deal, error
 	return deal, nil
 }
 
-// UpdateDeal — частичное обновление данных сделки.
-func (s *Service) UpdateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) (domain.Deal, error) {
+// UpdateDeal — частичное обновление данных сделки от имени userID.
+// Изменение статуса проходит через state machine (см. domain.DealTransitionParty).
+func (s *Service) UpdateDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID, update domain.DealFilter) (domain.Deal, error) {
 	const op = "deal.Service.UpdateDeal"
 
-	err := s.repo.UpdateDeal(ctx, dealID, update)
+	deal, err := s.GetDeal(ctx, dealID)
+	if err != nil {
+		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	party, err := dealParty(deal, userID)
 	if err != nil {
-		if errors.Is(err, repository.ErrDealNotFound) {
-			return domain.Deal{}, fmt.Errorf("%s: %w", op, ErrDealNotFound)
-		}
 		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Цену меняет только продавец и только пока сделка не принята
+	if update.Price != nil && (party != domain.DealPartySeller || deal.Status != domain.DealStatusPending) {
+		return domain.Deal{}, fmt.Errorf("%s: %w", op, ErrPriceChangeNotAllowed)
+	}
+
+	// Статус и покупатель меняются только через state machine. Переход проверяется до любой записи:
+	// отклонённый переход не должен оставить новую цену
+	if update.Status != nil && *update.Status != deal.Status {
+		change, err := s.checkTransition(deal, userID, *update.Status)
+		if err != nil {
+			return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+		}
+		// Цена пишется в той же транзакции, что и статус с историей
+		if err := s.applyTransition(ctx, change, update.Price); err != nil {
+			return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+		}
+	} else if update.Price != nil {
+		if err := s.repo.UpdateDeal(ctx, dealID, domain.DealFilter{Price: update.Price}); err != nil {
+			if errors.Is(err, repository.ErrDealNotFound) {
+				return domain.Deal{}, fmt.Errorf("%s: %w", op, ErrDealNotFound)
+			}
+			return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	updated, err := s.repo.GetByID(ctx, dealID)
 	if err != nil {
 		return domain.Deal{}, fmt.Errorf("%s: failed to fetch updated deal: %w", op, err)
@@ -90,6 +130,28 @@ func (s *Service) UpdateDeal(ctx context.Context, dealID uuid.UUID, update domai
 	return updated, nil
 }
 
+// GetDealHistory — возвращает историю статусов сделки. Доступна только участникам сделки.
+func (s *Service) GetDealHistory(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) ([]domain.DealStatusChange, error) {
+	const op = "deal.Service.GetDealHistory"
+
+	deal, err := s.GetDeal(ctx, dealID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if deal.SellerUserID != userID && (deal.BuyerUserID == nil || *deal.BuyerUserID != userID) {
+		return nil, fmt.Errorf("%s: %w", op, ErrNotDealParticipant)
+	}
+
+	history, err := s.repo.GetStatusHistory(ctx, dealID)
+	if err != nil {
+		s.log.Error("failed to get deal history", slog.String("deal_id", dealID.String()), sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return history, nil
+}
+
 // ListDeals — возвращает сделки по фильтру.
 func (s *Service) ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error) {
 	const op = "deal.Service.ListDeals"
@@ -107,25 +169,106 @@ func (s *Service) ListDeals(ctx context.Context, filter domain.DealFilter) ([]do
 func (s *Service) AcceptDeal(ctx context.Context, dealID uuid.UUID, buyerUserID uuid.UUID) (domain.Deal, error) {
 	const op = "deal.Service.AcceptDeal"
 
-	// Получаем текущую сделку
-	deal, err := s.repo.GetByID(ctx, dealID)
+	deal, err := s.GetDeal(ctx, dealID)
 	if err != nil {
-		if errors.Is(err, repository.ErrDealNotFound) {
-			return domain.Deal{}, fmt.Errorf("%s: %w", op, ErrDealNotFound)
-		}
 		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Проверяем, что сделка в статусе PENDING
-	if deal.Status != domain.DealStatusPending {
-		return domain.Deal{}, fmt.Errorf("%s: deal is not in PENDING status", op)
+	if err := s.transition(ctx, deal, buyerUserID, domain.DealStatusAccepted); err != nil {
+		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetDeal(ctx, dealID)
+}
+
+// transition проверяет переход deal.Status → to для пользователя userID и применяет его.
+func (s *Service) transition(ctx context.Context, deal domain.Deal, userID uuid.UUID, to domain.DealStatus) error {
+	change, err := s.checkTransition(deal, userID, to)
+	if err != nil {
+		return err
+	}
+	return s.applyTransition(ctx, change, nil)
+}
+
+// checkTransition проверяет, что переход deal.Status → to предусмотрен state machine
+// и доступен стороне сделки userID, и возвращает запись истории для него.
+func (s *Service) checkTransition(deal domain.Deal, userID uuid.UUID, to domain.DealStatus) (domain.DealStatusChange, error) {
+	party, err := dealParty(deal, userID)
+	if err != nil {
+		return domain.DealStatusChange{}, err
 	}
 
-	// Обновляем сделку: устанавливаем покупателя и статус ACCEPTED
-	update := domain.DealFilter{
-		BuyerUserID: &buyerUserID,
-		Status:      lo.ToPtr(domain.DealStatusAccepted),
+	allowed, ok := domain.DealTransitionParty(deal.Status, to)
+	if !ok {
+		s.log.Warn("rejected invalid deal status transition",
+			slog.String("deal_id", deal.ID.String()),
+			slog.String("from", deal.Status.String()),
+			slog.String("to", to.String()),
+		)
+		return domain.DealStatusChange{}, fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, deal.Status, to)
+	}
+	if party != allowed {
+		return domain.DealStatusChange{}, fmt.Errorf("%w: %s -> %s requires %s", ErrTransitionNotAllowed, deal.Status, to, allowed)
 	}
 
-	return s.UpdateDeal(ctx, dealID, update)
+	return domain.DealStatusChange{
+		DealID:          deal.ID,
+		FromStatus:      deal.Status,
+		ToStatus:        to,
+		ChangedByUserID: userID,
+	}, nil
+}
+
+// applyTransition применяет проверенный переход; price (если задан) сохраняется в той же транзакции.
+func (s *Service) applyTransition(ctx context.Context, change domain.DealStatusChange, price *float64) error {
+	log := s.log.With(
+		slog.String("deal_id", change.DealID.String()),
+		slog.String("from", change.FromStatus.String()),
+		slog.String("to", change.ToStatus.String()),
+	)
+
+	// Покупатель закрепляется за сделкой в момент принятия
+	var buyerUserID *uuid.UUID
+	if change.ToStatus == domain.DealStatusAccepted {
+		buyerUserID = &change.ChangedByUserID
+	}
+
+	// Завершение сделки передаёт лид покупателю в одной транзакции. Цена к этому моменту
+	// не меняется: её можно менять только в PENDING, а завершается ACCEPTED-сделка
+	var err error
+	if change.ToStatus == domain.DealStatusCompleted {
+		err = s.repo.CompleteDeal(ctx, change, change.ChangedByUserID)
+	} else {
+		err = s.repo.ChangeStatus(ctx, change, buyerUserID, price)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrDealStatusConflict):
+			return fmt.Errorf("%w: deal status changed concurrently", ErrInvalidStatusTransition)
+		case errors.Is(err, repository.ErrLeadAlreadyPurchased):
+			log.Warn("rejected completion of deal for already purchased lead")
+			return ErrLeadAlreadyPurchased
+		}
+		log.Error("failed to change deal status", sl.Err(err))
+		return err
+	}
+
+	log.Info("deal status changed", slog.String("user_id", change.ChangedByUserID.String()))
+	return nil
+}
+
+// dealParty определяет, какой стороной сделки выступает userID.
+// Для PENDING-сделки без покупателя любой пользователь, кроме продавца,
+// считается претендентом-покупателем.
+func dealParty(deal domain.Deal, userID uuid.UUID) (domain.DealParty, error) {
+	switch {
+	case deal.SellerUserID == userID:
+		return domain.DealPartySeller, nil
+	case deal.BuyerUserID != nil && *deal.BuyerUserID == userID:
+		return domain.DealPartyBuyer, nil
+	case deal.BuyerUserID == nil && deal.Status == domain.DealStatusPending:
+		return domain.DealPartyBuyer, nil
+	default:
+		return "", ErrNotDealParticipant
+	}
 }
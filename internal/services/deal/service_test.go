@@ -0,0 +1,253 @@
+package deal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// MockDealRepository хранит одну сделку в памяти и историю её переходов.
+type MockDealRepository struct {
+	deal    domain.Deal
+	history []domain.DealStatusChange
+	// leadOwner — владелец лида после CompleteDeal
+	leadOwner     *uuid.UUID
+	leadPurchased bool
+	// statusConflict — статус сделки параллельно изменился, ChangeStatus ничего не пишет
+	statusConflict bool
+}
+
+func (m *MockDealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid.UUID, error) {
+	return uuid.Nil, nil
+}
+func (m *MockDealRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Deal, error) {
+	return m.deal, nil
+}
+func (m *MockDealRepository) UpdateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) error {
+	if update.Price != nil {
+		m.deal.Price = *update.Price
+	}
+	return nil
+}
+func (m *MockDealRepository) ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error) {
+	return nil, nil
+}
+func (m *MockDealRepository) ChangeStatus(ctx context.Context, change domain.DealStatusChange, buyerUserID *uuid.UUID, price *float64) error {
+	if m.statusConflict {
+		return repository.ErrDealStatusConflict
+	}
+	m.deal.Status = change.ToStatus
+	if buyerUserID != nil {
+		m.deal.BuyerUserID = buyerUserID
+	}
+	if price != nil {
+		m.deal.Price = *price
+	}
+	m.history = append(m.history, change)
+	return nil
+}
+func (m *MockDealRepository) CompleteDeal(ctx context.Context, change domain.DealStatusChange, buyerUserID uuid.UUID) error {
+	if m.leadPurchased {
+		return repository.ErrLeadAlreadyPurchased
+	}
+	m.leadPurchased = true
+	m.leadOwner = &buyerUserID
+	return m.ChangeStatus(ctx, change, nil, nil)
+}
+func (m *MockDealRepository) GetStatusHistory(ctx context.Context, dealID uuid.UUID) ([]domain.DealStatusChange, error) {
+	return m.history, nil
+}
+
+func TestService_UpdateDeal_StateMachine(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	stranger := uuid.New()
+
+	tests := []struct {
+		name     string
+		status   domain.DealStatus
+		hasBuyer bool
+		actor    uuid.UUID
+		to       domain.DealStatus
+		wantErr  error
+	}{
+		{"buyer accepts pending", domain.DealStatusPending, false, buyer, domain.DealStatusAccepted, nil},
+		{"seller cannot accept own deal", domain.DealStatusPending, false, seller, domain.DealStatusAccepted, ErrTransitionNotAllowed},
+		{"seller cancels pending", domain.DealStatusPending, false, seller, domain.DealStatusCancelled, nil},
+		{"buyer cannot cancel", domain.DealStatusAccepted, true, buyer, domain.DealStatusCancelled, ErrTransitionNotAllowed},
+		{"pending cannot jump to completed", domain.DealStatusPending, false, buyer, domain.DealStatusCompleted, ErrInvalidStatusTransition},
+		{"buyer completes accepted", domain.DealStatusAccepted, true, buyer, domain.DealStatusCompleted, nil},
+		{"seller cannot complete", domain.DealStatusAccepted, true, seller, domain.DealStatusCompleted, ErrTransitionNotAllowed},
+		{"buyer rejects accepted", domain.DealStatusAccepted, true, buyer, domain.DealStatusRejected, nil},
+		{"seller cancels accepted", domain.DealStatusAccepted, true, seller, domain.DealStatusCancelled, nil},
+		{"stranger cannot touch accepted deal", domain.DealStatusAccepted, true, stranger, domain.DealStatusRejected, ErrNotDealParticipant},
+		{"cancelled is terminal", domain.DealStatusCancelled, false, seller, domain.DealStatusPending, ErrInvalidStatusTransition},
+		{"completed is terminal", domain.DealStatusCompleted, true, buyer, domain.DealStatusAccepted, ErrInvalidStatusTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deal := domain.Deal{ID: uuid.New(), SellerUserID: seller, Status: tt.status}
+			if tt.hasBuyer {
+				deal.BuyerUserID = &buyer
+			}
+			repo := &MockDealRepository{deal: deal}
+			svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+
+			updated, err := svc.UpdateDeal(context.Background(), deal.ID, tt.actor, domain.DealFilter{Status: &tt.to})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				if len(repo.history) != 0 {
+					t.Errorf("expected no history records, got %d", len(repo.history))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updated.Status != tt.to {
+				t.Errorf("expected status %s, got %s", tt.to, updated.Status)
+			}
+			if len(repo.history) != 1 {
+				t.Fatalf("expected 1 history record, got %d", len(repo.history))
+			}
+			if got := repo.history[0]; got.FromStatus != tt.status || got.ChangedByUserID != tt.actor {
+				t.Errorf("unexpected history record: %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_AcceptDeal_AssignsBuyer(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	repo := &MockDealRepository{deal: domain.Deal{ID: uuid.New(), SellerUserID: seller, Status: domain.DealStatusPending}}
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+
+	deal, err := svc.AcceptDeal(context.Background(), repo.deal.ID, buyer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deal.BuyerUserID == nil || *deal.BuyerUserID != buyer {
+		t.Errorf("expected buyer %s to be assigned, got %v", buyer, deal.BuyerUserID)
+	}
+
+	// Повторное принятие другой стороной невозможно: сделка уже не PENDING
+	if _, err := svc.AcceptDeal(context.Background(), repo.deal.ID, uuid.New()); !errors.Is(err, ErrNotDealParticipant) {
+		t.Errorf("expected ErrNotDealParticipant, got %v", err)
+	}
+}
+
+func TestService_UpdateDeal_PriceOnlyBySellerWhilePending(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	price := 100000.0
+
+	repo := &MockDealRepository{deal: domain.Deal{ID: uuid.New(), SellerUserID: seller, BuyerUserID: &buyer, Status: domain.DealStatusAccepted}}
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+
+	if _, err := svc.UpdateDeal(context.Background(), repo.deal.ID, seller, domain.DealFilter{Price: &price}); !errors.Is(err, ErrPriceChangeNotAllowed) {
+		t.Errorf("expected ErrPriceChangeNotAllowed for accepted deal, got %v", err)
+	}
+
+	repo.deal.Status = domain.DealStatusPending
+	repo.deal.BuyerUserID = nil
+	if _, err := svc.UpdateDeal(context.Background(), repo.deal.ID, buyer, domain.DealFilter{Price: &price}); !errors.Is(err, ErrPriceChangeNotAllowed) {
+		t.Errorf("expected ErrPriceChangeNotAllowed for buyer, got %v", err)
+	}
+
+	updated, err := svc.UpdateDeal(context.Background(), repo.deal.ID, seller, domain.DealFilter{Price: &price})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Price != price {
+		t.Errorf("expected price %v, got %v", price, updated.Price)
+	}
+}
+
+func TestService_UpdateDeal_PriceWithStatus(t *testing.T) {
+	seller := uuid.New()
+	price := 100000.0
+	accepted := domain.DealStatusAccepted
+	cancelled := domain.DealStatusCancelled
+
+	tests := []struct {
+		name       string
+		status     domain.DealStatus
+		conflict   bool
+		wantErr    error
+		wantPrice  float64
+		wantStatus domain.DealStatus
+	}{
+		{"seller cannot accept", accepted, false, ErrTransitionNotAllowed, 1, domain.DealStatusPending},
+		{"transition lost race", cancelled, true, ErrInvalidStatusTransition, 1, domain.DealStatusPending},
+		{"price and cancel applied together", cancelled, false, nil, price, domain.DealStatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockDealRepository{
+				deal:           domain.Deal{ID: uuid.New(), SellerUserID: seller, Price: 1, Status: domain.DealStatusPending},
+				statusConflict: tt.conflict,
+			}
+			svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+
+			_, err := svc.UpdateDeal(context.Background(), repo.deal.ID, seller, domain.DealFilter{Price: &price, Status: &tt.status})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateDeal() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.deal.Price != tt.wantPrice {
+				t.Errorf("price = %v, want %v", repo.deal.Price, tt.wantPrice)
+			}
+			if repo.deal.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", repo.deal.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestService_CompleteDeal_TransfersLead(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	completed := domain.DealStatusCompleted
+
+	repo := &MockDealRepository{deal: domain.Deal{ID: uuid.New(), SellerUserID: seller, BuyerUserID: &buyer, Status: domain.DealStatusAccepted}}
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+
+	if _, err := svc.UpdateDeal(context.Background(), repo.deal.ID, buyer, domain.DealFilter{Status: &completed}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.leadOwner == nil || *repo.leadOwner != buyer {
+		t.Errorf("expected lead owner %s, got %v", buyer, repo.leadOwner)
+	}
+}
+
+func TestService_CompleteDeal_LeadAlreadyPurchased(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	completed := domain.DealStatusCompleted
+
+	repo := &MockDealRepository{
+		deal:          domain.Deal{ID: uuid.New(), SellerUserID: seller, BuyerUserID: &buyer, Status: domain.DealStatusAccepted},
+		leadPurchased: true,
+	}
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo)
+
+	_, err := svc.UpdateDeal(context.Background(), repo.deal.ID, buyer, domain.DealFilter{Status: &completed})
+	if !errors.Is(err, ErrLeadAlreadyPurchased) {
+		t.Fatalf("expected ErrLeadAlreadyPurchased, got %v", err)
+	}
+	if repo.deal.Status != domain.DealStatusAccepted {
+		t.Errorf("expected deal to stay ACCEPTED, got %s", repo.deal.Status)
+	}
+}
@@ -0,0 +1,394 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// ErrEmbeddingModelMismatch — ML сервис отдаёт не ту модель, чьи векторы хранятся в базе.
+var ErrEmbeddingModelMismatch = errors.New("embedding model mismatch")
+
+type ModelRepository interface {
+	GetState(ctx context.Context) (domain.EmbeddingModelState, error)
+	AdoptActiveModel(ctx context.Context, model string, dimensions int) (bool, error)
+	StartMigration(ctx context.Context, model string, dimensions int) error
+	ModelStats(ctx context.Context) ([]domain.EmbeddingModelStats, error)
+	ShadowCoverage(ctx context.Context) ([]domain.EmbeddingCoverage, error)
+	Cutover(ctx context.Context, model string) error
+}
+
+// ShadowLeadRepository — чтение лида и запись вектора новой модели в теневую колонку.
+type ShadowLeadRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+	UpdateShadowEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+}
+
+// ShadowPropertyRepository — чтение объекта и запись вектора новой модели в теневую колонку.
+type ShadowPropertyRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Property, error)
+	UpdateShadowEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+}
+
+// ModelManager следит за моделью embedding: на старте сверяет модель ML сервиса с сохранёнными
+// векторами, а при заданном ML_NEXT_BASE_URL ведёт миграцию — векторы новой модели пишутся
+// в теневые колонки (поиск продолжает читать старые), и при полном покрытии выполняется
+// атомарное переключение, после которого active начинает обращаться к новой модели.
+type ModelManager struct {
+	log        *slog.Logger
+	repo       ModelRepository
+	active     *ml.Switch
+	next       ml.Client
+	leads      ShadowLeadRepository
+	properties ShadowPropertyRepository
+	cfg        config.MLConfig
+
+	mu sync.Mutex
+	// target — модель идущей миграции; пусто, если векторы в теневые колонки не пишутся.
+	target string
+}
+
+func NewModelManager(
+	log *slog.Logger,
+	repo ModelRepository,
+	active *ml.Switch,
+	next ml.Client,
+	leads ShadowLeadRepository,
+	properties ShadowPropertyRepository,
+	cfg config.MLConfig,
+) *ModelManager {
+	return &ModelManager{
+		log:        log,
+		repo:       repo,
+		active:     active,
+		next:       next,
+		leads:      leads,
+		properties: properties,
+		cfg:        cfg,
+	}
+}
+
+// Check — проверка модели на старте. Векторам, записанным до версионирования, приписывается
+// текущая модель. Несовпадение модели или размерности с активной в базе — ошибка: такие векторы
+// нельзя сравнивать с сохранёнными. Недоступность ML сервиса ошибкой не считается.
+func (m *ModelManager) Check(ctx context.Context) error {
+	const op = "embedding.ModelManager.Check"
+	log := m.log.With(slog.String("op", op))
+
+	if !m.cfg.Enabled {
+		log.Info("ML service is disabled, embedding model check skipped")
+		return nil
+	}
+
+	info, err := m.active.GetModelInfo(ctx)
+	if err != nil {
+		log.Warn("failed to get embedding model info, check skipped", sl.Err(err))
+		return nil
+	}
+
+	state, err := m.repo.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if state.ActiveModel == nil {
+		if err := m.adopt(ctx, log, info); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if state, err = m.repo.GetState(ctx); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	activeModel := lo.FromPtr(state.ActiveModel)
+
+	if activeModel != info.Model || state.ActiveDimensions != info.Dimensions {
+		// Переключение уже выполнено, а ML_BASE_URL ещё указывает на старую модель
+		if nextInfo := m.nextModelInfo(ctx); nextInfo != nil &&
+			nextInfo.Model == activeModel && nextInfo.Dimensions == state.ActiveDimensions {
+			m.promote()
+			log.Warn("embedding model was already cut over, using ML_NEXT_BASE_URL; point ML_BASE_URL to it",
+				slog.String("model", activeModel))
+			return nil
+		}
+		return fmt.Errorf("%s: %w: ML service serves %s (%d), stored vectors are %s (%d)",
+			op, ErrEmbeddingModelMismatch, info.Model, info.Dimensions, activeModel, state.ActiveDimensions)
+	}
+
+	m.reportStats(ctx, log, activeModel, state.ActiveDimensions)
+
+	if m.next != nil {
+		return m.startMigration(ctx, log, state, activeModel)
+	}
+	if state.MigrationInProgress() {
+		log.Warn("embedding model migration is in progress, but ML_NEXT_BASE_URL is not set on this instance",
+			slog.String("next_model", lo.FromPtr(state.NextModel)))
+	}
+	return nil
+}
+
+// adopt записывает модель ML сервиса как активную, если размерность сохранённых векторов
+// с ней совпадает; иначе векторы получены другой моделью и приписать их нельзя.
+func (m *ModelManager) adopt(ctx context.Context, log *slog.Logger, info *ml.ModelInfo) error {
+	stats, err := m.repo.ModelStats(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range stats {
+		if s.Dimensions != info.Dimensions {
+			return fmt.Errorf("%w: ML service serves %s (%d), %d stored %s vectors have %d dimensions",
+				ErrEmbeddingModelMismatch, info.Model, info.Dimensions, s.Count, s.EntityType, s.Dimensions)
+		}
+	}
+
+	adopted, err := m.repo.AdoptActiveModel(ctx, info.Model, info.Dimensions)
+	if err != nil {
+		return err
+	}
+	if adopted {
+		log.Info("embedding model recorded as active", slog.String("model", info.Model), slog.Int("dimensions", info.Dimensions))
+	}
+	return nil
+}
+
+// reportStats предупреждает о векторах чужой модели или размерности — их нужно переиндексировать.
+func (m *ModelManager) reportStats(ctx context.Context, log *slog.Logger, model string, dimensions int) {
+	stats, err := m.repo.ModelStats(ctx)
+	if err != nil {
+		log.Warn("failed to get embedding model stats", sl.Err(err))
+		return
+	}
+
+	for _, s := range stats {
+		if lo.FromPtr(s.Model) == model && s.Dimensions == dimensions {
+			continue
+		}
+		log.Warn("stored embeddings from another model, run cmd/reindex",
+			slog.String("entity_type", s.EntityType.String()),
+			slog.String("stored_model", lo.FromPtr(s.Model)),
+			slog.Int("stored_dimensions", s.Dimensions),
+			slog.Int("count", s.Count),
+		)
+	}
+}
+
+func (m *ModelManager) startMigration(ctx context.Context, log *slog.Logger, state domain.EmbeddingModelState, activeModel string) error {
+	const op = "embedding.ModelManager.startMigration"
+
+	info := m.nextModelInfo(ctx)
+	if info == nil {
+		log.Warn("failed to get next embedding model info, shadow writes disabled")
+		return nil
+	}
+	if info.Model == activeModel {
+		log.Warn("ML_NEXT_BASE_URL serves the active embedding model, nothing to migrate", slog.String("model", info.Model))
+		return nil
+	}
+
+	if lo.FromPtr(state.NextModel) != info.Model || lo.FromPtr(state.NextDimensions) != info.Dimensions {
+		if err := m.repo.StartMigration(ctx, info.Model, info.Dimensions); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		log.Info("embedding model migration started",
+			slog.String("from", activeModel),
+			slog.String("to", info.Model),
+			slog.Int("dimensions", info.Dimensions),
+		)
+	}
+
+	m.mu.Lock()
+	m.target = info.Model
+	m.mu.Unlock()
+
+	m.logCoverage(ctx, log)
+	return nil
+}
+
+func (m *ModelManager) nextModelInfo(ctx context.Context) *ml.ModelInfo {
+	if m.next == nil {
+		return nil
+	}
+	info, err := m.next.GetModelInfo(ctx)
+	if err != nil {
+		m.log.Warn("failed to get next embedding model info", sl.Err(err))
+		return nil
+	}
+	return info
+}
+
+// promote переводит active на клиент новой модели и прекращает запись в теневые колонки.
+func (m *ModelManager) promote() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.active.Set(m.next)
+	m.target = ""
+}
+
+// Target возвращает модель идущей миграции или пустую строку.
+func (m *ModelManager) Target() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.target
+}
+
+// ShadowClient — клиент новой модели, пока идёт миграция, иначе nil.
+func (m *ModelManager) ShadowClient() ml.Client {
+	if m.Target() == "" {
+		return nil
+	}
+	return m.next
+}
+
+// EmbedShadow записывает вектор новой модели в теневую колонку, если идёт миграция.
+// Удалённая сущность ошибкой не считается.
+func (m *ModelManager) EmbedShadow(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) error {
+	const op = "embedding.ModelManager.EmbedShadow"
+
+	client := m.ShadowClient()
+	if client == nil {
+		return nil
+	}
+
+	var req ml.ReindexRequest
+	var update func(ctx context.Context, id uuid.UUID, embedding []float32) error
+	switch entityType {
+	case domain.EmbeddingEntityLead:
+		l, err := m.leads.GetByID(ctx, entityID)
+		if err != nil {
+			return shadowError(op, err)
+		}
+		req, update = lead.ReindexRequest(l), m.leads.UpdateShadowEmbedding
+	case domain.EmbeddingEntityProperty:
+		p, err := m.properties.GetByID(ctx, entityID)
+		if err != nil {
+			return shadowError(op, err)
+		}
+		req, update = property.ReindexRequest(p), m.properties.UpdateShadowEmbedding
+	default:
+		return fmt.Errorf("%s: %w: %q", op, errUnknownEntityType, entityType)
+	}
+
+	resp, err := client.Reindex(ctx, req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	embedding := make([]float32, len(resp.Embedding))
+	for i, v := range resp.Embedding {
+		embedding[i] = float32(v)
+	}
+
+	if err := update(ctx, entityID, embedding); err != nil {
+		return shadowError(op, err)
+	}
+	return nil
+}
+
+func shadowError(op string, err error) error {
+	if errors.Is(err, repository.ErrLeadNotFound) || errors.Is(err, repository.ErrPropertyNotFound) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+// Sync при идущей миграции переключает поиск на новую модель, как только покрытие теневых
+// колонок достигло 100%, либо подхватывает переключение, выполненное другим экземпляром.
+// Возвращает true, если этот экземпляр перешёл на новую модель.
+func (m *ModelManager) Sync(ctx context.Context) (bool, error) {
+	const op = "embedding.ModelManager.Sync"
+	log := m.log.With(slog.String("op", op))
+
+	target := m.Target()
+	if target == "" {
+		return false, nil
+	}
+
+	if switched, err := m.switchedElsewhere(ctx, target); err != nil || switched {
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+		log.Info("embedding model was cut over by another instance", slog.String("model", target))
+		return true, nil
+	}
+
+	coverage, err := m.repo.ShadowCoverage(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, c := range coverage {
+		if !c.Complete() {
+			return false, nil
+		}
+	}
+
+	err = m.repo.Cutover(ctx, target)
+	switch {
+	case errors.Is(err, repository.ErrEmbeddingCoverageIncomplete):
+		return false, nil
+	case errors.Is(err, repository.ErrNoEmbeddingMigration):
+		switched, err := m.switchedElsewhere(ctx, target)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+		return switched, nil
+	case err != nil:
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.promote()
+	log.Info("embedding model cut over, search uses the new model; point ML_BASE_URL to it and unset ML_NEXT_BASE_URL",
+		slog.String("model", target))
+	return true, nil
+}
+
+// switchedElsewhere переводит экземпляр на новую модель, если она уже стала активной в базе.
+func (m *ModelManager) switchedElsewhere(ctx context.Context, target string) (bool, error) {
+	state, err := m.repo.GetState(ctx)
+	if err != nil {
+		return false, err
+	}
+	if lo.FromPtr(state.ActiveModel) != target {
+		return false, nil
+	}
+	m.promote()
+	return true, nil
+}
+
+// Coverage — заполненность теневых колонок; пусто, если миграция не идёт.
+func (m *ModelManager) Coverage(ctx context.Context) ([]domain.EmbeddingCoverage, error) {
+	const op = "embedding.ModelManager.Coverage"
+
+	if m.Target() == "" {
+		return nil, nil
+	}
+	coverage, err := m.repo.ShadowCoverage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return coverage, nil
+}
+
+func (m *ModelManager) logCoverage(ctx context.Context, log *slog.Logger) {
+	coverage, err := m.Coverage(ctx)
+	if err != nil {
+		log.Warn("failed to get embedding migration coverage", sl.Err(err))
+		return
+	}
+	for _, c := range coverage {
+		log.Info("embedding migration coverage",
+			slog.String("entity_type", c.EntityType.String()),
+			slog.String("coverage", fmt.Sprintf("%d/%d", c.Embedded, c.Total)),
+		)
+	}
+}
@@ -0,0 +1,283 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MockModelRepository хранит состояние моделей в памяти.
+type MockModelRepository struct {
+	state      domain.EmbeddingModelState
+	stats      []domain.EmbeddingModelStats
+	coverage   []domain.EmbeddingCoverage
+	cutoverErr error
+	started    string
+	cutovers   int
+}
+
+func (m *MockModelRepository) GetState(ctx context.Context) (domain.EmbeddingModelState, error) {
+	return m.state, nil
+}
+func (m *MockModelRepository) AdoptActiveModel(ctx context.Context, model string, dimensions int) (bool, error) {
+	if m.state.ActiveModel != nil {
+		return false, nil
+	}
+	m.state.ActiveModel, m.state.ActiveDimensions = &model, dimensions
+	return true, nil
+}
+func (m *MockModelRepository) StartMigration(ctx context.Context, model string, dimensions int) error {
+	m.started = model
+	m.state.NextModel, m.state.NextDimensions = &model, &dimensions
+	return nil
+}
+func (m *MockModelRepository) ModelStats(ctx context.Context) ([]domain.EmbeddingModelStats, error) {
+	return m.stats, nil
+}
+func (m *MockModelRepository) ShadowCoverage(ctx context.Context) ([]domain.EmbeddingCoverage, error) {
+	return m.coverage, nil
+}
+func (m *MockModelRepository) Cutover(ctx context.Context, model string) error {
+	if errors.Is(m.cutoverErr, repository.ErrNoEmbeddingMigration) {
+		// Другой экземпляр переключился между проверкой покрытия и Cutover
+		m.state.ActiveModel, m.state.NextModel = m.state.NextModel, nil
+	}
+	if m.cutoverErr != nil {
+		return m.cutoverErr
+	}
+	m.cutovers++
+	m.state.ActiveModel, m.state.ActiveDimensions = m.state.NextModel, *m.state.NextDimensions
+	m.state.NextModel, m.state.NextDimensions = nil, nil
+	return nil
+}
+
+// MockModelClient — ML сервис модели model; Reindex возвращает вектор размерности dimensions.
+type MockModelClient struct {
+	model      string
+	dimensions int
+}
+
+func (m *MockModelClient) PrepareAndEmbed(ctx context.Context, req ml.PrepareAndEmbedRequest) (*ml.PrepareAndEmbedResponse, error) {
+	return nil, nil
+}
+func (m *MockModelClient) Reindex(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error) {
+	return &ml.ReindexResponse{EntityID: req.EntityID, Embedding: make([]float64, m.dimensions)}, nil
+}
+func (m *MockModelClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+	return nil, nil
+}
+func (m *MockModelClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
+	return &ml.ModelInfo{Model: m.model, Dimensions: m.dimensions}, nil
+}
+func (m *MockModelClient) Ping(ctx context.Context) error {
+	return nil
+}
+
+// MockShadowRepository отдаёт сущность с любым ID и запоминает теневые векторы.
+type MockShadowRepository struct {
+	shadow map[uuid.UUID]int
+}
+
+func (m *MockShadowRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+	return domain.Lead{ID: id}, nil
+}
+func (m *MockShadowRepository) UpdateShadowEmbedding(ctx context.Context, id uuid.UUID, embedding []float32) error {
+	m.shadow[id] = len(embedding)
+	return nil
+}
+
+type MockShadowPropertyRepository struct{}
+
+func (m MockShadowPropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+	return domain.Property{}, repository.ErrPropertyNotFound
+}
+func (m MockShadowPropertyRepository) UpdateShadowEmbedding(ctx context.Context, id uuid.UUID, embedding []float32) error {
+	return nil
+}
+
+var (
+	modelV1 = &MockModelClient{model: "minilm", dimensions: 384}
+	modelV2 = &MockModelClient{model: "e5-large", dimensions: 1024}
+)
+
+func newTestModelManager(repo *MockModelRepository, active, next ml.Client, leads *MockShadowRepository) (*ModelManager, *ml.Switch) {
+	sw := ml.NewSwitch(active)
+	if leads == nil {
+		leads = &MockShadowRepository{shadow: map[uuid.UUID]int{}}
+	}
+	m := NewModelManager(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, sw, next,
+		leads, MockShadowPropertyRepository{}, config.MLConfig{Enabled: true})
+	return m, sw
+}
+
+func TestModelManager_Check(t *testing.T) {
+	t.Run("adopts model for legacy vectors", func(t *testing.T) {
+		repo := &MockModelRepository{stats: []domain.EmbeddingModelStats{{EntityType: domain.EmbeddingEntityLead, Dimensions: 384, Count: 10}}}
+		m, _ := newTestModelManager(repo, modelV1, nil, nil)
+
+		if err := m.Check(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if lo.FromPtr(repo.state.ActiveModel) != "minilm" || repo.state.ActiveDimensions != 384 {
+			t.Errorf("state = %+v", repo.state)
+		}
+	})
+
+	t.Run("refuses to adopt vectors of other dimensions", func(t *testing.T) {
+		repo := &MockModelRepository{stats: []domain.EmbeddingModelStats{{EntityType: domain.EmbeddingEntityLead, Dimensions: 768, Count: 10}}}
+		m, _ := newTestModelManager(repo, modelV1, nil, nil)
+
+		if err := m.Check(context.Background()); !errors.Is(err, ErrEmbeddingModelMismatch) {
+			t.Fatalf("err = %v, want ErrEmbeddingModelMismatch", err)
+		}
+		if repo.state.ActiveModel != nil {
+			t.Error("model must not be adopted")
+		}
+	})
+
+	t.Run("active model mismatch", func(t *testing.T) {
+		repo := &MockModelRepository{state: domain.EmbeddingModelState{ActiveModel: lo.ToPtr("e5-large"), ActiveDimensions: 1024}}
+		m, _ := newTestModelManager(repo, modelV1, nil, nil)
+
+		if err := m.Check(context.Background()); !errors.Is(err, ErrEmbeddingModelMismatch) {
+			t.Fatalf("err = %v, want ErrEmbeddingModelMismatch", err)
+		}
+	})
+
+	t.Run("starts migration to next model", func(t *testing.T) {
+		repo := &MockModelRepository{state: domain.EmbeddingModelState{ActiveModel: lo.ToPtr("minilm"), ActiveDimensions: 384}}
+		m, _ := newTestModelManager(repo, modelV1, modelV2, nil)
+
+		if err := m.Check(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if repo.started != "e5-large" || m.Target() != "e5-large" || m.ShadowClient() != modelV2 {
+			t.Errorf("started = %q, target = %q", repo.started, m.Target())
+		}
+	})
+
+	t.Run("continues migration without restarting it", func(t *testing.T) {
+		repo := &MockModelRepository{state: domain.EmbeddingModelState{
+			ActiveModel: lo.ToPtr("minilm"), ActiveDimensions: 384,
+			NextModel: lo.ToPtr("e5-large"), NextDimensions: lo.ToPtr(1024),
+		}}
+		m, _ := newTestModelManager(repo, modelV1, modelV2, nil)
+
+		if err := m.Check(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if repo.started != "" || m.Target() != "e5-large" {
+			t.Errorf("started = %q, target = %q", repo.started, m.Target())
+		}
+	})
+
+	t.Run("picks up completed cutover", func(t *testing.T) {
+		repo := &MockModelRepository{state: domain.EmbeddingModelState{ActiveModel: lo.ToPtr("e5-large"), ActiveDimensions: 1024}}
+		m, sw := newTestModelManager(repo, modelV1, modelV2, nil)
+
+		if err := m.Check(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sw.Current() != modelV2 || m.Target() != "" {
+			t.Errorf("active client was not switched to the next model")
+		}
+	})
+}
+
+func TestModelManager_Sync(t *testing.T) {
+	migrating := func() *MockModelRepository {
+		return &MockModelRepository{state: domain.EmbeddingModelState{
+			ActiveModel: lo.ToPtr("minilm"), ActiveDimensions: 384,
+			NextModel: lo.ToPtr("e5-large"), NextDimensions: lo.ToPtr(1024),
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		coverage     []domain.EmbeddingCoverage
+		cutoverErr   error
+		wantSwitched bool
+		wantCutovers int
+	}{
+		{
+			name:     "incomplete coverage",
+			coverage: []domain.EmbeddingCoverage{{EntityType: domain.EmbeddingEntityLead, Total: 10, Embedded: 9}},
+		},
+		{
+			name:         "complete coverage",
+			coverage:     []domain.EmbeddingCoverage{{EntityType: domain.EmbeddingEntityLead, Total: 10, Embedded: 10}},
+			wantSwitched: true,
+			wantCutovers: 1,
+		},
+		{
+			name:         "cut over by another instance",
+			coverage:     []domain.EmbeddingCoverage{{EntityType: domain.EmbeddingEntityLead, Total: 10, Embedded: 10}},
+			cutoverErr:   repository.ErrNoEmbeddingMigration,
+			wantSwitched: true,
+		},
+		{
+			name:       "coverage dropped before cutover",
+			coverage:   []domain.EmbeddingCoverage{{EntityType: domain.EmbeddingEntityLead, Total: 10, Embedded: 10}},
+			cutoverErr: repository.ErrEmbeddingCoverageIncomplete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := migrating()
+			m, sw := newTestModelManager(repo, modelV1, modelV2, nil)
+			if err := m.Check(context.Background()); err != nil {
+				t.Fatalf("check: %v", err)
+			}
+
+			repo.coverage, repo.cutoverErr = tt.coverage, tt.cutoverErr
+
+			switched, err := m.Sync(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if switched != tt.wantSwitched || repo.cutovers != tt.wantCutovers {
+				t.Errorf("switched = %v, cutovers = %d", switched, repo.cutovers)
+			}
+			if got := sw.Current() == modelV2; got != tt.wantSwitched {
+				t.Errorf("active client switched = %v, want %v", got, tt.wantSwitched)
+			}
+		})
+	}
+}
+
+func TestModelManager_EmbedShadow(t *testing.T) {
+	repo := &MockModelRepository{state: domain.EmbeddingModelState{ActiveModel: lo.ToPtr("minilm"), ActiveDimensions: 384}}
+	leads := &MockShadowRepository{shadow: map[uuid.UUID]int{}}
+	m, _ := newTestModelManager(repo, modelV1, modelV2, leads)
+	leadID := uuid.New()
+
+	// До старта миграции теневые векторы не пишутся
+	if err := m.EmbedShadow(context.Background(), domain.EmbeddingEntityLead, leadID); err != nil || len(leads.shadow) != 0 {
+		t.Fatalf("err = %v, shadow = %v", err, leads.shadow)
+	}
+
+	if err := m.Check(context.Background()); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if err := m.EmbedShadow(context.Background(), domain.EmbeddingEntityLead, leadID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if leads.shadow[leadID] != 1024 {
+		t.Errorf("shadow embedding dimensions = %d, want 1024", leads.shadow[leadID])
+	}
+
+	// Удалённый объект — не ошибка
+	if err := m.EmbedShadow(context.Background(), domain.EmbeddingEntityProperty, uuid.New()); err != nil {
+		t.Errorf("unexpected error for deleted property: %v", err)
+	}
+}
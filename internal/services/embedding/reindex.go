@@ -0,0 +1,335 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultReindexBatchSize — размер пачки ReindexBatch по умолчанию.
+	DefaultReindexBatchSize = 50
+	// DefaultReindexConcurrency — число одновременных запросов ReindexBatch по умолчанию.
+	DefaultReindexConcurrency = 2
+	// MaxReindexBatchSize — верхняя граница размера пачки.
+	MaxReindexBatchSize = 500
+)
+
+// ErrInvalidReindexOptions — некорректные параметры массовой переиндексации.
+var ErrInvalidReindexOptions = errors.New("invalid reindex options")
+
+// LeadReindexRepository — выборка лидов для массовой переиндексации.
+type LeadReindexRepository interface {
+	ListForReindex(ctx context.Context, filter domain.ReindexFilter) ([]domain.Lead, error)
+	CountForReindex(ctx context.Context, filter domain.ReindexFilter) (int, error)
+	UpdateEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+	UpdateShadowEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+}
+
+// PropertyReindexRepository — выборка объектов для массовой переиндексации.
+type PropertyReindexRepository interface {
+	ListForReindex(ctx context.Context, filter domain.ReindexFilter) ([]domain.Property, error)
+	CountForReindex(ctx context.Context, filter domain.ReindexFilter) (int, error)
+	UpdateEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+	UpdateShadowEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+}
+
+// ShadowTarget отдаёт клиент новой модели, пока идёт миграция embedding (см. ModelManager), иначе nil.
+type ShadowTarget interface {
+	ShadowClient() ml.Client
+}
+
+// Reindexer пересчитывает embedding всех лидов и объектов пачками через ML ReindexBatch.
+// Нужен при смене модели embedding; используется командой cmd/reindex и RPC ReindexAll.
+type Reindexer struct {
+	log        *slog.Logger
+	mlClient   ml.Client
+	shadow     ShadowTarget
+	leads      LeadReindexRepository
+	properties PropertyReindexRepository
+}
+
+// NewReindexer создаёт Reindexer; shadow может быть nil, тогда переиндексация в теневые колонки недоступна.
+func NewReindexer(
+	log *slog.Logger,
+	mlClient ml.Client,
+	shadow ShadowTarget,
+	leads LeadReindexRepository,
+	properties PropertyReindexRepository,
+) *Reindexer {
+	return &Reindexer{
+		log:        log,
+		mlClient:   mlClient,
+		shadow:     shadow,
+		leads:      leads,
+		properties: properties,
+	}
+}
+
+// reindexSource — выборка и запись embedding одного типа сущностей.
+type reindexSource struct {
+	entityType domain.EmbeddingEntityType
+	client     ml.Client
+	count      func(ctx context.Context, filter domain.ReindexFilter) (int, error)
+	// page возвращает запросы к ML сервису и ID последней сущности страницы.
+	page   func(ctx context.Context, filter domain.ReindexFilter) ([]ml.ReindexRequest, uuid.UUID, error)
+	update func(ctx context.Context, id uuid.UUID, embedding []float32) error
+}
+
+// ReindexAll переиндексирует сущности по opts. Страницы читаются по возрастанию ID,
+// пачки отправляются в ML сервис параллельно, не больше opts.Concurrency одновременно.
+// progress (если задан) вызывается последовательно после каждой пачки и по завершении типа сущностей.
+// Ошибки отдельных пачек не прерывают переиндексацию и учитываются в Failed.
+func (r *Reindexer) ReindexAll(ctx context.Context, opts domain.ReindexOptions, progress func(domain.ReindexProgress)) ([]domain.ReindexProgress, error) {
+	const op = "embedding.Reindexer.ReindexAll"
+
+	opts, err := normalizeReindexOptions(opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if progress == nil {
+		progress = func(domain.ReindexProgress) {}
+	}
+
+	// В режиме Shadow векторы считает ML сервис новой модели и пишутся они в теневые колонки
+	client := r.mlClient
+	if opts.Shadow {
+		if r.shadow != nil {
+			client = r.shadow.ShadowClient()
+		}
+		if r.shadow == nil || client == nil {
+			return nil, fmt.Errorf("%s: %w: no embedding model migration in progress", op, ErrInvalidReindexOptions)
+		}
+	}
+
+	var sources []reindexSource
+	if opts.EntityType == domain.EmbeddingEntityUnspecified || opts.EntityType == domain.EmbeddingEntityLead {
+		sources = append(sources, r.leadSource(client, opts.Shadow))
+	}
+	if opts.EntityType == domain.EmbeddingEntityUnspecified || opts.EntityType == domain.EmbeddingEntityProperty {
+		sources = append(sources, r.propertySource(client, opts.Shadow))
+	}
+
+	results := make([]domain.ReindexProgress, 0, len(sources))
+	for _, src := range sources {
+		result, err := r.reindex(ctx, src, opts, progress)
+		results = append(results, result)
+		if err != nil {
+			return results, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return results, nil
+}
+
+func normalizeReindexOptions(opts domain.ReindexOptions) (domain.ReindexOptions, error) {
+	switch opts.EntityType {
+	case domain.EmbeddingEntityUnspecified, domain.EmbeddingEntityLead, domain.EmbeddingEntityProperty:
+	default:
+		return opts, fmt.Errorf("%w: unknown entity type %q", ErrInvalidReindexOptions, opts.EntityType)
+	}
+
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultReindexBatchSize
+	}
+	if opts.BatchSize > MaxReindexBatchSize {
+		return opts, fmt.Errorf("%w: batch size must not exceed %d", ErrInvalidReindexOptions, MaxReindexBatchSize)
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultReindexConcurrency
+	}
+
+	return opts, nil
+}
+
+func (r *Reindexer) reindex(ctx context.Context, src reindexSource, opts domain.ReindexOptions, progress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
+	log := r.log.With(slog.String("entity_type", src.entityType.String()))
+
+	filter := domain.ReindexFilter{
+		OnlyMissing: opts.OnlyMissing,
+		Shadow:      opts.Shadow,
+		Since:       opts.Since,
+		Limit:       opts.BatchSize,
+	}
+
+	total, err := src.count(ctx, filter)
+	if err != nil {
+		return domain.ReindexProgress{EntityType: src.entityType}, err
+	}
+
+	state := domain.ReindexProgress{EntityType: src.entityType, Total: total}
+	log.Info("reindex started", slog.Int("total", total))
+	progress(state)
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		pageErr error
+	)
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for {
+		batch, lastID, err := src.page(ctx, filter)
+		if err != nil {
+			pageErr = err
+			break
+		}
+		if len(batch) == 0 {
+			break
+		}
+		filter.AfterID = &lastID
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			updated, failed := r.reindexBatch(ctx, log, src, batch)
+
+			mu.Lock()
+			defer mu.Unlock()
+			state.Processed += len(batch)
+			state.Updated += updated
+			state.Failed += failed
+			progress(state)
+		}()
+
+		if len(batch) < opts.BatchSize {
+			break
+		}
+	}
+
+	wg.Wait()
+
+	if pageErr == nil {
+		pageErr = ctx.Err()
+	}
+	if pageErr != nil {
+		log.Error("reindex interrupted", slog.Int("processed", state.Processed), sl.Err(pageErr))
+		return state, pageErr
+	}
+
+	state.Done = true
+	progress(state)
+	log.Info("reindex finished",
+		slog.Int("processed", state.Processed),
+		slog.Int("updated", state.Updated),
+		slog.Int("failed", state.Failed),
+	)
+	return state, nil
+}
+
+// reindexBatch отправляет пачку в ML сервис и записывает полученные embedding.
+// Сущность, удалённая за время переиндексации, не считается ни обновлённой, ни ошибочной.
+func (r *Reindexer) reindexBatch(ctx context.Context, log *slog.Logger, src reindexSource, batch []ml.ReindexRequest) (updated, failed int) {
+	resp, err := src.client.ReindexBatch(ctx, ml.ReindexBatchRequest{Entities: batch})
+	if err != nil {
+		log.Error("reindex batch failed", slog.Int("size", len(batch)), sl.Err(err))
+		return 0, len(batch)
+	}
+
+	results := make(map[string]ml.ReindexResponse, len(resp.Results))
+	for _, res := range resp.Results {
+		results[res.EntityID] = res
+	}
+
+	for _, req := range batch {
+		res, ok := results[req.EntityID]
+		if !ok || len(res.Embedding) == 0 {
+			log.Warn("no embedding returned for entity", slog.String("entity_id", req.EntityID), slog.String("message", res.Message))
+			failed++
+			continue
+		}
+
+		id, err := uuid.Parse(req.EntityID)
+		if err != nil {
+			failed++
+			continue
+		}
+
+		embedding := make([]float32, len(res.Embedding))
+		for i, v := range res.Embedding {
+			embedding[i] = float32(v)
+		}
+
+		if err := src.update(ctx, id, embedding); err != nil {
+			if errors.Is(err, repository.ErrLeadNotFound) || errors.Is(err, repository.ErrPropertyNotFound) {
+				continue
+			}
+			log.Error("failed to update embedding", slog.String("entity_id", req.EntityID), sl.Err(err))
+			failed++
+			continue
+		}
+		updated++
+	}
+
+	return updated, failed
+}
+
+func (r *Reindexer) leadSource(client ml.Client, shadow bool) reindexSource {
+	update := r.leads.UpdateEmbedding
+	if shadow {
+		update = r.leads.UpdateShadowEmbedding
+	}
+
+	return reindexSource{
+		entityType: domain.EmbeddingEntityLead,
+		client:     client,
+		count:      r.leads.CountForReindex,
+		page: func(ctx context.Context, filter domain.ReindexFilter) ([]ml.ReindexRequest, uuid.UUID, error) {
+			leads, err := r.leads.ListForReindex(ctx, filter)
+			if err != nil || len(leads) == 0 {
+				return nil, uuid.Nil, err
+			}
+			reqs := make([]ml.ReindexRequest, len(leads))
+			for i, l := range leads {
+				reqs[i] = lead.ReindexRequest(l)
+			}
+			return reqs, leads[len(leads)-1].ID, nil
+		},
+		update: update,
+	}
+}
+
+func (r *Reindexer) propertySource(client ml.Client, shadow bool) reindexSource {
+	update := r.properties.UpdateEmbedding
+	if shadow {
+		update = r.properties.UpdateShadowEmbedding
+	}
+
+	return reindexSource{
+		entityType: domain.EmbeddingEntityProperty,
+		client:     client,
+		count:      r.properties.CountForReindex,
+		page: func(ctx context.Context, filter domain.ReindexFilter) ([]ml.ReindexRequest, uuid.UUID, error) {
+			properties, err := r.properties.ListForReindex(ctx, filter)
+			if err != nil || len(properties) == 0 {
+				return nil, uuid.Nil, err
+			}
+			reqs := make([]ml.ReindexRequest, len(properties))
+			for i, p := range properties {
+				reqs[i] = property.ReindexRequest(p)
+			}
+			return reqs, properties[len(properties)-1].ID, nil
+		},
+		update: update,
+	}
+}
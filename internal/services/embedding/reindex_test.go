@@ -0,0 +1,270 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// sortedIDs — n случайных ID в порядке keyset-пагинации.
+func sortedIDs(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	return ids
+}
+
+// pageAfter — страница ids после filter.AfterID, как в ListForReindex.
+func pageAfter(ids []uuid.UUID, filter domain.ReindexFilter) []uuid.UUID {
+	start := 0
+	if filter.AfterID != nil {
+		start, _ = slices.BinarySearchFunc(ids, *filter.AfterID, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+		start++
+	}
+	start = min(start, len(ids))
+	return ids[start:min(start+filter.Limit, len(ids))]
+}
+
+// MockReindexStore хранит ID сущностей и записанные embedding.
+type MockReindexStore struct {
+	mu        sync.Mutex
+	ids       []uuid.UUID
+	updated   map[uuid.UUID][]float32
+	shadow    map[uuid.UUID][]float32
+	deleted   map[uuid.UUID]bool
+	pageCalls int
+}
+
+func newMockReindexStore(n int) *MockReindexStore {
+	return &MockReindexStore{
+		ids:     sortedIDs(n),
+		updated: map[uuid.UUID][]float32{},
+		shadow:  map[uuid.UUID][]float32{},
+		deleted: map[uuid.UUID]bool{},
+	}
+}
+
+func (m *MockReindexStore) CountForReindex(ctx context.Context, filter domain.ReindexFilter) (int, error) {
+	return len(m.ids), nil
+}
+
+func (m *MockReindexStore) update(target map[uuid.UUID][]float32, id uuid.UUID, embedding []float32, notFound error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleted[id] {
+		return fmt.Errorf("mock: %w", notFound)
+	}
+	target[id] = embedding
+	return nil
+}
+
+type MockLeadReindexRepository struct{ *MockReindexStore }
+
+func (m MockLeadReindexRepository) ListForReindex(ctx context.Context, filter domain.ReindexFilter) ([]domain.Lead, error) {
+	m.pageCalls++
+	var leads []domain.Lead
+	for _, id := range pageAfter(m.ids, filter) {
+		leads = append(leads, domain.Lead{ID: id, Title: "lead"})
+	}
+	return leads, nil
+}
+func (m MockLeadReindexRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error {
+	return m.update(m.updated, leadID, embedding, repository.ErrLeadNotFound)
+}
+func (m MockLeadReindexRepository) UpdateShadowEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error {
+	return m.update(m.shadow, leadID, embedding, repository.ErrLeadNotFound)
+}
+
+type MockPropertyReindexRepository struct{ *MockReindexStore }
+
+func (m MockPropertyReindexRepository) ListForReindex(ctx context.Context, filter domain.ReindexFilter) ([]domain.Property, error) {
+	m.pageCalls++
+	var properties []domain.Property
+	for _, id := range pageAfter(m.ids, filter) {
+		properties = append(properties, domain.Property{ID: id, Title: "property"})
+	}
+	return properties, nil
+}
+func (m MockPropertyReindexRepository) UpdateEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error {
+	return m.update(m.updated, propertyID, embedding, repository.ErrPropertyNotFound)
+}
+func (m MockPropertyReindexRepository) UpdateShadowEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error {
+	return m.update(m.shadow, propertyID, embedding, repository.ErrPropertyNotFound)
+}
+
+// MockShadowTarget отдаёт клиент новой модели.
+type MockShadowTarget struct {
+	client ml.Client
+}
+
+func (m MockShadowTarget) ShadowClient() ml.Client {
+	return m.client
+}
+
+// MockBatchMLClient отвечает на ReindexBatch единичным вектором; skip — ID без результата.
+type MockBatchMLClient struct {
+	mu      sync.Mutex
+	err     error
+	skip    map[string]bool
+	batches []int
+}
+
+func (m *MockBatchMLClient) PrepareAndEmbed(ctx context.Context, req ml.PrepareAndEmbedRequest) (*ml.PrepareAndEmbedResponse, error) {
+	return nil, nil
+}
+func (m *MockBatchMLClient) Reindex(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error) {
+	return nil, nil
+}
+func (m *MockBatchMLClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+	m.mu.Lock()
+	m.batches = append(m.batches, len(req.Entities))
+	m.mu.Unlock()
+
+	if m.err != nil {
+		return nil, m.err
+	}
+	resp := &ml.ReindexBatchResponse{Total: len(req.Entities)}
+	for _, e := range req.Entities {
+		if m.skip[e.EntityID] {
+			continue
+		}
+		resp.Results = append(resp.Results, ml.ReindexResponse{EntityID: e.EntityID, EntityType: e.EntityType, Embedding: []float64{1, 0}})
+	}
+	return resp, nil
+}
+func (m *MockBatchMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
+	return nil, nil
+}
+func (m *MockBatchMLClient) Ping(ctx context.Context) error {
+	return nil
+}
+
+func TestReindexer_ReindexAll(t *testing.T) {
+	leads := newMockReindexStore(7)
+	properties := newMockReindexStore(3)
+	mlClient := &MockBatchMLClient{skip: map[string]bool{properties.ids[0].String(): true}}
+	leads.deleted[leads.ids[6]] = true
+
+	r := NewReindexer(slog.New(slog.NewTextHandler(io.Discard, nil)), mlClient, nil,
+		MockLeadReindexRepository{leads}, MockPropertyReindexRepository{properties})
+
+	var reports []domain.ReindexProgress
+	results, err := r.ReindexAll(context.Background(), domain.ReindexOptions{BatchSize: 3, Concurrency: 2}, func(p domain.ReindexProgress) {
+		reports = append(reports, p)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []domain.ReindexProgress{
+		{EntityType: domain.EmbeddingEntityLead, Total: 7, Processed: 7, Updated: 6, Failed: 0, Done: true},
+		{EntityType: domain.EmbeddingEntityProperty, Total: 3, Processed: 3, Updated: 2, Failed: 1, Done: true},
+	}
+	if !slices.Equal(results, want) {
+		t.Errorf("results = %+v, want %+v", results, want)
+	}
+
+	if len(leads.updated) != 6 || len(properties.updated) != 2 {
+		t.Errorf("updated embeddings: leads %d, properties %d", len(leads.updated), len(properties.updated))
+	}
+	// 7 лидов пачками по 3 и 3 объекта одной полной пачкой, после которой нужна пустая страница
+	if !slices.Equal(slices.Sorted(slices.Values(mlClient.batches)), []int{1, 3, 3, 3}) {
+		t.Errorf("batches = %v", mlClient.batches)
+	}
+	if properties.pageCalls != 2 {
+		t.Errorf("property pages = %d, want 2", properties.pageCalls)
+	}
+	// Старт и финиш каждого типа плюс отчёт после каждой пачки
+	if len(reports) != 2+2+4 {
+		t.Errorf("progress reports = %d, want 8", len(reports))
+	}
+}
+
+func TestReindexer_ReindexAll_Options(t *testing.T) {
+	newReindexer := func(mlClient ml.Client, leads, properties *MockReindexStore) *Reindexer {
+		return NewReindexer(slog.New(slog.NewTextHandler(io.Discard, nil)), mlClient, nil,
+			MockLeadReindexRepository{leads}, MockPropertyReindexRepository{properties})
+	}
+
+	t.Run("single entity type", func(t *testing.T) {
+		leads, properties := newMockReindexStore(2), newMockReindexStore(2)
+		results, err := newReindexer(&MockBatchMLClient{}, leads, properties).
+			ReindexAll(context.Background(), domain.ReindexOptions{EntityType: domain.EmbeddingEntityProperty}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(results) != 1 || results[0].EntityType != domain.EmbeddingEntityProperty {
+			t.Errorf("results = %+v", results)
+		}
+		if leads.pageCalls != 0 || len(properties.updated) != 2 {
+			t.Errorf("lead pages = %d, property updates = %d", leads.pageCalls, len(properties.updated))
+		}
+	})
+
+	t.Run("ml failure counts batch as failed", func(t *testing.T) {
+		results, err := newReindexer(&MockBatchMLClient{err: errors.New("ml down")}, newMockReindexStore(4), newMockReindexStore(0)).
+			ReindexAll(context.Background(), domain.ReindexOptions{BatchSize: 2}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if results[0].Failed != 4 || results[0].Updated != 0 || !results[0].Done {
+			t.Errorf("lead result = %+v", results[0])
+		}
+	})
+
+	t.Run("shadow writes next model", func(t *testing.T) {
+		leads, properties := newMockReindexStore(2), newMockReindexStore(1)
+		active, next := &MockBatchMLClient{}, &MockBatchMLClient{}
+		r := NewReindexer(slog.New(slog.NewTextHandler(io.Discard, nil)), active, MockShadowTarget{client: next},
+			MockLeadReindexRepository{leads}, MockPropertyReindexRepository{properties})
+
+		if _, err := r.ReindexAll(context.Background(), domain.ReindexOptions{Shadow: true}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(active.batches) != 0 || len(next.batches) != 2 {
+			t.Errorf("active batches = %v, next batches = %v", active.batches, next.batches)
+		}
+		if len(leads.updated)+len(properties.updated) != 0 || len(leads.shadow) != 2 || len(properties.shadow) != 1 {
+			t.Errorf("updated %d/%d, shadow %d/%d",
+				len(leads.updated), len(properties.updated), len(leads.shadow), len(properties.shadow))
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		r := newReindexer(&MockBatchMLClient{}, newMockReindexStore(0), newMockReindexStore(0))
+		for _, opts := range []domain.ReindexOptions{
+			{EntityType: "deal"},
+			{BatchSize: MaxReindexBatchSize + 1},
+			{Shadow: true},
+		} {
+			if _, err := r.ReindexAll(context.Background(), opts, nil); !errors.Is(err, ErrInvalidReindexOptions) {
+				t.Errorf("opts %+v: err = %v, want ErrInvalidReindexOptions", opts, err)
+			}
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results, err := newReindexer(&MockBatchMLClient{}, newMockReindexStore(3), newMockReindexStore(3)).
+			ReindexAll(ctx, domain.ReindexOptions{}, nil)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("err = %v, want context.Canceled", err)
+		}
+		if len(results) != 1 || results[0].Done {
+			t.Errorf("results = %+v", results)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobRepository interface {
+	ClaimJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.EmbeddingJob, error)
+	CompleteJob(ctx context.Context, jobID, lockedBy uuid.UUID) error
+	RescheduleJob(ctx context.Context, jobID, lockedBy uuid.UUID, lastError string, nextRunAt time.Time) error
+	MarkDead(ctx context.Context, jobID, lockedBy uuid.UUID, lastError string) error
+	RetryJob(ctx context.Context, jobID uuid.UUID) (domain.EmbeddingJob, error)
+	ListJobs(ctx context.Context, filter domain.EmbeddingJobFilter) (*domain.PaginatedResult[domain.EmbeddingJob], error)
+}
+
+// LeadEmbedder генерирует embedding лида.
+type LeadEmbedder interface {
+	EmbedLead(ctx context.Context, leadID uuid.UUID) error
+}
+
+// PropertyEmbedder генерирует embedding объекта недвижимости.
+type PropertyEmbedder interface {
+	EmbedProperty(ctx context.Context, propertyID uuid.UUID) error
+}
+
+type Service struct {
+	log        *slog.Logger
+	repo       JobRepository
+	leads      LeadEmbedder
+	properties PropertyEmbedder
+	reindexer  *Reindexer
+	models     *ModelManager
+	cfg        config.EmbeddingWorkerConfig
+}
+
+var (
+	// ErrJobNotFound — задание не найдено или не находится в dead-letter.
+	ErrJobNotFound = errors.New("embedding job not found")
+	// ErrLeaseLost — задание выполнялось дольше lease и было перезабрано; результат не зафиксирован.
+	ErrLeaseLost = errors.New("embedding job lease lost")
+	// errUnknownEntityType — в задании неизвестный тип сущности.
+	errUnknownEntityType = errors.New("unknown entity type")
+)
+
+func New(
+	log *slog.Logger,
+	repo JobRepository,
+	leads LeadEmbedder,
+	properties PropertyEmbedder,
+	reindexer *Reindexer,
+	models *ModelManager,
+	cfg config.EmbeddingWorkerConfig,
+) *Service {
+	return &Service{
+		log:        log,
+		repo:       repo,
+		leads:      leads,
+		properties: properties,
+		reindexer:  reindexer,
+		models:     models,
+		cfg:        cfg,
+	}
+}
+
+// ListJobs — задания outbox для администратора.
+func (s *Service) ListJobs(ctx context.Context, filter domain.EmbeddingJobFilter) (*domain.PaginatedResult[domain.EmbeddingJob], error) {
+	const op = "embedding.Service.ListJobs"
+
+	result, err := s.repo.ListJobs(ctx, filter)
+	if err != nil {
+		s.log.Error("failed to list embedding jobs", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// RetryJob — возвращает DEAD-задание в очередь.
+func (s *Service) RetryJob(ctx context.Context, jobID uuid.UUID) (domain.EmbeddingJob, error) {
+	const op = "embedding.Service.RetryJob"
+
+	job, err := s.repo.RetryJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmbeddingJobNotFound) {
+			return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, ErrJobNotFound)
+		}
+		s.log.Error("failed to retry embedding job", sl.Err(err))
+		return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("embedding job requeued",
+		slog.String("job_id", jobID.String()),
+		slog.String("entity_type", job.EntityType.String()),
+		slog.String("entity_id", job.EntityID.String()),
+	)
+	return job, nil
+}
+
+// ReindexAll — массовая переиндексация embedding, см. Reindexer.ReindexAll.
+func (s *Service) ReindexAll(ctx context.Context, opts domain.ReindexOptions, progress func(domain.ReindexProgress)) ([]domain.ReindexProgress, error) {
+	const op = "embedding.Service.ReindexAll"
+
+	s.log.Info("bulk reindex requested",
+		slog.String("entity_type", opts.EntityType.String()),
+		slog.Bool("only_missing", opts.OnlyMissing),
+	)
+
+	results, err := s.reindexer.ReindexAll(ctx, opts, progress)
+	if err != nil {
+		return results, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return results, nil
+}
+
+// ProcessJob — выполняет забранное задание и фиксирует результат: удаление при успехе,
+// повтор с экспоненциальной задержкой при ошибке, DEAD после MaxAttempts попыток.
+// Задание для удалённой сущности считается выполненным. Во время миграции модели
+// после основного вектора пишется и вектор новой модели; его ошибка задание не повторяет —
+// запись останется без теневого вектора и будет дозаполнена cmd/reindex --shadow.
+// Если lease задания истёк, результат не фиксируется и возвращается ErrLeaseLost:
+// задание к этому моменту перезабрано и будет выполнено заново.
+func (s *Service) ProcessJob(ctx context.Context, job domain.EmbeddingJob) error {
+	const op = "embedding.Service.ProcessJob"
+	log := s.log.With(
+		slog.String("job_id", job.ID.String()),
+		slog.String("entity_type", job.EntityType.String()),
+		slog.String("entity_id", job.EntityID.String()),
+		slog.Int("attempt", job.Attempts),
+	)
+
+	err := s.embed(ctx, job)
+	if err == nil || errors.Is(err, lead.ErrLeadNotFound) || errors.Is(err, property.ErrPropertyNotFound) {
+		if err != nil {
+			log.Warn("entity for embedding job no longer exists")
+		} else if s.models != nil {
+			if err := s.models.EmbedShadow(ctx, job.EntityType, job.EntityID); err != nil {
+				log.Warn("failed to write embedding of the next model", sl.Err(err))
+			}
+		}
+		if err := s.repo.CompleteJob(ctx, job.ID, job.LockedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, leaseError(err))
+		}
+		return nil
+	}
+
+	// Повтор не поможет: тип сущности неизвестен или в её полях нет текста для embedding
+	if job.Attempts >= s.cfg.MaxAttempts || errors.Is(err, errUnknownEntityType) || errors.Is(err, ml.ErrEmptyText) {
+		log.Error("embedding job moved to dead-letter", sl.Err(err))
+		if err := s.repo.MarkDead(ctx, job.ID, job.LockedBy, err.Error()); err != nil {
+			return fmt.Errorf("%s: %w", op, leaseError(err))
+		}
+		return nil
+	}
+
+	delay := s.backoff(job.Attempts)
+	log.Warn("embedding job failed, will retry", slog.Duration("retry_in", delay), sl.Err(err))
+	if err := s.repo.RescheduleJob(ctx, job.ID, job.LockedBy, err.Error(), time.Now().Add(delay)); err != nil {
+		return fmt.Errorf("%s: %w", op, leaseError(err))
+	}
+	return nil
+}
+
+// leaseError переводит потерю lease из репозитория в ErrLeaseLost.
+func leaseError(err error) error {
+	if errors.Is(err, repository.ErrEmbeddingJobLeaseLost) {
+		return ErrLeaseLost
+	}
+	return err
+}
+
+func (s *Service) embed(ctx context.Context, job domain.EmbeddingJob) error {
+	switch job.EntityType {
+	case domain.EmbeddingEntityLead:
+		return s.leads.EmbedLead(ctx, job.EntityID)
+	case domain.EmbeddingEntityProperty:
+		return s.properties.EmbedProperty(ctx, job.EntityID)
+	default:
+		return fmt.Errorf("%w: %q", errUnknownEntityType, job.EntityType)
+	}
+}
+
+// backoff — задержка перед следующей попыткой: BackoffBase * 2^(attempt-1), не больше BackoffMax,
+// из которой случайно берётся от половины до целого, чтобы повторы не шли волной.
+func (s *Service) backoff(attempt int) time.Duration {
+	delay := s.cfg.BackoffBase
+	for i := 1; i < attempt && delay < s.cfg.BackoffMax; i++ {
+		delay *= 2
+	}
+	delay = min(delay, s.cfg.BackoffMax)
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
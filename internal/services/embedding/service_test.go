@@ -0,0 +1,224 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockJobRepository запоминает, чем закончилось задание.
+// Задания с токеном захвата, отличным от lockedBy, считаются перезабранными.
+type MockJobRepository struct {
+	completed   []uuid.UUID
+	dead        []uuid.UUID
+	rescheduled map[uuid.UUID]time.Time
+	lastError   string
+	lockedBy    uuid.UUID
+}
+
+func newMockJobRepository() *MockJobRepository {
+	return &MockJobRepository{rescheduled: map[uuid.UUID]time.Time{}, lockedBy: testLockedBy}
+}
+
+var testLockedBy = uuid.New()
+
+func (m *MockJobRepository) checkLease(lockedBy uuid.UUID) error {
+	if lockedBy != m.lockedBy {
+		return repository.ErrEmbeddingJobLeaseLost
+	}
+	return nil
+}
+
+func (m *MockJobRepository) ClaimJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.EmbeddingJob, error) {
+	return nil, nil
+}
+func (m *MockJobRepository) CompleteJob(ctx context.Context, jobID, lockedBy uuid.UUID) error {
+	if err := m.checkLease(lockedBy); err != nil {
+		return err
+	}
+	m.completed = append(m.completed, jobID)
+	return nil
+}
+func (m *MockJobRepository) RescheduleJob(ctx context.Context, jobID, lockedBy uuid.UUID, lastError string, nextRunAt time.Time) error {
+	if err := m.checkLease(lockedBy); err != nil {
+		return err
+	}
+	m.rescheduled[jobID] = nextRunAt
+	m.lastError = lastError
+	return nil
+}
+func (m *MockJobRepository) MarkDead(ctx context.Context, jobID, lockedBy uuid.UUID, lastError string) error {
+	if err := m.checkLease(lockedBy); err != nil {
+		return err
+	}
+	m.dead = append(m.dead, jobID)
+	m.lastError = lastError
+	return nil
+}
+func (m *MockJobRepository) RetryJob(ctx context.Context, jobID uuid.UUID) (domain.EmbeddingJob, error) {
+	return domain.EmbeddingJob{}, nil
+}
+func (m *MockJobRepository) ListJobs(ctx context.Context, filter domain.EmbeddingJobFilter) (*domain.PaginatedResult[domain.EmbeddingJob], error) {
+	return &domain.PaginatedResult[domain.EmbeddingJob]{}, nil
+}
+
+// MockEmbedder возвращает заданную ошибку для лидов и объектов.
+type MockEmbedder struct {
+	err   error
+	calls int
+}
+
+func (m *MockEmbedder) EmbedLead(ctx context.Context, leadID uuid.UUID) error {
+	m.calls++
+	return m.err
+}
+func (m *MockEmbedder) EmbedProperty(ctx context.Context, propertyID uuid.UUID) error {
+	m.calls++
+	return m.err
+}
+
+func newTestService(repo *MockJobRepository, embedder *MockEmbedder) *Service {
+	cfg := config.EmbeddingWorkerConfig{
+		MaxAttempts: 3,
+		BackoffBase: time.Second,
+		BackoffMax:  time.Minute,
+	}
+	return New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, embedder, embedder, nil, nil, cfg)
+}
+
+func TestService_ProcessJob(t *testing.T) {
+	mlDown := errors.New("ml service unavailable")
+
+	tests := []struct {
+		name            string
+		job             domain.EmbeddingJob
+		embedErr        error
+		wantCompleted   bool
+		wantRescheduled bool
+		wantDead        bool
+	}{
+		{
+			name:          "success",
+			job:           domain.EmbeddingJob{EntityType: domain.EmbeddingEntityLead, Attempts: 1},
+			wantCompleted: true,
+		},
+		{
+			name:          "deleted entity",
+			job:           domain.EmbeddingJob{EntityType: domain.EmbeddingEntityLead, Attempts: 1},
+			embedErr:      fmt.Errorf("lead.Service.EmbedLead: %w", lead.ErrLeadNotFound),
+			wantCompleted: true,
+		},
+		{
+			name:            "transient error",
+			job:             domain.EmbeddingJob{EntityType: domain.EmbeddingEntityProperty, Attempts: 2},
+			embedErr:        mlDown,
+			wantRescheduled: true,
+		},
+		{
+			name:     "attempts exhausted",
+			job:      domain.EmbeddingJob{EntityType: domain.EmbeddingEntityProperty, Attempts: 3},
+			embedErr: mlDown,
+			wantDead: true,
+		},
+		{
+			name:     "nothing to embed",
+			job:      domain.EmbeddingJob{EntityType: domain.EmbeddingEntityLead, Attempts: 1},
+			embedErr: fmt.Errorf("lead.Service.EmbedLead: %w", ml.ErrEmptyText),
+			wantDead: true,
+		},
+		{
+			name:     "unknown entity type",
+			job:      domain.EmbeddingJob{EntityType: "deal", Attempts: 1},
+			wantDead: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockJobRepository()
+			svc := newTestService(repo, &MockEmbedder{err: tt.embedErr})
+			tt.job.ID = uuid.New()
+			tt.job.LockedBy = testLockedBy
+
+			if err := svc.ProcessJob(context.Background(), tt.job); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := len(repo.completed) == 1; got != tt.wantCompleted {
+				t.Errorf("completed = %v, want %v", got, tt.wantCompleted)
+			}
+			if _, got := repo.rescheduled[tt.job.ID]; got != tt.wantRescheduled {
+				t.Errorf("rescheduled = %v, want %v", got, tt.wantRescheduled)
+			}
+			if got := len(repo.dead) == 1; got != tt.wantDead {
+				t.Errorf("dead = %v, want %v", got, tt.wantDead)
+			}
+			if (tt.wantRescheduled || tt.wantDead) && repo.lastError == "" {
+				t.Error("expected last error to be recorded")
+			}
+		})
+	}
+}
+
+func TestService_ProcessJob_LeaseLost(t *testing.T) {
+	mlDown := errors.New("ml service unavailable")
+
+	tests := []struct {
+		name     string
+		attempts int
+		embedErr error
+	}{
+		{name: "complete", attempts: 1},
+		{name: "reschedule", attempts: 1, embedErr: mlDown},
+		{name: "mark dead", attempts: 3, embedErr: mlDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockJobRepository()
+			svc := newTestService(repo, &MockEmbedder{err: tt.embedErr})
+			// Задание перезабрано другим воркером после истечения lease
+			job := domain.EmbeddingJob{ID: uuid.New(), EntityType: domain.EmbeddingEntityLead, Attempts: tt.attempts, LockedBy: uuid.New()}
+
+			err := svc.ProcessJob(context.Background(), job)
+			if !errors.Is(err, ErrLeaseLost) {
+				t.Fatalf("ProcessJob() error = %v, want %v", err, ErrLeaseLost)
+			}
+			if len(repo.completed) != 0 || len(repo.rescheduled) != 0 || len(repo.dead) != 0 {
+				t.Error("result of a lost lease must not be recorded")
+			}
+		})
+	}
+}
+
+func TestService_Backoff(t *testing.T) {
+	svc := newTestService(newMockJobRepository(), &MockEmbedder{})
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Second},
+		{attempt: 2, max: 2 * time.Second},
+		{attempt: 4, max: 8 * time.Second},
+		{attempt: 30, max: time.Minute},
+	}
+
+	for _, tt := range tests {
+		got := svc.backoff(tt.attempt)
+		if got < tt.max/2 || got > tt.max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.max/2, tt.max)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Run запускает пул воркеров и обрабатывает outbox embedding_jobs до отмены ctx.
+// Задания забираются из БД через ClaimJobs, поэтому несколько экземпляров сервера не дублируют работу.
+func (s *Service) Run(ctx context.Context) {
+	const op = "embedding.Service.Run"
+	log := s.log.With(slog.String("op", op))
+
+	if !s.cfg.Enabled {
+		log.Info("embedding worker is disabled")
+		return
+	}
+
+	workers := max(s.cfg.Workers, 1)
+	jobs := make(chan domain.EmbeddingJob)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				s.processClaimed(ctx, log, job)
+			}
+		}()
+	}
+
+	if s.models != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.syncModels(ctx, log)
+		}()
+	}
+
+	log.Info("embedding worker started", slog.Int("workers", workers))
+
+	for ctx.Err() == nil {
+		claimed, err := s.repo.ClaimJobs(ctx, max(s.cfg.BatchSize, 1), s.cfg.Lease)
+		if err != nil && ctx.Err() == nil {
+			log.Error("failed to claim embedding jobs", sl.Err(err))
+		}
+
+		for _, job := range claimed {
+			jobs <- job
+		}
+
+		// Пока очередь отдаёт полные пачки, забираем следующую сразу
+		if len(claimed) >= s.cfg.BatchSize && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.cfg.PollInterval):
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+}
+
+// syncModels периодически проверяет, можно ли переключиться на новую модель embedding.
+func (s *Service) syncModels(ctx context.Context, log *slog.Logger) {
+	ticker := time.NewTicker(max(s.cfg.ModelSyncInterval, time.Second))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.models.Sync(ctx); err != nil && ctx.Err() == nil {
+				log.Error("failed to sync embedding model", sl.Err(err))
+			}
+		}
+	}
+}
+
+// processClaimed выполняет задание в пределах lease. Забранное задание доводится до конца
+// и при остановке сервера, иначе оно ждало бы истечения lease.
+func (s *Service) processClaimed(ctx context.Context, log *slog.Logger, job domain.EmbeddingJob) {
+	jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.cfg.Lease)
+	defer cancel()
+
+	err := s.ProcessJob(jobCtx, job)
+	switch {
+	case errors.Is(err, ErrLeaseLost):
+		log.Warn("embedding job lease lost, result discarded",
+			slog.String("job_id", job.ID.String()),
+			slog.Duration("lease", s.cfg.Lease),
+		)
+	case err != nil:
+		log.Error("failed to process embedding job", slog.String("job_id", job.ID.String()), sl.Err(err))
+	}
+}
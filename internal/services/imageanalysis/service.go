@@ -0,0 +1,292 @@
+package imageanalysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/vision"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/property"
+)
+
+type Repository interface {
+	ListByProperty(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error)
+	SaveAnalysis(ctx context.Context, imageID uuid.UUID, a domain.ImageAnalysis) error
+	GetAnalysisState(ctx context.Context, propertyID uuid.UUID) (domain.ImageAnalysisState, error)
+	StartAnalysis(ctx context.Context, propertyID uuid.UUID, status domain.ImageAnalysisStatus, force bool, lease time.Duration) (bool, error)
+	ClaimPendingAnalyses(ctx context.Context, limit int, lease time.Duration) ([]uuid.UUID, error)
+	FinishAnalysis(ctx context.Context, summary domain.PropertyImageAnalysis) error
+}
+
+// Storage — откуда скачиваются фотографии галереи (MinIO).
+type Storage interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+var (
+	// ErrNoImages — в галерее объекта нет фотографий.
+	ErrNoImages = errors.New("property has no images to analyze")
+	// ErrVisionUnavailable — CV API выключен или отключён circuit breaker.
+	ErrVisionUnavailable = errors.New("vision service is unavailable")
+)
+
+// Service — анализ фотографий галереи компьютерным зрением. Результаты по фотографиям
+// хранятся в property_images, агрегаты — в properties. Если анализа ждут больше
+// cfg.SyncMaxImages фотографий, галерея ставится в очередь и анализируется в Run.
+// Права доступа проверяет декоратор authz.ImageAnalysisService.
+type Service struct {
+	log     *slog.Logger
+	repo    Repository
+	storage Storage
+	vision  vision.Client
+	cfg     config.VisionConfig
+	// wake будит Run, когда галерея поставлена в очередь
+	wake chan struct{}
+	now  func() time.Time
+}
+
+func New(log *slog.Logger, repo Repository, storage Storage, visionClient vision.Client, cfg config.VisionConfig) *Service {
+	return &Service{
+		log:     log,
+		repo:    repo,
+		storage: storage,
+		vision:  visionClient,
+		cfg:     cfg,
+		wake:    make(chan struct{}, 1),
+		now:     time.Now,
+	}
+}
+
+// AnalyzeImages — анализирует фотографии галереи, которые ещё не проанализированы (при force — все).
+// Возвращает состояние со статусом DONE/FAILED после анализа в запросе или PENDING, если галерея
+// поставлена в очередь. Если анализ галереи уже идёт, возвращает текущее состояние.
+func (s *Service) AnalyzeImages(ctx context.Context, propertyID uuid.UUID, force bool) (domain.PropertyImageAnalysis, error) {
+	const op = "imageanalysis.Service.AnalyzeImages"
+	log := s.log.With(slog.String("op", op), slog.String("property_id", propertyID.String()))
+
+	if !s.vision.IsEnabled() {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, ErrVisionUnavailable)
+	}
+
+	images, err := s.repo.ListByProperty(ctx, propertyID)
+	if err != nil {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(images) == 0 {
+		// Отличаем пустую галерею от несуществующего объекта
+		if _, err := s.repo.GetAnalysisState(ctx, propertyID); err != nil {
+			return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, mapRepoError(err))
+		}
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, ErrNoImages)
+	}
+
+	pending := len(pendingImages(images))
+	if force {
+		pending = len(images)
+	}
+
+	if pending > s.cfg.SyncMaxImages {
+		started, err := s.repo.StartAnalysis(ctx, propertyID, domain.ImageAnalysisPending, force, s.cfg.AnalysisLease)
+		if err != nil {
+			return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, mapRepoError(err))
+		}
+		if started {
+			log.Info("image analysis queued", slog.Int("pending", pending))
+			s.notify()
+		}
+		return s.GetAnalysis(ctx, propertyID)
+	}
+
+	started, err := s.repo.StartAnalysis(ctx, propertyID, domain.ImageAnalysisProcessing, force, s.cfg.AnalysisLease)
+	if err != nil {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+	if !started {
+		return s.GetAnalysis(ctx, propertyID)
+	}
+
+	if err := s.process(ctx, log, propertyID); err != nil {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.GetAnalysis(ctx, propertyID)
+}
+
+// GetAnalysis — сохранённые результаты анализа галереи и его состояние.
+func (s *Service) GetAnalysis(ctx context.Context, propertyID uuid.UUID) (domain.PropertyImageAnalysis, error) {
+	const op = "imageanalysis.Service.GetAnalysis"
+
+	state, err := s.repo.GetAnalysisState(ctx, propertyID)
+	if err != nil {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+
+	images, err := s.repo.ListByProperty(ctx, propertyID)
+	if err != nil {
+		return domain.PropertyImageAnalysis{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := summarize(propertyID, images)
+	result.ImageAnalysisState = state
+	return result, nil
+}
+
+// process анализирует непроанализированные фотографии галереи, которую вызывающий перевёл
+// в PROCESSING, и завершает анализ. Ошибки отдельных фотографий не прерывают анализ:
+// галерея получает статус FAILED, а фотографии останутся в ожидании следующего запуска.
+func (s *Service) process(ctx context.Context, log *slog.Logger, propertyID uuid.UUID) error {
+	images, err := s.repo.ListByProperty(ctx, propertyID)
+	if err != nil {
+		return err
+	}
+
+	pending := pendingImages(images)
+	var failed int
+	for _, img := range pending {
+		if err := s.analyzeImage(ctx, img); err != nil {
+			if ctx.Err() != nil {
+				// Аренда истечёт, и галерею доделает воркер
+				return ctx.Err()
+			}
+			log.Warn("failed to analyze image", slog.String("image_id", img.ID.String()), sl.Err(err))
+			failed++
+		}
+	}
+
+	images, err = s.repo.ListByProperty(ctx, propertyID)
+	if err != nil {
+		return err
+	}
+
+	summary := summarize(propertyID, images)
+	summary.Status = domain.ImageAnalysisDone
+	if failed > 0 {
+		summary.Status = domain.ImageAnalysisFailed
+		summary.Error = fmt.Sprintf("%d of %d images failed analysis", failed, len(pending))
+	}
+	if err := s.repo.FinishAnalysis(ctx, summary); err != nil {
+		return err
+	}
+
+	log.Info("property images analyzed",
+		slog.Int("analyzed", len(pending)-failed),
+		slog.Int("failed", failed),
+		slog.Float64("average_quality", summary.AverageQuality),
+	)
+
+	return nil
+}
+
+// analyzeImage скачивает фотографию из хранилища, анализирует и сохраняет результат.
+func (s *Service) analyzeImage(ctx context.Context, img domain.PropertyImage) error {
+	data, err := s.storage.Get(ctx, img.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", img.StorageKey, err)
+	}
+
+	a, err := s.vision.AnalyzeImage(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	analysis := imageAnalysisFromVision(a)
+	analysis.AnalyzedAt = s.now().UTC()
+	return s.repo.SaveAnalysis(ctx, img.ID, analysis)
+}
+
+// notify будит Run, не блокируясь, если он уже разбужен.
+func (s *Service) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// pendingImages — фотографии, которые ещё не проанализированы.
+func pendingImages(images []domain.PropertyImage) []domain.PropertyImage {
+	var pending []domain.PropertyImage
+	for _, img := range images {
+		if img.Analysis == nil {
+			pending = append(pending, img)
+		}
+	}
+	return pending
+}
+
+// summarize агрегирует результаты проанализированных фотографий галереи так же, как vision.Aggregate.
+func summarize(propertyID uuid.UUID, images []domain.PropertyImage) domain.PropertyImageAnalysis {
+	result := domain.PropertyImageAnalysis{PropertyID: propertyID}
+
+	var analyses []*vision.ImageAnalysis
+	for _, img := range images {
+		if img.Analysis == nil {
+			result.PendingImages++
+			continue
+		}
+		result.Images = append(result.Images, img)
+		analyses = append(analyses, imageAnalysisToVision(*img.Analysis))
+	}
+	if len(analyses) == 0 {
+		return result
+	}
+
+	agg := vision.Aggregate(analyses)
+	result.TotalImages = agg.TotalImages
+	result.AverageQuality = agg.AverageQuality
+	result.DetectedRooms = agg.DetectedRooms
+	result.ViewTypes = agg.ViewTypes
+	result.OverallAssessment = agg.OverallAssessment
+	result.VisualFeatures = agg.EmbeddingFeatures
+	for _, f := range agg.AllFeatures {
+		result.AllFeatures = append(result.AllFeatures, domain.ImageFeature(f))
+	}
+
+	return result
+}
+
+func imageAnalysisFromVision(a *vision.ImageAnalysis) domain.ImageAnalysis {
+	result := domain.ImageAnalysis{
+		RoomType:     a.RoomType,
+		QualityScore: a.QualityScore,
+		ViewType:     a.ViewType,
+		Brightness:   a.Brightness,
+		Tags:         a.Tags,
+		Confidence:   a.Confidence,
+		// Признаки фотографии считаются так же, как признаки галереи из одной фотографии
+		VisualFeatures: vision.Aggregate([]*vision.ImageAnalysis{a}).EmbeddingFeatures,
+	}
+	for _, f := range a.DetectedFeatures {
+		result.DetectedFeatures = append(result.DetectedFeatures, domain.ImageFeature(f))
+	}
+	return result
+}
+
+func imageAnalysisToVision(a domain.ImageAnalysis) *vision.ImageAnalysis {
+	result := &vision.ImageAnalysis{
+		RoomType:     a.RoomType,
+		QualityScore: a.QualityScore,
+		ViewType:     a.ViewType,
+		Brightness:   a.Brightness,
+		Tags:         a.Tags,
+		Confidence:   a.Confidence,
+	}
+	for _, f := range a.DetectedFeatures {
+		result.DetectedFeatures = append(result.DetectedFeatures, vision.Feature(f))
+	}
+	return result
+}
+
+func mapRepoError(err error) error {
+	if errors.Is(err, repository.ErrPropertyNotFound) {
+		return property.ErrPropertyNotFound
+	}
+	return err
+}
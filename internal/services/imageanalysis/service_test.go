@@ -0,0 +1,297 @@
+package imageanalysis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/vision"
+	"lead_exchange/internal/lib/vision/visiontest"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockRepository хранит галереи и состояние анализа в памяти, повторяя переходы статусов из SQL.
+type MockRepository struct {
+	mu       sync.Mutex
+	images   map[uuid.UUID][]domain.PropertyImage
+	states   map[uuid.UUID]domain.ImageAnalysisState
+	summary  map[uuid.UUID]domain.PropertyImageAnalysis
+	finished int
+}
+
+func newMockRepository() *MockRepository {
+	return &MockRepository{
+		images:  map[uuid.UUID][]domain.PropertyImage{},
+		states:  map[uuid.UUID]domain.ImageAnalysisState{},
+		summary: map[uuid.UUID]domain.PropertyImageAnalysis{},
+	}
+}
+
+func (m *MockRepository) ListByProperty(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.images[propertyID]), nil
+}
+func (m *MockRepository) SaveAnalysis(ctx context.Context, imageID uuid.UUID, a domain.ImageAnalysis) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, gallery := range m.images {
+		for i := range gallery {
+			if gallery[i].ID == imageID {
+				m.images[id][i].Analysis = &a
+				return nil
+			}
+		}
+	}
+	return repository.ErrPropertyImageNotFound
+}
+func (m *MockRepository) GetAnalysisState(ctx context.Context, propertyID uuid.UUID) (domain.ImageAnalysisState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[propertyID]
+	if !ok {
+		return domain.ImageAnalysisState{}, repository.ErrPropertyNotFound
+	}
+	return state, nil
+}
+func (m *MockRepository) StartAnalysis(ctx context.Context, propertyID uuid.UUID, status domain.ImageAnalysisStatus, force bool, lease time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[propertyID]
+	if !ok {
+		return false, repository.ErrPropertyNotFound
+	}
+	if state.Status == domain.ImageAnalysisPending || state.Status == domain.ImageAnalysisProcessing {
+		return false, nil
+	}
+	if force {
+		for i := range m.images[propertyID] {
+			m.images[propertyID][i].Analysis = nil
+		}
+	}
+	m.states[propertyID] = domain.ImageAnalysisState{Status: status, AnalyzedAt: state.AnalyzedAt}
+	return true, nil
+}
+func (m *MockRepository) ClaimPendingAnalyses(ctx context.Context, limit int, lease time.Duration) ([]uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ids []uuid.UUID
+	for id, state := range m.states {
+		if state.Status == domain.ImageAnalysisPending && len(ids) < limit {
+			state.Status = domain.ImageAnalysisProcessing
+			m.states[id] = state
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+func (m *MockRepository) FinishAnalysis(ctx context.Context, summary domain.PropertyImageAnalysis) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	m.states[summary.PropertyID] = domain.ImageAnalysisState{Status: summary.Status, Error: summary.Error, AnalyzedAt: &now}
+	m.summary[summary.PropertyID] = summary
+	m.finished++
+	return nil
+}
+
+func (m *MockRepository) addProperty(keys ...string) uuid.UUID {
+	id := uuid.New()
+	m.states[id] = domain.ImageAnalysisState{}
+	for i, key := range keys {
+		m.images[id] = append(m.images[id], domain.PropertyImage{ID: uuid.New(), PropertyID: id, StorageKey: key, Position: i})
+	}
+	return id
+}
+
+// MockStorage — содержимое объекта равно его ключу.
+type MockStorage struct{}
+
+func (MockStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	return []byte(key), nil
+}
+
+func newTestService(t *testing.T, syncMax int) (*Service, *MockRepository, *visiontest.Server) {
+	t.Helper()
+
+	srv := visiontest.NewServer()
+	t.Cleanup(srv.Close)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfg := config.VisionConfig{
+		Enabled:       true,
+		BaseURL:       srv.URL,
+		Timeout:       5 * time.Second,
+		SyncMaxImages: syncMax,
+		PollInterval:  10 * time.Millisecond,
+		AnalysisLease: time.Minute,
+	}
+	repo := newMockRepository()
+	return New(log, repo, MockStorage{}, vision.NewClient(cfg, log), cfg), repo, srv
+}
+
+// expectedSummary — агрегаты, которые должен получить сервис для ключей keys.
+func expectedSummary(keys ...string) *vision.PropertyImageAnalysis {
+	var analyses []*vision.ImageAnalysis
+	for _, key := range keys {
+		a := visiontest.Analyze([]byte(key))
+		analyses = append(analyses, &a)
+	}
+	return vision.Aggregate(analyses)
+}
+
+func TestService_AnalyzeImages_Sync(t *testing.T) {
+	svc, repo, srv := newTestService(t, 5)
+	keys := []string{"a.jpg", "b.jpg", "c.jpg"}
+	propertyID := repo.addProperty(keys...)
+
+	got, err := svc.AnalyzeImages(context.Background(), propertyID, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Status != domain.ImageAnalysisDone {
+		t.Errorf("Status = %q, want DONE", got.Status)
+	}
+	if got.TotalImages != 3 || got.PendingImages != 0 || len(got.Images) != 3 {
+		t.Errorf("TotalImages = %d, PendingImages = %d, Images = %d", got.TotalImages, got.PendingImages, len(got.Images))
+	}
+
+	want := expectedSummary(keys...)
+	if got.AverageQuality != want.AverageQuality || got.OverallAssessment != want.OverallAssessment {
+		t.Errorf("got quality %v / %q, want %v / %q", got.AverageQuality, got.OverallAssessment, want.AverageQuality, want.OverallAssessment)
+	}
+	if !slices.Equal(got.DetectedRooms, want.DetectedRooms) {
+		t.Errorf("DetectedRooms = %v, want %v", got.DetectedRooms, want.DetectedRooms)
+	}
+
+	stored := repo.summary[propertyID]
+	if !slices.Equal(stored.VisualFeatures, want.EmbeddingFeatures) {
+		t.Errorf("stored VisualFeatures = %v, want %v", stored.VisualFeatures, want.EmbeddingFeatures)
+	}
+
+	first := got.Images[0].Analysis
+	if wantFirst := visiontest.Analyze([]byte("a.jpg")); first.RoomType != wantFirst.RoomType || first.QualityScore != wantFirst.QualityScore {
+		t.Errorf("first image analysis = %+v, want %+v", first, wantFirst)
+	}
+	if len(first.VisualFeatures) != 16 {
+		t.Errorf("len(VisualFeatures) = %d, want 16", len(first.VisualFeatures))
+	}
+
+	// Повторный запуск не анализирует уже проанализированные фотографии
+	if _, err := svc.AnalyzeImages(context.Background(), propertyID, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if srv.Requests() != 3 {
+		t.Errorf("requests = %d, want 3", srv.Requests())
+	}
+
+	// force анализирует все заново
+	if _, err := svc.AnalyzeImages(context.Background(), propertyID, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if srv.Requests() != 6 {
+		t.Errorf("requests after force = %d, want 6", srv.Requests())
+	}
+}
+
+func TestService_AnalyzeImages_PartialFailure(t *testing.T) {
+	svc, repo, srv := newTestService(t, 5)
+	propertyID := repo.addProperty("ok.jpg", "broken.jpg")
+	srv.FailOn([]byte("broken.jpg"))
+
+	got, err := svc.AnalyzeImages(context.Background(), propertyID, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Status != domain.ImageAnalysisFailed || got.Error == "" {
+		t.Errorf("Status = %q, Error = %q, want FAILED with error", got.Status, got.Error)
+	}
+	if got.TotalImages != 1 || got.PendingImages != 1 {
+		t.Errorf("TotalImages = %d, PendingImages = %d, want 1 and 1", got.TotalImages, got.PendingImages)
+	}
+	if want := expectedSummary("ok.jpg"); got.AverageQuality != want.AverageQuality {
+		t.Errorf("AverageQuality = %v, want %v", got.AverageQuality, want.AverageQuality)
+	}
+}
+
+func TestService_AnalyzeImages_Async(t *testing.T) {
+	svc, repo, srv := newTestService(t, 2)
+	keys := []string{"1.jpg", "2.jpg", "3.jpg", "4.jpg"}
+	propertyID := repo.addProperty(keys...)
+
+	got, err := svc.AnalyzeImages(context.Background(), propertyID, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != domain.ImageAnalysisPending || got.PendingImages != 4 {
+		t.Fatalf("Status = %q, PendingImages = %d, want PENDING and 4", got.Status, got.PendingImages)
+	}
+	if srv.Requests() != 0 {
+		t.Errorf("requests before worker = %d, want 0", srv.Requests())
+	}
+
+	// Пока галерея в очереди, повторный запрос не ставит её заново
+	if got, _ := svc.AnalyzeImages(context.Background(), propertyID, true); got.Status != domain.ImageAnalysisPending {
+		t.Errorf("Status = %q, want PENDING", got.Status)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		svc.Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got, err = svc.GetAnalysis(context.Background(), propertyID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Status == domain.ImageAnalysisDone || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if got.Status != domain.ImageAnalysisDone {
+		t.Fatalf("Status = %q, want DONE", got.Status)
+	}
+	if want := expectedSummary(keys...); got.TotalImages != 4 || got.AverageQuality != want.AverageQuality {
+		t.Errorf("TotalImages = %d, AverageQuality = %v, want 4 and %v", got.TotalImages, got.AverageQuality, want.AverageQuality)
+	}
+	if repo.finished != 1 {
+		t.Errorf("finished = %d, want 1", repo.finished)
+	}
+}
+
+func TestService_AnalyzeImages_Errors(t *testing.T) {
+	svc, repo, _ := newTestService(t, 5)
+	empty := repo.addProperty()
+
+	if _, err := svc.AnalyzeImages(context.Background(), empty, false); !errors.Is(err, ErrNoImages) {
+		t.Errorf("empty gallery: err = %v, want ErrNoImages", err)
+	}
+	if _, err := svc.AnalyzeImages(context.Background(), uuid.New(), false); !errors.Is(err, property.ErrPropertyNotFound) {
+		t.Errorf("unknown property: err = %v, want ErrPropertyNotFound", err)
+	}
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	disabled := New(log, repo, MockStorage{}, vision.NewClient(config.VisionConfig{}, log), config.VisionConfig{})
+	if _, err := disabled.AnalyzeImages(context.Background(), repo.addProperty("a.jpg"), false); !errors.Is(err, ErrVisionUnavailable) {
+		t.Errorf("disabled vision: err = %v, want ErrVisionUnavailable", err)
+	}
+}
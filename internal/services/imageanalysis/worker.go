@@ -0,0 +1,61 @@
+package imageanalysis
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"lead_exchange/internal/lib/logger/sl"
+)
+
+// Run — фоновый воркер: забирает галереи из очереди по одной и анализирует их,
+// пока ctx не отменён. Галерея, которую не успели доделать, вернётся в работу
+// по истечении аренды cfg.AnalysisLease.
+func (s *Service) Run(ctx context.Context) {
+	const op = "imageanalysis.Service.Run"
+	log := s.log.With(slog.String("op", op))
+
+	log.Info("image analysis worker started")
+
+	for ctx.Err() == nil {
+		claimed := s.claim(ctx, log)
+		if claimed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-s.wake:
+		case <-time.After(s.cfg.PollInterval):
+		}
+	}
+}
+
+// claim забирает и анализирует одну галерею; true — очередь, возможно, ещё не пуста.
+func (s *Service) claim(ctx context.Context, log *slog.Logger) bool {
+	// С выключенным CV API галереи ждут в очереди
+	if !s.vision.IsEnabled() {
+		return false
+	}
+
+	ids, err := s.repo.ClaimPendingAnalyses(ctx, 1, s.cfg.AnalysisLease)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error("failed to claim image analysis", sl.Err(err))
+		}
+		return false
+	}
+	if len(ids) == 0 {
+		return false
+	}
+
+	propertyID := ids[0]
+	jobCtx, cancel := context.WithTimeout(ctx, s.cfg.AnalysisLease)
+	defer cancel()
+
+	if err := s.process(jobCtx, log.With(slog.String("property_id", propertyID.String())), propertyID); err != nil {
+		log.Error("failed to analyze property images", slog.String("property_id", propertyID.String()), sl.Err(err))
+	}
+
+	return true
+}
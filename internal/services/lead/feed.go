@@ -0,0 +1,226 @@
+package lead
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// subscriberBuffer — размер буфера канала подписчика; при переполнении события пропускаются.
+	subscriberBuffer = 64
+	// subscriberSeenLimit — сколько последних версий лидов помнит подписка, чтобы не отправлять повторы.
+	subscriberSeenLimit = 1024
+)
+
+var ErrFeedUnavailable = errors.New("lead feed is not configured")
+
+// LeadEventSource — источник событий лидов (Postgres LISTEN/NOTIFY).
+type LeadEventSource interface {
+	ListenLeadEvents(ctx context.Context, handle func(leadID uuid.UUID)) error
+}
+
+// Feed раздаёт события о новых и опубликованных лидах подписчикам.
+// На каждый экземпляр сервера держится одна LISTEN-подписка; события от других
+// экземпляров приходят через ту же подписку, поэтому лента работает при горизонтальном масштабировании.
+type Feed struct {
+	log    *slog.Logger
+	repo   LeadRepository
+	source LeadEventSource
+
+	mu   sync.RWMutex
+	subs map[chan domain.Lead]struct{}
+}
+
+// NewFeed создаёт ленту лидов. Для доставки событий нужно запустить Run.
+func NewFeed(log *slog.Logger, repo LeadRepository, source LeadEventSource) *Feed {
+	return &Feed{
+		log:    log,
+		repo:   repo,
+		source: source,
+		subs:   make(map[chan domain.Lead]struct{}),
+	}
+}
+
+// Run слушает события лидов до отмены ctx, переподключаясь при обрыве соединения.
+func (f *Feed) Run(ctx context.Context) {
+	const op = "lead.Feed.Run"
+	log := f.log.With(slog.String("op", op))
+
+	backoff := time.Second
+	for {
+		err := f.source.ListenLeadEvents(ctx, func(leadID uuid.UUID) {
+			backoff = time.Second
+			f.dispatch(ctx, leadID)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Error("lead events listener stopped, reconnecting", sl.Err(err), slog.Duration("backoff", backoff))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 30*time.Second)
+	}
+}
+
+// subscribe регистрирует подписчика; канал закрывается после отмены ctx.
+func (f *Feed) subscribe(ctx context.Context) <-chan domain.Lead {
+	ch := make(chan domain.Lead, subscriberBuffer)
+
+	f.mu.Lock()
+	f.subs[ch] = struct{}{}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		f.mu.Lock()
+		delete(f.subs, ch)
+		close(ch)
+		f.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// dispatch загружает лид и отправляет его всем подписчикам.
+func (f *Feed) dispatch(ctx context.Context, leadID uuid.UUID) {
+	f.mu.RLock()
+	empty := len(f.subs) == 0
+	f.mu.RUnlock()
+	if empty {
+		return
+	}
+
+	l, err := f.repo.GetByID(ctx, leadID)
+	if err != nil {
+		f.log.Warn("failed to load lead for feed", slog.String("lead_id", leadID.String()), sl.Err(err))
+		return
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for ch := range f.subs {
+		select {
+		case ch <- l:
+		default:
+			f.log.Warn("lead feed subscriber is too slow, event dropped", slog.String("lead_id", leadID.String()))
+		}
+	}
+}
+
+// SubscribeLeads — поток лидов, удовлетворяющих подписке, до отмены ctx.
+// Повтор недавно отправленной версии лида (лид + статус) не отправляется; подписка помнит
+// subscriberSeenLimit последних версий, поэтому память долгой подписки не растёт.
+func (s *Service) SubscribeLeads(ctx context.Context, sub domain.LeadSubscription) (<-chan domain.Lead, error) {
+	if s.feed == nil {
+		return nil, ErrFeedUnavailable
+	}
+
+	events := s.feed.subscribe(ctx)
+	out := make(chan domain.Lead)
+
+	go func() {
+		defer close(out)
+
+		seen := newSeenSet(subscriberSeenLimit)
+
+		for l := range events {
+			if !matchesSubscription(sub, l) {
+				continue
+			}
+			if !seen.add(seenKey{id: l.ID, status: l.Status}) {
+				continue
+			}
+
+			select {
+			case out <- l:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// seenKey — версия лида, отправленная подписчику.
+type seenKey struct {
+	id     uuid.UUID
+	status domain.LeadStatus
+}
+
+// seenSet — последние limit версий лидов; при переполнении вытесняется та, что встречалась давнее всех (LRU).
+type seenSet struct {
+	limit int
+	order *list.List // от недавних к давним
+	items map[seenKey]*list.Element
+}
+
+func newSeenSet(limit int) *seenSet {
+	return &seenSet{
+		limit: limit,
+		order: list.New(),
+		items: make(map[seenKey]*list.Element, limit),
+	}
+}
+
+// add запоминает версию; false — она уже встречалась.
+func (s *seenSet) add(key seenKey) bool {
+	if el, ok := s.items[key]; ok {
+		s.order.MoveToFront(el)
+		return false
+	}
+
+	s.items[key] = s.order.PushFront(key)
+	if s.order.Len() > s.limit {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(seenKey))
+	}
+	return true
+}
+
+// matchesSubscription проверяет фильтр подписки и, если задан вектор, семантическое сходство.
+// Лиды без embedding не проходят семантический фильтр: они придут повторно, когда embedding появится.
+func matchesSubscription(sub domain.LeadSubscription, l domain.Lead) bool {
+	if !sub.Filter.Matches(l) {
+		return false
+	}
+	if len(sub.Embedding) == 0 {
+		return true
+	}
+	if len(l.Embedding) == 0 {
+		return false
+	}
+	return cosineSimilarity(sub.Embedding, l.Embedding) >= sub.MinSimilarity
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,123 @@
+package lead
+
+import (
+	"context"
+	"io"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeLeadEventSource передаёт события из канала вместо Postgres NOTIFY.
+type fakeLeadEventSource struct {
+	events chan uuid.UUID
+}
+
+func (f *fakeLeadEventSource) ListenLeadEvents(ctx context.Context, handle func(leadID uuid.UUID)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case id := <-f.events:
+			handle(id)
+		}
+	}
+}
+
+func TestService_SubscribeLeads(t *testing.T) {
+	owner := uuid.New()
+	viewer := uuid.New()
+	city := "Москва"
+
+	leads := map[uuid.UUID]domain.Lead{}
+	add := func(l domain.Lead) uuid.UUID {
+		l.ID = uuid.New()
+		leads[l.ID] = l
+		return l.ID
+	}
+
+	foreignNew := add(domain.Lead{Status: domain.LeadStatusNew, OwnerUserID: owner, CreatedUserID: owner, City: &city})
+	otherCity := add(domain.Lead{Status: domain.LeadStatusPublished, OwnerUserID: owner, City: strPtr("Казань")})
+	notSimilar := add(domain.Lead{Status: domain.LeadStatusPublished, OwnerUserID: owner, City: &city, Embedding: []float32{0, 1}})
+	noEmbedding := add(domain.Lead{Status: domain.LeadStatusPublished, OwnerUserID: owner, City: &city})
+	match := add(domain.Lead{Status: domain.LeadStatusPublished, OwnerUserID: owner, City: &city, Embedding: []float32{1, 0.1}})
+
+	repo := &MockLeadRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return leads[id], nil
+		},
+	}
+	source := &fakeLeadEventSource{events: make(chan uuid.UUID)}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	feed := NewFeed(log, repo, source)
+	svc := NewWithFeed(log, repo, &MockMLClient{}, feed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go feed.Run(ctx)
+
+	ch, err := svc.SubscribeLeads(ctx, domain.LeadSubscription{
+		Filter:        domain.LeadFilter{City: &city, VisibleToUserID: &viewer},
+		Embedding:     []float32{1, 0},
+		MinSimilarity: 0.9,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, id := range []uuid.UUID{foreignNew, otherCity, notSimilar, noEmbedding, match, match} {
+		source.events <- id
+	}
+
+	select {
+	case got := <-ch:
+		if got.ID != match {
+			t.Fatalf("expected lead %s, got %s", match, got.ID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected matching lead in feed")
+	}
+
+	// Повторное событие по той же версии лида не дублируется
+	select {
+	case got := <-ch:
+		t.Fatalf("unexpected duplicate lead %s", got.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestService_SubscribeLeads_WithoutFeed(t *testing.T) {
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), &MockLeadRepository{}, &MockMLClient{})
+	if _, err := svc.SubscribeLeads(context.Background(), domain.LeadSubscription{}); err != ErrFeedUnavailable {
+		t.Errorf("expected ErrFeedUnavailable, got %v", err)
+	}
+}
+
+func TestSeenSet(t *testing.T) {
+	a, b, c := seenKey{id: uuid.New()}, seenKey{id: uuid.New()}, seenKey{id: uuid.New()}
+	s := newSeenSet(2)
+
+	if !s.add(a) || !s.add(b) {
+		t.Fatal("new keys must be added")
+	}
+	if s.add(a) {
+		t.Error("repeated key must be reported as seen")
+	}
+
+	// a только что встречался, поэтому вытесняется b
+	s.add(c)
+	if len(s.items) != 2 || s.order.Len() != 2 {
+		t.Fatalf("size = %d/%d, want 2", len(s.items), s.order.Len())
+	}
+	if s.add(a) {
+		t.Error("recently seen key must survive eviction")
+	}
+	if !s.add(b) {
+		t.Error("least recently seen key must be evicted")
+	}
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,84 @@
+package lead
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"log/slog"
+	"sort"
+)
+
+// ErrPropertyHasNoEmbedding — у объекта ещё нет embedding, обратный матчинг невозможен.
+var ErrPropertyHasNoEmbedding = errors.New("property has no embedding")
+
+// MatchLeads подбирает лиды для объекта недвижимости (обратный матчинг).
+// Кандидаты ищутся по embedding объекта с жёсткими фильтрами из LeadHardFiltersFromProperty,
+// затем ранжируются по тем же взвешенным scores, что и MatchPropertiesWeighted:
+// объект оценивается по критериям из requirement каждого лида.
+// Без явного статуса ищутся только опубликованные лиды.
+func (s *Service) MatchLeads(
+	ctx context.Context,
+	property domain.Property,
+	filter domain.LeadFilter,
+	limit int,
+	weights *domain.MatchWeights,
+) ([]domain.MatchedLead, error) {
+	const op = "lead.Service.MatchLeads"
+
+	if len(property.Embedding) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrPropertyHasNoEmbedding)
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+	if filter.Status == nil {
+		published := domain.LeadStatusPublished
+		filter.Status = &published
+	}
+
+	// Для ранжирования получаем больше кандидатов
+	fetchLimit := min(limit*5, 100)
+	hardFilters := domain.LeadHardFiltersFromProperty(property)
+
+	s.log.Debug("matching leads with hard filters",
+		slog.String("property_id", property.ID.String()),
+		slog.Any("hard_filters", hardFilters),
+	)
+
+	matches, err := s.repo.MatchLeads(ctx, property.Embedding, filter, &hardFilters, fetchLimit)
+	if err != nil {
+		s.log.Error("failed to match leads", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	w := domain.DefaultWeights()
+	if weights != nil {
+		w = weights.Normalize()
+	}
+
+	for i := range matches {
+		criteria := matches[i].Lead.Requirement.SoftCriteria()
+		scores := domain.ScoreMatch(property, matches[i].Similarity, w, criteria)
+
+		matches[i].TotalScore = &scores.Total
+		matches[i].PriceScore = &scores.Price
+		matches[i].DistrictScore = &scores.District
+		matches[i].RoomsScore = &scores.Rooms
+		matches[i].AreaScore = &scores.Area
+		matches[i].SemanticScore = &scores.Semantic
+		matches[i].VisualScore = &scores.Visual
+		matches[i].MatchExplanation = &scores.Explanation
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return *matches[i].TotalScore > *matches[j].TotalScore
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,84 @@
+package lead
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestService_MatchLeads(t *testing.T) {
+	price := int64(10_000_000)
+	rooms := int32(3)
+	city := "Москва"
+	property := domain.Property{
+		ID:        uuid.New(),
+		Address:   "Москва, Арбат",
+		City:      &city,
+		Price:     &price,
+		Rooms:     &rooms,
+		Embedding: []float32{1, 0},
+	}
+
+	// Лид с подходящими требованиями ранжируется выше лида с большей семантической близостью
+	fitting := domain.Lead{ID: uuid.New(), Requirement: mustParseRequirement(t, `{"version": 1, "budget_max": 10000000, "rooms_min": 3, "rooms_max": 3, "districts": ["Арбат"]}`)}
+	similar := domain.Lead{ID: uuid.New(), Requirement: mustParseRequirement(t, `{"version": 1, "budget_max": 20000000, "rooms_min": 1}`)}
+
+	var gotFilter domain.LeadFilter
+	var gotHard *domain.LeadHardFilters
+	repo := &MockLeadRepository{
+		MatchLeadsFunc: func(ctx context.Context, emb []float32, filter domain.LeadFilter, hf *domain.LeadHardFilters, limit int) ([]domain.MatchedLead, error) {
+			gotFilter, gotHard = filter, hf
+			return []domain.MatchedLead{
+				{Lead: similar, Similarity: 0.9},
+				{Lead: fitting, Similarity: 0.7},
+			}, nil
+		},
+	}
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, &MockMLClient{})
+
+	matches, err := svc.MatchLeads(context.Background(), property, domain.LeadFilter{}, 10, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotFilter.Status == nil || *gotFilter.Status != domain.LeadStatusPublished {
+		t.Error("expected matching to default to published leads")
+	}
+	if gotHard == nil || gotHard.City == nil || *gotHard.City != city || *gotHard.Price != price || *gotHard.Rooms != rooms {
+		t.Errorf("expected hard filters from property, got %+v", gotHard)
+	}
+
+	if len(matches) != 2 || matches[0].Lead.ID != fitting.ID {
+		t.Fatalf("expected fitting lead first, got %+v", matches)
+	}
+	if *matches[0].PriceScore != 1.0 || *matches[0].RoomsScore != 1.0 || *matches[0].DistrictScore != 1.0 {
+		t.Errorf("unexpected scores for fitting lead: price=%v rooms=%v district=%v",
+			*matches[0].PriceScore, *matches[0].RoomsScore, *matches[0].DistrictScore)
+	}
+	if matches[0].MatchExplanation == nil || *matches[0].MatchExplanation == "" {
+		t.Error("expected match explanation")
+	}
+}
+
+func TestService_MatchLeads_NoEmbedding(t *testing.T) {
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), &MockLeadRepository{}, &MockMLClient{})
+
+	_, err := svc.MatchLeads(context.Background(), domain.Property{ID: uuid.New()}, domain.LeadFilter{}, 10, nil)
+	if !errors.Is(err, ErrPropertyHasNoEmbedding) {
+		t.Errorf("expected ErrPropertyHasNoEmbedding, got %v", err)
+	}
+}
+
+func mustParseRequirement(t *testing.T, data string) domain.LeadRequirement {
+	t.Helper()
+	req, err := domain.ParseLeadRequirement([]byte(data))
+	if err != nil {
+		t.Fatalf("invalid requirement: %v", err)
+	}
+	return req
+}
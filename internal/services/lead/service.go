@@ -2,7 +2,6 @@ package lead
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"lead_exchange/internal/domain"
@@ -20,12 +19,15 @@ type LeadRepository interface {
 	UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error
 	ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error)
 	UpdateEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+	CreateContactReveal(ctx context.Context, reveal domain.LeadContactReveal) error
+	MatchLeads(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.LeadHardFilters, limit int) ([]domain.MatchedLead, error)
 }
 
 type Service struct {
 	log      *slog.Logger
 	repo     LeadRepository
 	mlClient ml.Client
+	feed     *Feed
 }
 
 var (
@@ -40,14 +42,29 @@ func New(log *slog.Logger, repo LeadRepository, mlClient ml.Client) *Service {
 	}
 }
 
-// CreateLead — создаёт нового лида и генерирует embedding.
+// NewWithFeed создаёт сервис с лентой событий лидов для SubscribeLeads.
+func NewWithFeed(log *slog.Logger, repo LeadRepository, mlClient ml.Client, feed *Feed) *Service {
+	s := New(log, repo, mlClient)
+	s.feed = feed
+	return s
+}
+
+// CreateLead — создаёт нового лида. Embedding генерирует воркер по заданию из outbox,
+// которое репозиторий ставит в той же транзакции.
 func (s *Service) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID, error) {
 	const op = "lead.Service.CreateLead"
 	log := s.log.With(slog.String("op", op), slog.String("title", lead.Title))
 
 	log.Info("creating new lead")
 
-	// Сначала сохраняем лид без embedding
+	if lead.Requirement.Version == 0 && lead.Requirement.IsEmpty() {
+		lead.Requirement = domain.NewLeadRequirement()
+	}
+	if err := lead.Requirement.Validate(); err != nil {
+		log.Warn("invalid lead requirement", sl.Err(err))
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.repo.CreateLead(ctx, lead)
 	if err != nil {
 		log.Error("failed to create lead", sl.Err(err))
@@ -56,61 +73,43 @@ func (s *Service) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID,
 
 	log.Info("lead created successfully", slog.String("lead_id", id.String()))
 
-	// Генерируем embedding асинхронно (в фоне)
-	go func() {
-		if err := s.generateAndUpdateEmbedding(context.Background(), id, lead); err != nil {
-			s.log.Error("failed to generate embedding", slog.String("lead_id", id.String()), sl.Err(err))
-		}
-	}()
-
 	return id, nil
 }
 
-// generateAndUpdateEmbedding генерирует embedding для лида и обновляет запись.
-func (s *Service) generateAndUpdateEmbedding(ctx context.Context, leadID uuid.UUID, lead domain.Lead) error {
-	const op = "lead.Service.generateAndUpdateEmbedding"
+// EmbedLead — генерирует embedding лида по актуальным данным. Вызывается воркером outbox.
+func (s *Service) EmbedLead(ctx context.Context, leadID uuid.UUID) error {
+	const op = "lead.Service.EmbedLead"
 
-	// Парсим requirement из JSON
-	var requirementMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirementMap); err != nil {
-			s.log.Warn("failed to parse requirement JSON", sl.Err(err))
-			requirementMap = nil
+	lead, err := s.repo.GetByID(ctx, leadID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeadNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrLeadNotFound)
 		}
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Извлекаем данные из requirement для ML сервиса
-	var price *int64
-	var district *string
-	var rooms *int32
-	var area *float64
-
-	if requirementMap != nil {
-		if p, ok := requirementMap["price"].(float64); ok {
-			priceVal := int64(p)
-			price = &priceVal
-		}
-		if d, ok := requirementMap["district"].(string); ok {
-			district = &d
-		}
-		if r, ok := requirementMap["roomNumber"].(float64); ok {
-			roomsVal := int32(r)
-			rooms = &roomsVal
-		}
-		if a, ok := requirementMap["area"].(float64); ok {
-			area = &a
-		}
+	if err := s.generateAndUpdateEmbedding(ctx, leadID, lead); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	return nil
+}
+
+// generateAndUpdateEmbedding генерирует embedding для лида и обновляет запись.
+func (s *Service) generateAndUpdateEmbedding(ctx context.Context, leadID uuid.UUID, lead domain.Lead) error {
+	const op = "lead.Service.generateAndUpdateEmbedding"
+
+	req := lead.Requirement
+
 	// Подготавливаем запрос к ML сервису
 	mlReq := ml.PrepareAndEmbedRequest{
 		Title:       lead.Title,
 		Description: lead.Description,
-		Requirement: requirementMap,
-		Price:       price,
-		District:    district,
-		Rooms:       rooms,
-		Area:        area,
+		Requirement: req.Map(),
+		Price:       req.TargetPrice(),
+		District:    req.TargetDistrict(),
+		Rooms:       req.TargetRooms(),
+		Area:        req.TargetArea(),
 	}
 
 	// Получаем embedding от ML сервиса
@@ -151,10 +150,17 @@ func (s *Service) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error
 	return lead, nil
 }
 
-// UpdateLead — частичное обновление данных лида.
+// UpdateLead — частичное обновление данных лида. Если изменились данные, влияющие на matching,
+// репозиторий ставит задание на пересчёт embedding в той же транзакции.
 func (s *Service) UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) (domain.Lead, error) {
 	const op = "lead.Service.UpdateLead"
 
+	if update.Requirement != nil {
+		if err := update.Requirement.Validate(); err != nil {
+			return domain.Lead{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	err := s.repo.UpdateLead(ctx, leadID, update)
 	if err != nil {
 		if errors.Is(err, repository.ErrLeadNotFound) {
@@ -168,15 +174,6 @@ func (s *Service) UpdateLead(ctx context.Context, leadID uuid.UUID, update domai
 		return domain.Lead{}, fmt.Errorf("%s: failed to fetch updated lead: %w", op, err)
 	}
 
-	// Переиндексируем embedding асинхронно, если изменились данные, влияющие на matching
-	if update.Title != nil || update.Description != nil || update.Requirement != nil {
-		go func() {
-			if err := s.reindexLead(context.Background(), leadID, updated); err != nil {
-				s.log.Error("failed to reindex lead", slog.String("lead_id", leadID.String()), sl.Err(err))
-			}
-		}()
-	}
-
 	return updated, nil
 }
 
@@ -199,56 +196,14 @@ func (s *Service) ReindexLead(ctx context.Context, leadID uuid.UUID) error {
 	return nil
 }
 
-// reindexLead переиндексирует embedding для лида после обновления.
+// reindexLead переиндексирует embedding лида через ML Reindex.
 func (s *Service) reindexLead(ctx context.Context, leadID uuid.UUID, lead domain.Lead) error {
 	const op = "lead.Service.reindexLead"
 
-	// Парсим requirement из JSON
-	var requirementMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirementMap); err != nil {
-			s.log.Warn("failed to parse requirement JSON", sl.Err(err))
-			requirementMap = nil
-		}
-	}
-
-	// Извлекаем данные из requirement для ML сервиса
-	var price *int64
-	var district *string
-	var rooms *int32
-	var area *float64
-
-	if requirementMap != nil {
-		if p, ok := requirementMap["price"].(float64); ok {
-			priceVal := int64(p)
-			price = &priceVal
-		}
-		if d, ok := requirementMap["district"].(string); ok {
-			district = &d
-		}
-		if r, ok := requirementMap["roomNumber"].(float64); ok {
-			roomsVal := int32(r)
-			rooms = &roomsVal
-		}
-		if a, ok := requirementMap["area"].(float64); ok {
-			area = &a
-		}
-	}
-
-	// Подготавливаем запрос к ML сервису для переиндексации
-	mlReq := ml.ReindexRequest{
-		EntityID:    leadID.String(),
-		EntityType:  "lead",
-		Title:       lead.Title,
-		Description: lead.Description,
-		Price:       price,
-		District:    district,
-		Rooms:       rooms,
-		Area:        area,
-	}
+	lead.ID = leadID
 
 	// Получаем новый embedding от ML сервиса
-	mlResp, err := s.mlClient.Reindex(ctx, mlReq)
+	mlResp, err := s.mlClient.Reindex(ctx, ReindexRequest(lead))
 	if err != nil {
 		return fmt.Errorf("%s: failed to reindex: %w", op, err)
 	}
@@ -268,6 +223,21 @@ func (s *Service) reindexLead(ctx context.Context, leadID uuid.UUID, lead domain
 	return nil
 }
 
+// ReindexRequest — запрос к ML сервису на переиндексацию лида (одиночную или в составе ReindexBatch).
+func ReindexRequest(lead domain.Lead) ml.ReindexRequest {
+	req := lead.Requirement
+	return ml.ReindexRequest{
+		EntityID:    lead.ID.String(),
+		EntityType:  domain.EmbeddingEntityLead.String(),
+		Title:       lead.Title,
+		Description: lead.Description,
+		Price:       req.TargetPrice(),
+		District:    req.TargetDistrict(),
+		Rooms:       req.TargetRooms(),
+		Area:        req.TargetArea(),
+	}
+}
+
 // ListLeads — возвращает лидов по фильтру с пагинацией.
 func (s *Service) ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
 	const op = "lead.Service.ListLeads"
@@ -280,3 +250,25 @@ func (s *Service) ListLeads(ctx context.Context, filter domain.LeadFilter) (*dom
 
 	return result, nil
 }
+
+// RecordContactReveal — фиксирует в аудите раскрытие контактов лида пользователем.
+func (s *Service) RecordContactReveal(ctx context.Context, leadID, userID uuid.UUID, reason domain.ContactAccessReason) error {
+	const op = "lead.Service.RecordContactReveal"
+
+	reveal := domain.LeadContactReveal{
+		LeadID: leadID,
+		UserID: userID,
+		Reason: reason,
+	}
+	if err := s.repo.CreateContactReveal(ctx, reveal); err != nil {
+		s.log.Error("failed to record contact reveal", slog.String("lead_id", leadID.String()), sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("lead contacts revealed",
+		slog.String("lead_id", leadID.String()),
+		slog.String("user_id", userID.String()),
+		slog.String("reason", reason.String()),
+	)
+	return nil
+}
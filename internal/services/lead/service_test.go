@@ -15,6 +15,7 @@ import (
 type MockLeadRepository struct {
 	GetByIDFunc         func(ctx context.Context, id uuid.UUID) (domain.Lead, error)
 	UpdateEmbeddingFunc func(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+	MatchLeadsFunc      func(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.LeadHardFilters, limit int) ([]domain.MatchedLead, error)
 	// other methods not needed for this test
 }
 
@@ -40,6 +41,17 @@ func (m *MockLeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UU
 	return nil
 }
 
+func (m *MockLeadRepository) CreateContactReveal(ctx context.Context, reveal domain.LeadContactReveal) error {
+	return nil
+}
+
+func (m *MockLeadRepository) MatchLeads(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.LeadHardFilters, limit int) ([]domain.MatchedLead, error) {
+	if m.MatchLeadsFunc != nil {
+		return m.MatchLeadsFunc(ctx, propertyEmbedding, filter, hardFilters, limit)
+	}
+	return nil, nil
+}
+
 // MockMLClient
 type MockMLClient struct {
 	ReindexFunc func(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error)
@@ -60,6 +72,9 @@ func (m *MockMLClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequ
 func (m *MockMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
 	return nil, nil
 }
+func (m *MockMLClient) Ping(ctx context.Context) error {
+	return nil
+}
 
 func TestService_ReindexLead(t *testing.T) {
 	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) (*domain.PaginatedResult[domain.Notification], error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int32, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, all bool) (int64, error)
+}
+
+type Service struct {
+	log  *slog.Logger
+	repo NotificationRepository
+}
+
+// ErrNothingToMark — не переданы ни ID уведомлений, ни флаг all.
+var ErrNothingToMark = errors.New("no notifications to mark as read")
+
+func New(log *slog.Logger, repo NotificationRepository) *Service {
+	return &Service{
+		log:  log,
+		repo: repo,
+	}
+}
+
+// ListNotifications — страница уведомлений пользователя и общее число непрочитанных.
+func (s *Service) ListNotifications(ctx context.Context, filter domain.NotificationFilter) (*domain.PaginatedResult[domain.Notification], int32, error) {
+	const op = "notification.Service.ListNotifications"
+
+	result, err := s.repo.ListNotifications(ctx, filter)
+	if err != nil {
+		s.log.Error("failed to list notifications", sl.Err(err))
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	unread, err := s.repo.CountUnread(ctx, filter.UserID)
+	if err != nil {
+		s.log.Error("failed to count unread notifications", sl.Err(err))
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, unread, nil
+}
+
+// MarkRead — отмечает прочитанными уведомления пользователя. Чужие ID игнорируются.
+func (s *Service) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, all bool) (int64, error) {
+	const op = "notification.Service.MarkRead"
+
+	if !all && len(ids) == 0 {
+		return 0, fmt.Errorf("%s: %w", op, ErrNothingToMark)
+	}
+
+	updated, err := s.repo.MarkRead(ctx, userID, ids, all)
+	if err != nil {
+		s.log.Error("failed to mark notifications as read", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
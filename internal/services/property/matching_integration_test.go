@@ -6,64 +6,48 @@ package property
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
+	"io"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/ml"
+	"log/slog"
 	"math"
-	"net/http"
+	"sync"
 	"testing"
 	"time"
-)
 
-const mlServiceURL = "https://calcifer0323-matching.hf.space"
+	"github.com/ilyakaznacheev/cleanenv"
+)
 
-// EmbeddingRequest - запрос на генерацию эмбеддинга
-type EmbeddingRequest struct {
-	Title       string `json:"title,omitempty"`
-	Description string `json:"description,omitempty"`
-}
+var (
+	mlClientOnce sync.Once
+	mlClient     ml.Client
+)
 
-// EmbeddingResponse - ответ с эмбеддингом
-type EmbeddingResponse struct {
-	Embedding    []float64 `json:"embedding"`
-	Dimensions   int       `json:"dimensions"`
-	PreparedText string    `json:"prepared_text"`
+// testMLClient возвращает ML клиент, настроенный переменными окружения ML_* так же, как у сервера.
+// По умолчанию это удалённый ML сервис; с ML_PROVIDER=hashing тесты матчинга идут без сети.
+func testMLClient() ml.Client {
+	mlClientOnce.Do(func() {
+		var cfg config.MLConfig
+		if err := cleanenv.ReadEnv(&cfg); err != nil {
+			panic("cannot read ML config from environment: " + err.Error())
+		}
+		cfg.Enabled = true
+		cfg.Timeout = 60 * time.Second
+		mlClient = ml.NewClient(cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	})
+	return mlClient
 }
 
-// getEmbedding получает эмбеддинг от реального ML сервиса
+// getEmbedding получает эмбеддинг от ML сервиса
 func getEmbedding(title, description string) ([]float64, error) {
-	req := EmbeddingRequest{
+	resp, err := testMLClient().PrepareAndEmbed(context.Background(), ml.PrepareAndEmbedRequest{
 		Title:       title,
 		Description: description,
-	}
-
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	httpReq, err := http.NewRequest(http.MethodPost, mlServiceURL+"/prepare-and-embed", bytes.NewBuffer(body))
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{Timeout: 60 * time.Second}
-	resp, err := client.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	var result EmbeddingResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return result.Embedding, nil
+	return resp.Embedding, nil
 }
 
 // cosineSimilarity вычисляет косинусное сходство между двумя векторами
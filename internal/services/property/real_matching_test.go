@@ -4,83 +4,19 @@
 package property
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"os"
+	"lead_exchange/internal/lib/ml"
 	"testing"
-	"time"
 )
 
-// MLServiceConfig содержит настройки для ML сервиса
-type MLServiceConfig struct {
-	BaseURL string
-	Timeout time.Duration
-}
-
-func getMLConfig() MLServiceConfig {
-	baseURL := os.Getenv("ML_BASE_URL")
-	if baseURL == "" {
-		baseURL = "https://calcifer0323-matching.hf.space"
-	}
-	return MLServiceConfig{
-		BaseURL: baseURL,
-		Timeout: 60 * time.Second,
-	}
-}
-
-// PrepareAndEmbedRequest - запрос к ML сервису
-type PrepareAndEmbedRequest struct {
-	Title       string   `json:"title,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Price       *int64   `json:"price,omitempty"`
-	Rooms       *int32   `json:"rooms,omitempty"`
-	Area        *float64 `json:"area,omitempty"`
-	Address     *string  `json:"address,omitempty"`
-}
-
-// PrepareAndEmbedResponse - ответ от ML сервиса
-type PrepareAndEmbedResponse struct {
-	Embedding    []float64 `json:"embedding"`
-	Dimensions   int       `json:"dimensions"`
-	PreparedText string    `json:"prepared_text"`
-}
-
-// callMLService вызывает реальный ML сервис для генерации эмбеддингов
-func callMLService(cfg MLServiceConfig, req PrepareAndEmbedRequest) (*PrepareAndEmbedResponse, error) {
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	httpReq, err := http.NewRequest(http.MethodPost, cfg.BaseURL+"/prepare-and-embed", bytes.NewBuffer(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
+// PrepareAndEmbedRequest — сокращение для ml.PrepareAndEmbedRequest в таблицах тестов ниже;
+// запрос уходит в клиент testMLClient — remote-сервис или локальный провайдер из ML_PROVIDER.
+type PrepareAndEmbedRequest = ml.PrepareAndEmbedRequest
 
-	client := &http.Client{Timeout: cfg.Timeout}
-	resp, err := client.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(respBody))
-	}
-
-	var result PrepareAndEmbedResponse
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &result, nil
+// callMLService генерирует эмбеддинг через ML клиент тестов (см. testMLClient)
+func callMLService(client ml.Client, req PrepareAndEmbedRequest) (*ml.PrepareAndEmbedResponse, error) {
+	return client.PrepareAndEmbed(context.Background(), req)
 }
 
 // TestMLServiceAvailability проверяет доступность ML сервиса
@@ -89,22 +25,12 @@ func TestMLServiceAvailability(t *testing.T) {
 		t.Skip("Skipping integration test in short mode")
 	}
 
-	cfg := getMLConfig()
-	t.Logf("Testing ML service at: %s", cfg.BaseURL)
-
-	// Проверяем health endpoint
-	resp, err := http.Get(cfg.BaseURL + "/health")
+	info, err := testMLClient().GetModelInfo(context.Background())
 	if err != nil {
 		t.Fatalf("ML service not available: %v", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("ML service health check failed: status=%d, body=%s", resp.StatusCode, string(body))
-	}
 
-	t.Log("✅ ML service is available")
+	t.Logf("✅ ML service is available: model=%s, dimensions=%d", info.Model, info.Dimensions)
 }
 
 // TestRealEmbeddingGeneration проверяет генерацию реальных эмбеддингов
@@ -113,7 +39,7 @@ func TestRealEmbeddingGeneration(t *testing.T) {
 		t.Skip("Skipping integration test in short mode")
 	}
 
-	cfg := getMLConfig()
+	cfg := testMLClient()
 	ctx := context.Background()
 	_ = ctx
 
@@ -173,7 +99,7 @@ func TestRealMatchingScenarios(t *testing.T) {
 		t.Skip("Skipping integration test in short mode")
 	}
 
-	cfg := getMLConfig()
+	cfg := testMLClient()
 
 	t.Log("╔══════════════════════════════════════════════════════════════════╗")
 	t.Log("║           РЕАЛЬНЫЕ ТЕСТЫ МАТЧИНГА НЕДВИЖИМОСТИ                   ║")
@@ -439,7 +365,7 @@ func TestEndToEndMatchingPipeline(t *testing.T) {
 		t.Skip("Skipping integration test in short mode")
 	}
 
-	cfg := getMLConfig()
+	cfg := testMLClient()
 
 	t.Log("╔══════════════════════════════════════════════════════════════════╗")
 	t.Log("║           END-TO-END ТЕСТ ПАЙПЛАЙНА МАТЧИНГА                     ║")
@@ -9,12 +9,14 @@ import (
 	"lead_exchange/internal/lib/logger/sl"
 	"lead_exchange/internal/lib/ml"
 	"lead_exchange/internal/lib/reranker"
+	"lead_exchange/internal/lib/tracing"
 	"lead_exchange/internal/repository"
 	"lead_exchange/internal/repository/property_repository"
 	"lead_exchange/internal/services/weights"
 	"log/slog"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type PropertyRepository interface {
@@ -84,14 +86,14 @@ func NewWithAdvancedSearch(
 	}
 }
 
-// CreateProperty — создаёт новый объект недвижимости и генерирует embedding.
+// CreateProperty — создаёт новый объект недвижимости. Embedding генерирует воркер по заданию
+// из outbox, которое репозиторий ставит в той же транзакции.
 func (s *Service) CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error) {
 	const op = "property.Service.CreateProperty"
 	log := s.log.With(slog.String("op", op), slog.String("title", property.Title))
 
 	log.Info("creating new property")
 
-	// Сначала сохраняем объект без embedding
 	id, err := s.repo.CreateProperty(ctx, property)
 	if err != nil {
 		log.Error("failed to create property", sl.Err(err))
@@ -100,14 +102,26 @@ func (s *Service) CreateProperty(ctx context.Context, property domain.Property)
 
 	log.Info("property created successfully", slog.String("property_id", id.String()))
 
-	// Генерируем embedding асинхронно (в фоне)
-	go func() {
-		if err := s.generateAndUpdateEmbedding(context.Background(), id, property); err != nil {
-			s.log.Error("failed to generate embedding", slog.String("property_id", id.String()), sl.Err(err))
+	return id, nil
+}
+
+// EmbedProperty — генерирует embedding объекта по актуальным данным. Вызывается воркером outbox.
+func (s *Service) EmbedProperty(ctx context.Context, propertyID uuid.UUID) error {
+	const op = "property.Service.EmbedProperty"
+
+	property, err := s.repo.GetByID(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
 		}
-	}()
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-	return id, nil
+	if err := s.generateAndUpdateEmbedding(ctx, propertyID, property); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
 }
 
 // generateAndUpdateEmbedding генерирует embedding для объекта недвижимости и обновляет запись.
@@ -162,7 +176,8 @@ func (s *Service) GetProperty(ctx context.Context, id uuid.UUID) (domain.Propert
 	return property, nil
 }
 
-// UpdateProperty — частичное обновление данных объекта недвижимости.
+// UpdateProperty — частичное обновление данных объекта недвижимости. Если изменились данные,
+// влияющие на matching, репозиторий ставит задание на пересчёт embedding в той же транзакции.
 func (s *Service) UpdateProperty(ctx context.Context, propertyID uuid.UUID, update domain.PropertyFilter) (domain.Property, error) {
 	const op = "property.Service.UpdateProperty"
 
@@ -179,16 +194,6 @@ func (s *Service) UpdateProperty(ctx context.Context, propertyID uuid.UUID, upda
 		return domain.Property{}, fmt.Errorf("%s: failed to fetch updated property: %w", op, err)
 	}
 
-	// Переиндексируем embedding асинхронно, если изменились данные, влияющие на matching
-	if update.Title != nil || update.Description != nil || update.Address != nil ||
-		update.Price != nil || update.Rooms != nil || update.Area != nil {
-		go func() {
-			if err := s.reindexProperty(context.Background(), propertyID, updated); err != nil {
-				s.log.Error("failed to reindex property", slog.String("property_id", propertyID.String()), sl.Err(err))
-			}
-		}()
-	}
-
 	return updated, nil
 }
 
@@ -211,24 +216,14 @@ func (s *Service) ReindexProperty(ctx context.Context, propertyID uuid.UUID) err
 	return nil
 }
 
-// reindexProperty переиндексирует embedding для объекта недвижимости после обновления.
+// reindexProperty переиндексирует embedding объекта через ML Reindex.
 func (s *Service) reindexProperty(ctx context.Context, propertyID uuid.UUID, property domain.Property) error {
 	const op = "property.Service.reindexProperty"
 
-	// Подготавливаем запрос к ML сервису для переиндексации
-	mlReq := ml.ReindexRequest{
-		EntityID:    propertyID.String(),
-		EntityType:  "property",
-		Title:       property.Title,
-		Description: property.Description,
-		Price:       property.Price,
-		Rooms:       property.Rooms,
-		Area:        property.Area,
-		Address:     &property.Address,
-	}
+	property.ID = propertyID
 
 	// Получаем новый embedding от ML сервиса
-	mlResp, err := s.mlClient.Reindex(ctx, mlReq)
+	mlResp, err := s.mlClient.Reindex(ctx, ReindexRequest(property))
 	if err != nil {
 		return fmt.Errorf("%s: failed to reindex: %w", op, err)
 	}
@@ -248,6 +243,20 @@ func (s *Service) reindexProperty(ctx context.Context, propertyID uuid.UUID, pro
 	return nil
 }
 
+// ReindexRequest — запрос к ML сервису на переиндексацию объекта (одиночную или в составе ReindexBatch).
+func ReindexRequest(property domain.Property) ml.ReindexRequest {
+	return ml.ReindexRequest{
+		EntityID:    property.ID.String(),
+		EntityType:  domain.EmbeddingEntityProperty.String(),
+		Title:       property.Title,
+		Description: property.Description,
+		Price:       property.Price,
+		Rooms:       property.Rooms,
+		Area:        property.Area,
+		Address:     &property.Address,
+	}
+}
+
 // ListProperties — возвращает объекты недвижимости по фильтру с пагинацией.
 func (s *Service) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
 	const op = "property.Service.ListProperties"
@@ -275,10 +284,22 @@ func (s *Service) MatchPropertiesAdvanced(
 	leadID uuid.UUID,
 	filter domain.PropertyFilter,
 	limit int,
-) ([]domain.MatchedProperty, error) {
+) (matches []domain.MatchedProperty, err error) {
 	const op = "property.Service.MatchPropertiesAdvanced"
 
-	lead, err := s.leadService.GetLead(ctx, leadID)
+	// Спан на весь матчинг и на каждый этап: загрузка лида, анализ весов, поиск, reranker, ранжирование
+	ctx, span := tracing.Start(ctx, "property.MatchPropertiesAdvanced",
+		attribute.String("lead_id", leadID.String()),
+		attribute.Int("limit", limit),
+	)
+	defer func() {
+		span.SetAttributes(attribute.Int("results", len(matches)))
+		tracing.End(span, err)
+	}()
+
+	stageCtx, stage := tracing.Start(ctx, "match.get_lead")
+	lead, err := s.leadService.GetLead(stageCtx, leadID)
+	tracing.End(stage, err)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to get lead: %w", op, err)
 	}
@@ -290,7 +311,9 @@ func (s *Service) MatchPropertiesAdvanced(
 	// Анализируем лид для динамических весов
 	var analysisResult *weights.AnalyzeResult
 	if s.weightsAnalyzer != nil && s.searchCfg.DynamicWeightsEnabled {
-		analysisResult, err = s.weightsAnalyzer.AnalyzeLead(ctx, lead)
+		stageCtx, stage := tracing.Start(ctx, "match.analyze_weights")
+		analysisResult, err = s.weightsAnalyzer.AnalyzeLead(stageCtx, lead)
+		tracing.End(stage, err)
 		if err != nil {
 			s.log.Warn("failed to analyze lead, using default weights",
 				slog.String("lead_id", leadID.String()),
@@ -326,14 +349,13 @@ func (s *Service) MatchPropertiesAdvanced(
 		candidateLimit = limit * 5
 	}
 
-	var matches []domain.MatchedProperty
-
 	// Выбираем стратегию поиска
 	if s.searchCfg.HybridSearchEnabled {
 		// Гибридный поиск (векторный + полнотекстовый)
 		searchQuery := lead.Title + " " + lead.Description
 
-		matches, err = s.repo.HybridSearch(ctx, property_repository.HybridSearchParams{
+		stageCtx, stage = tracing.Start(ctx, "match.hybrid_search", attribute.Int("candidate_limit", candidateLimit))
+		matches, err = s.repo.HybridSearch(stageCtx, property_repository.HybridSearchParams{
 			LeadEmbedding:  lead.Embedding,
 			SearchQuery:    searchQuery,
 			VectorWeight:   s.searchCfg.VectorWeight,
@@ -344,8 +366,11 @@ func (s *Service) MatchPropertiesAdvanced(
 		})
 	} else {
 		// Только векторный поиск
-		matches, err = s.repo.MatchPropertiesWithHardFilters(ctx, lead.Embedding, filter, hardFilters, candidateLimit)
+		stageCtx, stage = tracing.Start(ctx, "match.vector_search", attribute.Int("candidate_limit", candidateLimit))
+		matches, err = s.repo.MatchPropertiesWithHardFilters(stageCtx, lead.Embedding, filter, hardFilters, candidateLimit)
 	}
+	stage.SetAttributes(attribute.Int("candidates", len(matches)))
+	tracing.End(stage, err)
 
 	if err != nil {
 		s.log.Error("failed to search properties", sl.Err(err))
@@ -354,18 +379,24 @@ func (s *Service) MatchPropertiesAdvanced(
 
 	// Применяем реранкер если включен
 	if s.searchCfg.UseReranker && s.rerankerClient != nil && s.rerankerClient.IsEnabled() && len(matches) > 0 {
-		matches, err = s.applyReranker(ctx, lead, matches, limit)
+		stageCtx, stage := tracing.Start(ctx, "match.rerank", attribute.Int("candidates", len(matches)))
+		reranked, err := s.applyReranker(stageCtx, lead, matches, limit)
+		tracing.End(stage, err)
 		if err != nil {
 			s.log.Warn("reranker failed, using original ranking",
 				slog.String("lead_id", leadID.String()),
 				sl.Err(err),
 			)
+		} else {
+			matches = reranked
 		}
 	}
 
 	// Применяем взвешенное ранжирование
 	if len(matches) > 0 {
+		_, stage := tracing.Start(ctx, "match.rank")
 		matches = s.rankMatches(matches, matchWeights, softCriteria)
+		stage.End()
 	}
 
 	// Ограничиваем результаты
@@ -560,181 +591,15 @@ func (s *Service) rankMatches(matches []domain.MatchedProperty, w domain.MatchWe
 
 // calculateScores вычисляет все scores для одного матча.
 func (s *Service) calculateScores(m *domain.MatchedProperty, w domain.MatchWeights, criteria *domain.SoftCriteria) {
-	p := m.Property
-
-	// Semantic score (косинусная близость уже 0-1)
-	semantic := m.Similarity
-	if semantic < 0 {
-		semantic = (semantic + 1) / 2
-	}
-
-	// Price score
-	price := s.calcPriceScore(p.Price, criteria)
-
-	// District score
-	district := s.calcDistrictScore(p.Address, criteria)
-
-	// Rooms score
-	rooms := s.calcRoomsScore(p.Rooms, criteria)
-
-	// Area score
-	area := s.calcAreaScore(p.Area, criteria)
-
-	// Total weighted score
-	total := w.Price*price + w.District*district + w.Rooms*rooms + w.Area*area + w.Semantic*semantic
-
-	m.TotalScore = &total
-	m.PriceScore = &price
-	m.DistrictScore = &district
-	m.RoomsScore = &rooms
-	m.AreaScore = &area
-	m.SemanticScore = &semantic
-
-	// Генерируем объяснение
-	expl := s.generateExplanation(m)
-	m.MatchExplanation = &expl
-}
-
-func (s *Service) calcPriceScore(objPrice *int64, c *domain.SoftCriteria) float64 {
-	if objPrice == nil || c == nil || c.TargetPrice == nil {
-		return 0.5
-	}
-	target := float64(*c.TargetPrice)
-	price := float64(*objPrice)
-	if target == 0 {
-		return 0.5
-	}
-	dev := abs(price-target) / target * 100
-	if dev <= 20 {
-		return 1.0 - (dev/20)*0.3
-	}
-	return max(0.0, 0.7-(dev-20)/100*0.7)
-}
-
-func (s *Service) calcDistrictScore(address string, c *domain.SoftCriteria) float64 {
-	if c == nil || address == "" {
-		return 0.3
-	}
-	addrLower := toLower(address)
-	if c.TargetDistrict != nil {
-		if contains(addrLower, toLower(*c.TargetDistrict)) {
-			return 1.0
-		}
-	}
-	for _, pref := range c.PreferredDistricts {
-		if contains(addrLower, toLower(pref)) {
-			return 0.7
-		}
-	}
-	return 0.3
-}
-
-func (s *Service) calcRoomsScore(objRooms *int32, c *domain.SoftCriteria) float64 {
-	if objRooms == nil || c == nil || c.TargetRooms == nil {
-		return 0.5
-	}
-	diff := absInt(*objRooms - *c.TargetRooms)
-	switch diff {
-	case 0:
-		return 1.0
-	case 1:
-		return 0.6
-	case 2:
-		return 0.3
-	default:
-		return 0.1
-	}
-}
-
-func (s *Service) calcAreaScore(objArea *float64, c *domain.SoftCriteria) float64 {
-	if objArea == nil || c == nil || c.TargetArea == nil || *c.TargetArea == 0 {
-		return 0.5
-	}
-	dev := abs(*objArea-*c.TargetArea) / *c.TargetArea * 100
-	if dev <= 15 {
-		return 1.0 - (dev/15)*0.3
-	}
-	return max(0.0, 0.7-(dev-15)/50*0.7)
-}
-
-func (s *Service) generateExplanation(m *domain.MatchedProperty) string {
-	var parts []string
-	if m.PriceScore != nil && *m.PriceScore >= 0.7 && m.Property.Price != nil {
-		parts = append(parts, fmt.Sprintf("цена %d₽ подходит", *m.Property.Price))
-	}
-	if m.DistrictScore != nil && *m.DistrictScore >= 0.7 {
-		parts = append(parts, "район подходит")
-	}
-	if m.RoomsScore != nil && *m.RoomsScore >= 0.7 && m.Property.Rooms != nil {
-		parts = append(parts, fmt.Sprintf("%d комн.", *m.Property.Rooms))
-	}
-	if m.AreaScore != nil && *m.AreaScore >= 0.7 && m.Property.Area != nil {
-		parts = append(parts, fmt.Sprintf("%.0f м²", *m.Property.Area))
-	}
-	if m.SemanticScore != nil && *m.SemanticScore >= 0.6 {
-		parts = append(parts, "описание соответствует")
-	}
-	if len(parts) == 0 {
-		return "частичное совпадение"
-	}
-	result := parts[0]
-	for i := 1; i < len(parts); i++ {
-		result += "; " + parts[i]
-	}
-	return result
-}
-
-// Вспомогательные функции
-func abs(x float64) float64 {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
-func absInt(x int32) int32 {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
-func max(a, b float64) float64 {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-func toLower(s string) string {
-	result := make([]byte, len(s))
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 'A' && c <= 'Z' {
-			c += 32
-		}
-		result[i] = c
-	}
-	return string(result)
-}
-
-func contains(s, substr string) bool {
-	return len(substr) <= len(s) && findSubstring(s, substr) >= 0
-}
-
-func findSubstring(s, substr string) int {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		match := true
-		for j := 0; j < len(substr); j++ {
-			if s[i+j] != substr[j] {
-				match = false
-				break
-			}
-		}
-		if match {
-			return i
-		}
-	}
-	return -1
+	scores := domain.ScoreMatch(m.Property, m.Similarity, w, criteria)
+
+	m.TotalScore = &scores.Total
+	m.PriceScore = &scores.Price
+	m.DistrictScore = &scores.District
+	m.RoomsScore = &scores.Rooms
+	m.AreaScore = &scores.Area
+	m.SemanticScore = &scores.Semantic
+	m.VisualScore = &scores.Visual
+	m.MatchExplanation = &scores.Explanation
 }
 
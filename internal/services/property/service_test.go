@@ -2,6 +2,7 @@ package property
 
 import (
 	"context"
+	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/ml"
 	"lead_exchange/internal/repository/property_repository"
@@ -10,12 +11,16 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // MockPropertyRepository
 type MockPropertyRepository struct {
 	GetByIDFunc         func(ctx context.Context, id uuid.UUID) (domain.Property, error)
 	UpdateEmbeddingFunc func(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+	HybridSearchFunc    func(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error)
 }
 
 func (m *MockPropertyRepository) CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error) {
@@ -46,6 +51,9 @@ func (m *MockPropertyRepository) MatchPropertiesWithHardFilters(ctx context.Cont
 	return nil, nil
 }
 func (m *MockPropertyRepository) HybridSearch(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error) {
+	if m.HybridSearchFunc != nil {
+		return m.HybridSearchFunc(ctx, params)
+	}
 	return nil, nil
 }
 func (m *MockPropertyRepository) FulltextSearch(ctx context.Context, query string, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error) {
@@ -72,12 +80,17 @@ func (m *MockMLClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequ
 func (m *MockMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
 	return nil, nil
 }
+func (m *MockMLClient) Ping(ctx context.Context) error {
+	return nil
+}
 
 // MockLeadService
-type MockLeadService struct{}
+type MockLeadService struct {
+	Lead domain.Lead
+}
 
 func (m *MockLeadService) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
-	return domain.Lead{}, nil
+	return m.Lead, nil
 }
 
 func TestService_ReindexProperty(t *testing.T) {
@@ -129,133 +142,6 @@ func TestService_ReindexProperty(t *testing.T) {
 	}
 }
 
-// TestCalcPriceScore тестирует расчёт score по цене.
-func TestCalcPriceScore(t *testing.T) {
-	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	svc := &Service{log: log}
-
-	tests := []struct {
-		name        string
-		objPrice    *int64
-		targetPrice *int64
-		wantMin     float64
-		wantMax     float64
-	}{
-		{
-			name:        "exact match",
-			objPrice:    ptr[int64](10000000),
-			targetPrice: ptr[int64](10000000),
-			wantMin:     0.95,
-			wantMax:     1.0,
-		},
-		{
-			name:        "10% deviation",
-			objPrice:    ptr[int64](11000000),
-			targetPrice: ptr[int64](10000000),
-			wantMin:     0.8,
-			wantMax:     0.95,
-		},
-		{
-			name:        "30% deviation",
-			objPrice:    ptr[int64](13000000),
-			targetPrice: ptr[int64](10000000),
-			wantMin:     0.5,
-			wantMax:     0.75,
-		},
-		{
-			name:        "nil target",
-			objPrice:    ptr[int64](10000000),
-			targetPrice: nil,
-			wantMin:     0.5,
-			wantMax:     0.5,
-		},
-		{
-			name:        "nil object price",
-			objPrice:    nil,
-			targetPrice: ptr[int64](10000000),
-			wantMin:     0.5,
-			wantMax:     0.5,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var criteria *domain.SoftCriteria
-			if tt.targetPrice != nil {
-				criteria = &domain.SoftCriteria{TargetPrice: tt.targetPrice}
-			}
-			score := svc.calcPriceScore(tt.objPrice, criteria)
-			if score < tt.wantMin || score > tt.wantMax {
-				t.Errorf("calcPriceScore() = %v, want between %v and %v", score, tt.wantMin, tt.wantMax)
-			}
-		})
-	}
-}
-
-// TestCalcRoomsScore тестирует расчёт score по комнатам.
-func TestCalcRoomsScore(t *testing.T) {
-	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	svc := &Service{log: log}
-
-	tests := []struct {
-		name        string
-		objRooms    *int32
-		targetRooms *int32
-		want        float64
-	}{
-		{"exact match", ptr[int32](3), ptr[int32](3), 1.0},
-		{"diff 1", ptr[int32](3), ptr[int32](2), 0.6},
-		{"diff 2", ptr[int32](4), ptr[int32](2), 0.3},
-		{"diff 3+", ptr[int32](5), ptr[int32](1), 0.1},
-		{"nil target", ptr[int32](3), nil, 0.5},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var criteria *domain.SoftCriteria
-			if tt.targetRooms != nil {
-				criteria = &domain.SoftCriteria{TargetRooms: tt.targetRooms}
-			}
-			score := svc.calcRoomsScore(tt.objRooms, criteria)
-			if score != tt.want {
-				t.Errorf("calcRoomsScore() = %v, want %v", score, tt.want)
-			}
-		})
-	}
-}
-
-// TestCalcDistrictScore тестирует расчёт score по району.
-func TestCalcDistrictScore(t *testing.T) {
-	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	svc := &Service{log: log}
-
-	tests := []struct {
-		name    string
-		address string
-		target  *string
-		prefs   []string
-		want    float64
-	}{
-		{"exact match", "Центральный район", ptr[string]("Центральный"), nil, 1.0},
-		{"in preferred", "Арбат, Москва", nil, []string{"Арбат", "Тверской"}, 0.7},
-		{"no match", "Бирюлёво", ptr[string]("Центр"), []string{"Арбат"}, 0.3},
-		{"empty address", "", ptr[string]("Центр"), nil, 0.3},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			criteria := &domain.SoftCriteria{
-				TargetDistrict:     tt.target,
-				PreferredDistricts: tt.prefs,
-			}
-			score := svc.calcDistrictScore(tt.address, criteria)
-			if score != tt.want {
-				t.Errorf("calcDistrictScore() = %v, want %v", score, tt.want)
-			}
-		})
-	}
-}
-
 // TestRankMatches тестирует сортировку по взвешенному score.
 func TestRankMatches(t *testing.T) {
 	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
@@ -311,7 +197,7 @@ func TestWeightPresets(t *testing.T) {
 
 	for _, p := range presets {
 		w := p.Weights.Normalize()
-		sum := w.Price + w.District + w.Rooms + w.Area + w.Semantic
+		sum := w.Price + w.District + w.Rooms + w.Area + w.Semantic + w.Visual
 		if sum < 0.99 || sum > 1.01 {
 			t.Errorf("preset %s: normalized sum = %v, want ~1.0", p.ID, sum)
 		}
@@ -330,3 +216,50 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+
+func TestMatchPropertiesAdvanced_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prev)
+
+	lead := domain.Lead{ID: uuid.New(), Title: "Квартира", Embedding: []float32{0.1, 0.2}}
+	repo := &MockPropertyRepository{
+		HybridSearchFunc: func(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error) {
+			return []domain.MatchedProperty{{Property: domain.Property{ID: uuid.New()}, Similarity: 0.9}}, nil
+		},
+	}
+	svc := NewWithAdvancedSearch(
+		slog.New(slog.NewTextHandler(os.Stdout, nil)), repo, &MockMLClient{}, nil, nil,
+		&MockLeadService{Lead: lead}, config.SearchConfig{HybridSearchEnabled: true},
+	)
+
+	matches, err := svc.MatchPropertiesAdvanced(context.Background(), lead.ID, domain.PropertyFilter{}, 5)
+	if err != nil || len(matches) != 1 {
+		t.Fatalf("matches = %d, err = %v", len(matches), err)
+	}
+
+	spans := recorder.Ended()
+	byName := make(map[string]sdktrace.ReadOnlySpan, len(spans))
+	for _, span := range spans {
+		byName[span.Name()] = span
+	}
+
+	root, ok := byName["property.MatchPropertiesAdvanced"]
+	if !ok {
+		t.Fatalf("root span not recorded, got %d spans", len(spans))
+	}
+	for _, stage := range []string{"match.get_lead", "match.hybrid_search", "match.rank"} {
+		span, ok := byName[stage]
+		if !ok {
+			t.Errorf("stage %s not traced", stage)
+			continue
+		}
+		if span.Parent().SpanID() != root.SpanContext().SpanID() {
+			t.Errorf("stage %s is not a child of the matching span", stage)
+		}
+	}
+	if _, ok := byName["match.rerank"]; ok {
+		t.Error("rerank stage must not run without reranker")
+	}
+}
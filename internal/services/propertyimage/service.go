@@ -0,0 +1,236 @@
+package propertyimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	minio "lead_exchange/internal/lib/minio/core"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/property"
+)
+
+// MaxImages — сколько фотографий может быть в галерее одного объекта.
+const MaxImages = 30
+
+type ImageRepository interface {
+	AddImages(ctx context.Context, propertyID uuid.UUID, keys []string, maxImages int) ([]domain.PropertyImage, error)
+	ListByProperty(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error)
+	ListByProperties(ctx context.Context, propertyIDs []uuid.UUID) (map[uuid.UUID][]domain.PropertyImage, error)
+	Reorder(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error)
+	Delete(ctx context.Context, propertyID, imageID uuid.UUID) (domain.PropertyImage, error)
+	IsKeyReferenced(ctx context.Context, key string) (bool, error)
+}
+
+// UploadRepository — записи о загрузках: в галерею попадают только подтверждённые файлы их владельца.
+type UploadRepository interface {
+	GetByKey(ctx context.Context, key string) (domain.Upload, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Storage — хранилище файлов галереи (MinIO).
+type Storage interface {
+	Stat(ctx context.Context, key string) (domain.ObjectInfo, error)
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error)
+	Remove(ctx context.Context, key string) error
+}
+
+var (
+	ErrImageNotFound = errors.New("image not found")
+	// ErrObjectNotFound — ключа нет в хранилище: файл не загружен или уже удалён.
+	ErrObjectNotFound = errors.New("uploaded object not found")
+	// ErrUploadNotOwned — файл загружен другим пользователем.
+	ErrUploadNotOwned = errors.New("upload belongs to another user")
+	// ErrUploadNotCompleted — загрузка не подтверждена через CompleteUpload и не прошла проверку.
+	ErrUploadNotCompleted = errors.New("upload is not completed")
+	ErrTooManyImages      = fmt.Errorf("property gallery is limited to %d images", MaxImages)
+	ErrInvalidOrder       = errors.New("image order must list every image of the property exactly once")
+)
+
+// Service — галерея фотографий объекта. В БД хранятся ключи объектов MinIO,
+// ссылки на скачивание выдаются заново при каждом чтении и действуют urlTTL.
+// Права доступа проверяет декоратор authz.PropertyImageService.
+type Service struct {
+	log     *slog.Logger
+	repo    ImageRepository
+	uploads UploadRepository
+	storage Storage
+	urlTTL  time.Duration
+}
+
+func New(log *slog.Logger, repo ImageRepository, uploads UploadRepository, storage Storage, urlTTL time.Duration) *Service {
+	return &Service{
+		log:     log,
+		repo:    repo,
+		uploads: uploads,
+		storage: storage,
+		urlTTL:  urlTTL,
+	}
+}
+
+// AddImages — добавляет загруженные файлы в конец галереи. Каждый ключ должен быть
+// подтверждённой загрузкой пользователя userID и существовать в хранилище: так в галерею
+// не попадут чужие файлы и непроверенные оригиналы с EXIF.
+func (s *Service) AddImages(ctx context.Context, userID, propertyID uuid.UUID, keys []string) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.AddImages"
+	log := s.log.With(slog.String("op", op), slog.String("property_id", propertyID.String()))
+
+	for _, key := range keys {
+		u, err := s.uploads.GetByKey(ctx, key)
+		if err != nil {
+			if errors.Is(err, repository.ErrUploadNotFound) {
+				return nil, fmt.Errorf("%s: %s: %w", op, key, ErrObjectNotFound)
+			}
+			log.Error("failed to get upload", slog.String("key", key), sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if u.UserID != userID {
+			return nil, fmt.Errorf("%s: %s: %w", op, key, ErrUploadNotOwned)
+		}
+		if u.Status != domain.UploadCompleted {
+			return nil, fmt.Errorf("%s: %s: %w", op, key, ErrUploadNotCompleted)
+		}
+
+		if _, err := s.storage.Stat(ctx, key); err != nil {
+			if errors.Is(err, minio.ErrObjectNotFound) {
+				return nil, fmt.Errorf("%s: %s: %w", op, key, ErrObjectNotFound)
+			}
+			log.Error("failed to stat object", slog.String("key", key), sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	images, err := s.repo.AddImages(ctx, propertyID, keys, MaxImages)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+
+	log.Info("property images added", slog.Int("count", len(keys)), slog.Int("total", len(images)))
+
+	return s.withURLs(ctx, images)
+}
+
+// ListImages — галерея объекта со свежими ссылками.
+func (s *Service) ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.ListImages"
+
+	images, err := s.repo.ListByProperty(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	images, err = s.withURLs(ctx, images)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return images, nil
+}
+
+// ListImagesByProperties — галереи нескольких объектов (для списков и выдачи матчинга).
+func (s *Service) ListImagesByProperties(ctx context.Context, propertyIDs []uuid.UUID) (map[uuid.UUID][]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.ListImagesByProperties"
+
+	byProperty, err := s.repo.ListByProperties(ctx, propertyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for id, images := range byProperty {
+		if byProperty[id], err = s.withURLs(ctx, images); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return byProperty, nil
+}
+
+// ReorderImages — задаёт новый порядок галереи.
+func (s *Service) ReorderImages(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.ReorderImages"
+
+	images, err := s.repo.Reorder(ctx, propertyID, imageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+
+	return s.withURLs(ctx, images)
+}
+
+// DeleteImage — удаляет фотографию из галереи. Файл, его копии и запись о загрузке удаляются,
+// только если ключ больше не используется ни в одной галерее; ошибки удаления только логируются.
+func (s *Service) DeleteImage(ctx context.Context, propertyID, imageID uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.DeleteImage"
+	log := s.log.With(slog.String("op", op), slog.String("property_id", propertyID.String()))
+
+	deleted, err := s.repo.Delete(ctx, propertyID, imageID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+
+	referenced, err := s.repo.IsKeyReferenced(ctx, deleted.StorageKey)
+	switch {
+	case err != nil:
+		log.Warn("failed to check object references, object kept", slog.String("key", deleted.StorageKey), sl.Err(err))
+	case !referenced:
+		if err := s.storage.Remove(ctx, deleted.StorageKey); err != nil {
+			log.Warn("failed to remove object", slog.String("key", deleted.StorageKey), sl.Err(err))
+		}
+		// Копии разных размеров живут и умирают вместе с исходным файлом
+		for _, v := range deleted.Variants {
+			if v.Key == deleted.StorageKey {
+				continue
+			}
+			if err := s.storage.Remove(ctx, v.Key); err != nil {
+				log.Warn("failed to remove image variant", slog.String("key", v.Key), sl.Err(err))
+			}
+		}
+		// Без записи о загрузке ключ нельзя снова добавить в галерею, когда файла уже нет
+		if err := s.uploads.Delete(ctx, deleted.StorageKey); err != nil {
+			log.Warn("failed to delete upload record", slog.String("key", deleted.StorageKey), sl.Err(err))
+		}
+	}
+
+	log.Info("property image deleted", slog.String("image_id", imageID.String()))
+
+	return s.ListImages(ctx, propertyID)
+}
+
+// withURLs выдаёт каждой фотографии ссылку на скачивание.
+func (s *Service) withURLs(ctx context.Context, images []domain.PropertyImage) ([]domain.PropertyImage, error) {
+	for i := range images {
+		url, err := s.storage.PresignGet(ctx, images[i].StorageKey, s.urlTTL)
+		if err != nil {
+			return nil, err
+		}
+		images[i].URL = url
+
+		for j := range images[i].Variants {
+			url, err := s.storage.PresignGet(ctx, images[i].Variants[j].Key, s.urlTTL)
+			if err != nil {
+				return nil, err
+			}
+			images[i].Variants[j].URL = url
+		}
+	}
+	return images, nil
+}
+
+func mapRepoError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPropertyNotFound):
+		return property.ErrPropertyNotFound
+	case errors.Is(err, repository.ErrPropertyImageNotFound):
+		return ErrImageNotFound
+	case errors.Is(err, repository.ErrPropertyImageLimit):
+		return ErrTooManyImages
+	case errors.Is(err, repository.ErrPropertyImageOrder):
+		return ErrInvalidOrder
+	default:
+		return err
+	}
+}
@@ -0,0 +1,314 @@
+package propertyimage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	minio "lead_exchange/internal/lib/minio/core"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockImageRepository хранит галереи в памяти, как их упорядочивает property_images.position.
+// variants — копии файлов по ключу, как их возвращает join с uploads.
+type MockImageRepository struct {
+	images   map[uuid.UUID][]domain.PropertyImage
+	variants map[string][]domain.ImageVariant
+}
+
+func (m *MockImageRepository) AddImages(ctx context.Context, propertyID uuid.UUID, keys []string, maxImages int) ([]domain.PropertyImage, error) {
+	gallery := m.images[propertyID]
+	for _, key := range keys {
+		if slices.ContainsFunc(gallery, func(img domain.PropertyImage) bool { return img.StorageKey == key }) {
+			continue
+		}
+		gallery = append(gallery, domain.PropertyImage{
+			ID: uuid.New(), PropertyID: propertyID, StorageKey: key, Position: len(gallery),
+			Variants: slices.Clone(m.variants[key]),
+		})
+	}
+	if len(gallery) > maxImages {
+		return nil, repository.ErrPropertyImageLimit
+	}
+	m.images[propertyID] = gallery
+	return slices.Clone(gallery), nil
+}
+func (m *MockImageRepository) ListByProperty(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	return slices.Clone(m.images[propertyID]), nil
+}
+func (m *MockImageRepository) ListByProperties(ctx context.Context, propertyIDs []uuid.UUID) (map[uuid.UUID][]domain.PropertyImage, error) {
+	result := map[uuid.UUID][]domain.PropertyImage{}
+	for _, id := range propertyIDs {
+		if gallery, ok := m.images[id]; ok {
+			result[id] = slices.Clone(gallery)
+		}
+	}
+	return result, nil
+}
+func (m *MockImageRepository) Reorder(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error) {
+	gallery := m.images[propertyID]
+	if len(gallery) != len(imageIDs) {
+		return nil, repository.ErrPropertyImageOrder
+	}
+	reordered := make([]domain.PropertyImage, 0, len(gallery))
+	for pos, id := range imageIDs {
+		i := slices.IndexFunc(gallery, func(img domain.PropertyImage) bool { return img.ID == id })
+		if i < 0 {
+			return nil, repository.ErrPropertyImageOrder
+		}
+		img := gallery[i]
+		img.Position = pos
+		reordered = append(reordered, img)
+	}
+	m.images[propertyID] = reordered
+	return slices.Clone(reordered), nil
+}
+func (m *MockImageRepository) Delete(ctx context.Context, propertyID, imageID uuid.UUID) (domain.PropertyImage, error) {
+	gallery := m.images[propertyID]
+	i := slices.IndexFunc(gallery, func(img domain.PropertyImage) bool { return img.ID == imageID })
+	if i < 0 {
+		return domain.PropertyImage{}, repository.ErrPropertyImageNotFound
+	}
+	deleted := gallery[i]
+	gallery = slices.Delete(gallery, i, i+1)
+	for j := range gallery {
+		gallery[j].Position = j
+	}
+	m.images[propertyID] = gallery
+	return deleted, nil
+}
+func (m *MockImageRepository) IsKeyReferenced(ctx context.Context, key string) (bool, error) {
+	for _, gallery := range m.images {
+		for _, img := range gallery {
+			if img.StorageKey == key {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// MockStorage — бакет в памяти; ссылка на объект — "signed:<key>".
+type MockStorage struct {
+	objects map[string]bool
+	removed []string
+}
+
+func (m *MockStorage) Stat(ctx context.Context, key string) (domain.ObjectInfo, error) {
+	if !m.objects[key] {
+		return domain.ObjectInfo{}, minio.ErrObjectNotFound
+	}
+	return domain.ObjectInfo{Key: key}, nil
+}
+func (m *MockStorage) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return "signed:" + key, nil
+}
+func (m *MockStorage) Remove(ctx context.Context, key string) error {
+	m.removed = append(m.removed, key)
+	delete(m.objects, key)
+	return nil
+}
+
+// MockUploadRepository — записи uploads по ключу.
+type MockUploadRepository struct {
+	uploads map[string]domain.Upload
+}
+
+func (m *MockUploadRepository) GetByKey(ctx context.Context, key string) (domain.Upload, error) {
+	u, ok := m.uploads[key]
+	if !ok {
+		return domain.Upload{}, repository.ErrUploadNotFound
+	}
+	return u, nil
+}
+func (m *MockUploadRepository) Delete(ctx context.Context, key string) error {
+	delete(m.uploads, key)
+	return nil
+}
+
+// testUserID — владелец загрузок, созданных newTestService.
+var testUserID = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+
+// newTestService кладёт keys в хранилище как подтверждённые загрузки testUserID.
+func newTestService(keys ...string) (*Service, *MockImageRepository, *MockStorage) {
+	svc, repo, _, storage := newTestServiceWithUploads(keys...)
+	return svc, repo, storage
+}
+
+func newTestServiceWithUploads(keys ...string) (*Service, *MockImageRepository, *MockUploadRepository, *MockStorage) {
+	repo := &MockImageRepository{images: map[uuid.UUID][]domain.PropertyImage{}}
+	uploads := &MockUploadRepository{uploads: map[string]domain.Upload{}}
+	storage := &MockStorage{objects: map[string]bool{}}
+	for _, k := range keys {
+		storage.objects[k] = true
+		uploads.uploads[k] = domain.Upload{Key: k, UserID: testUserID, Status: domain.UploadCompleted}
+	}
+	svc := New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, uploads, storage, time.Hour)
+	return svc, repo, uploads, storage
+}
+
+func imageKeys(images []domain.PropertyImage) []string {
+	keys := make([]string, len(images))
+	for i, img := range images {
+		keys[i] = img.StorageKey
+	}
+	return keys
+}
+
+func TestService_AddImages(t *testing.T) {
+	propertyID := uuid.New()
+
+	tests := []struct {
+		name     string
+		uploaded []string
+		// other — загрузки другого пользователя, pending — неподтверждённые загрузки testUserID
+		other    []string
+		pending  []string
+		keys     []string
+		wantKeys []string
+		wantErr  error
+	}{
+		{name: "adds in order", uploaded: []string{"a", "b"}, keys: []string{"a", "b"}, wantKeys: []string{"a", "b"}},
+		{name: "skips duplicates", uploaded: []string{"a"}, keys: []string{"a", "a"}, wantKeys: []string{"a"}},
+		{name: "rejects missing object", uploaded: []string{"a"}, keys: []string{"a", "missing"}, wantErr: ErrObjectNotFound},
+		{name: "rejects another user's upload", uploaded: []string{"a"}, other: []string{"b"}, keys: []string{"a", "b"}, wantErr: ErrUploadNotOwned},
+		{name: "rejects pending upload", uploaded: []string{"a"}, pending: []string{"b"}, keys: []string{"a", "b"}, wantErr: ErrUploadNotCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, uploads, storage := newTestServiceWithUploads(tt.uploaded...)
+			for _, k := range tt.other {
+				storage.objects[k] = true
+				uploads.uploads[k] = domain.Upload{Key: k, UserID: uuid.New(), Status: domain.UploadCompleted}
+			}
+			for _, k := range tt.pending {
+				storage.objects[k] = true
+				uploads.uploads[k] = domain.Upload{Key: k, UserID: testUserID, Status: domain.UploadPending}
+			}
+
+			images, err := svc.AddImages(context.Background(), testUserID, propertyID, tt.keys)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if len(repo.images[propertyID]) != 0 {
+					t.Errorf("gallery = %v, want nothing added on error", imageKeys(repo.images[propertyID]))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := imageKeys(images); !slices.Equal(got, tt.wantKeys) {
+				t.Errorf("keys = %v, want %v", got, tt.wantKeys)
+			}
+			for _, img := range images {
+				if img.URL != "signed:"+img.StorageKey {
+					t.Errorf("image %s url = %q, want fresh presigned url", img.StorageKey, img.URL)
+				}
+			}
+		})
+	}
+}
+
+func TestService_AddImages_Limit(t *testing.T) {
+	keys := make([]string, MaxImages+1)
+	for i := range keys {
+		keys[i] = uuid.NewString()
+	}
+	svc, _, _ := newTestService(keys...)
+
+	if _, err := svc.AddImages(context.Background(), testUserID, uuid.New(), keys); !errors.Is(err, ErrTooManyImages) {
+		t.Errorf("expected ErrTooManyImages, got %v", err)
+	}
+}
+
+func TestService_ReorderAndDelete(t *testing.T) {
+	svc, _, uploads, storage := newTestServiceWithUploads("a", "b", "c")
+	ctx := context.Background()
+	propertyID, otherID := uuid.New(), uuid.New()
+
+	images, err := svc.AddImages(ctx, testUserID, propertyID, []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	// Тот же файл используется в галерее другого объекта
+	if _, err := svc.AddImages(ctx, testUserID, otherID, []string{"b"}); err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+
+	if _, err := svc.ReorderImages(ctx, propertyID, []uuid.UUID{images[0].ID}); !errors.Is(err, ErrInvalidOrder) {
+		t.Errorf("expected ErrInvalidOrder for partial order, got %v", err)
+	}
+
+	reordered, err := svc.ReorderImages(ctx, propertyID, []uuid.UUID{images[2].ID, images[0].ID, images[1].ID})
+	if err != nil {
+		t.Fatalf("reorder failed: %v", err)
+	}
+	if got := imageKeys(reordered); !slices.Equal(got, []string{"c", "a", "b"}) {
+		t.Errorf("order = %v, want [c a b]", got)
+	}
+
+	// b ещё в другой галерее — файл остаётся; c больше нигде не используется — удаляется
+	if _, err := svc.DeleteImage(ctx, propertyID, images[1].ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	remaining, err := svc.DeleteImage(ctx, propertyID, images[2].ID)
+	if err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if got := imageKeys(remaining); !slices.Equal(got, []string{"a"}) || remaining[0].Position != 0 {
+		t.Errorf("remaining = %+v, want [a] at position 0", remaining)
+	}
+	if !slices.Equal(storage.removed, []string{"c"}) {
+		t.Errorf("removed objects = %v, want [c]", storage.removed)
+	}
+	if _, ok := uploads.uploads["b"]; !ok {
+		t.Error("upload b is still referenced and must be kept")
+	}
+	if _, ok := uploads.uploads["c"]; ok {
+		t.Error("upload c must be deleted together with its object")
+	}
+
+	if _, err := svc.DeleteImage(ctx, propertyID, images[1].ID); !errors.Is(err, ErrImageNotFound) {
+		t.Errorf("expected ErrImageNotFound, got %v", err)
+	}
+}
+
+func TestService_ImageVariants(t *testing.T) {
+	svc, repo, storage := newTestService("a", "a/thumbnail.jpg", "a/card.webp")
+	ctx := context.Background()
+	propertyID := uuid.New()
+	repo.variants = map[string][]domain.ImageVariant{
+		"a": {
+			{Name: "full", Format: "jpeg", Key: "a"},
+			{Name: "thumbnail", Format: "jpeg", Key: "a/thumbnail.jpg"},
+			{Name: "card", Format: "webp", Key: "a/card.webp"},
+		},
+	}
+
+	images, err := svc.AddImages(ctx, testUserID, propertyID, []string{"a"})
+	if err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	for _, v := range images[0].Variants {
+		if v.URL != "signed:"+v.Key {
+			t.Errorf("variant %s url = %q", v.Key, v.URL)
+		}
+	}
+
+	// Вместе с файлом удаляются его копии
+	if _, err := svc.DeleteImage(ctx, propertyID, images[0].ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if want := []string{"a", "a/thumbnail.jpg", "a/card.webp"}; !slices.Equal(storage.removed, want) {
+		t.Errorf("removed objects = %v, want %v", storage.removed, want)
+	}
+}
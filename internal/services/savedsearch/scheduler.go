@@ -0,0 +1,62 @@
+package savedsearch
+
+import (
+	"context"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+// tickInterval — как часто планировщик проверяет очередь поисков.
+const tickInterval = time.Minute
+
+// Scheduler периодически перезапускает сохранённые поиски.
+// Поиски забираются из БД через ClaimDue, поэтому несколько экземпляров сервера не дублируют работу.
+type Scheduler struct {
+	log *slog.Logger
+	svc *Service
+	cfg config.SavedSearchConfig
+}
+
+func NewScheduler(log *slog.Logger, svc *Service, cfg config.SavedSearchConfig) *Scheduler {
+	return &Scheduler{log: log, svc: svc, cfg: cfg}
+}
+
+// Run обрабатывает сохранённые поиски до отмены ctx.
+func (sch *Scheduler) Run(ctx context.Context) {
+	const op = "savedsearch.Scheduler.Run"
+	log := sch.log.With(slog.String("op", op))
+
+	if !sch.cfg.Enabled {
+		log.Info("saved search scheduler is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(min(tickInterval, sch.cfg.Interval))
+	defer ticker.Stop()
+
+	for {
+		sch.drain(ctx, log)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain забирает поиски пачками, пока очередь не опустеет.
+func (sch *Scheduler) drain(ctx context.Context, log *slog.Logger) {
+	for ctx.Err() == nil {
+		n, err := sch.svc.RunDue(ctx, sch.cfg.Interval, sch.cfg.BatchSize)
+		if err != nil {
+			log.Error("failed to run saved searches", sl.Err(err))
+			return
+		}
+		if n < sch.cfg.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package savedsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/authz"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxLimit — максимальное количество объектов в выдаче сохранённого поиска.
+const maxLimit = 100
+
+type SavedSearchRepository interface {
+	CreateSavedSearch(ctx context.Context, s domain.SavedSearch) (domain.SavedSearch, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userID, searchID uuid.UUID) error
+	ClaimDue(ctx context.Context, before time.Time, limit int) ([]domain.SavedSearch, error)
+	SaveRunResults(ctx context.Context, searchID uuid.UUID, propertyIDs []uuid.UUID, notifications map[uuid.UUID]domain.Notification) (int, error)
+}
+
+// PropertySearcher — поиск объектов: по фильтру или матчингом по лиду.
+type PropertySearcher interface {
+	ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error)
+	MatchPropertiesWeighted(
+		ctx context.Context,
+		leadID uuid.UUID,
+		filter domain.PropertyFilter,
+		limit int,
+		weights *domain.MatchWeights,
+		criteria *domain.SoftCriteria,
+		useWeightedRanking bool,
+	) ([]domain.MatchedProperty, error)
+}
+
+type Service struct {
+	log        *slog.Logger
+	repo       SavedSearchRepository
+	properties PropertySearcher
+}
+
+var (
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	// ErrUnknownWeightPreset — указан несуществующий пресет весов.
+	ErrUnknownWeightPreset = errors.New("unknown weight preset")
+)
+
+func New(log *slog.Logger, repo SavedSearchRepository, properties PropertySearcher) *Service {
+	return &Service{
+		log:        log,
+		repo:       repo,
+		properties: properties,
+	}
+}
+
+// CreateSavedSearch — сохраняет поиск пользователя. Первый запуск планировщика
+// запоминает текущую выдачу, уведомления приходят только о новых объектах.
+func (s *Service) CreateSavedSearch(ctx context.Context, search domain.SavedSearch) (domain.SavedSearch, error) {
+	const op = "savedsearch.Service.CreateSavedSearch"
+
+	if search.WeightPreset != nil && domain.GetWeightPresetByID(*search.WeightPreset) == nil {
+		return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, ErrUnknownWeightPreset)
+	}
+	if search.Limit <= 0 {
+		search.Limit = domain.DefaultSavedSearchLimit
+	}
+	search.Limit = min(search.Limit, maxLimit)
+	search.Enabled = true
+
+	created, err := s.repo.CreateSavedSearch(ctx, search)
+	if err != nil {
+		s.log.Error("failed to create saved search", sl.Err(err))
+		return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("saved search created",
+		slog.String("saved_search_id", created.ID.String()),
+		slog.String("user_id", created.UserID.String()),
+	)
+	return created, nil
+}
+
+// ListSavedSearches — сохранённые поиски пользователя.
+func (s *Service) ListSavedSearches(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error) {
+	const op = "savedsearch.Service.ListSavedSearches"
+
+	searches, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to list saved searches", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return searches, nil
+}
+
+// DeleteSavedSearch — удаляет сохранённый поиск пользователя.
+func (s *Service) DeleteSavedSearch(ctx context.Context, userID, searchID uuid.UUID) error {
+	const op = "savedsearch.Service.DeleteSavedSearch"
+
+	if err := s.repo.DeleteSavedSearch(ctx, userID, searchID); err != nil {
+		if errors.Is(err, repository.ErrSavedSearchNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrSavedSearchNotFound)
+		}
+		s.log.Error("failed to delete saved search", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RunDue — запускает до batchSize поисков, не запускавшихся дольше interval.
+// Возвращает количество забранных поисков; ошибки отдельных поисков только логируются.
+func (s *Service) RunDue(ctx context.Context, interval time.Duration, batchSize int) (int, error) {
+	const op = "savedsearch.Service.RunDue"
+
+	searches, err := s.repo.ClaimDue(ctx, time.Now().Add(-interval), batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, search := range searches {
+		if _, err := s.RunSearch(ctx, search); err != nil {
+			s.log.Warn("saved search run failed",
+				slog.String("saved_search_id", search.ID.String()),
+				sl.Err(err),
+			)
+		}
+	}
+
+	return len(searches), nil
+}
+
+// RunSearch — выполняет поиск и создаёт уведомления о появившихся в выдаче объектах.
+// Пока исходная выдача не записана (BaselineAt == nil), выдача только запоминается:
+// неудачный первый запуск не превращает следующий в уведомление обо всех объектах.
+// Возвращает количество созданных уведомлений.
+func (s *Service) RunSearch(ctx context.Context, search domain.SavedSearch) (int, error) {
+	const op = "savedsearch.Service.RunSearch"
+
+	properties, err := s.search(ctx, search)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Планировщик работает без пользователя в контексте, поэтому видимость проверяется явно
+	sub := authz.Subject{UserID: search.UserID, Role: domain.UserRoleUser}
+	firstRun := search.BaselineAt == nil
+
+	ids := make([]uuid.UUID, 0, len(properties))
+	notifications := make(map[uuid.UUID]domain.Notification)
+	for _, p := range properties {
+		if !authz.CanViewProperty(sub, p) {
+			continue
+		}
+		ids = append(ids, p.ID)
+		if !firstRun {
+			notifications[p.ID] = newMatchNotification(search, p)
+		}
+	}
+
+	created, err := s.repo.SaveRunResults(ctx, search.ID, ids, notifications)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if created > 0 {
+		s.log.Info("saved search produced new matches",
+			slog.String("saved_search_id", search.ID.String()),
+			slog.Int("notifications", created),
+		)
+	}
+	return created, nil
+}
+
+// search — выдача сохранённого поиска: матчинг по лиду или выборка по фильтру.
+// Без явного статуса ищутся только опубликованные объекты.
+func (s *Service) search(ctx context.Context, search domain.SavedSearch) ([]domain.Property, error) {
+	filter := search.Filter
+	if filter.Status == nil {
+		published := domain.PropertyStatusPublished
+		filter.Status = &published
+	}
+
+	if search.LeadID == nil {
+		filter.Pagination = &domain.PaginationParams{PageSize: int32(search.Limit)}
+		result, err := s.properties.ListProperties(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		return result.Items, nil
+	}
+
+	var weights *domain.MatchWeights
+	if search.WeightPreset != nil {
+		if preset := domain.GetWeightPresetByID(*search.WeightPreset); preset != nil {
+			weights = &preset.Weights
+		}
+	}
+
+	matches, err := s.properties.MatchPropertiesWeighted(ctx, *search.LeadID, filter, search.Limit, weights, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make([]domain.Property, 0, len(matches))
+	for _, m := range matches {
+		properties = append(properties, m.Property)
+	}
+	return properties, nil
+}
+
+// matchPayload — данные уведомления о новом объекте по сохранённому поиску.
+type matchPayload struct {
+	SavedSearchID uuid.UUID  `json:"saved_search_id"`
+	PropertyID    uuid.UUID  `json:"property_id"`
+	LeadID        *uuid.UUID `json:"lead_id,omitempty"`
+}
+
+func newMatchNotification(search domain.SavedSearch, p domain.Property) domain.Notification {
+	payload, _ := json.Marshal(matchPayload{
+		SavedSearchID: search.ID,
+		PropertyID:    p.ID,
+		LeadID:        search.LeadID,
+	})
+
+	return domain.Notification{
+		UserID:  search.UserID,
+		Type:    domain.NotificationTypeSavedSearchMatch,
+		Title:   fmt.Sprintf("Новый объект по поиску «%s»", search.Name),
+		Body:    p.Title,
+		Payload: payload,
+	}
+}
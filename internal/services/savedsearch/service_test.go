@@ -0,0 +1,246 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockSavedSearchRepository хранит поиски, найденные объекты и созданные уведомления в памяти.
+type MockSavedSearchRepository struct {
+	searches      []domain.SavedSearch
+	hits          map[uuid.UUID]map[uuid.UUID]struct{}
+	notifications []domain.Notification
+	created       domain.SavedSearch
+}
+
+func newMockSavedSearchRepository() *MockSavedSearchRepository {
+	return &MockSavedSearchRepository{hits: map[uuid.UUID]map[uuid.UUID]struct{}{}}
+}
+
+func (m *MockSavedSearchRepository) CreateSavedSearch(ctx context.Context, s domain.SavedSearch) (domain.SavedSearch, error) {
+	s.ID = uuid.New()
+	m.created = s
+	return s, nil
+}
+func (m *MockSavedSearchRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error) {
+	return nil, nil
+}
+func (m *MockSavedSearchRepository) DeleteSavedSearch(ctx context.Context, userID, searchID uuid.UUID) error {
+	return nil
+}
+
+// ClaimDue, как и репозиторий, отмечает поиски запущенными до выполнения.
+func (m *MockSavedSearchRepository) ClaimDue(ctx context.Context, before time.Time, limit int) ([]domain.SavedSearch, error) {
+	claimed := make([]domain.SavedSearch, len(m.searches))
+	copy(claimed, m.searches)
+	now := time.Now()
+	for i := range m.searches {
+		m.searches[i].LastRunAt = &now
+	}
+	return claimed, nil
+}
+func (m *MockSavedSearchRepository) SaveRunResults(ctx context.Context, searchID uuid.UUID, propertyIDs []uuid.UUID, notifications map[uuid.UUID]domain.Notification) (int, error) {
+	seen, ok := m.hits[searchID]
+	if !ok {
+		seen = map[uuid.UUID]struct{}{}
+		m.hits[searchID] = seen
+	}
+	created := 0
+	for _, id := range propertyIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if n, ok := notifications[id]; ok {
+			m.notifications = append(m.notifications, n)
+			created++
+		}
+	}
+	for i := range m.searches {
+		if m.searches[i].ID == searchID && m.searches[i].BaselineAt == nil {
+			now := time.Now()
+			m.searches[i].BaselineAt = &now
+		}
+	}
+	return created, nil
+}
+
+// MockPropertySearcher возвращает заданный список объектов.
+type MockPropertySearcher struct {
+	properties []domain.Property
+	lastFilter domain.PropertyFilter
+	matched    bool
+	err        error
+}
+
+func (m *MockPropertySearcher) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
+	m.lastFilter = filter
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.PaginatedResult[domain.Property]{Items: m.properties}, nil
+}
+func (m *MockPropertySearcher) MatchPropertiesWeighted(
+	ctx context.Context,
+	leadID uuid.UUID,
+	filter domain.PropertyFilter,
+	limit int,
+	weights *domain.MatchWeights,
+	criteria *domain.SoftCriteria,
+	useWeightedRanking bool,
+) ([]domain.MatchedProperty, error) {
+	m.lastFilter = filter
+	m.matched = true
+	var matches []domain.MatchedProperty
+	for _, p := range m.properties {
+		matches = append(matches, domain.MatchedProperty{Property: p})
+	}
+	return matches, nil
+}
+
+func newTestService(repo *MockSavedSearchRepository, searcher *MockPropertySearcher) *Service {
+	return New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, searcher)
+}
+
+func TestService_RunSearch_NotifiesOnlyAboutNewMatches(t *testing.T) {
+	userID := uuid.New()
+	published := func() domain.Property {
+		return domain.Property{ID: uuid.New(), Title: "Квартира", Status: domain.PropertyStatusPublished, OwnerUserID: uuid.New()}
+	}
+	first, second := published(), published()
+
+	repo := newMockSavedSearchRepository()
+	searcher := &MockPropertySearcher{properties: []domain.Property{first}}
+	svc := newTestService(repo, searcher)
+	ctx := context.Background()
+
+	search := domain.SavedSearch{ID: uuid.New(), UserID: userID, Name: "Центр", Limit: 20}
+
+	// Первый запуск только запоминает текущую выдачу
+	created, err := svc.RunSearch(ctx, search)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created != 0 {
+		t.Errorf("expected no notifications on first run, got %d", created)
+	}
+	if searcher.lastFilter.Status == nil || *searcher.lastFilter.Status != domain.PropertyStatusPublished {
+		t.Error("expected search to default to published properties")
+	}
+
+	// Повторный запуск уведомляет только о новом объекте
+	now := time.Now()
+	search.LastRunAt, search.BaselineAt = &now, &now
+	searcher.properties = []domain.Property{first, second}
+
+	created, err = svc.RunSearch(ctx, search)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created != 1 || len(repo.notifications) != 1 {
+		t.Fatalf("expected one notification, got %d", len(repo.notifications))
+	}
+	n := repo.notifications[0]
+	if n.UserID != userID || n.Type != domain.NotificationTypeSavedSearchMatch {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+	if len(n.Payload) == 0 {
+		t.Error("expected notification payload")
+	}
+
+	// Без новых объектов уведомлений нет
+	if created, _ = svc.RunSearch(ctx, search); created != 0 {
+		t.Errorf("expected no notifications without new matches, got %d", created)
+	}
+}
+
+func TestService_RunSearch_SkipsInvisibleProperties(t *testing.T) {
+	userID := uuid.New()
+	foreignDraft := domain.Property{ID: uuid.New(), Status: domain.PropertyStatusNew, OwnerUserID: uuid.New()}
+	ownDraft := domain.Property{ID: uuid.New(), Status: domain.PropertyStatusNew, OwnerUserID: userID}
+
+	repo := newMockSavedSearchRepository()
+	searcher := &MockPropertySearcher{properties: []domain.Property{foreignDraft, ownDraft}}
+	svc := newTestService(repo, searcher)
+
+	leadID := uuid.New()
+	now := time.Now()
+	search := domain.SavedSearch{ID: uuid.New(), UserID: userID, LeadID: &leadID, LastRunAt: &now, BaselineAt: &now, Limit: 20}
+
+	if _, err := svc.RunSearch(context.Background(), search); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !searcher.matched {
+		t.Error("expected lead-based search to use matching")
+	}
+	if _, ok := repo.hits[search.ID][foreignDraft.ID]; ok {
+		t.Error("foreign NEW property must not be recorded")
+	}
+	if len(repo.notifications) != 1 || repo.notifications[0].Body != ownDraft.Title {
+		t.Errorf("expected notification only about own property, got %d", len(repo.notifications))
+	}
+}
+
+func TestService_RunDue_FailedFirstRunKeepsBaseline(t *testing.T) {
+	existing := domain.Property{ID: uuid.New(), Title: "Старый", Status: domain.PropertyStatusPublished, OwnerUserID: uuid.New()}
+	fresh := domain.Property{ID: uuid.New(), Title: "Новый", Status: domain.PropertyStatusPublished, OwnerUserID: uuid.New()}
+
+	repo := newMockSavedSearchRepository()
+	repo.searches = []domain.SavedSearch{{ID: uuid.New(), UserID: uuid.New(), Name: "Центр", Limit: 20, Enabled: true}}
+	searcher := &MockPropertySearcher{}
+	svc := newTestService(repo, searcher)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		searchErr         error
+		properties        []domain.Property
+		wantNotifications int
+	}{
+		// Поиск захвачен (last_run_at выставлен), но выдача не записана
+		{name: "first run fails", searchErr: errors.New("timeout"), properties: []domain.Property{existing}},
+		// Следующий запуск всё ещё первый: существующие объекты только запоминаются
+		{name: "retry records baseline", properties: []domain.Property{existing}},
+		{name: "new property is notified", properties: []domain.Property{existing, fresh}, wantNotifications: 1},
+	}
+
+	for _, tt := range tests {
+		searcher.err, searcher.properties = tt.searchErr, tt.properties
+		if _, err := svc.RunDue(ctx, time.Minute, 10); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if len(repo.notifications) != tt.wantNotifications {
+			t.Errorf("%s: notifications = %d, want %d", tt.name, len(repo.notifications), tt.wantNotifications)
+		}
+	}
+	if len(repo.notifications) == 1 && repo.notifications[0].Body != fresh.Title {
+		t.Errorf("notified about %q, want %q", repo.notifications[0].Body, fresh.Title)
+	}
+}
+
+func TestService_CreateSavedSearch(t *testing.T) {
+	repo := newMockSavedSearchRepository()
+	svc := newTestService(repo, &MockPropertySearcher{})
+	ctx := context.Background()
+
+	unknown := "unknown"
+	if _, err := svc.CreateSavedSearch(ctx, domain.SavedSearch{WeightPreset: &unknown}); !errors.Is(err, ErrUnknownWeightPreset) {
+		t.Errorf("expected ErrUnknownWeightPreset, got %v", err)
+	}
+
+	balanced := "balanced"
+	created, err := svc.CreateSavedSearch(ctx, domain.SavedSearch{Name: "Поиск", WeightPreset: &balanced, Limit: 500})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Limit != maxLimit || !created.Enabled {
+		t.Errorf("expected limit clamped to %d and search enabled, got %+v", maxLimit, created)
+	}
+}
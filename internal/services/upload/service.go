@@ -0,0 +1,418 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/imageproc"
+	"lead_exchange/internal/lib/logger/sl"
+	minio "lead_exchange/internal/lib/minio/core"
+	"lead_exchange/internal/repository"
+)
+
+// sniffLen — сколько первых байт файла нужно для определения типа (http.DetectContentType).
+const sniffLen = 512
+
+type Repository interface {
+	Create(ctx context.Context, u domain.Upload) (domain.Upload, error)
+	GetByKey(ctx context.Context, key string) (domain.Upload, error)
+	Complete(ctx context.Context, key, contentType string, size int64, variants []domain.ImageVariant) (domain.Upload, error)
+	Delete(ctx context.Context, key string) error
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]string, error)
+}
+
+// Storage — хранилище загружаемых файлов (MinIO).
+type Storage interface {
+	PresignPut(ctx context.Context, key, contentType string, size int64, ttl time.Duration) (string, error)
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	ReadHead(ctx context.Context, key string, n int64) ([]byte, error)
+	Get(ctx context.Context, key string) ([]byte, error)
+	Stat(ctx context.Context, key string) (domain.ObjectInfo, error)
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error)
+	Remove(ctx context.Context, key string) error
+}
+
+var (
+	ErrUnsupportedType = errors.New("unsupported content type")
+	ErrInvalidSize     = errors.New("file size does not match the declared size")
+	ErrFileTooLarge    = errors.New("file is too large")
+	ErrUploadNotFound  = errors.New("upload not found")
+	// ErrUploadExpired — ссылка на загрузку истекла до подтверждения.
+	ErrUploadExpired = errors.New("upload url has expired")
+	// ErrNotUploaded — ссылка выдана, но файла в хранилище ещё нет.
+	ErrNotUploaded = errors.New("file has not been uploaded yet")
+	// ErrContentMismatch — содержимое файла не является изображением допустимого типа.
+	ErrContentMismatch = errors.New("file content is not an allowed image type")
+	// ErrInvalidImage — файл похож на изображение по сигнатуре, но не декодируется или слишком велик в пикселях.
+	ErrInvalidImage = errors.New("file is not a valid image")
+)
+
+// Service — загрузка файлов мимо unary gRPC: presigned PUT с последующим подтверждением
+// или поток. Каждая загрузка регистрируется в uploads; подтверждённые файлы проверены
+// по содержимому (тип) и размеру и обработаны imageproc: исходный файл заменяется
+// перекодированной копией без EXIF, рядом кладутся копии других размеров и WebP.
+type Service struct {
+	log        *slog.Logger
+	repo       Repository
+	storage    Storage
+	maxSize    int64
+	uploadTTL  time.Duration
+	presignTTL time.Duration
+	// processing — семафор обработки изображений: файл читается в память и декодируется только под ним
+	processing chan struct{}
+}
+
+func New(log *slog.Logger, repo Repository, storage Storage, cfg config.MinioConfig) *Service {
+	return &Service{
+		log:        log,
+		repo:       repo,
+		storage:    storage,
+		maxSize:    cfg.UploadMaxSize,
+		uploadTTL:  cfg.UploadURLTTL,
+		presignTTL: cfg.PresignTTL,
+		processing: make(chan struct{}, max(cfg.UploadProcessConcurrency, 1)),
+	}
+}
+
+// CreateUploadURL — выдаёт ссылку на загрузку файла заявленного типа (jpeg, png, webp) и размера.
+func (s *Service) CreateUploadURL(ctx context.Context, userID uuid.UUID, fileName, contentType string, size int64) (domain.UploadTicket, error) {
+	const op = "upload.Service.CreateUploadURL"
+
+	mime, err := s.checkDeclared(contentType, size)
+	if err != nil {
+		return domain.UploadTicket{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key := uuid.New().String()
+	url, err := s.storage.PresignPut(ctx, key, mime, size, s.uploadTTL)
+	if err != nil {
+		return domain.UploadTicket{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	upload, err := s.repo.Create(ctx, domain.Upload{
+		Key:         key,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: mime,
+		Size:        size,
+		Status:      domain.UploadPending,
+		ExpiresAt:   time.Now().Add(s.uploadTTL),
+	})
+	if err != nil {
+		return domain.UploadTicket{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return domain.UploadTicket{
+		Upload: upload,
+		URL:    url,
+		Headers: map[string]string{
+			"Content-Type":   mime,
+			"Content-Length": strconv.FormatInt(size, 10),
+		},
+	}, nil
+}
+
+// CompleteUpload — подтверждает загрузку по ссылке: ссылка не должна истечь, а файл должен быть
+// в хранилище, совпадать с заявленными размером и типом (по содержимому) и быть изображением.
+// Файл, не прошедший проверку, удаляется. Подтвердить можно только свою загрузку;
+// повторный вызов возвращает тот же результат.
+func (s *Service) CompleteUpload(ctx context.Context, userID uuid.UUID, key string) (domain.StoredFile, error) {
+	const op = "upload.Service.CompleteUpload"
+	log := s.log.With(slog.String("op", op), slog.String("key", key))
+
+	upload, err := s.repo.GetByKey(ctx, key)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+	// Чужая загрузка неотличима от несуществующей
+	if upload.UserID != userID {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, ErrUploadNotFound)
+	}
+	if upload.Status == domain.UploadCompleted {
+		return s.storedFile(ctx, upload)
+	}
+	// Просроченную загрузку удалит SweepExpired; подтверждать её нельзя
+	if time.Now().After(upload.ExpiresAt) {
+		s.reject(ctx, log, key)
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, ErrUploadExpired)
+	}
+
+	info, err := s.storage.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, minio.ErrObjectNotFound) {
+			return domain.StoredFile{}, fmt.Errorf("%s: %w", op, ErrNotUploaded)
+		}
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if info.Size > s.maxSize {
+		s.reject(ctx, log, key)
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, ErrFileTooLarge)
+	}
+	if info.Size != upload.Size {
+		s.reject(ctx, log, key)
+		return domain.StoredFile{}, fmt.Errorf("%s: %d != %d bytes: %w", op, info.Size, upload.Size, ErrInvalidSize)
+	}
+
+	head, err := s.storage.ReadHead(ctx, key, sniffLen)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if mime, ok := sniff(head); !ok || mime != upload.ContentType {
+		s.reject(ctx, log, key)
+		return domain.StoredFile{}, fmt.Errorf("%s: %s: %w", op, mime, ErrContentMismatch)
+	}
+
+	renditions, err := s.process(ctx, func() ([]byte, error) { return s.storage.Get(ctx, key) })
+	if err != nil {
+		if errors.Is(err, ErrInvalidImage) {
+			s.reject(ctx, log, key)
+		}
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+	primary, variants, err := s.store(ctx, log, key, renditions)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	upload, err = s.repo.Complete(ctx, key, primary.ContentType, primary.Size, variants)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, mapRepoError(err))
+	}
+
+	return s.storedFile(ctx, upload)
+}
+
+// Upload — загружает файл из потока r, в котором должно быть ровно size байт.
+// Тип определяется по первым байтам; в хранилище попадают только обработанные копии.
+func (s *Service) Upload(ctx context.Context, userID uuid.UUID, fileName, contentType string, size int64, r io.Reader) (domain.StoredFile, error) {
+	const op = "upload.Service.Upload"
+
+	declared, err := s.checkDeclared(contentType, size)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	head := make([]byte, min(size, sniffLen))
+	if _, err := io.ReadFull(r, head); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return domain.StoredFile{}, fmt.Errorf("%s: %w", op, ErrInvalidSize)
+		}
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if mime, ok := sniff(head); !ok || mime != declared {
+		return domain.StoredFile{}, fmt.Errorf("%s: %s: %w", op, mime, ErrContentMismatch)
+	}
+
+	// Поток сохраняется во временный файл, а не в память: ожидающие обработки загрузки
+	// не держат файлы в памяти, пока заняты все слоты семафора
+	tmp, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	// Лишний байт сверх заявленного размера означает, что файл не тот, что заявлен
+	n, err := io.Copy(tmp, io.LimitReader(io.MultiReader(bytes.NewReader(head), r), size+1))
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if n != size {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, ErrInvalidSize)
+	}
+
+	renditions, err := s.process(ctx, func() ([]byte, error) { return os.ReadFile(tmp.Name()) })
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	key := uuid.New().String()
+	log := s.log.With(slog.String("op", op), slog.String("key", key))
+
+	primary, variants, err := s.store(ctx, log, key, renditions)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	upload, err := s.repo.Create(ctx, domain.Upload{
+		Key:         key,
+		UserID:      userID,
+		FileName:    fileName,
+		ContentType: primary.ContentType,
+		Size:        primary.Size,
+		Status:      domain.UploadCompleted,
+		ExpiresAt:   time.Now(),
+		Variants:    variants,
+	})
+	if err != nil {
+		for _, v := range variants {
+			s.removeObject(ctx, log, v.Key)
+		}
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.storedFile(ctx, upload)
+}
+
+// process читает файл через read и строит его копии, заняв слот семафора обработки.
+// Ошибка декодирования возвращается как ErrInvalidImage.
+func (s *Service) process(ctx context.Context, read func() ([]byte, error)) ([]imageproc.Rendition, error) {
+	select {
+	case s.processing <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-s.processing }()
+
+	data, err := read()
+	if err != nil {
+		return nil, err
+	}
+	renditions, err := imageproc.Process(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	return renditions, nil
+}
+
+// store кладёт копии изображения в хранилище под ключами, производными от key, и возвращает
+// основную копию и список всех копий. Основная копия записывается последней: до этого под key
+// остаётся исходный файл, и при ошибке удаляются только уже записанные копии.
+func (s *Service) store(ctx context.Context, log *slog.Logger, key string, renditions []imageproc.Rendition) (domain.ImageVariant, []domain.ImageVariant, error) {
+	variants := make([]domain.ImageVariant, 0, len(renditions))
+	var primary imageproc.Rendition
+	var primaryVariant domain.ImageVariant
+	for _, r := range renditions {
+		variants = append(variants, domain.ImageVariant{
+			Name:        r.Size,
+			Format:      r.Format,
+			Key:         r.Key(key),
+			ContentType: r.ContentType,
+			Width:       r.Width,
+			Height:      r.Height,
+			Size:        int64(len(r.Data)),
+		})
+		if r.Primary {
+			primary, primaryVariant = r, variants[len(variants)-1]
+		}
+	}
+
+	var written []string
+	put := func(r imageproc.Rendition) error {
+		if err := s.storage.Put(ctx, r.Key(key), bytes.NewReader(r.Data), int64(len(r.Data)), r.ContentType); err != nil {
+			for _, k := range written {
+				s.removeObject(ctx, log, k)
+			}
+			return err
+		}
+		written = append(written, r.Key(key))
+		return nil
+	}
+	for _, r := range renditions {
+		if !r.Primary {
+			if err := put(r); err != nil {
+				return domain.ImageVariant{}, nil, err
+			}
+		}
+	}
+	if err := put(primary); err != nil {
+		return domain.ImageVariant{}, nil, err
+	}
+
+	return primaryVariant, variants, nil
+}
+
+// checkDeclared проверяет заявленные тип и размер и возвращает MIME-тип.
+func (s *Service) checkDeclared(contentType string, size int64) (string, error) {
+	mime, ok := domain.UploadContentTypes[contentType]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", contentType, ErrUnsupportedType)
+	}
+	if size <= 0 {
+		return "", ErrInvalidSize
+	}
+	if size > s.maxSize {
+		return "", fmt.Errorf("%d > %d bytes: %w", size, s.maxSize, ErrFileTooLarge)
+	}
+	return mime, nil
+}
+
+func (s *Service) storedFile(ctx context.Context, u domain.Upload) (domain.StoredFile, error) {
+	const op = "upload.Service.storedFile"
+
+	url, err := s.storage.PresignGet(ctx, u.Key, s.presignTTL)
+	if err != nil {
+		return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	variants := make([]domain.ImageVariant, 0, len(u.Variants))
+	for _, v := range u.Variants {
+		v.URL, err = s.storage.PresignGet(ctx, v.Key, s.presignTTL)
+		if err != nil {
+			return domain.StoredFile{}, fmt.Errorf("%s: %w", op, err)
+		}
+		variants = append(variants, v)
+	}
+
+	return domain.StoredFile{Key: u.Key, URL: url, ContentType: u.ContentType, Size: u.Size, Variants: variants}, nil
+}
+
+// reject удаляет файл, не прошедший проверку, вместе с записью о загрузке.
+func (s *Service) reject(ctx context.Context, log *slog.Logger, key string) {
+	s.removeObject(ctx, log, key)
+	if err := s.repo.Delete(ctx, key); err != nil {
+		log.Warn("failed to delete upload", sl.Err(err))
+	}
+}
+
+func (s *Service) removeObject(ctx context.Context, log *slog.Logger, key string) {
+	if err := s.storage.Remove(ctx, key); err != nil {
+		log.Warn("failed to remove object", sl.Err(err))
+	}
+}
+
+// sniff определяет MIME-тип по содержимому; ok — тип входит в domain.UploadContentTypes.
+func sniff(head []byte) (string, bool) {
+	mime := http.DetectContentType(head)
+	for _, allowed := range domain.UploadContentTypes {
+		if mime == allowed {
+			return mime, true
+		}
+	}
+	return mime, false
+}
+
+// DetectType — имя допустимого типа (ключ domain.UploadContentTypes) по содержимому файла.
+func DetectType(data []byte) (string, bool) {
+	mime, ok := sniff(data)
+	if !ok {
+		return "", false
+	}
+	for name, allowed := range domain.UploadContentTypes {
+		if mime == allowed {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func mapRepoError(err error) error {
+	if errors.Is(err, repository.ErrUploadNotFound) {
+		return ErrUploadNotFound
+	}
+	return err
+}
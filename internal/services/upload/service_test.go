@@ -0,0 +1,469 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	minio "lead_exchange/internal/lib/minio/core"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"reflect"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockRepository хранит загрузки в памяти.
+type MockRepository struct {
+	mu      sync.Mutex
+	uploads map[string]domain.Upload
+}
+
+func (m *MockRepository) Create(ctx context.Context, u domain.Upload) (domain.Upload, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u.ID = uuid.New()
+	u.CreatedAt = time.Now()
+	if u.Status == domain.UploadCompleted {
+		now := time.Now()
+		u.CompletedAt = &now
+	}
+	m.uploads[u.Key] = u
+	return u, nil
+}
+func (m *MockRepository) GetByKey(ctx context.Context, key string) (domain.Upload, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.uploads[key]
+	if !ok {
+		return domain.Upload{}, repository.ErrUploadNotFound
+	}
+	return u, nil
+}
+func (m *MockRepository) Complete(ctx context.Context, key, contentType string, size int64, variants []domain.ImageVariant) (domain.Upload, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.uploads[key]
+	if !ok {
+		return domain.Upload{}, repository.ErrUploadNotFound
+	}
+	if u.Status == domain.UploadPending {
+		now := time.Now()
+		u.Status, u.ContentType, u.Size, u.CompletedAt = domain.UploadCompleted, contentType, size, &now
+		u.Variants = variants
+		m.uploads[key] = u
+	}
+	return u, nil
+}
+func (m *MockRepository) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.uploads, key)
+	return nil
+}
+
+func (m *MockRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key, u := range m.uploads {
+		if u.Status == domain.UploadPending && u.ExpiresAt.Before(before) && len(keys) < limit {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// MockStorage — бакет в памяти; presigned PUT имитируется методом upload.
+type MockStorage struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	signed  map[string]string
+}
+
+func (m *MockStorage) PresignPut(ctx context.Context, key, contentType string, size int64, ttl time.Duration) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.signed[key] = contentType
+	return "https://minio.test/put/" + key, nil
+}
+func (m *MockStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return fmt.Errorf("read %d bytes, want %d", len(data), size)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = data
+	return nil
+}
+func (m *MockStorage) ReadHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, minio.ErrObjectNotFound
+	}
+	return data[:min(int64(len(data)), n)], nil
+}
+func (m *MockStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, minio.ErrObjectNotFound
+	}
+	return data, nil
+}
+func (m *MockStorage) Stat(ctx context.Context, key string) (domain.ObjectInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return domain.ObjectInfo{}, minio.ErrObjectNotFound
+	}
+	return domain.ObjectInfo{Key: key, Size: int64(len(data))}, nil
+}
+func (m *MockStorage) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return "https://minio.test/get/" + key, nil
+}
+func (m *MockStorage) Remove(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+// upload кладёт файл в бакет, как это сделал бы клиент по presigned-ссылке.
+func (m *MockStorage) upload(key string, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = data
+}
+
+func newTestService(maxSize int64) (*Service, *MockRepository, *MockStorage) {
+	repo := &MockRepository{uploads: map[string]domain.Upload{}}
+	storage := &MockStorage{objects: map[string][]byte{}, signed: map[string]string{}}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	cfg := config.MinioConfig{UploadMaxSize: maxSize, UploadURLTTL: 15 * time.Minute, PresignTTL: time.Hour}
+	return New(log, repo, storage, cfg), repo, storage
+}
+
+func pngImage(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestService_CreateAndCompleteUpload(t *testing.T) {
+	svc, repo, storage := newTestService(1 << 20)
+	userID := uuid.New()
+	data := pngImage(t)
+
+	ticket, err := svc.CreateUploadURL(context.Background(), userID, "photo.png", "png", int64(len(data)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ticket.Status != domain.UploadPending || ticket.URL == "" {
+		t.Errorf("ticket = %+v, want PENDING with url", ticket)
+	}
+	if ticket.Headers["Content-Type"] != "image/png" || ticket.Headers["Content-Length"] != fmt.Sprint(len(data)) {
+		t.Errorf("Headers = %v", ticket.Headers)
+	}
+	if storage.signed[ticket.Key] != "image/png" {
+		t.Errorf("signed content type = %q, want image/png", storage.signed[ticket.Key])
+	}
+
+	// До загрузки подтверждать нечего
+	if _, err := svc.CompleteUpload(context.Background(), userID, ticket.Key); !errors.Is(err, ErrNotUploaded) {
+		t.Errorf("err = %v, want ErrNotUploaded", err)
+	}
+
+	storage.upload(ticket.Key, data)
+
+	// Чужую загрузку подтвердить нельзя
+	if _, err := svc.CompleteUpload(context.Background(), uuid.New(), ticket.Key); !errors.Is(err, ErrUploadNotFound) {
+		t.Errorf("other user: err = %v, want ErrUploadNotFound", err)
+	}
+
+	stored, err := svc.CompleteUpload(context.Background(), userID, ticket.Key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Исходный файл заменён перекодированной копией; записаны её тип и размер
+	if stored.Key != ticket.Key || stored.ContentType != "image/png" || stored.Size != int64(len(storage.objects[ticket.Key])) || stored.URL == "" {
+		t.Errorf("stored = %+v", stored)
+	}
+	if repo.uploads[ticket.Key].Status != domain.UploadCompleted {
+		t.Errorf("status = %q, want COMPLETED", repo.uploads[ticket.Key].Status)
+	}
+	if len(stored.Variants) == 0 {
+		t.Fatal("no variants")
+	}
+	for _, v := range stored.Variants {
+		if _, ok := storage.objects[v.Key]; !ok || v.URL == "" {
+			t.Errorf("variant %s/%s: stored %v, url %q", v.Name, v.Format, ok, v.URL)
+		}
+	}
+
+	// Повторное подтверждение возвращает тот же результат
+	again, err := svc.CompleteUpload(context.Background(), userID, ticket.Key)
+	if err != nil || !reflect.DeepEqual(again, stored) {
+		t.Errorf("repeat: %+v, %v; want %+v", again, err, stored)
+	}
+}
+
+func TestService_CompleteUpload_RejectsContent(t *testing.T) {
+	svc, repo, storage := newTestService(1 << 20)
+	userID := uuid.New()
+	fake := []byte("<html><body>not an image</body></html>")
+
+	ticket, err := svc.CreateUploadURL(context.Background(), userID, "photo.jpg", "jpeg", int64(len(fake)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	storage.upload(ticket.Key, fake)
+
+	if _, err := svc.CompleteUpload(context.Background(), userID, ticket.Key); !errors.Is(err, ErrContentMismatch) {
+		t.Fatalf("err = %v, want ErrContentMismatch", err)
+	}
+	if _, ok := storage.objects[ticket.Key]; ok {
+		t.Error("rejected object must be removed")
+	}
+	if _, ok := repo.uploads[ticket.Key]; ok {
+		t.Error("rejected upload must be deleted")
+	}
+}
+
+func TestService_CompleteUpload_RejectsMismatch(t *testing.T) {
+	data := pngImage(t)
+	size := int64(len(data))
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		// expired — ссылка истекла до подтверждения
+		expired bool
+		wantErr error
+	}{
+		{name: "declared size differs", contentType: "png", size: size + 1, wantErr: ErrInvalidSize},
+		{name: "declared type differs", contentType: "jpeg", size: size, wantErr: ErrContentMismatch},
+		{name: "expired", contentType: "png", size: size, expired: true, wantErr: ErrUploadExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, storage := newTestService(1 << 20)
+			userID := uuid.New()
+
+			ticket, err := svc.CreateUploadURL(context.Background(), userID, "photo", tt.contentType, tt.size)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			storage.upload(ticket.Key, data)
+			if tt.expired {
+				u := repo.uploads[ticket.Key]
+				u.ExpiresAt = time.Now().Add(-time.Second)
+				repo.uploads[ticket.Key] = u
+			}
+
+			if _, err := svc.CompleteUpload(context.Background(), userID, ticket.Key); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if _, ok := storage.objects[ticket.Key]; ok {
+				t.Error("rejected object must be removed")
+			}
+			if _, ok := repo.uploads[ticket.Key]; ok {
+				t.Error("rejected upload must be deleted")
+			}
+		})
+	}
+}
+
+func TestService_SweepExpired(t *testing.T) {
+	svc, repo, storage := newTestService(1 << 20)
+	data := pngImage(t)
+	now := time.Now()
+
+	repo.uploads = map[string]domain.Upload{
+		"expired":   {Key: "expired", Status: domain.UploadPending, ExpiresAt: now.Add(-time.Hour)},
+		"in-grace":  {Key: "in-grace", Status: domain.UploadPending, ExpiresAt: now.Add(-sweepGrace / 2)},
+		"active":    {Key: "active", Status: domain.UploadPending, ExpiresAt: now.Add(time.Hour)},
+		"completed": {Key: "completed", Status: domain.UploadCompleted, ExpiresAt: now.Add(-time.Hour)},
+	}
+	for key := range repo.uploads {
+		storage.upload(key, data)
+	}
+
+	n, err := svc.SweepExpired(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("removed = %d, want 1", n)
+	}
+	for key := range repo.uploads {
+		if _, ok := storage.objects[key]; !ok {
+			t.Errorf("object %s of a kept upload was removed", key)
+		}
+	}
+	if _, ok := repo.uploads["expired"]; ok {
+		t.Error("expired upload must be deleted")
+	}
+	if _, ok := storage.objects["expired"]; ok {
+		t.Error("object of an expired upload must be removed")
+	}
+	if len(repo.uploads) != 3 {
+		t.Errorf("uploads = %d, want 3 kept", len(repo.uploads))
+	}
+}
+
+func TestService_CreateUploadURL_Validation(t *testing.T) {
+	svc, _, _ := newTestService(1024)
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		wantErr     error
+	}{
+		{name: "unsupported type", contentType: "gif", size: 10, wantErr: ErrUnsupportedType},
+		{name: "empty", contentType: "png", size: 0, wantErr: ErrInvalidSize},
+		{name: "too large", contentType: "png", size: 2048, wantErr: ErrFileTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateUploadURL(context.Background(), uuid.New(), "f", tt.contentType, tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_Upload(t *testing.T) {
+	data := pngImage(t)
+	size := int64(len(data))
+	// Сигнатура PNG без изображения: проходит проверку типа, но не декодируется
+	broken := append(data[:16:16], make([]byte, 64)...)
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int64
+		body        io.Reader
+		wantErr     error
+	}{
+		{
+			name:        "ok in small pieces",
+			contentType: "png",
+			size:        size,
+			body:        iotest.OneByteReader(bytes.NewReader(data)),
+		},
+		{
+			name:        "stream shorter than declared",
+			contentType: "png",
+			size:        size + 10,
+			body:        bytes.NewReader(data),
+			wantErr:     ErrInvalidSize,
+		},
+		{
+			name:        "stream longer than declared",
+			contentType: "png",
+			size:        size - 1,
+			body:        bytes.NewReader(data),
+			wantErr:     ErrInvalidSize,
+		},
+		{
+			name:        "not an image",
+			contentType: "jpeg",
+			size:        5,
+			body:        bytes.NewReader([]byte("hello")),
+			wantErr:     ErrContentMismatch,
+		},
+		{
+			name:        "broken image",
+			contentType: "png",
+			size:        int64(len(broken)),
+			body:        bytes.NewReader(broken),
+			wantErr:     ErrInvalidImage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, storage := newTestService(1 << 20)
+			userID := uuid.New()
+
+			stored, err := svc.Upload(context.Background(), userID, "photo.png", tt.contentType, tt.size, tt.body)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if len(storage.objects) != 0 || len(repo.uploads) != 0 {
+					t.Errorf("failed upload left objects %d, uploads %d", len(storage.objects), len(repo.uploads))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Записывается основная копия: тип и размер определены по ней
+			if stored.ContentType != "image/png" || stored.Size != int64(len(storage.objects[stored.Key])) {
+				t.Errorf("stored = %+v", stored)
+			}
+			if len(storage.objects) != len(stored.Variants) {
+				t.Errorf("objects = %d, variants = %d", len(storage.objects), len(stored.Variants))
+			}
+			if u := repo.uploads[stored.Key]; u.Status != domain.UploadCompleted || u.UserID != userID {
+				t.Errorf("upload = %+v", u)
+			}
+		})
+	}
+}
+
+func TestService_Upload_WaitsForProcessingSlot(t *testing.T) {
+	data := pngImage(t)
+	svc, repo, storage := newTestService(1 << 20)
+
+	// Все слоты обработки заняты: загрузка ждёт и прерывается вместе с контекстом
+	for range cap(svc.processing) {
+		svc.processing <- struct{}{}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := svc.Upload(ctx, uuid.New(), "photo.png", "png", int64(len(data)), bytes.NewReader(data))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if len(storage.objects) != 0 || len(repo.uploads) != 0 {
+		t.Errorf("cancelled upload left objects %d, uploads %d", len(storage.objects), len(repo.uploads))
+	}
+
+	<-svc.processing
+	if _, err := svc.Upload(context.Background(), uuid.New(), "photo.png", "png", int64(len(data)), bytes.NewReader(data)); err != nil {
+		t.Fatalf("upload with a free slot failed: %v", err)
+	}
+}
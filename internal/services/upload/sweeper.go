@@ -0,0 +1,76 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"lead_exchange/internal/lib/logger/sl"
+)
+
+const (
+	// sweepBatch — сколько просроченных загрузок удаляется за один запрос к БД
+	sweepBatch = 100
+	// sweepGrace — запас после истечения ссылки: CompleteUpload, начатый до истечения, успевает завершиться
+	sweepGrace = time.Minute
+)
+
+// Run периодически удаляет неподтверждённые загрузки с истёкшей ссылкой, пока не отменён ctx.
+func (s *Service) Run(ctx context.Context, interval time.Duration) {
+	const op = "upload.Service.Run"
+	log := s.log.With(slog.String("op", op))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := s.SweepExpired(ctx); err != nil {
+			if ctx.Err() == nil {
+				log.Error("failed to sweep expired uploads", sl.Err(err))
+			}
+		} else if n > 0 {
+			log.Info("expired uploads removed", slog.Int("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SweepExpired — удаляет загрузки, не подтверждённые до истечения ссылки: сначала файл,
+// затем запись. Если файл удалить не удалось, запись остаётся до следующего прохода.
+// Возвращает число удалённых загрузок.
+func (s *Service) SweepExpired(ctx context.Context) (int, error) {
+	const op = "upload.Service.SweepExpired"
+	log := s.log.With(slog.String("op", op))
+
+	removed := 0
+	for {
+		keys, err := s.repo.ListExpired(ctx, time.Now().Add(-sweepGrace), sweepBatch)
+		if err != nil {
+			return removed, fmt.Errorf("%s: %w", op, err)
+		}
+
+		failed := 0
+		for _, key := range keys {
+			if err := s.storage.Remove(ctx, key); err != nil {
+				log.Warn("failed to remove expired object", slog.String("key", key), sl.Err(err))
+				failed++
+				continue
+			}
+			if err := s.repo.Delete(ctx, key); err != nil {
+				return removed, fmt.Errorf("%s: %w", op, err)
+			}
+			removed++
+		}
+
+		// Неполная пачка — просроченных больше нет; пачка из одних ошибок повторится на следующем проходе
+		if len(keys) < sweepBatch || failed == len(keys) {
+			return removed, nil
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+)
+
+// LockedError — вход временно заблокирован до Until: по email после серии неудач или с IP клиента.
+// errors.Is(err, ErrAccountLocked) == true.
+type LockedError struct {
+	Until  time.Time
+	reason domain.LoginFailureReason
+}
+
+func (e *LockedError) Error() string {
+	return ErrAccountLocked.Error() + " until " + e.Until.UTC().Format(time.RFC3339)
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrAccountLocked
+}
+
+// checkLoginAllowed возвращает *LockedError, если вход по email или с IP клиента сейчас заблокирован.
+func (s *Service) checkLoginAllowed(ctx context.Context, attempt domain.LoginAttempt) error {
+	now := s.now()
+
+	lockout, err := s.repo.GetLoginLockout(ctx, attempt.Email)
+	if err != nil {
+		return err
+	}
+	if lockout.IsLocked(now) {
+		return &LockedError{Until: *lockout.LockedUntil, reason: domain.LoginFailureLocked}
+	}
+
+	if s.protection.IPMaxFailures > 0 && attempt.Client.IP != "" {
+		failures, err := s.repo.CountIPLoginFailures(ctx, attempt.Client.IP, now.Add(-s.protection.FailureWindow))
+		if err != nil {
+			return err
+		}
+		if failures >= s.protection.IPMaxFailures {
+			// Верхняя оценка: к этому моменту все учтённые неудачи выйдут из окна
+			return &LockedError{Until: now.Add(s.protection.FailureWindow), reason: domain.LoginFailureIPBlocked}
+		}
+	}
+
+	return nil
+}
+
+// loginFailed учитывает неудачную попытку: журнал, счётчик по email (с блокировкой при достижении
+// порога) и задержка ответа, растущая с числом неудач по email или с IP.
+func (s *Service) loginFailed(ctx context.Context, log *slog.Logger, attempt domain.LoginAttempt) {
+	s.recordLoginAttempt(ctx, log, attempt, domain.LoginFailureInvalidCredentials)
+
+	lockout, err := s.repo.RegisterLoginFailure(
+		ctx, attempt.Email, s.protection.MaxFailures, s.protection.FailureWindow, s.protection.LockoutDuration,
+	)
+	if err != nil {
+		log.Error("failed to register login failure", sl.Err(err))
+	}
+	if lockout.IsLocked(s.now()) {
+		log.Warn("login locked after repeated failures",
+			slog.Int("failures", lockout.FailedCount),
+			slog.Time("locked_until", *lockout.LockedUntil),
+		)
+	}
+
+	failures := lockout.FailedCount
+	if s.protection.IPMaxFailures > 0 && attempt.Client.IP != "" {
+		ipFailures, err := s.repo.CountIPLoginFailures(ctx, attempt.Client.IP, s.now().Add(-s.protection.FailureWindow))
+		if err != nil {
+			log.Error("failed to count ip login failures", sl.Err(err))
+		}
+		failures = max(failures, ipFailures)
+	}
+
+	if d := s.failureDelay(failures); d > 0 {
+		s.sleep(ctx, d)
+	}
+}
+
+// failureDelay — задержка ответа после failures неудач подряд: DelayBase·2^(failures-1), не больше DelayMax.
+func (s *Service) failureDelay(failures int) time.Duration {
+	base, limit := s.protection.DelayBase, s.protection.DelayMax
+	if base <= 0 || failures < 1 {
+		return 0
+	}
+
+	d := base
+	for i := 1; i < failures; i++ {
+		d *= 2
+		if limit > 0 && d >= limit {
+			return limit
+		}
+	}
+	if limit > 0 && d > limit {
+		return limit
+	}
+	return d
+}
+
+// recordLoginAttempt пишет попытку в журнал; ошибка записи не мешает входу.
+func (s *Service) recordLoginAttempt(
+	ctx context.Context, log *slog.Logger, attempt domain.LoginAttempt, reason domain.LoginFailureReason,
+) {
+	attempt.FailureReason = reason
+	if err := s.repo.CreateLoginAttempt(ctx, attempt); err != nil {
+		log.Error("failed to record login attempt", sl.Err(err))
+	}
+}
+
+// loginKey — email в виде ключа блокировки: регистр и пробелы по краям не создают отдельных счётчиков.
+func loginKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
@@ -0,0 +1,164 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func newProtectedTestService(repo *MockUserRepository, protection config.LoginProtectionConfig) (*Service, *[]time.Duration) {
+	svc := NewWithLoginProtection(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, time.Hour, 24*time.Hour, "secret", protection)
+	var delays []time.Duration
+	svc.sleep = func(_ context.Context, d time.Duration) { delays = append(delays, d) }
+	return svc, &delays
+}
+
+func TestService_Login_LocksAfterFailures(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc, delays := newProtectedTestService(repo, config.LoginProtectionConfig{
+		MaxFailures:     3,
+		FailureWindow:   15 * time.Minute,
+		LockoutDuration: 15 * time.Minute,
+		DelayBase:       100 * time.Millisecond,
+		DelayMax:        300 * time.Millisecond,
+	})
+	ctx := context.Background()
+	client := domain.LoginClient{IP: "203.0.113.5", UserAgent: "curl/8.0"}
+
+	for i := range 3 {
+		if _, _, err := svc.Login(ctx, repo.user.Email, "wrong", "web", client); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: expected ErrInvalidCredentials, got %v", i, err)
+		}
+	}
+
+	wantDelays := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}
+	if len(*delays) != len(wantDelays) {
+		t.Fatalf("delays = %v, want %v", *delays, wantDelays)
+	}
+	for i, d := range wantDelays {
+		if (*delays)[i] != d {
+			t.Errorf("delay %d = %v, want %v", i, (*delays)[i], d)
+		}
+	}
+
+	// Заблокирован даже с верным паролем; регистр email не обходит блокировку
+	_, _, err := svc.Login(ctx, "AGENT@example.com", "password123", "web", client)
+	var locked *LockedError
+	if !errors.As(err, &locked) || !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected LockedError, got %v", err)
+	}
+	if until := time.Until(locked.Until); until <= 0 || until > 15*time.Minute {
+		t.Errorf("locked until in %v, want within lockout duration", until)
+	}
+
+	// Администратор снимает блокировку
+	if _, err := svc.UnlockUser(ctx, repo.user.ID); err != nil {
+		t.Fatalf("unlock failed: %v", err)
+	}
+	if _, _, err := svc.Login(ctx, repo.user.Email, "password123", "web", client); err != nil {
+		t.Fatalf("login after unlock failed: %v", err)
+	}
+
+	// Журнал: 3 неудачи, отказ из-за блокировки, успешный вход
+	wantReasons := []domain.LoginFailureReason{
+		domain.LoginFailureInvalidCredentials,
+		domain.LoginFailureInvalidCredentials,
+		domain.LoginFailureInvalidCredentials,
+		domain.LoginFailureLocked,
+		"",
+	}
+	if len(repo.attempts) != len(wantReasons) {
+		t.Fatalf("attempts = %d, want %d", len(repo.attempts), len(wantReasons))
+	}
+	for i, reason := range wantReasons {
+		a := repo.attempts[i]
+		if a.FailureReason != reason {
+			t.Errorf("attempt %d reason = %q, want %q", i, a.FailureReason, reason)
+		}
+		if a.Client != client {
+			t.Errorf("attempt %d client = %+v, want %+v", i, a.Client, client)
+		}
+	}
+	if last := repo.attempts[len(repo.attempts)-1]; !last.Success || last.UserID == nil || *last.UserID != repo.user.ID {
+		t.Errorf("expected successful attempt with user id, got %+v", last)
+	}
+}
+
+func TestService_Login_SuccessResetsFailures(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc, _ := newProtectedTestService(repo, config.LoginProtectionConfig{
+		MaxFailures:     3,
+		FailureWindow:   15 * time.Minute,
+		LockoutDuration: 15 * time.Minute,
+	})
+	ctx := context.Background()
+
+	for range 2 {
+		_, _, _ = svc.Login(ctx, repo.user.Email, "wrong", "web", domain.LoginClient{})
+	}
+	if _, _, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{}); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	for range 2 {
+		_, _, _ = svc.Login(ctx, repo.user.Email, "wrong", "web", domain.LoginClient{})
+	}
+
+	if _, _, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{}); err != nil {
+		t.Errorf("failures before success must not count towards lockout, got %v", err)
+	}
+}
+
+func TestService_Login_BlocksIP(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc, _ := newProtectedTestService(repo, config.LoginProtectionConfig{
+		FailureWindow: 15 * time.Minute,
+		IPMaxFailures: 2,
+	})
+	ctx := context.Background()
+	attacker := domain.LoginClient{IP: "203.0.113.5"}
+
+	// Перебор разных email с одного адреса
+	for _, email := range []string{"a@example.com", "b@example.com"} {
+		if _, _, err := svc.Login(ctx, email, "guess", "web", attacker); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+		}
+	}
+
+	if _, _, err := svc.Login(ctx, repo.user.Email, "password123", "web", attacker); !errors.Is(err, ErrAccountLocked) {
+		t.Errorf("expected IP to be blocked, got %v", err)
+	}
+	if got := repo.attempts[len(repo.attempts)-1].FailureReason; got != domain.LoginFailureIPBlocked {
+		t.Errorf("reason = %q, want %q", got, domain.LoginFailureIPBlocked)
+	}
+
+	if _, _, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{IP: "198.51.100.7"}); err != nil {
+		t.Errorf("other IP must not be blocked, got %v", err)
+	}
+}
+
+func TestService_FailureDelay(t *testing.T) {
+	svc := &Service{protection: config.LoginProtectionConfig{DelayBase: 250 * time.Millisecond, DelayMax: 4 * time.Second}}
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, 250 * time.Millisecond},
+		{2, 500 * time.Millisecond},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{100, 4 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := svc.failureDelay(tt.failures); got != tt.want {
+			t.Errorf("failureDelay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
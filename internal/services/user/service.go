@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
+	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/jwt"
 	"lead_exchange/internal/lib/logger/sl"
@@ -22,26 +23,60 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
 	UpdateUser(ctx context.Context, userID uuid.UUID, update domain.UserFilter) error
 	ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+
+	CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (uuid.UUID, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (domain.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, oldID uuid.UUID, next domain.RefreshToken) (uuid.UUID, error)
+	RevokeRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+	GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error)
+
+	CreateLoginAttempt(ctx context.Context, attempt domain.LoginAttempt) error
+	CountIPLoginFailures(ctx context.Context, ip string, since time.Time) (int, error)
+	GetLoginLockout(ctx context.Context, email string) (domain.LoginLockout, error)
+	RegisterLoginFailure(ctx context.Context, email string, maxFailures int, window, lockout time.Duration) (domain.LoginLockout, error)
+	ResetLoginFailures(ctx context.Context, email string) error
 }
 
 type Service struct {
-	log      *slog.Logger
-	repo     UserRepository
-	tokenTTL time.Duration
-	secret   string
+	log             *slog.Logger
+	repo            UserRepository
+	tokenTTL        time.Duration
+	refreshTokenTTL time.Duration
+	secret          string
+	protection      config.LoginProtectionConfig
+	now             func() time.Time
+	sleep           func(ctx context.Context, d time.Duration)
 }
 
 var (
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrUserExists         = errors.New("user already exists")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrUserExists          = errors.New("user already exists")
+	ErrUserBanned          = errors.New("user is banned")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrAccountLocked       = errors.New("login temporarily locked")
 )
 
-func New(log *slog.Logger, repo UserRepository, tokenTTL time.Duration, secret string) *Service {
+// New создаёт сервис без блокировок и задержек входа; попытки входа всё равно пишутся в журнал.
+func New(log *slog.Logger, repo UserRepository, tokenTTL, refreshTokenTTL time.Duration, secret string) *Service {
+	return NewWithLoginProtection(log, repo, tokenTTL, refreshTokenTTL, secret, config.LoginProtectionConfig{})
+}
+
+// NewWithLoginProtection создаёт сервис с защитой входа от подбора пароля:
+// задержки после неудач, блокировка по email и по IP.
+func NewWithLoginProtection(
+	log *slog.Logger, repo UserRepository, tokenTTL, refreshTokenTTL time.Duration, secret string,
+	protection config.LoginProtectionConfig,
+) *Service {
 	return &Service{
-		log:      log,
-		repo:     repo,
-		tokenTTL: tokenTTL,
-		secret:   secret,
+		log:             log,
+		repo:            repo,
+		tokenTTL:        tokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+		secret:          secret,
+		protection:      protection,
+		now:             time.Now,
+		sleep:           sleepContext,
 	}
 }
 
@@ -71,36 +106,196 @@ func (s *Service) Register(ctx context.Context, email, password, firstName, last
 	return id, nil
 }
 
-// Login — аутентификация пользователя и выдача JWT-токена.
-func (s *Service) Login(ctx context.Context, email, password string) (uuid.UUID, string, error) {
+// Login — аутентификация пользователя и выдача пары access/refresh токенов для устройства.
+// Каждая попытка пишется в журнал вместе с IP и User-Agent клиента. Неудачные попытки
+// замедляют ответ и после порога блокируют вход по email (или с IP) — тогда возвращается *LockedError.
+func (s *Service) Login(ctx context.Context, email, password, device string, client domain.LoginClient) (uuid.UUID, domain.TokenPair, error) {
 	const op = "user.Service.Login"
-	log := s.log.With(slog.String("op", op), slog.String("email", email))
+	log := s.log.With(slog.String("op", op), slog.String("email", email), slog.String("ip", client.IP))
 
 	log.Info("attempting login")
 
+	attempt := domain.LoginAttempt{Email: loginKey(email), Client: client}
+
+	if err := s.checkLoginAllowed(ctx, attempt); err != nil {
+		var locked *LockedError
+		if errors.As(err, &locked) {
+			log.Warn("login rejected: locked", slog.Time("locked_until", locked.Until))
+			s.recordLoginAttempt(ctx, log, attempt, locked.reason)
+			return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+		}
+		log.Error("failed to check login lockout", sl.Err(err))
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	user, err := s.repo.GetByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			log.Warn("user not found", sl.Err(err))
-			return uuid.Nil, "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			s.loginFailed(ctx, log, attempt)
+			return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 		}
 		log.Error("failed to fetch user", sl.Err(err))
-		return uuid.Nil, "", fmt.Errorf("%s: %w", op, err)
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
 	}
+	attempt.UserID = &user.ID
 
 	if err := bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password)); err != nil {
 		log.Info("invalid password", sl.Err(err))
-		return uuid.Nil, "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		s.loginFailed(ctx, log, attempt)
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if user.Status == domain.UserStatusBanned {
+		log.Warn("banned user tried to login", slog.String("user_id", user.ID.String()))
+		s.recordLoginAttempt(ctx, log, attempt, domain.LoginFailureBanned)
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrUserBanned)
 	}
 
-	token, err := jwt.NewToken(user, s.secret, s.tokenTTL)
+	refresh, err := s.newRefreshToken(user.ID, device)
+	if err != nil {
+		log.Error("failed to generate refresh token", sl.Err(err))
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := s.repo.CreateRefreshToken(ctx, refresh.stored); err != nil {
+		log.Error("failed to save refresh token", sl.Err(err))
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pair, err := s.tokenPair(user, refresh)
 	if err != nil {
 		log.Error("failed to generate token", sl.Err(err))
-		return uuid.Nil, "", fmt.Errorf("%s: %w", op, err)
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.repo.ResetLoginFailures(ctx, attempt.Email); err != nil {
+		log.Error("failed to reset login failures", sl.Err(err))
 	}
+	attempt.Success = true
+	s.recordLoginAttempt(ctx, log, attempt, "")
 
 	log.Info("login successful")
-	return user.ID, token, nil
+	return user.ID, pair, nil
+}
+
+// RefreshToken — обменивает refresh-токен на новую пару токенов (ротация).
+// Повторное предъявление уже отозванного токена считается кражей: все сессии пользователя отзываются.
+func (s *Service) RefreshToken(ctx context.Context, refreshToken string) (domain.TokenPair, error) {
+	const op = "user.Service.RefreshToken"
+	log := s.log.With(slog.String("op", op))
+
+	current, err := s.repo.GetRefreshTokenByHash(ctx, jwt.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+		log.Error("failed to get refresh token", sl.Err(err))
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if current.RevokedAt != nil {
+		log.Warn("revoked refresh token reused, revoking all sessions", slog.String("user_id", current.UserID.String()))
+		if err := s.repo.RevokeUserSessions(ctx, current.UserID); err != nil {
+			log.Error("failed to revoke sessions", sl.Err(err))
+		}
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+	}
+	if !current.IsActive(time.Now()) {
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+	}
+
+	user, err := s.repo.GetByID(ctx, current.UserID)
+	if err != nil {
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Status == domain.UserStatusBanned {
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrUserBanned)
+	}
+
+	next, err := s.newRefreshToken(user.ID, current.Device)
+	if err != nil {
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := s.repo.RotateRefreshToken(ctx, current.ID, next.stored); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenRevoked) {
+			return domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+		log.Error("failed to rotate refresh token", sl.Err(err))
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pair, err := s.tokenPair(user, next)
+	if err != nil {
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pair, nil
+}
+
+// Logout — завершает сессию устройства (отзывает refresh-токен).
+// Если allDevices, отзываются все сессии пользователя, включая выданные access-токены.
+func (s *Service) Logout(ctx context.Context, userID uuid.UUID, refreshToken string, allDevices bool) error {
+	const op = "user.Service.Logout"
+
+	if allDevices {
+		if err := s.repo.RevokeUserSessions(ctx, userID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		s.log.Info("all user sessions revoked", slog.String("user_id", userID.String()))
+		return nil
+	}
+
+	if refreshToken == "" {
+		return fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+	}
+	if err := s.repo.RevokeRefreshToken(ctx, userID, jwt.HashRefreshToken(refreshToken)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetTokenVersion — текущая версия токенов пользователя (для проверки отзыва access-токенов).
+func (s *Service) GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	return s.repo.GetTokenVersion(ctx, userID)
+}
+
+// issuedRefreshToken — сгенерированный refresh-токен и его запись для БД.
+type issuedRefreshToken struct {
+	plain  string
+	stored domain.RefreshToken
+}
+
+func (s *Service) newRefreshToken(userID uuid.UUID, device string) (issuedRefreshToken, error) {
+	plain, err := jwt.NewRefreshToken()
+	if err != nil {
+		return issuedRefreshToken{}, err
+	}
+
+	return issuedRefreshToken{
+		plain: plain,
+		stored: domain.RefreshToken{
+			UserID:    userID,
+			TokenHash: jwt.HashRefreshToken(plain),
+			Device:    device,
+			ExpiresAt: time.Now().Add(s.refreshTokenTTL),
+		},
+	}, nil
+}
+
+func (s *Service) tokenPair(user domain.User, refresh issuedRefreshToken) (domain.TokenPair, error) {
+	accessExpiresAt := time.Now().Add(s.tokenTTL)
+	access, err := jwt.NewToken(user, s.secret, s.tokenTTL)
+	if err != nil {
+		return domain.TokenPair{}, err
+	}
+
+	return domain.TokenPair{
+		AccessToken:           access,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refresh.plain,
+		RefreshTokenExpiresAt: refresh.stored.ExpiresAt,
+	}, nil
 }
 
 // GetProfile — возвращает профиль пользователя по ID.
@@ -140,7 +335,7 @@ func (s *Service) ListUsers(ctx context.Context, filter domain.UserFilter) ([]do
 	return s.repo.ListUsers(ctx, filter)
 }
 
-// UpdateUserStatus — обновляет статус пользователя.
+// UpdateUserStatus — обновляет статус пользователя. Блокировка сразу завершает все его сессии.
 func (s *Service) UpdateUserStatus(ctx context.Context, userID uuid.UUID, status domain.UserStatus) (domain.User, error) {
 	const op = "user.Service.UpdateUserStatus"
 
@@ -156,5 +351,35 @@ func (s *Service) UpdateUserStatus(ctx context.Context, userID uuid.UUID, status
 		return domain.User{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if status == domain.UserStatusBanned {
+		if err := s.repo.RevokeUserSessions(ctx, userID); err != nil {
+			s.log.Error("failed to revoke sessions of banned user", slog.String("user_id", userID.String()), sl.Err(err))
+			return domain.User{}, fmt.Errorf("%s: %w", op, err)
+		}
+		s.log.Info("user banned, all sessions revoked", slog.String("user_id", userID.String()))
+	}
+
 	return s.repo.GetByID(ctx, userID)
 }
+
+// UnlockUser — снимает блокировку входа пользователя и сбрасывает счётчик неудачных попыток.
+// Блокировка по IP не снимается: она истекает сама по окну LOGIN_FAILURE_WINDOW.
+func (s *Service) UnlockUser(ctx context.Context, userID uuid.UUID) (domain.User, error) {
+	const op = "user.Service.UnlockUser"
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.User{}, fmt.Errorf("%s: %w", op, repository.ErrUserNotFound)
+		}
+		return domain.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.repo.ResetLoginFailures(ctx, loginKey(user.Email)); err != nil {
+		s.log.Error("failed to unlock user", slog.String("user_id", userID.String()), sl.Err(err))
+		return domain.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("user login unlocked", slog.String("user_id", userID.String()))
+	return user, nil
+}
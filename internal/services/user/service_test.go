@@ -0,0 +1,264 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/jwt"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MockUserRepository хранит одного пользователя, его refresh-токены и журнал входов в памяти.
+type MockUserRepository struct {
+	user     domain.User
+	tokens   map[string]*domain.RefreshToken
+	attempts []domain.LoginAttempt
+	lockouts map[string]domain.LoginLockout
+}
+
+func newMockUserRepository(t *testing.T, password string) *MockUserRepository {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	return &MockUserRepository{
+		user: domain.User{
+			ID:           uuid.New(),
+			Email:        "agent@example.com",
+			PasswordHash: hash,
+			Role:         domain.UserRoleUser,
+			Status:       domain.UserStatusActive,
+		},
+		tokens:   map[string]*domain.RefreshToken{},
+		lockouts: map[string]domain.LoginLockout{},
+	}
+}
+
+func (m *MockUserRepository) CreateUser(ctx context.Context, email, firstName, lastName string, passwordHash []byte) (uuid.UUID, error) {
+	return uuid.Nil, nil
+}
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	if email != m.user.Email {
+		return domain.User{}, repository.ErrUserNotFound
+	}
+	return m.user, nil
+}
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User, error) {
+	return m.user, nil
+}
+func (m *MockUserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, update domain.UserFilter) error {
+	if update.Status != nil {
+		m.user.Status = *update.Status
+	}
+	return nil
+}
+func (m *MockUserRepository) ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) CreateRefreshToken(ctx context.Context, token domain.RefreshToken) (uuid.UUID, error) {
+	token.ID = uuid.New()
+	m.tokens[token.TokenHash] = &token
+	return token.ID, nil
+}
+func (m *MockUserRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	t, ok := m.tokens[tokenHash]
+	if !ok {
+		return domain.RefreshToken{}, repository.ErrRefreshTokenNotFound
+	}
+	return *t, nil
+}
+func (m *MockUserRepository) RotateRefreshToken(ctx context.Context, oldID uuid.UUID, next domain.RefreshToken) (uuid.UUID, error) {
+	for _, t := range m.tokens {
+		if t.ID == oldID {
+			if t.RevokedAt != nil {
+				return uuid.Nil, repository.ErrRefreshTokenRevoked
+			}
+			now := time.Now()
+			t.RevokedAt = &now
+		}
+	}
+	return m.CreateRefreshToken(ctx, next)
+}
+func (m *MockUserRepository) RevokeRefreshToken(ctx context.Context, userID uuid.UUID, tokenHash string) error {
+	if t, ok := m.tokens[tokenHash]; ok && t.RevokedAt == nil {
+		now := time.Now()
+		t.RevokedAt = &now
+	}
+	return nil
+}
+func (m *MockUserRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	m.user.TokenVersion++
+	now := time.Now()
+	for _, t := range m.tokens {
+		if t.RevokedAt == nil {
+			t.RevokedAt = &now
+		}
+	}
+	return nil
+}
+func (m *MockUserRepository) GetTokenVersion(ctx context.Context, userID uuid.UUID) (int, error) {
+	return m.user.TokenVersion, nil
+}
+
+func (m *MockUserRepository) CreateLoginAttempt(ctx context.Context, attempt domain.LoginAttempt) error {
+	attempt.CreatedAt = time.Now()
+	m.attempts = append(m.attempts, attempt)
+	return nil
+}
+func (m *MockUserRepository) CountIPLoginFailures(ctx context.Context, ip string, since time.Time) (int, error) {
+	n := 0
+	for _, a := range m.attempts {
+		if a.Client.IP == ip && !a.Success && a.FailureReason == domain.LoginFailureInvalidCredentials && a.CreatedAt.After(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+func (m *MockUserRepository) GetLoginLockout(ctx context.Context, email string) (domain.LoginLockout, error) {
+	l, ok := m.lockouts[email]
+	if !ok {
+		return domain.LoginLockout{Email: email}, nil
+	}
+	return l, nil
+}
+func (m *MockUserRepository) RegisterLoginFailure(ctx context.Context, email string, maxFailures int, window, lockout time.Duration) (domain.LoginLockout, error) {
+	now := time.Now()
+	l, ok := m.lockouts[email]
+	if !ok || l.LastFailedAt.Before(now.Add(-window)) {
+		l = domain.LoginLockout{Email: email, LockedUntil: l.LockedUntil}
+	}
+	l.FailedCount++
+	l.LastFailedAt = now
+	if maxFailures > 0 && l.FailedCount >= maxFailures {
+		until := now.Add(lockout)
+		l.LockedUntil = &until
+	}
+	m.lockouts[email] = l
+	return l, nil
+}
+func (m *MockUserRepository) ResetLoginFailures(ctx context.Context, email string) error {
+	delete(m.lockouts, email)
+	return nil
+}
+
+func newTestService(repo *MockUserRepository) *Service {
+	return New(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, time.Hour, 24*time.Hour, "secret")
+}
+
+func TestService_RefreshToken_Rotates(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	_, pair, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{})
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	rotated, err := svc.RefreshToken(ctx, pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	if rotated.RefreshToken == pair.RefreshToken {
+		t.Error("expected refresh token to be rotated")
+	}
+	if rotated.AccessToken == "" {
+		t.Error("expected new access token")
+	}
+
+	// Повторное использование старого токена отзывает все сессии
+	if _, err := svc.RefreshToken(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("expected ErrInvalidRefreshToken on reuse, got %v", err)
+	}
+	if repo.user.TokenVersion != 1 {
+		t.Errorf("expected token version to be bumped, got %d", repo.user.TokenVersion)
+	}
+	if _, err := svc.RefreshToken(ctx, rotated.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected rotated token to be revoked after reuse, got %v", err)
+	}
+}
+
+func TestService_RefreshToken_Expired(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	_, pair, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{})
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	for _, tok := range repo.tokens {
+		tok.ExpiresAt = time.Now().Add(-time.Minute)
+	}
+
+	if _, err := svc.RefreshToken(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken for expired token, got %v", err)
+	}
+}
+
+func TestService_Logout(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	_, web, _ := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{})
+	_, phone, _ := svc.Login(ctx, repo.user.Email, "password123", "phone", domain.LoginClient{})
+
+	if err := svc.Logout(ctx, repo.user.ID, web.RefreshToken, false); err != nil {
+		t.Fatalf("logout failed: %v", err)
+	}
+	if repo.tokens[jwt.HashRefreshToken(web.RefreshToken)].RevokedAt == nil {
+		t.Error("expected web session to be revoked")
+	}
+	if repo.user.TokenVersion != 0 {
+		t.Errorf("single device logout must not bump token version, got %d", repo.user.TokenVersion)
+	}
+
+	// Сессия на другом устройстве продолжает работать
+	phone, err := svc.RefreshToken(ctx, phone.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected phone session to stay active, got %v", err)
+	}
+
+	if err := svc.Logout(ctx, repo.user.ID, "", true); err != nil {
+		t.Fatalf("logout from all devices failed: %v", err)
+	}
+	if repo.user.TokenVersion != 1 {
+		t.Errorf("expected token version to be bumped, got %d", repo.user.TokenVersion)
+	}
+	if repo.tokens[jwt.HashRefreshToken(phone.RefreshToken)].RevokedAt == nil {
+		t.Error("expected phone session to be revoked")
+	}
+}
+
+func TestService_UpdateUserStatus_BanRevokesSessions(t *testing.T) {
+	repo := newMockUserRepository(t, "password123")
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	_, pair, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{})
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	if _, err := svc.UpdateUserStatus(ctx, repo.user.ID, domain.UserStatusBanned); err != nil {
+		t.Fatalf("ban failed: %v", err)
+	}
+	if repo.user.TokenVersion != 1 {
+		t.Errorf("expected token version to be bumped on ban, got %d", repo.user.TokenVersion)
+	}
+	if _, err := svc.RefreshToken(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected refresh to fail after ban, got %v", err)
+	}
+	if _, _, err := svc.Login(ctx, repo.user.Email, "password123", "web", domain.LoginClient{}); !errors.Is(err, ErrUserBanned) {
+		t.Errorf("expected ErrUserBanned on login, got %v", err)
+	}
+}
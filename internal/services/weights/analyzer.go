@@ -2,9 +2,9 @@ package weights
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"lead_exchange/internal/config"
@@ -74,18 +74,10 @@ func (a *Analyzer) AnalyzeLead(ctx context.Context, lead domain.Lead) (*AnalyzeR
 func (a *Analyzer) llmAnalysis(ctx context.Context, lead domain.Lead) (*AnalyzeResult, error) {
 	const op = "weights.Analyzer.llmAnalysis"
 
-	// Парсим requirement
-	var requirementMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirementMap); err != nil {
-			a.log.Warn("failed to parse requirement JSON", slog.String("error", err.Error()))
-		}
-	}
-
 	req := llm.AnalyzeLeadRequest{
 		Title:       lead.Title,
 		Description: lead.Description,
-		Requirement: requirementMap,
+		Requirement: lead.Requirement.Map(),
 	}
 
 	resp, err := a.llmClient.AnalyzeLeadIntent(ctx, req)
@@ -101,6 +93,7 @@ func (a *Analyzer) llmAnalysis(ctx context.Context, lead domain.Lead) (*AnalyzeR
 			Rooms:    resp.RecommendedWeights.Rooms,
 			Area:     resp.RecommendedWeights.Area,
 			Semantic: resp.RecommendedWeights.Semantic,
+			Visual:   resp.RecommendedWeights.Visual,
 		},
 		LeadType:    resp.LeadType,
 		Confidence:  resp.Confidence,
@@ -122,6 +115,12 @@ func (a *Analyzer) llmAnalysis(ctx context.Context, lead domain.Lead) (*AnalyzeR
 		}
 	}
 
+	// Визуальные пожелания LLM не извлекает — берём их из текста и особенностей
+	features := slices.Concat(lead.Requirement.MustHaveFeatures,
+		resp.ExtractedCriteria.MustHaveFeatures, resp.ExtractedCriteria.NiceToHaveFeatures)
+	result.Criteria, result.Weights = applyVisualPreferences(result.Criteria, result.Weights,
+		extractVisualPreferences(strings.ToLower(lead.Title+" "+lead.Description), features))
+
 	// Нормализуем веса
 	result.Weights = result.Weights.Normalize()
 
@@ -154,6 +153,10 @@ func (a *Analyzer) heuristicAnalysis(lead domain.Lead) *AnalyzeResult {
 	// Извлекаем критерии из requirement
 	result.Criteria = a.extractCriteriaFromRequirement(lead)
 
+	// Пожелания к ремонту, виду и особенностям проверяются по фотографиям объекта
+	result.Criteria, result.Weights = applyVisualPreferences(result.Criteria, result.Weights,
+		extractVisualPreferences(text, lead.Requirement.MustHaveFeatures))
+
 	// Корректируем веса на основе заполненности данных
 	result.Weights = a.adjustWeightsBasedOnData(result.Weights, lead, result.Criteria)
 
@@ -203,7 +206,7 @@ func (a *Analyzer) detectLeadType(text string) (string, domain.MatchWeights) {
 
 	if luxuryScore > maxScore {
 		leadType = "luxury"
-		weights = domain.MatchWeights{Price: 0.10, District: 0.25, Rooms: 0.15, Area: 0.20, Semantic: 0.30}
+		weights = domain.MatchWeights{Price: 0.10, District: 0.20, Rooms: 0.10, Area: 0.15, Semantic: 0.25, Visual: 0.20}
 	}
 
 	// Если ни один тип не определён явно
@@ -226,47 +229,99 @@ func (a *Analyzer) countKeywords(text string, keywords []string) int {
 	return count
 }
 
-// extractCriteriaFromRequirement извлекает критерии из JSON requirement.
-func (a *Analyzer) extractCriteriaFromRequirement(lead domain.Lead) *domain.SoftCriteria {
-	if len(lead.Requirement) == 0 {
-		return nil
-	}
+// qualityKeywords — формулировки про состояние отделки и ожидаемая оценка качества по фото.
+var qualityKeywords = []struct {
+	keyword string
+	quality float64
+}{
+	{"дизайнерск", 0.85},
+	{"свежий ремонт", 0.7},
+	{"свежим ремонтом", 0.7},
+	{"новый ремонт", 0.7},
+	{"новым ремонтом", 0.7},
+	{"евроремонт", 0.7},
+	{"хороший ремонт", 0.6},
+	{"хорошим ремонтом", 0.6},
+	{"с ремонтом", 0.5},
+}
 
-	var reqMap map[string]interface{}
-	if err := json.Unmarshal(lead.Requirement, &reqMap); err != nil {
-		return nil
-	}
+// viewKeywords — пожелания к виду из окна.
+var viewKeywords = []string{"вид на парк", "вид на реку", "вид на воду", "видом на", "панорамный вид", "панорамным видом", "красивый вид"}
+
+// visualFeatureKeywords — ключевые слова для особенностей из domain.VisualFeatureFlags.
+var visualFeatureKeywords = map[string][]string{
+	"balcony":           {"балкон", "лоджи"},
+	"terrace":           {"террас"},
+	"panoramic_windows": {"панорамные окна", "панорамными окнами", "панорамное остекление"},
+	"high_ceilings":     {"высокие потолки", "высокими потолками"},
+	"modern_kitchen":    {"современная кухня", "современной кухней"},
+	"walk_in_closet":    {"гардероб"},
+	"parking":           {"парковк", "паркинг", "машиноместо"},
+	"security":          {"охран", "консьерж"},
+	"gym":               {"фитнес", "спортзал", "тренажёр", "тренажер"},
+	"pool":              {"бассейн"},
+}
 
-	criteria := &domain.SoftCriteria{}
-	hasData := false
+// extractVisualPreferences извлекает из текста лида и списка особенностей пожелания,
+// которые можно проверить по фотографиям объекта. text — в нижнем регистре.
+func extractVisualPreferences(text string, features []string) *domain.VisualPreferences {
+	prefs := &domain.VisualPreferences{}
 
-	if price, ok := reqMap["price"].(float64); ok {
-		p := int64(price)
-		criteria.TargetPrice = &p
-		hasData = true
+	for _, kw := range qualityKeywords {
+		if strings.Contains(text, kw.keyword) {
+			quality := kw.quality
+			prefs.MinQuality = &quality
+			break
+		}
 	}
 
-	if district, ok := reqMap["district"].(string); ok {
-		criteria.TargetDistrict = &district
-		hasData = true
+	for _, kw := range viewKeywords {
+		if strings.Contains(text, kw) {
+			prefs.GoodView = true
+			break
+		}
 	}
 
-	if rooms, ok := reqMap["roomNumber"].(float64); ok {
-		r := int32(rooms)
-		criteria.TargetRooms = &r
-		hasData = true
+	lowered := make([]string, 0, len(features))
+	for _, f := range features {
+		lowered = append(lowered, strings.ToLower(f))
 	}
-
-	if area, ok := reqMap["area"].(float64); ok {
-		criteria.TargetArea = &area
-		hasData = true
+	for _, flag := range domain.VisualFeatureFlags {
+		if slices.Contains(lowered, flag) {
+			prefs.Features = append(prefs.Features, flag)
+			continue
+		}
+		for _, kw := range visualFeatureKeywords[flag] {
+			if strings.Contains(text, kw) || slices.ContainsFunc(lowered, func(f string) bool { return strings.Contains(f, kw) }) {
+				prefs.Features = append(prefs.Features, flag)
+				break
+			}
+		}
 	}
 
-	if !hasData {
+	if prefs.IsEmpty() {
 		return nil
 	}
+	return prefs
+}
 
-	return criteria
+// applyVisualPreferences добавляет визуальные пожелания в критерии и поднимает вес Visual:
+// если лид что-то сказал о ремонте или виде, эта оценка не должна теряться среди остальных.
+func applyVisualPreferences(criteria *domain.SoftCriteria, weights domain.MatchWeights, prefs *domain.VisualPreferences) (*domain.SoftCriteria, domain.MatchWeights) {
+	if prefs.IsEmpty() {
+		return criteria, weights
+	}
+	if criteria == nil {
+		criteria = &domain.SoftCriteria{}
+	}
+	criteria.Visual = prefs
+	weights.Visual = max(weights.Visual*2, 0.15)
+	return criteria, weights
+}
+
+// extractCriteriaFromRequirement извлекает критерии из требований лида.
+func (a *Analyzer) extractCriteriaFromRequirement(lead domain.Lead) *domain.SoftCriteria {
+	return lead.Requirement.SoftCriteria()
 }
 
 // adjustWeightsBasedOnData корректирует веса на основе заполненности данных.
@@ -324,11 +379,27 @@ func (a *Analyzer) generateExplanation(result *AnalyzeResult) string {
 		if result.Criteria.TargetRooms != nil {
 			parts = append(parts, fmt.Sprintf("%d комн.", *result.Criteria.TargetRooms))
 		}
+		if v := result.Criteria.Visual; !v.IsEmpty() {
+			parts = append(parts, "по фото: "+describeVisual(v))
+		}
 	}
 
 	return strings.Join(parts, "; ")
 }
 
+// describeVisual — краткое описание визуальных пожеланий для объяснения.
+func describeVisual(v *domain.VisualPreferences) string {
+	var parts []string
+	if v.MinQuality != nil {
+		parts = append(parts, fmt.Sprintf("ремонт от %.2f", *v.MinQuality))
+	}
+	if v.GoodView {
+		parts = append(parts, "хороший вид")
+	}
+	parts = append(parts, v.Features...)
+	return strings.Join(parts, ", ")
+}
+
 // GetPresetByLeadType возвращает пресет весов по типу лида.
 func (a *Analyzer) GetPresetByLeadType(leadType string) *domain.WeightPreset {
 	presets := domain.GetWeightPresets()
@@ -338,7 +409,7 @@ func (a *Analyzer) GetPresetByLeadType(leadType string) *domain.WeightPreset {
 		"location_oriented": "location_first",
 		"family_oriented":   "family",
 		"investor":          "budget_first",
-		"luxury":            "semantic",
+		"luxury":            "visual_first",
 		"balanced":          "balanced",
 	}
 
@@ -367,25 +438,9 @@ func (a *Analyzer) IsShortLead(lead domain.Lead) bool {
 		return true
 	}
 
-	if len(lead.Requirement) == 0 {
-		return true
-	}
-
-	var reqMap map[string]interface{}
-	if err := json.Unmarshal(lead.Requirement, &reqMap); err != nil {
-		return true
-	}
-
-	// Проверяем наличие ключевых полей
-	hasPrice := false
-	hasRooms := false
-
-	if _, ok := reqMap["price"]; ok {
-		hasPrice = true
-	}
-	if _, ok := reqMap["roomNumber"]; ok {
-		hasRooms = true
-	}
+	req := lead.Requirement
+	hasPrice := req.BudgetMin != nil || req.BudgetMax != nil
+	hasRooms := req.RoomsMin != nil || req.RoomsMax != nil
 
 	return !hasPrice && !hasRooms
 }
@@ -398,25 +453,17 @@ func (a *Analyzer) GetMissingFields(lead domain.Lead) []string {
 		missing = append(missing, "city")
 	}
 
-	var reqMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		json.Unmarshal(lead.Requirement, &reqMap)
-	}
-
-	if reqMap == nil {
-		return append(missing, "price", "roomNumber", "area", "district")
-	}
-
-	if _, ok := reqMap["price"]; !ok {
+	req := lead.Requirement
+	if req.BudgetMin == nil && req.BudgetMax == nil {
 		missing = append(missing, "price")
 	}
-	if _, ok := reqMap["roomNumber"]; !ok {
+	if req.RoomsMin == nil && req.RoomsMax == nil {
 		missing = append(missing, "roomNumber")
 	}
-	if _, ok := reqMap["area"]; !ok {
+	if req.AreaMin == nil && req.AreaMax == nil {
 		missing = append(missing, "area")
 	}
-	if _, ok := reqMap["district"]; !ok {
+	if len(req.Districts) == 0 {
 		missing = append(missing, "district")
 	}
 
@@ -2,12 +2,13 @@ package weights
 
 import (
 	"context"
-	"encoding/json"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/llm"
 	"log/slog"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -42,6 +43,10 @@ func (m *MockLLMClient) IsEnabled() bool {
 	return m.IsEnabledValue
 }
 
+func (m *MockLLMClient) Ping(ctx context.Context) error {
+	return nil
+}
+
 func TestAnalyzer_HeuristicAnalysis_BudgetOriented(t *testing.T) {
 	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	llmClient := &MockLLMClient{IsEnabledValue: false}
@@ -225,13 +230,12 @@ func TestAnalyzer_ExtractCriteriaFromRequirement(t *testing.T) {
 		"roomNumber": float64(3),
 		"area":       float64(80),
 	}
-	reqJSON, _ := json.Marshal(requirement)
 
 	lead := domain.Lead{
 		ID:          uuid.New(),
 		Title:       "Test Lead",
 		Description: "Test",
-		Requirement: reqJSON,
+		Requirement: domain.UpgradeLegacyRequirement(requirement),
 	}
 
 	result, err := analyzer.AnalyzeLead(context.Background(), lead)
@@ -252,6 +256,102 @@ func TestAnalyzer_ExtractCriteriaFromRequirement(t *testing.T) {
 	}
 }
 
+func TestExtractVisualPreferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		features    []string
+		wantQuality *float64
+		wantView    bool
+		wantFlags   []string
+	}{
+		{
+			name: "nothing visual",
+			text: "ищу двушку недорого",
+		},
+		{
+			name:        "renovation and park view",
+			text:        "нужна квартира со свежим ремонтом и видом на парк",
+			wantQuality: ptr(0.7),
+			wantView:    true,
+		},
+		{
+			name:        "designer renovation wins",
+			text:        "дизайнерский ремонт, евроремонт",
+			wantQuality: ptr(0.85),
+		},
+		{
+			name:      "features from text and requirement",
+			text:      "обязательно балкон и подземный паркинг",
+			features:  []string{"Pool", "лифт"},
+			wantFlags: []string{"balcony", "parking", "pool"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractVisualPreferences(tt.text, tt.features)
+			if tt.wantQuality == nil && !tt.wantView && tt.wantFlags == nil {
+				if got != nil {
+					t.Fatalf("expected no preferences, got %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected preferences")
+			}
+			if (got.MinQuality == nil) != (tt.wantQuality == nil) ||
+				(got.MinQuality != nil && *got.MinQuality != *tt.wantQuality) {
+				t.Errorf("MinQuality = %v, want %v", got.MinQuality, tt.wantQuality)
+			}
+			if got.GoodView != tt.wantView {
+				t.Errorf("GoodView = %v, want %v", got.GoodView, tt.wantView)
+			}
+			if !slices.Equal(got.Features, tt.wantFlags) {
+				t.Errorf("Features = %v, want %v", got.Features, tt.wantFlags)
+			}
+		})
+	}
+}
+
+func TestAnalyzer_VisualPreferencesRaiseWeight(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.SearchConfig{DynamicWeightsEnabled: true}
+	analyzer := NewAnalyzer(log, &MockLLMClient{IsEnabledValue: false}, cfg)
+
+	plain, err := analyzer.AnalyzeLead(context.Background(), domain.Lead{
+		ID:          uuid.New(),
+		Title:       "Двушка",
+		Description: "Ищу двухкомнатную квартиру",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	visual, err := analyzer.AnalyzeLead(context.Background(), domain.Lead{
+		ID:          uuid.New(),
+		Title:       "Двушка",
+		Description: "Ищу двухкомнатную квартиру со свежим ремонтом",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if visual.Criteria == nil || visual.Criteria.Visual.IsEmpty() {
+		t.Fatal("expected visual preferences in criteria")
+	}
+	if visual.Weights.Visual <= plain.Weights.Visual {
+		t.Errorf("visual weight = %f, want > %f", visual.Weights.Visual, plain.Weights.Visual)
+	}
+	if !strings.Contains(visual.Explanation, "по фото") {
+		t.Errorf("explanation %q should mention visual preferences", visual.Explanation)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestMatchWeights_Normalize(t *testing.T) {
 	weights := domain.MatchWeights{
 		Price:    0.5,
@@ -278,7 +378,7 @@ func TestMatchWeights_Normalize(t *testing.T) {
 func TestDefaultWeights(t *testing.T) {
 	weights := domain.DefaultWeights()
 
-	sum := weights.Price + weights.District + weights.Rooms + weights.Area + weights.Semantic
+	sum := weights.Price + weights.District + weights.Rooms + weights.Area + weights.Semantic + weights.Visual
 	if sum < 0.99 || sum > 1.01 {
 		t.Errorf("expected default weights sum to be ~1.0, got %f", sum)
 	}
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- История переходов статусов сделки (state machine PENDING → ACCEPTED → COMPLETED)
+CREATE TABLE IF NOT EXISTS deal_status_history
+(
+    history_id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    deal_id            UUID        NOT NULL REFERENCES deals(deal_id) ON DELETE CASCADE,
+    from_status        TEXT,
+    to_status          TEXT        NOT NULL,
+    changed_by_user_id UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_deal_status_history_deal_id ON deal_status_history (deal_id, created_at);
+
+-- Для существующих сделок фиксируем текущий статус как начальную запись истории
+INSERT INTO deal_status_history (deal_id, from_status, to_status, changed_by_user_id, created_at)
+SELECT deal_id, NULL, status, seller_user_id, updated_at
+FROM deals;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS deal_status_history;
+
+-- +goose StatementEnd
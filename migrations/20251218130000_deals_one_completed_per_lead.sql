@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Лид может быть продан только один раз: не более одной завершённой сделки на лид
+CREATE UNIQUE INDEX IF NOT EXISTS deals_one_completed_per_lead_idx ON deals (lead_id)
+    WHERE status = 'COMPLETED';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS deals_one_completed_per_lead_idx;
+
+-- +goose StatementEnd
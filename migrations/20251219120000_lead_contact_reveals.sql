@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Аудит раскрытия контактных данных лида (RevealLeadContacts)
+CREATE TABLE IF NOT EXISTS lead_contact_reveals
+(
+    reveal_id  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lead_id    UUID        NOT NULL REFERENCES leads(lead_id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason     TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_lead_contact_reveals_lead_id ON lead_contact_reveals (lead_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_lead_contact_reveals_user_id ON lead_contact_reveals (user_id, created_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS lead_contact_reveals;
+
+-- +goose StatementEnd
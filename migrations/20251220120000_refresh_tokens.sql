@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Версия токенов пользователя: увеличение отзывает все выданные access-токены
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS token_version INT NOT NULL DEFAULT 0;
+
+-- Refresh-токены (ротация при каждом обновлении, хранится только SHA-256 хеш)
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    token_id    UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    token_hash  TEXT        NOT NULL UNIQUE,
+    device      TEXT        NOT NULL DEFAULT '',
+    expires_at  TIMESTAMPTZ NOT NULL,
+    revoked_at  TIMESTAMPTZ,
+    replaced_by UUID REFERENCES refresh_tokens(token_id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id) WHERE revoked_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS refresh_tokens;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS token_version;
+
+-- +goose StatementEnd
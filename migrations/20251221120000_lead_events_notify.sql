@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Уведомления о событиях лидов для потоковой подписки (SubscribeLeads).
+-- Срабатывает при создании лида, смене статуса и первом появлении embedding
+-- (подписчики с семантическим фильтром получают лид, когда его можно сравнить).
+CREATE OR REPLACE FUNCTION notify_lead_event() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT'
+        OR NEW.status IS DISTINCT FROM OLD.status
+        OR (OLD.embedding IS NULL AND NEW.embedding IS NOT NULL) THEN
+        PERFORM pg_notify('lead_events', json_build_object('lead_id', NEW.lead_id, 'status', NEW.status)::text);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER leads_notify_event
+    AFTER INSERT OR UPDATE ON leads
+    FOR EACH ROW
+EXECUTE FUNCTION notify_lead_event();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER IF EXISTS leads_notify_event ON leads;
+DROP FUNCTION IF EXISTS notify_lead_event();
+
+-- +goose StatementEnd
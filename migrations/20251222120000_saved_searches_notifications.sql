@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Сохранённые поиски: лид или фильтр объектов + пресет весов, периодически перезапускаются планировщиком
+CREATE TABLE IF NOT EXISTS saved_searches
+(
+    saved_search_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id         UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name            TEXT        NOT NULL,
+    lead_id         UUID REFERENCES leads(lead_id) ON DELETE CASCADE,
+    filter          JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    weight_preset   TEXT,
+    result_limit    INT         NOT NULL DEFAULT 20,
+    enabled         BOOLEAN     NOT NULL DEFAULT TRUE,
+    last_run_at     TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user_id ON saved_searches (user_id);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_due ON saved_searches (last_run_at NULLS FIRST) WHERE enabled;
+
+-- Объекты, уже найденные сохранённым поиском (для вычисления новых совпадений)
+CREATE TABLE IF NOT EXISTS saved_search_hits
+(
+    saved_search_id UUID        NOT NULL REFERENCES saved_searches(saved_search_id) ON DELETE CASCADE,
+    property_id     UUID        NOT NULL REFERENCES properties(property_id) ON DELETE CASCADE,
+    first_seen_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (saved_search_id, property_id)
+);
+
+-- Уведомления пользователей
+CREATE TABLE IF NOT EXISTS notifications
+(
+    notification_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id         UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    type            TEXT        NOT NULL,
+    title           TEXT        NOT NULL,
+    body            TEXT        NOT NULL DEFAULT '',
+    payload         JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    read_at         TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created ON notifications (user_id, created_at DESC, notification_id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread ON notifications (user_id) WHERE read_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS saved_search_hits;
+DROP TABLE IF EXISTS saved_searches;
+
+-- +goose StatementEnd
@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Перевод leads.requirement со свободного JSON на схему domain.LeadRequirement (версия 1).
+-- Исходный JSON сохраняется в requirement_legacy для отката.
+ALTER TABLE leads ADD COLUMN IF NOT EXISTS requirement_legacy JSONB;
+
+-- Первое числовое значение по одному из ключей; строки вида "8 000 000" тоже считаются числами
+CREATE OR REPLACE FUNCTION legacy_requirement_number(req JSONB, keys TEXT[]) RETURNS NUMERIC AS $$
+DECLARE
+    k   TEXT;
+    val TEXT;
+BEGIN
+    FOREACH k IN ARRAY keys LOOP
+        IF jsonb_typeof(req -> k) = 'number' THEN
+            RETURN (req ->> k)::numeric;
+        END IF;
+        IF jsonb_typeof(req -> k) = 'string' THEN
+            val := regexp_replace(req ->> k, '\s', '', 'g');
+            IF val ~ '^-?[0-9]+(\.[0-9]+)?$' THEN
+                RETURN val::numeric;
+            END IF;
+        END IF;
+    END LOOP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Значения разбираются как в domain.UpgradeLegacyRequirement: точные значения становятся диапазонами
+-- из одного значения, цена — верхней границей бюджета, неизвестные ключи отбрасываются.
+-- В отличие от него, значения, которые не пройдут проверку схемы (цена <= 0, комнаты вне 0..50,
+-- площадь <= 0, неизвестный тип), при переносе пропускаются, а не делают документ невалидным.
+WITH legacy AS (
+    SELECT
+        lead_id,
+        requirement AS old,
+        trunc(legacy_requirement_number(requirement, ARRAY['price', 'preferredPrice'])) AS price,
+        trunc(legacy_requirement_number(requirement, ARRAY['roomNumber', 'rooms'])) AS rooms,
+        legacy_requirement_number(requirement, ARRAY['area']) AS area,
+        NULLIF(btrim(requirement ->> 'district'), '') AS district,
+        UPPER(COALESCE(requirement ->> 'propertyType', requirement ->> 'property_type')) AS property_type
+    FROM leads
+    WHERE jsonb_typeof(requirement) <> 'object' OR NOT requirement ? 'version'
+)
+UPDATE leads l
+SET requirement_legacy = legacy.old,
+    requirement = jsonb_strip_nulls(jsonb_build_object(
+        'version', 1,
+        'budget_max', CASE WHEN legacy.price > 0 THEN legacy.price::bigint END,
+        'rooms_min', CASE WHEN legacy.rooms BETWEEN 0 AND 50 THEN legacy.rooms::int END,
+        'rooms_max', CASE WHEN legacy.rooms BETWEEN 0 AND 50 THEN legacy.rooms::int END,
+        'area_min', CASE WHEN legacy.area > 0 THEN legacy.area END,
+        'area_max', CASE WHEN legacy.area > 0 THEN legacy.area END,
+        'districts', CASE WHEN legacy.district IS NOT NULL THEN jsonb_build_array(legacy.district) END,
+        'property_type', CASE WHEN legacy.property_type IN ('APARTMENT', 'HOUSE', 'COMMERCIAL', 'LAND') THEN legacy.property_type END
+    ))
+FROM legacy
+WHERE l.lead_id = legacy.lead_id;
+
+DROP FUNCTION legacy_requirement_number(JSONB, TEXT[]);
+
+ALTER TABLE leads ADD CONSTRAINT leads_requirement_versioned
+    CHECK (jsonb_typeof(requirement) = 'object' AND requirement ? 'version');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE leads DROP CONSTRAINT IF EXISTS leads_requirement_versioned;
+
+UPDATE leads SET requirement = requirement_legacy WHERE requirement_legacy IS NOT NULL;
+
+ALTER TABLE leads DROP COLUMN IF EXISTS requirement_legacy;
+
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Outbox генерации embedding: задание пишется в одной транзакции с созданием/изменением лида или объекта
+-- и выполняется пулом воркеров с повторами. Успешные задания удаляются, исчерпавшие попытки — DEAD.
+CREATE TABLE IF NOT EXISTS embedding_jobs
+(
+    job_id       UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type  TEXT        NOT NULL CHECK (entity_type IN ('lead', 'property')),
+    entity_id    UUID        NOT NULL,
+    status       TEXT        NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'PROCESSING', 'DEAD')),
+    attempts     INT         NOT NULL DEFAULT 0,
+    last_error   TEXT,
+    next_run_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Не больше одного ожидающего задания на сущность: повторные изменения схлопываются
+CREATE UNIQUE INDEX IF NOT EXISTS uq_embedding_jobs_pending
+    ON embedding_jobs (entity_type, entity_id) WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_embedding_jobs_due ON embedding_jobs (next_run_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_embedding_jobs_status ON embedding_jobs (status, created_at DESC);
+
+-- Лиды и объекты, оставшиеся без embedding после прежней генерации в фоне
+INSERT INTO embedding_jobs (entity_type, entity_id)
+SELECT 'lead', lead_id FROM leads WHERE embedding IS NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO embedding_jobs (entity_type, entity_id)
+SELECT 'property', property_id FROM properties WHERE embedding IS NULL
+ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS embedding_jobs;
+
+-- +goose StatementEnd
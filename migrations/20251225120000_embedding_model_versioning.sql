@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Модель, которой получен embedding записи. Заполняется при записи embedding;
+-- для записей, созданных до версионирования, проставляется при первой проверке модели на старте.
+ALTER TABLE leads ADD COLUMN IF NOT EXISTS embedding_model TEXT;
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS embedding_model TEXT;
+
+-- Теневые колонки для миграции на новую модель: поиск читает embedding, а embedding новой
+-- модели пишется в embedding_next. Размерность задаётся при старте миграции (vector(N)).
+ALTER TABLE leads ADD COLUMN IF NOT EXISTS embedding_next vector;
+ALTER TABLE leads ADD COLUMN IF NOT EXISTS embedding_next_model TEXT;
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS embedding_next vector;
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS embedding_next_model TEXT;
+
+-- Состояние моделей embedding (одна строка): активная модель и целевая модель идущей миграции.
+CREATE TABLE IF NOT EXISTS embedding_model_state
+(
+    id                   BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    active_model         TEXT,
+    active_dimensions    INT         NOT NULL DEFAULT 384,
+    next_model           TEXT,
+    next_dimensions      INT,
+    migration_started_at TIMESTAMPTZ,
+    cutover_at           TIMESTAMPTZ,
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO embedding_model_state (id) VALUES (TRUE) ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS embedding_model_state;
+
+ALTER TABLE properties DROP COLUMN IF EXISTS embedding_next_model;
+ALTER TABLE properties DROP COLUMN IF EXISTS embedding_next;
+ALTER TABLE leads DROP COLUMN IF EXISTS embedding_next_model;
+ALTER TABLE leads DROP COLUMN IF EXISTS embedding_next;
+ALTER TABLE properties DROP COLUMN IF EXISTS embedding_model;
+ALTER TABLE leads DROP COLUMN IF EXISTS embedding_model;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Счётчики rate limit для нескольких экземпляров сервера: одно окно фиксированной длины на ключ
+-- (метод + пользователь или IP). При переходе в новое окно счётчик сбрасывается.
+CREATE TABLE IF NOT EXISTS rate_limits
+(
+    bucket_key   TEXT PRIMARY KEY,
+    window_start TIMESTAMPTZ NOT NULL,
+    hits         INT         NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limits_window_start ON rate_limits (window_start);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS rate_limits;
+
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Журнал попыток входа: успешные и неудачные, с адресом и User-Agent клиента.
+-- Неудачные попытки с неверным паролем учитываются при блокировке по IP.
+CREATE TABLE IF NOT EXISTS login_attempts
+(
+    attempt_id     UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+    email          TEXT        NOT NULL,
+    user_id        UUID REFERENCES users (user_id) ON DELETE SET NULL,
+    ip             TEXT        NOT NULL,
+    user_agent     TEXT        NOT NULL DEFAULT '',
+    success        BOOLEAN     NOT NULL,
+    failure_reason TEXT        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_attempts_email_created_at ON login_attempts (email, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_login_attempts_ip_failures ON login_attempts (ip, created_at)
+    WHERE NOT success AND failure_reason = 'invalid_credentials';
+
+-- Неудачные попытки подряд по email и временная блокировка входа.
+-- Ключ — email, а не пользователь: несуществующие адреса блокируются так же, как существующие.
+CREATE TABLE IF NOT EXISTS login_lockouts
+(
+    email          TEXT PRIMARY KEY,
+    failed_count   INT         NOT NULL,
+    last_failed_at TIMESTAMPTZ NOT NULL,
+    locked_until   TIMESTAMPTZ
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS login_lockouts;
+DROP TABLE IF EXISTS login_attempts;
+
+-- +goose StatementEnd
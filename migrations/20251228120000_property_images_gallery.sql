@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Галерея объекта: property_images хранит ключи объектов MinIO (storage_path) и их порядок.
+-- Ссылки на скачивание не хранятся — они выдаются заново при каждом чтении.
+ALTER TABLE property_images ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_property_images_property_position ON property_images (property_id, position);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_property_images_property_storage_path ON property_images (property_id, storage_path);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_property_images_property_storage_path;
+DROP INDEX IF EXISTS idx_property_images_property_position;
+ALTER TABLE property_images DROP COLUMN IF EXISTS position;
+
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Состояние анализа галереи: большие галереи анализируются фоновым воркером,
+-- который забирает объекты в статусе PENDING (или с истёкшей арендой PROCESSING).
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS image_analysis_status VARCHAR(20);
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS image_analysis_error TEXT;
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS image_analysis_locked_until TIMESTAMP;
+ALTER TABLE properties ADD COLUMN IF NOT EXISTS images_analyzed_at TIMESTAMP;
+
+ALTER TABLE properties ADD CONSTRAINT chk_properties_image_analysis_status
+    CHECK (image_analysis_status IN ('PENDING', 'PROCESSING', 'DONE', 'FAILED'));
+
+CREATE INDEX IF NOT EXISTS idx_properties_image_analysis_queue ON properties (image_analysis_status)
+    WHERE image_analysis_status IN ('PENDING', 'PROCESSING');
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_properties_image_analysis_queue;
+ALTER TABLE properties DROP CONSTRAINT IF EXISTS chk_properties_image_analysis_status;
+ALTER TABLE properties DROP COLUMN IF EXISTS images_analyzed_at;
+ALTER TABLE properties DROP COLUMN IF EXISTS image_analysis_locked_until;
+ALTER TABLE properties DROP COLUMN IF EXISTS image_analysis_error;
+ALTER TABLE properties DROP COLUMN IF EXISTS image_analysis_status;
+
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Загрузки файлов мимо gRPC: presigned PUT (CreateUploadURL → CompleteUpload) и поток UploadFileStream.
+-- PENDING — ссылка выдана, COMPLETED — объект найден в MinIO, тип и размер проверены.
+CREATE TABLE IF NOT EXISTS uploads (
+    upload_id    UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    storage_key  TEXT NOT NULL UNIQUE,
+    user_id      UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    file_name    TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size         BIGINT NOT NULL,
+    status       TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'COMPLETED')),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_uploads_pending_expires ON uploads (expires_at) WHERE status = 'PENDING';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS uploads;
+
+-- +goose StatementEnd
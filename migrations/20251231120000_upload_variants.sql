@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Копии загруженных изображений (thumbnail, card, full; основной формат и WebP),
+-- построенные при подтверждении загрузки: [{name, format, key, content_type, width, height, size}].
+ALTER TABLE uploads ADD COLUMN IF NOT EXISTS variants JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE uploads DROP COLUMN IF EXISTS variants;
+
+-- +goose StatementEnd
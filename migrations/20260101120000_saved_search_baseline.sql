@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Момент, когда записана исходная выдача сохранённого поиска. До этого запуск считается первым
+-- и уведомлений не создаёт. last_run_at для этого не годится: он ставится при захвате поиска,
+-- ещё до запуска, и после неудачного первого запуска следующий уведомил бы обо всей выдаче.
+ALTER TABLE saved_searches ADD COLUMN IF NOT EXISTS baseline_at TIMESTAMPTZ;
+
+-- Поиски с сохранённой выдачей уже прошли первый запуск
+UPDATE saved_searches s
+SET baseline_at = s.last_run_at
+WHERE s.last_run_at IS NOT NULL
+  AND EXISTS (SELECT 1 FROM saved_search_hits h WHERE h.saved_search_id = s.saved_search_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE saved_searches DROP COLUMN IF EXISTS baseline_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Токен захвата задания. Воркер может фиксировать результат, только пока lease принадлежит ему:
+-- иначе воркер, не уложившийся в lease, удалил бы или перевёл в DEAD задание,
+-- которое уже перезабрал и выполняет другой.
+ALTER TABLE embedding_jobs ADD COLUMN IF NOT EXISTS locked_by UUID;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE embedding_jobs DROP COLUMN IF EXISTS locked_by;
+
+-- +goose StatementEnd
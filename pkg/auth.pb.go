@@ -117,9 +117,11 @@ func (x *RegisterRequest) GetAvatarUrl() string {
 }
 
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Название устройства/клиента для сессии (например, "iPhone", "web")
+	Device        *string `protobuf:"bytes,3,opt,name=device,proto3,oneof" json:"device,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -168,11 +170,25 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDevice() string {
+	if x != nil && x.Device != nil {
+		return *x.Device
+	}
+	return ""
+}
+
 type AuthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Access-токен (JWT)
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Refresh-токен для получения новой пары токенов
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Время истечения access-токена
+	TokenExpiresAt string `protobuf:"bytes,3,opt,name=token_expires_at,json=tokenExpiresAt,proto3" json:"token_expires_at,omitempty"`
+	// Время истечения refresh-токена
+	RefreshTokenExpiresAt string `protobuf:"bytes,4,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *AuthResponse) Reset() {
@@ -212,16 +228,138 @@ func (x *AuthResponse) GetToken() string {
 	return ""
 }
 
-type HealthCheckResponse struct {
+func (x *AuthResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetTokenExpiresAt() string {
+	if x != nil {
+		return x.TokenExpiresAt
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetRefreshTokenExpiresAt() string {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Refresh-токен завершаемой сессии (не нужен, если all_devices = true)
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Завершить все сессии пользователя на всех устройствах
+	AllDevices    bool `protobuf:"varint,2,opt,name=all_devices,json=allDevices,proto3" json:"all_devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetAllDevices() bool {
+	if x != nil {
+		return x.AllDevices
+	}
+	return false
+}
+
+type HealthCheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ok, degraded (недоступна некритичная зависимость) или fail.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Статус каждой зависимости (postgres, migrations, minio, ml, llm): ok или fail.
+	Checks        map[string]string `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HealthCheckResponse) Reset() {
 	*x = HealthCheckResponse{}
-	mi := &file_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +371,7 @@ func (x *HealthCheckResponse) String() string {
 func (*HealthCheckResponse) ProtoMessage() {}
 
 func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +384,7 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{3}
+	return file_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HealthCheckResponse) GetStatus() string {
@@ -256,6 +394,13 @@ func (x *HealthCheckResponse) GetStatus() string {
 	return ""
 }
 
+func (x *HealthCheckResponse) GetChecks() map[string]string {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
 var File_auth_proto protoreflect.FileDescriptor
 
 const file_auth_proto_rawDesc = "" +
@@ -275,17 +420,34 @@ const file_auth_proto_rawDesc = "" +
 	"avatar_url\x18\a \x01(\tH\x02R\tavatarUrl\x88\x01\x01B\b\n" +
 	"\x06_phoneB\x0e\n" +
 	"\f_agency_nameB\r\n" +
-	"\v_avatar_url\"R\n" +
+	"\v_avatar_url\"\x84\x01\n" +
 	"\fLoginRequest\x12\x1d\n" +
 	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12#\n" +
-	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\bR\bpassword\"$\n" +
+	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\bR\bpassword\x12%\n" +
+	"\x06device\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01H\x00R\x06device\x88\x01\x01B\t\n" +
+	"\a_device\"\xac\x01\n" +
 	"\fAuthResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"-\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12(\n" +
+	"\x10token_expires_at\x18\x03 \x01(\tR\x0etokenExpiresAt\x127\n" +
+	"\x18refresh_token_expires_at\x18\x04 \x01(\tR\x15refreshTokenExpiresAt\"C\n" +
+	"\x13RefreshTokenRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\frefreshToken\"U\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1f\n" +
+	"\vall_devices\x18\x02 \x01(\bR\n" +
+	"allDevices\"\xb2\x01\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\xb1\x02\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12H\n" +
+	"\x06checks\x18\x02 \x03(\v20.leadexchange.v1.HealthCheckResponse.ChecksEntryR\x06checks\x1a9\n" +
+	"\vChecksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\x81\x04\n" +
 	"\vAuthService\x12b\n" +
 	"\bRegister\x12 .leadexchange.v1.RegisterRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/register\x12`\n" +
-	"\x05Login\x12\x1d.leadexchange.v1.LoginRequest\x1a\x1d.leadexchange.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12\\\n" +
+	"\x05Login\x12\x1d.leadexchange.v1.LoginRequest\x1a\x1d.leadexchange.v1.AuthResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12p\n" +
+	"\fRefreshToken\x12$.leadexchange.v1.RefreshTokenRequest\x1a\x1d.leadexchange.v1.AuthResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/auth/refresh\x12\\\n" +
+	"\x06Logout\x12\x1e.leadexchange.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12\\\n" +
 	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a$.leadexchange.v1.HealthCheckResponse\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/healthB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
@@ -300,26 +462,34 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_auth_proto_goTypes = []any{
 	(*RegisterRequest)(nil),     // 0: leadexchange.v1.RegisterRequest
 	(*LoginRequest)(nil),        // 1: leadexchange.v1.LoginRequest
 	(*AuthResponse)(nil),        // 2: leadexchange.v1.AuthResponse
-	(*HealthCheckResponse)(nil), // 3: leadexchange.v1.HealthCheckResponse
-	(*emptypb.Empty)(nil),       // 4: google.protobuf.Empty
+	(*RefreshTokenRequest)(nil), // 3: leadexchange.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),       // 4: leadexchange.v1.LogoutRequest
+	(*HealthCheckResponse)(nil), // 5: leadexchange.v1.HealthCheckResponse
+	nil,                         // 6: leadexchange.v1.HealthCheckResponse.ChecksEntry
+	(*emptypb.Empty)(nil),       // 7: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
-	0, // 0: leadexchange.v1.AuthService.Register:input_type -> leadexchange.v1.RegisterRequest
-	1, // 1: leadexchange.v1.AuthService.Login:input_type -> leadexchange.v1.LoginRequest
-	4, // 2: leadexchange.v1.AuthService.HealthCheck:input_type -> google.protobuf.Empty
-	4, // 3: leadexchange.v1.AuthService.Register:output_type -> google.protobuf.Empty
-	2, // 4: leadexchange.v1.AuthService.Login:output_type -> leadexchange.v1.AuthResponse
-	3, // 5: leadexchange.v1.AuthService.HealthCheck:output_type -> leadexchange.v1.HealthCheckResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: leadexchange.v1.HealthCheckResponse.checks:type_name -> leadexchange.v1.HealthCheckResponse.ChecksEntry
+	0, // 1: leadexchange.v1.AuthService.Register:input_type -> leadexchange.v1.RegisterRequest
+	1, // 2: leadexchange.v1.AuthService.Login:input_type -> leadexchange.v1.LoginRequest
+	3, // 3: leadexchange.v1.AuthService.RefreshToken:input_type -> leadexchange.v1.RefreshTokenRequest
+	4, // 4: leadexchange.v1.AuthService.Logout:input_type -> leadexchange.v1.LogoutRequest
+	7, // 5: leadexchange.v1.AuthService.HealthCheck:input_type -> google.protobuf.Empty
+	7, // 6: leadexchange.v1.AuthService.Register:output_type -> google.protobuf.Empty
+	2, // 7: leadexchange.v1.AuthService.Login:output_type -> leadexchange.v1.AuthResponse
+	2, // 8: leadexchange.v1.AuthService.RefreshToken:output_type -> leadexchange.v1.AuthResponse
+	7, // 9: leadexchange.v1.AuthService.Logout:output_type -> google.protobuf.Empty
+	5, // 10: leadexchange.v1.AuthService.HealthCheck:output_type -> leadexchange.v1.HealthCheckResponse
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -328,13 +498,14 @@ func file_auth_proto_init() {
 		return
 	}
 	file_auth_proto_msgTypes[0].OneofWrappers = []any{}
+	file_auth_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
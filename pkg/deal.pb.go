@@ -195,6 +195,78 @@ func (x *Deal) GetUpdatedAt() string {
 	return ""
 }
 
+// DealStatusChange — запись истории перехода статуса сделки.
+type DealStatusChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Предыдущий статус (UNSPECIFIED для записи о создании сделки)
+	FromStatus DealStatus `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=leadexchange.v1.DealStatus" json:"from_status,omitempty"`
+	// Новый статус
+	ToStatus DealStatus `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=leadexchange.v1.DealStatus" json:"to_status,omitempty"`
+	// UUID пользователя, выполнившего переход
+	ChangedByUserId string `protobuf:"bytes,3,opt,name=changed_by_user_id,json=changedByUserId,proto3" json:"changed_by_user_id,omitempty"`
+	CreatedAt       string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DealStatusChange) Reset() {
+	*x = DealStatusChange{}
+	mi := &file_deal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DealStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealStatusChange) ProtoMessage() {}
+
+func (x *DealStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_deal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealStatusChange.ProtoReflect.Descriptor instead.
+func (*DealStatusChange) Descriptor() ([]byte, []int) {
+	return file_deal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DealStatusChange) GetFromStatus() DealStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return DealStatus_DEAL_STATUS_UNSPECIFIED
+}
+
+func (x *DealStatusChange) GetToStatus() DealStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return DealStatus_DEAL_STATUS_UNSPECIFIED
+}
+
+func (x *DealStatusChange) GetChangedByUserId() string {
+	if x != nil {
+		return x.ChangedByUserId
+	}
+	return ""
+}
+
+func (x *DealStatusChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 type CreateDealRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// UUID лида, который продаётся
@@ -207,7 +279,7 @@ type CreateDealRequest struct {
 
 func (x *CreateDealRequest) Reset() {
 	*x = CreateDealRequest{}
-	mi := &file_deal_proto_msgTypes[1]
+	mi := &file_deal_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +291,7 @@ func (x *CreateDealRequest) String() string {
 func (*CreateDealRequest) ProtoMessage() {}
 
 func (x *CreateDealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[1]
+	mi := &file_deal_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +304,7 @@ func (x *CreateDealRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDealRequest.ProtoReflect.Descriptor instead.
 func (*CreateDealRequest) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{1}
+	return file_deal_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateDealRequest) GetLeadId() string {
@@ -258,7 +330,7 @@ type GetDealRequest struct {
 
 func (x *GetDealRequest) Reset() {
 	*x = GetDealRequest{}
-	mi := &file_deal_proto_msgTypes[2]
+	mi := &file_deal_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +342,7 @@ func (x *GetDealRequest) String() string {
 func (*GetDealRequest) ProtoMessage() {}
 
 func (x *GetDealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[2]
+	mi := &file_deal_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +355,7 @@ func (x *GetDealRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDealRequest.ProtoReflect.Descriptor instead.
 func (*GetDealRequest) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{2}
+	return file_deal_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetDealRequest) GetDealId() string {
@@ -302,7 +374,7 @@ type ListDealsRequest struct {
 
 func (x *ListDealsRequest) Reset() {
 	*x = ListDealsRequest{}
-	mi := &file_deal_proto_msgTypes[3]
+	mi := &file_deal_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -314,7 +386,7 @@ func (x *ListDealsRequest) String() string {
 func (*ListDealsRequest) ProtoMessage() {}
 
 func (x *ListDealsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[3]
+	mi := &file_deal_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +399,7 @@ func (x *ListDealsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDealsRequest.ProtoReflect.Descriptor instead.
 func (*ListDealsRequest) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{3}
+	return file_deal_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListDealsRequest) GetFilter() *ListDealsRequest_Filter {
@@ -346,7 +418,7 @@ type ListDealsResponse struct {
 
 func (x *ListDealsResponse) Reset() {
 	*x = ListDealsResponse{}
-	mi := &file_deal_proto_msgTypes[4]
+	mi := &file_deal_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +430,7 @@ func (x *ListDealsResponse) String() string {
 func (*ListDealsResponse) ProtoMessage() {}
 
 func (x *ListDealsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[4]
+	mi := &file_deal_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +443,7 @@ func (x *ListDealsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDealsResponse.ProtoReflect.Descriptor instead.
 func (*ListDealsResponse) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{4}
+	return file_deal_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListDealsResponse) GetDeals() []*Deal {
@@ -392,7 +464,7 @@ type UpdateDealRequest struct {
 
 func (x *UpdateDealRequest) Reset() {
 	*x = UpdateDealRequest{}
-	mi := &file_deal_proto_msgTypes[5]
+	mi := &file_deal_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +476,7 @@ func (x *UpdateDealRequest) String() string {
 func (*UpdateDealRequest) ProtoMessage() {}
 
 func (x *UpdateDealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[5]
+	mi := &file_deal_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +489,7 @@ func (x *UpdateDealRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDealRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDealRequest) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{5}
+	return file_deal_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateDealRequest) GetDealId() string {
@@ -450,7 +522,7 @@ type AcceptDealRequest struct {
 
 func (x *AcceptDealRequest) Reset() {
 	*x = AcceptDealRequest{}
-	mi := &file_deal_proto_msgTypes[6]
+	mi := &file_deal_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +534,7 @@ func (x *AcceptDealRequest) String() string {
 func (*AcceptDealRequest) ProtoMessage() {}
 
 func (x *AcceptDealRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[6]
+	mi := &file_deal_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +547,7 @@ func (x *AcceptDealRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptDealRequest.ProtoReflect.Descriptor instead.
 func (*AcceptDealRequest) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{6}
+	return file_deal_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AcceptDealRequest) GetDealId() string {
@@ -494,7 +566,7 @@ type DealResponse struct {
 
 func (x *DealResponse) Reset() {
 	*x = DealResponse{}
-	mi := &file_deal_proto_msgTypes[7]
+	mi := &file_deal_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +578,7 @@ func (x *DealResponse) String() string {
 func (*DealResponse) ProtoMessage() {}
 
 func (x *DealResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[7]
+	mi := &file_deal_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +591,7 @@ func (x *DealResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealResponse.ProtoReflect.Descriptor instead.
 func (*DealResponse) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{7}
+	return file_deal_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DealResponse) GetDeal() *Deal {
@@ -529,6 +601,94 @@ func (x *DealResponse) GetDeal() *Deal {
 	return nil
 }
 
+type GetDealHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DealId        string                 `protobuf:"bytes,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDealHistoryRequest) Reset() {
+	*x = GetDealHistoryRequest{}
+	mi := &file_deal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDealHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDealHistoryRequest) ProtoMessage() {}
+
+func (x *GetDealHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDealHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDealHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_deal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDealHistoryRequest) GetDealId() string {
+	if x != nil {
+		return x.DealId
+	}
+	return ""
+}
+
+type GetDealHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*DealStatusChange    `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDealHistoryResponse) Reset() {
+	*x = GetDealHistoryResponse{}
+	mi := &file_deal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDealHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDealHistoryResponse) ProtoMessage() {}
+
+func (x *GetDealHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_deal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDealHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDealHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_deal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDealHistoryResponse) GetHistory() []*DealStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
 type ListDealsRequest_Filter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeadId        *string                `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3,oneof" json:"lead_id,omitempty"`
@@ -543,7 +703,7 @@ type ListDealsRequest_Filter struct {
 
 func (x *ListDealsRequest_Filter) Reset() {
 	*x = ListDealsRequest_Filter{}
-	mi := &file_deal_proto_msgTypes[8]
+	mi := &file_deal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +715,7 @@ func (x *ListDealsRequest_Filter) String() string {
 func (*ListDealsRequest_Filter) ProtoMessage() {}
 
 func (x *ListDealsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[8]
+	mi := &file_deal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +728,7 @@ func (x *ListDealsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDealsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListDealsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{3, 0}
+	return file_deal_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *ListDealsRequest_Filter) GetLeadId() string {
@@ -629,7 +789,14 @@ const file_deal_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"\\\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\xd6\x01\n" +
+	"\x10DealStatusChange\x12<\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x1b.leadexchange.v1.DealStatusR\n" +
+	"fromStatus\x128\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.DealStatusR\btoStatus\x12+\n" +
+	"\x12changed_by_user_id\x18\x03 \x01(\tR\x0fchangedByUserId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\\\n" +
 	"\x11CreateDealRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x12$\n" +
 	"\x05price\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\"3\n" +
@@ -664,7 +831,11 @@ const file_deal_proto_rawDesc = "" +
 	"\x11AcceptDealRequest\x12!\n" +
 	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"9\n" +
 	"\fDealResponse\x12)\n" +
-	"\x04deal\x18\x01 \x01(\v2\x15.leadexchange.v1.DealR\x04deal*\xac\x01\n" +
+	"\x04deal\x18\x01 \x01(\v2\x15.leadexchange.v1.DealR\x04deal\":\n" +
+	"\x15GetDealHistoryRequest\x12!\n" +
+	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"U\n" +
+	"\x16GetDealHistoryResponse\x12;\n" +
+	"\ahistory\x18\x01 \x03(\v2!.leadexchange.v1.DealStatusChangeR\ahistory*\xac\x01\n" +
 	"\n" +
 	"DealStatus\x12\x1b\n" +
 	"\x17DEAL_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -672,7 +843,7 @@ const file_deal_proto_rawDesc = "" +
 	"\x14DEAL_STATUS_ACCEPTED\x10\x02\x12\x19\n" +
 	"\x15DEAL_STATUS_COMPLETED\x10\x03\x12\x19\n" +
 	"\x15DEAL_STATUS_CANCELLED\x10\x04\x12\x18\n" +
-	"\x14DEAL_STATUS_REJECTED\x10\x052\xac\x04\n" +
+	"\x14DEAL_STATUS_REJECTED\x10\x052\xb5\x05\n" +
 	"\vDealService\x12e\n" +
 	"\n" +
 	"CreateDeal\x12\".leadexchange.v1.CreateDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/deals\x12f\n" +
@@ -681,7 +852,8 @@ const file_deal_proto_rawDesc = "" +
 	"\n" +
 	"UpdateDeal\x12\".leadexchange.v1.UpdateDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/deals/{deal_id}\x12v\n" +
 	"\n" +
-	"AcceptDeal\x12\".leadexchange.v1.AcceptDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/deals/{deal_id}/acceptB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+	"AcceptDeal\x12\".leadexchange.v1.AcceptDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/deals/{deal_id}/accept\x12\x86\x01\n" +
+	"\x0eGetDealHistory\x12&.leadexchange.v1.GetDealHistoryRequest\x1a'.leadexchange.v1.GetDealHistoryResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/deals/{deal_id}/historyB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
 	file_deal_proto_rawDescOnce sync.Once
@@ -696,41 +868,49 @@ func file_deal_proto_rawDescGZIP() []byte {
 }
 
 var file_deal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_deal_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_deal_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_deal_proto_goTypes = []any{
 	(DealStatus)(0),                 // 0: leadexchange.v1.DealStatus
 	(*Deal)(nil),                    // 1: leadexchange.v1.Deal
-	(*CreateDealRequest)(nil),       // 2: leadexchange.v1.CreateDealRequest
-	(*GetDealRequest)(nil),          // 3: leadexchange.v1.GetDealRequest
-	(*ListDealsRequest)(nil),        // 4: leadexchange.v1.ListDealsRequest
-	(*ListDealsResponse)(nil),       // 5: leadexchange.v1.ListDealsResponse
-	(*UpdateDealRequest)(nil),       // 6: leadexchange.v1.UpdateDealRequest
-	(*AcceptDealRequest)(nil),       // 7: leadexchange.v1.AcceptDealRequest
-	(*DealResponse)(nil),            // 8: leadexchange.v1.DealResponse
-	(*ListDealsRequest_Filter)(nil), // 9: leadexchange.v1.ListDealsRequest.Filter
+	(*DealStatusChange)(nil),        // 2: leadexchange.v1.DealStatusChange
+	(*CreateDealRequest)(nil),       // 3: leadexchange.v1.CreateDealRequest
+	(*GetDealRequest)(nil),          // 4: leadexchange.v1.GetDealRequest
+	(*ListDealsRequest)(nil),        // 5: leadexchange.v1.ListDealsRequest
+	(*ListDealsResponse)(nil),       // 6: leadexchange.v1.ListDealsResponse
+	(*UpdateDealRequest)(nil),       // 7: leadexchange.v1.UpdateDealRequest
+	(*AcceptDealRequest)(nil),       // 8: leadexchange.v1.AcceptDealRequest
+	(*DealResponse)(nil),            // 9: leadexchange.v1.DealResponse
+	(*GetDealHistoryRequest)(nil),   // 10: leadexchange.v1.GetDealHistoryRequest
+	(*GetDealHistoryResponse)(nil),  // 11: leadexchange.v1.GetDealHistoryResponse
+	(*ListDealsRequest_Filter)(nil), // 12: leadexchange.v1.ListDealsRequest.Filter
 }
 var file_deal_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.Deal.status:type_name -> leadexchange.v1.DealStatus
-	9,  // 1: leadexchange.v1.ListDealsRequest.filter:type_name -> leadexchange.v1.ListDealsRequest.Filter
-	1,  // 2: leadexchange.v1.ListDealsResponse.deals:type_name -> leadexchange.v1.Deal
-	0,  // 3: leadexchange.v1.UpdateDealRequest.status:type_name -> leadexchange.v1.DealStatus
-	1,  // 4: leadexchange.v1.DealResponse.deal:type_name -> leadexchange.v1.Deal
-	0,  // 5: leadexchange.v1.ListDealsRequest.Filter.status:type_name -> leadexchange.v1.DealStatus
-	2,  // 6: leadexchange.v1.DealService.CreateDeal:input_type -> leadexchange.v1.CreateDealRequest
-	3,  // 7: leadexchange.v1.DealService.GetDeal:input_type -> leadexchange.v1.GetDealRequest
-	4,  // 8: leadexchange.v1.DealService.ListDeals:input_type -> leadexchange.v1.ListDealsRequest
-	6,  // 9: leadexchange.v1.DealService.UpdateDeal:input_type -> leadexchange.v1.UpdateDealRequest
-	7,  // 10: leadexchange.v1.DealService.AcceptDeal:input_type -> leadexchange.v1.AcceptDealRequest
-	8,  // 11: leadexchange.v1.DealService.CreateDeal:output_type -> leadexchange.v1.DealResponse
-	8,  // 12: leadexchange.v1.DealService.GetDeal:output_type -> leadexchange.v1.DealResponse
-	5,  // 13: leadexchange.v1.DealService.ListDeals:output_type -> leadexchange.v1.ListDealsResponse
-	8,  // 14: leadexchange.v1.DealService.UpdateDeal:output_type -> leadexchange.v1.DealResponse
-	8,  // 15: leadexchange.v1.DealService.AcceptDeal:output_type -> leadexchange.v1.DealResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	0,  // 1: leadexchange.v1.DealStatusChange.from_status:type_name -> leadexchange.v1.DealStatus
+	0,  // 2: leadexchange.v1.DealStatusChange.to_status:type_name -> leadexchange.v1.DealStatus
+	12, // 3: leadexchange.v1.ListDealsRequest.filter:type_name -> leadexchange.v1.ListDealsRequest.Filter
+	1,  // 4: leadexchange.v1.ListDealsResponse.deals:type_name -> leadexchange.v1.Deal
+	0,  // 5: leadexchange.v1.UpdateDealRequest.status:type_name -> leadexchange.v1.DealStatus
+	1,  // 6: leadexchange.v1.DealResponse.deal:type_name -> leadexchange.v1.Deal
+	2,  // 7: leadexchange.v1.GetDealHistoryResponse.history:type_name -> leadexchange.v1.DealStatusChange
+	0,  // 8: leadexchange.v1.ListDealsRequest.Filter.status:type_name -> leadexchange.v1.DealStatus
+	3,  // 9: leadexchange.v1.DealService.CreateDeal:input_type -> leadexchange.v1.CreateDealRequest
+	4,  // 10: leadexchange.v1.DealService.GetDeal:input_type -> leadexchange.v1.GetDealRequest
+	5,  // 11: leadexchange.v1.DealService.ListDeals:input_type -> leadexchange.v1.ListDealsRequest
+	7,  // 12: leadexchange.v1.DealService.UpdateDeal:input_type -> leadexchange.v1.UpdateDealRequest
+	8,  // 13: leadexchange.v1.DealService.AcceptDeal:input_type -> leadexchange.v1.AcceptDealRequest
+	10, // 14: leadexchange.v1.DealService.GetDealHistory:input_type -> leadexchange.v1.GetDealHistoryRequest
+	9,  // 15: leadexchange.v1.DealService.CreateDeal:output_type -> leadexchange.v1.DealResponse
+	9,  // 16: leadexchange.v1.DealService.GetDeal:output_type -> leadexchange.v1.DealResponse
+	6,  // 17: leadexchange.v1.DealService.ListDeals:output_type -> leadexchange.v1.ListDealsResponse
+	9,  // 18: leadexchange.v1.DealService.UpdateDeal:output_type -> leadexchange.v1.DealResponse
+	9,  // 19: leadexchange.v1.DealService.AcceptDeal:output_type -> leadexchange.v1.DealResponse
+	11, // 20: leadexchange.v1.DealService.GetDealHistory:output_type -> leadexchange.v1.GetDealHistoryResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_deal_proto_init() }
@@ -738,15 +918,15 @@ func file_deal_proto_init() {
 	if File_deal_proto != nil {
 		return
 	}
-	file_deal_proto_msgTypes[5].OneofWrappers = []any{}
-	file_deal_proto_msgTypes[8].OneofWrappers = []any{}
+	file_deal_proto_msgTypes[6].OneofWrappers = []any{}
+	file_deal_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deal_proto_rawDesc), len(file_deal_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
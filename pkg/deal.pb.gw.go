@@ -226,6 +226,45 @@ func local_request_DealService_AcceptDeal_0(ctx context.Context, marshaler runti
 	return msg, metadata, err
 }
 
+func request_DealService_GetDealHistory_0(ctx context.Context, marshaler runtime.Marshaler, client DealServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetDealHistoryRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["deal_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "deal_id")
+	}
+	protoReq.DealId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "deal_id", err)
+	}
+	msg, err := client.GetDealHistory(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_DealService_GetDealHistory_0(ctx context.Context, marshaler runtime.Marshaler, server DealServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetDealHistoryRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["deal_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "deal_id")
+	}
+	protoReq.DealId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "deal_id", err)
+	}
+	msg, err := server.GetDealHistory(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterDealServiceHandlerServer registers the http handlers for service DealService to "mux".
 // UnaryRPC     :call DealServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -332,6 +371,26 @@ func RegisterDealServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 		}
 		forward_DealService_AcceptDeal_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_DealService_GetDealHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/leadexchange.v1.DealService/GetDealHistory", runtime.WithHTTPPathPattern("/v1/deals/{deal_id}/history"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_DealService_GetDealHistory_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DealService_GetDealHistory_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -457,21 +516,40 @@ func RegisterDealServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux
 		}
 		forward_DealService_AcceptDeal_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_DealService_GetDealHistory_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/leadexchange.v1.DealService/GetDealHistory", runtime.WithHTTPPathPattern("/v1/deals/{deal_id}/history"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DealService_GetDealHistory_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_DealService_GetDealHistory_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
 var (
-	pattern_DealService_CreateDeal_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "deals"}, ""))
-	pattern_DealService_GetDeal_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "deals", "deal_id"}, ""))
-	pattern_DealService_ListDeals_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "deals"}, ""))
-	pattern_DealService_UpdateDeal_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "deals", "deal_id"}, ""))
-	pattern_DealService_AcceptDeal_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"v1", "deals", "deal_id", "accept"}, ""))
+	pattern_DealService_CreateDeal_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "deals"}, ""))
+	pattern_DealService_GetDeal_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "deals", "deal_id"}, ""))
+	pattern_DealService_ListDeals_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "deals"}, ""))
+	pattern_DealService_UpdateDeal_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "deals", "deal_id"}, ""))
+	pattern_DealService_AcceptDeal_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"v1", "deals", "deal_id", "accept"}, ""))
+	pattern_DealService_GetDealHistory_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"v1", "deals", "deal_id", "history"}, ""))
 )
 
 var (
-	forward_DealService_CreateDeal_0 = runtime.ForwardResponseMessage
-	forward_DealService_GetDeal_0    = runtime.ForwardResponseMessage
-	forward_DealService_ListDeals_0  = runtime.ForwardResponseMessage
-	forward_DealService_UpdateDeal_0 = runtime.ForwardResponseMessage
-	forward_DealService_AcceptDeal_0 = runtime.ForwardResponseMessage
+	forward_DealService_CreateDeal_0     = runtime.ForwardResponseMessage
+	forward_DealService_GetDeal_0        = runtime.ForwardResponseMessage
+	forward_DealService_ListDeals_0      = runtime.ForwardResponseMessage
+	forward_DealService_UpdateDeal_0     = runtime.ForwardResponseMessage
+	forward_DealService_AcceptDeal_0     = runtime.ForwardResponseMessage
+	forward_DealService_GetDealHistory_0 = runtime.ForwardResponseMessage
 )
@@ -189,6 +189,114 @@ var _ interface {
 	ErrorName() string
 } = DealValidationError{}
 
+// Validate checks the field values on DealStatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DealStatusChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DealStatusChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DealStatusChangeMultiError, or nil if none found.
+func (m *DealStatusChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DealStatusChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromStatus
+
+	// no validation rules for ToStatus
+
+	// no validation rules for ChangedByUserId
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return DealStatusChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DealStatusChangeMultiError is an error wrapping multiple validation errors
+// returned by DealStatusChange.ValidateAll() if the designated constraints
+// aren't met.
+type DealStatusChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DealStatusChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DealStatusChangeMultiError) AllErrors() []error { return m }
+
+// DealStatusChangeValidationError is the validation error returned by
+// DealStatusChange.Validate if the designated constraints aren't met.
+type DealStatusChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DealStatusChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DealStatusChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DealStatusChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DealStatusChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DealStatusChangeValidationError) ErrorName() string { return "DealStatusChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DealStatusChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDealStatusChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DealStatusChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DealStatusChangeValidationError{}
+
 // Validate checks the field values on CreateDealRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1087,6 +1195,264 @@ var _ interface {
 	ErrorName() string
 } = DealResponseValidationError{}
 
+// Validate checks the field values on GetDealHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDealHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDealHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDealHistoryRequestMultiError, or nil if none found.
+func (m *GetDealHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDealHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetDealId()); err != nil {
+		err = GetDealHistoryRequestValidationError{
+			field:  "DealId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDealHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetDealHistoryRequest) _validateUuid(uuid string) error {
+	if matched := _deal_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetDealHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDealHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDealHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDealHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDealHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetDealHistoryRequestValidationError is the validation error returned by
+// GetDealHistoryRequest.Validate if the designated constraints aren't met.
+type GetDealHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDealHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDealHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDealHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDealHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDealHistoryRequestValidationError) ErrorName() string {
+	return "GetDealHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDealHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDealHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDealHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDealHistoryRequestValidationError{}
+
+// Validate checks the field values on GetDealHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDealHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDealHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDealHistoryResponseMultiError, or nil if none found.
+func (m *GetDealHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDealHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDealHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDealHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDealHistoryResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDealHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDealHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDealHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDealHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDealHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDealHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetDealHistoryResponseValidationError is the validation error returned by
+// GetDealHistoryResponse.Validate if the designated constraints aren't met.
+type GetDealHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDealHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDealHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDealHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDealHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDealHistoryResponseValidationError) ErrorName() string {
+	return "GetDealHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDealHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDealHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDealHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDealHistoryResponseValidationError{}
+
 // Validate checks the field values on ListDealsRequest_Filter with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
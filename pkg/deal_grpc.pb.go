@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DealService_CreateDeal_FullMethodName = "/leadexchange.v1.DealService/CreateDeal"
-	DealService_GetDeal_FullMethodName    = "/leadexchange.v1.DealService/GetDeal"
-	DealService_ListDeals_FullMethodName  = "/leadexchange.v1.DealService/ListDeals"
-	DealService_UpdateDeal_FullMethodName = "/leadexchange.v1.DealService/UpdateDeal"
-	DealService_AcceptDeal_FullMethodName = "/leadexchange.v1.DealService/AcceptDeal"
+	DealService_CreateDeal_FullMethodName     = "/leadexchange.v1.DealService/CreateDeal"
+	DealService_GetDeal_FullMethodName        = "/leadexchange.v1.DealService/GetDeal"
+	DealService_ListDeals_FullMethodName      = "/leadexchange.v1.DealService/ListDeals"
+	DealService_UpdateDeal_FullMethodName     = "/leadexchange.v1.DealService/UpdateDeal"
+	DealService_AcceptDeal_FullMethodName     = "/leadexchange.v1.DealService/AcceptDeal"
+	DealService_GetDealHistory_FullMethodName = "/leadexchange.v1.DealService/GetDealHistory"
 )
 
 // DealServiceClient is the client API for DealService service.
@@ -40,6 +41,8 @@ type DealServiceClient interface {
 	UpdateDeal(ctx context.Context, in *UpdateDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
 	// Принять сделку (покупатель принимает предложение).
 	AcceptDeal(ctx context.Context, in *AcceptDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
+	// Получить историю изменения статусов сделки.
+	GetDealHistory(ctx context.Context, in *GetDealHistoryRequest, opts ...grpc.CallOption) (*GetDealHistoryResponse, error)
 }
 
 type dealServiceClient struct {
@@ -100,6 +103,16 @@ func (c *dealServiceClient) AcceptDeal(ctx context.Context, in *AcceptDealReques
 	return out, nil
 }
 
+func (c *dealServiceClient) GetDealHistory(ctx context.Context, in *GetDealHistoryRequest, opts ...grpc.CallOption) (*GetDealHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDealHistoryResponse)
+	err := c.cc.Invoke(ctx, DealService_GetDealHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DealServiceServer is the server API for DealService service.
 // All implementations must embed UnimplementedDealServiceServer
 // for forward compatibility.
@@ -114,6 +127,8 @@ type DealServiceServer interface {
 	UpdateDeal(context.Context, *UpdateDealRequest) (*DealResponse, error)
 	// Принять сделку (покупатель принимает предложение).
 	AcceptDeal(context.Context, *AcceptDealRequest) (*DealResponse, error)
+	// Получить историю изменения статусов сделки.
+	GetDealHistory(context.Context, *GetDealHistoryRequest) (*GetDealHistoryResponse, error)
 	mustEmbedUnimplementedDealServiceServer()
 }
 
@@ -139,6 +154,9 @@ func (UnimplementedDealServiceServer) UpdateDeal(context.Context, *UpdateDealReq
 func (UnimplementedDealServiceServer) AcceptDeal(context.Context, *AcceptDealRequest) (*DealResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcceptDeal not implemented")
 }
+func (UnimplementedDealServiceServer) GetDealHistory(context.Context, *GetDealHistoryRequest) (*GetDealHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDealHistory not implemented")
+}
 func (UnimplementedDealServiceServer) mustEmbedUnimplementedDealServiceServer() {}
 func (UnimplementedDealServiceServer) testEmbeddedByValue()                     {}
 
@@ -250,6 +268,24 @@ func _DealService_AcceptDeal_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DealService_GetDealHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDealHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealServiceServer).GetDealHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DealService_GetDealHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealServiceServer).GetDealHistory(ctx, req.(*GetDealHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DealService_ServiceDesc is the grpc.ServiceDesc for DealService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +313,10 @@ var DealService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptDeal",
 			Handler:    _DealService_AcceptDeal_Handler,
 		},
+		{
+			MethodName: "GetDealHistory",
+			Handler:    _DealService_GetDealHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deal.proto",
@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: embedding.proto
+
+package leadexchangev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmbeddingJobStatus — статус задания на генерацию embedding.
+type EmbeddingJobStatus int32
+
+const (
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED EmbeddingJobStatus = 0
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PENDING     EmbeddingJobStatus = 1 // Ожидает выполнения или повтора
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PROCESSING  EmbeddingJobStatus = 2 // Выполняется воркером
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DEAD        EmbeddingJobStatus = 3 // Попытки исчерпаны
+)
+
+// Enum value maps for EmbeddingJobStatus.
+var (
+	EmbeddingJobStatus_name = map[int32]string{
+		0: "EMBEDDING_JOB_STATUS_UNSPECIFIED",
+		1: "EMBEDDING_JOB_STATUS_PENDING",
+		2: "EMBEDDING_JOB_STATUS_PROCESSING",
+		3: "EMBEDDING_JOB_STATUS_DEAD",
+	}
+	EmbeddingJobStatus_value = map[string]int32{
+		"EMBEDDING_JOB_STATUS_UNSPECIFIED": 0,
+		"EMBEDDING_JOB_STATUS_PENDING":     1,
+		"EMBEDDING_JOB_STATUS_PROCESSING":  2,
+		"EMBEDDING_JOB_STATUS_DEAD":        3,
+	}
+)
+
+func (x EmbeddingJobStatus) Enum() *EmbeddingJobStatus {
+	p := new(EmbeddingJobStatus)
+	*p = x
+	return p
+}
+
+func (x EmbeddingJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmbeddingJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_embedding_proto_enumTypes[0].Descriptor()
+}
+
+func (EmbeddingJobStatus) Type() protoreflect.EnumType {
+	return &file_embedding_proto_enumTypes[0]
+}
+
+func (x EmbeddingJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmbeddingJobStatus.Descriptor instead.
+func (EmbeddingJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{0}
+}
+
+// EmbeddingEntityType — тип сущности задания.
+type EmbeddingEntityType int32
+
+const (
+	EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED EmbeddingEntityType = 0
+	EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_LEAD        EmbeddingEntityType = 1
+	EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_PROPERTY    EmbeddingEntityType = 2
+)
+
+// Enum value maps for EmbeddingEntityType.
+var (
+	EmbeddingEntityType_name = map[int32]string{
+		0: "EMBEDDING_ENTITY_TYPE_UNSPECIFIED",
+		1: "EMBEDDING_ENTITY_TYPE_LEAD",
+		2: "EMBEDDING_ENTITY_TYPE_PROPERTY",
+	}
+	EmbeddingEntityType_value = map[string]int32{
+		"EMBEDDING_ENTITY_TYPE_UNSPECIFIED": 0,
+		"EMBEDDING_ENTITY_TYPE_LEAD":        1,
+		"EMBEDDING_ENTITY_TYPE_PROPERTY":    2,
+	}
+)
+
+func (x EmbeddingEntityType) Enum() *EmbeddingEntityType {
+	p := new(EmbeddingEntityType)
+	*p = x
+	return p
+}
+
+func (x EmbeddingEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmbeddingEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_embedding_proto_enumTypes[1].Descriptor()
+}
+
+func (EmbeddingEntityType) Type() protoreflect.EnumType {
+	return &file_embedding_proto_enumTypes[1]
+}
+
+func (x EmbeddingEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmbeddingEntityType.Descriptor instead.
+func (EmbeddingEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{1}
+}
+
+type EmbeddingJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	EntityType    EmbeddingEntityType    `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=leadexchange.v1.EmbeddingEntityType" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Status        EmbeddingJobStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=leadexchange.v1.EmbeddingJobStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRunAt     string                 `protobuf:"bytes,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingJob) Reset() {
+	*x = EmbeddingJob{}
+	mi := &file_embedding_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingJob) ProtoMessage() {}
+
+func (x *EmbeddingJob) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingJob.ProtoReflect.Descriptor instead.
+func (*EmbeddingJob) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmbeddingJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetEntityType() EmbeddingEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *EmbeddingJob) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetStatus() EmbeddingJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *EmbeddingJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *EmbeddingJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListEmbeddingJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *EmbeddingJobStatus    `protobuf:"varint,1,opt,name=status,proto3,enum=leadexchange.v1.EmbeddingJobStatus,oneof" json:"status,omitempty"`
+	EntityType    *EmbeddingEntityType   `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=leadexchange.v1.EmbeddingEntityType,oneof" json:"entity_type,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	PageToken     *string                `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmbeddingJobsRequest) Reset() {
+	*x = ListEmbeddingJobsRequest{}
+	mi := &file_embedding_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmbeddingJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmbeddingJobsRequest) ProtoMessage() {}
+
+func (x *ListEmbeddingJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmbeddingJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmbeddingJobsRequest) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListEmbeddingJobsRequest) GetStatus() EmbeddingJobStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ListEmbeddingJobsRequest) GetEntityType() EmbeddingEntityType {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ListEmbeddingJobsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEmbeddingJobsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListEmbeddingJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*EmbeddingJob        `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmbeddingJobsResponse) Reset() {
+	*x = ListEmbeddingJobsResponse{}
+	mi := &file_embedding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmbeddingJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmbeddingJobsResponse) ProtoMessage() {}
+
+func (x *ListEmbeddingJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmbeddingJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmbeddingJobsResponse) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEmbeddingJobsResponse) GetJobs() []*EmbeddingJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListEmbeddingJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RetryEmbeddingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryEmbeddingJobRequest) Reset() {
+	*x = RetryEmbeddingJobRequest{}
+	mi := &file_embedding_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryEmbeddingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEmbeddingJobRequest) ProtoMessage() {}
+
+func (x *RetryEmbeddingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEmbeddingJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryEmbeddingJobRequest) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RetryEmbeddingJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type EmbeddingJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *EmbeddingJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingJobResponse) Reset() {
+	*x = EmbeddingJobResponse{}
+	mi := &file_embedding_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingJobResponse) ProtoMessage() {}
+
+func (x *EmbeddingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingJobResponse.ProtoReflect.Descriptor instead.
+func (*EmbeddingJobResponse) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EmbeddingJobResponse) GetJob() *EmbeddingJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ReindexAllRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Тип сущностей; UNSPECIFIED — лиды и объекты.
+	EntityType EmbeddingEntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=leadexchange.v1.EmbeddingEntityType" json:"entity_type,omitempty"`
+	// Только записи без embedding.
+	OnlyMissing bool `protobuf:"varint,2,opt,name=only_missing,json=onlyMissing,proto3" json:"only_missing,omitempty"`
+	// Только записи, изменённые начиная с момента (RFC3339).
+	Since *string `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Размер пачки ReindexBatch (по умолчанию 50).
+	BatchSize *int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`
+	// Число одновременных запросов к ML сервису (по умолчанию 2).
+	Concurrency *int32 `protobuf:"varint,5,opt,name=concurrency,proto3,oneof" json:"concurrency,omitempty"`
+	// Заполнить теневые колонки векторами новой модели идущей миграции (ML_NEXT_BASE_URL).
+	Shadow        bool `protobuf:"varint,6,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexAllRequest) Reset() {
+	*x = ReindexAllRequest{}
+	mi := &file_embedding_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexAllRequest) ProtoMessage() {}
+
+func (x *ReindexAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexAllRequest.ProtoReflect.Descriptor instead.
+func (*ReindexAllRequest) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReindexAllRequest) GetEntityType() EmbeddingEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ReindexAllRequest) GetOnlyMissing() bool {
+	if x != nil {
+		return x.OnlyMissing
+	}
+	return false
+}
+
+func (x *ReindexAllRequest) GetSince() string {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return ""
+}
+
+func (x *ReindexAllRequest) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+func (x *ReindexAllRequest) GetConcurrency() int32 {
+	if x != nil && x.Concurrency != nil {
+		return *x.Concurrency
+	}
+	return 0
+}
+
+func (x *ReindexAllRequest) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+// ReindexProgress — прогресс переиндексации одного типа сущностей.
+type ReindexProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    EmbeddingEntityType    `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=leadexchange.v1.EmbeddingEntityType" json:"entity_type,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`         // Записей под фильтром на момент старта
+	Processed     int32                  `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"` // Отправлено в ML сервис
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Done          bool                   `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"` // Тип сущностей переиндексирован полностью
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexProgress) Reset() {
+	*x = ReindexProgress{}
+	mi := &file_embedding_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProgress) ProtoMessage() {}
+
+func (x *ReindexProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProgress.ProtoReflect.Descriptor instead.
+func (*ReindexProgress) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReindexProgress) GetEntityType() EmbeddingEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EmbeddingEntityType_EMBEDDING_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ReindexProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+var File_embedding_proto protoreflect.FileDescriptor
+
+const file_embedding_proto_rawDesc = "" +
+	"\n" +
+	"\x0fembedding.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xdf\x02\n" +
+	"\fEmbeddingJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12E\n" +
+	"\ventity_type\x18\x02 \x01(\x0e2$.leadexchange.v1.EmbeddingEntityTypeR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12;\n" +
+	"\x06status\x18\x04 \x01(\x0e2#.leadexchange.v1.EmbeddingJobStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\x1e\n" +
+	"\vnext_run_at\x18\a \x01(\tR\tnextRunAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xb1\x02\n" +
+	"\x18ListEmbeddingJobsRequest\x12@\n" +
+	"\x06status\x18\x01 \x01(\x0e2#.leadexchange.v1.EmbeddingJobStatusH\x00R\x06status\x88\x01\x01\x12J\n" +
+	"\ventity_type\x18\x02 \x01(\x0e2$.leadexchange.v1.EmbeddingEntityTypeH\x01R\n" +
+	"entityType\x88\x01\x01\x12+\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x02R\bpageSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tH\x03R\tpageToken\x88\x01\x01B\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_page_token\"v\n" +
+	"\x19ListEmbeddingJobsResponse\x121\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x1d.leadexchange.v1.EmbeddingJobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\";\n" +
+	"\x18RetryEmbeddingJobRequest\x12\x1f\n" +
+	"\x06job_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05jobId\"G\n" +
+	"\x14EmbeddingJobResponse\x12/\n" +
+	"\x03job\x18\x01 \x01(\v2\x1d.leadexchange.v1.EmbeddingJobR\x03job\"\xbb\x02\n" +
+	"\x11ReindexAllRequest\x12E\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2$.leadexchange.v1.EmbeddingEntityTypeR\n" +
+	"entityType\x12!\n" +
+	"\fonly_missing\x18\x02 \x01(\bR\vonlyMissing\x12\x19\n" +
+	"\x05since\x18\x03 \x01(\tH\x00R\x05since\x88\x01\x01\x12.\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03(\x01H\x01R\tbatchSize\x88\x01\x01\x120\n" +
+	"\vconcurrency\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x10(\x01H\x02R\vconcurrency\x88\x01\x01\x12\x16\n" +
+	"\x06shadow\x18\x06 \x01(\bR\x06shadowB\b\n" +
+	"\x06_sinceB\r\n" +
+	"\v_batch_sizeB\x0e\n" +
+	"\f_concurrency\"\xd2\x01\n" +
+	"\x0fReindexProgress\x12E\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2$.leadexchange.v1.EmbeddingEntityTypeR\n" +
+	"entityType\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x03 \x01(\x05R\tprocessed\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x12\n" +
+	"\x04done\x18\x06 \x01(\bR\x04done*\xa0\x01\n" +
+	"\x12EmbeddingJobStatus\x12$\n" +
+	" EMBEDDING_JOB_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEMBEDDING_JOB_STATUS_PENDING\x10\x01\x12#\n" +
+	"\x1fEMBEDDING_JOB_STATUS_PROCESSING\x10\x02\x12\x1d\n" +
+	"\x19EMBEDDING_JOB_STATUS_DEAD\x10\x03*\x80\x01\n" +
+	"\x13EmbeddingEntityType\x12%\n" +
+	"!EMBEDDING_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEMBEDDING_ENTITY_TYPE_LEAD\x10\x01\x12\"\n" +
+	"\x1eEMBEDDING_ENTITY_TYPE_PROPERTY\x10\x022\xbc\x03\n" +
+	"\x10EmbeddingService\x12\x8c\x01\n" +
+	"\x11ListEmbeddingJobs\x12).leadexchange.v1.ListEmbeddingJobsRequest\x1a*.leadexchange.v1.ListEmbeddingJobsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/admin/embedding-jobs\x12\x99\x01\n" +
+	"\x11RetryEmbeddingJob\x12).leadexchange.v1.RetryEmbeddingJobRequest\x1a%.leadexchange.v1.EmbeddingJobResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/admin/embedding-jobs/{job_id}/retry\x12}\n" +
+	"\n" +
+	"ReindexAll\x12\".leadexchange.v1.ReindexAllRequest\x1a .leadexchange.v1.ReindexProgress\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/admin/embeddings/reindex0\x01B4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_embedding_proto_rawDescOnce sync.Once
+	file_embedding_proto_rawDescData []byte
+)
+
+func file_embedding_proto_rawDescGZIP() []byte {
+	file_embedding_proto_rawDescOnce.Do(func() {
+		file_embedding_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_embedding_proto_rawDesc), len(file_embedding_proto_rawDesc)))
+	})
+	return file_embedding_proto_rawDescData
+}
+
+var file_embedding_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_embedding_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_embedding_proto_goTypes = []any{
+	(EmbeddingJobStatus)(0),           // 0: leadexchange.v1.EmbeddingJobStatus
+	(EmbeddingEntityType)(0),          // 1: leadexchange.v1.EmbeddingEntityType
+	(*EmbeddingJob)(nil),              // 2: leadexchange.v1.EmbeddingJob
+	(*ListEmbeddingJobsRequest)(nil),  // 3: leadexchange.v1.ListEmbeddingJobsRequest
+	(*ListEmbeddingJobsResponse)(nil), // 4: leadexchange.v1.ListEmbeddingJobsResponse
+	(*RetryEmbeddingJobRequest)(nil),  // 5: leadexchange.v1.RetryEmbeddingJobRequest
+	(*EmbeddingJobResponse)(nil),      // 6: leadexchange.v1.EmbeddingJobResponse
+	(*ReindexAllRequest)(nil),         // 7: leadexchange.v1.ReindexAllRequest
+	(*ReindexProgress)(nil),           // 8: leadexchange.v1.ReindexProgress
+}
+var file_embedding_proto_depIdxs = []int32{
+	1,  // 0: leadexchange.v1.EmbeddingJob.entity_type:type_name -> leadexchange.v1.EmbeddingEntityType
+	0,  // 1: leadexchange.v1.EmbeddingJob.status:type_name -> leadexchange.v1.EmbeddingJobStatus
+	0,  // 2: leadexchange.v1.ListEmbeddingJobsRequest.status:type_name -> leadexchange.v1.EmbeddingJobStatus
+	1,  // 3: leadexchange.v1.ListEmbeddingJobsRequest.entity_type:type_name -> leadexchange.v1.EmbeddingEntityType
+	2,  // 4: leadexchange.v1.ListEmbeddingJobsResponse.jobs:type_name -> leadexchange.v1.EmbeddingJob
+	2,  // 5: leadexchange.v1.EmbeddingJobResponse.job:type_name -> leadexchange.v1.EmbeddingJob
+	1,  // 6: leadexchange.v1.ReindexAllRequest.entity_type:type_name -> leadexchange.v1.EmbeddingEntityType
+	1,  // 7: leadexchange.v1.ReindexProgress.entity_type:type_name -> leadexchange.v1.EmbeddingEntityType
+	3,  // 8: leadexchange.v1.EmbeddingService.ListEmbeddingJobs:input_type -> leadexchange.v1.ListEmbeddingJobsRequest
+	5,  // 9: leadexchange.v1.EmbeddingService.RetryEmbeddingJob:input_type -> leadexchange.v1.RetryEmbeddingJobRequest
+	7,  // 10: leadexchange.v1.EmbeddingService.ReindexAll:input_type -> leadexchange.v1.ReindexAllRequest
+	4,  // 11: leadexchange.v1.EmbeddingService.ListEmbeddingJobs:output_type -> leadexchange.v1.ListEmbeddingJobsResponse
+	6,  // 12: leadexchange.v1.EmbeddingService.RetryEmbeddingJob:output_type -> leadexchange.v1.EmbeddingJobResponse
+	8,  // 13: leadexchange.v1.EmbeddingService.ReindexAll:output_type -> leadexchange.v1.ReindexProgress
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_embedding_proto_init() }
+func file_embedding_proto_init() {
+	if File_embedding_proto != nil {
+		return
+	}
+	file_embedding_proto_msgTypes[1].OneofWrappers = []any{}
+	file_embedding_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_embedding_proto_rawDesc), len(file_embedding_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_embedding_proto_goTypes,
+		DependencyIndexes: file_embedding_proto_depIdxs,
+		EnumInfos:         file_embedding_proto_enumTypes,
+		MessageInfos:      file_embedding_proto_msgTypes,
+	}.Build()
+	File_embedding_proto = out.File
+	file_embedding_proto_goTypes = nil
+	file_embedding_proto_depIdxs = nil
+}
@@ -0,0 +1,930 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: embedding.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _embedding_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on EmbeddingJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EmbeddingJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmbeddingJob with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EmbeddingJobMultiError, or
+// nil if none found.
+func (m *EmbeddingJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmbeddingJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastError
+
+	// no validation rules for NextRunAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return EmbeddingJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmbeddingJobMultiError is an error wrapping multiple validation errors
+// returned by EmbeddingJob.ValidateAll() if the designated constraints aren't met.
+type EmbeddingJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmbeddingJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmbeddingJobMultiError) AllErrors() []error { return m }
+
+// EmbeddingJobValidationError is the validation error returned by
+// EmbeddingJob.Validate if the designated constraints aren't met.
+type EmbeddingJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmbeddingJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmbeddingJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmbeddingJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmbeddingJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmbeddingJobValidationError) ErrorName() string { return "EmbeddingJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmbeddingJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmbeddingJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmbeddingJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmbeddingJobValidationError{}
+
+// Validate checks the field values on ListEmbeddingJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEmbeddingJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEmbeddingJobsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEmbeddingJobsRequestMultiError, or nil if none found.
+func (m *ListEmbeddingJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEmbeddingJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.EntityType != nil {
+		// no validation rules for EntityType
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := ListEmbeddingJobsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if len(errors) > 0 {
+		return ListEmbeddingJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEmbeddingJobsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListEmbeddingJobsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListEmbeddingJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEmbeddingJobsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEmbeddingJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListEmbeddingJobsRequestValidationError is the validation error returned by
+// ListEmbeddingJobsRequest.Validate if the designated constraints aren't met.
+type ListEmbeddingJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEmbeddingJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEmbeddingJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEmbeddingJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEmbeddingJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEmbeddingJobsRequestValidationError) ErrorName() string {
+	return "ListEmbeddingJobsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEmbeddingJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEmbeddingJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEmbeddingJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEmbeddingJobsRequestValidationError{}
+
+// Validate checks the field values on ListEmbeddingJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEmbeddingJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEmbeddingJobsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEmbeddingJobsResponseMultiError, or nil if none found.
+func (m *ListEmbeddingJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEmbeddingJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEmbeddingJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEmbeddingJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEmbeddingJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListEmbeddingJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEmbeddingJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListEmbeddingJobsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListEmbeddingJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEmbeddingJobsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEmbeddingJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListEmbeddingJobsResponseValidationError is the validation error returned by
+// ListEmbeddingJobsResponse.Validate if the designated constraints aren't met.
+type ListEmbeddingJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEmbeddingJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEmbeddingJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEmbeddingJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEmbeddingJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEmbeddingJobsResponseValidationError) ErrorName() string {
+	return "ListEmbeddingJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEmbeddingJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEmbeddingJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEmbeddingJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEmbeddingJobsResponseValidationError{}
+
+// Validate checks the field values on RetryEmbeddingJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryEmbeddingJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryEmbeddingJobRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryEmbeddingJobRequestMultiError, or nil if none found.
+func (m *RetryEmbeddingJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryEmbeddingJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetJobId()); err != nil {
+		err = RetryEmbeddingJobRequestValidationError{
+			field:  "JobId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetryEmbeddingJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RetryEmbeddingJobRequest) _validateUuid(uuid string) error {
+	if matched := _embedding_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RetryEmbeddingJobRequestMultiError is an error wrapping multiple validation
+// errors returned by RetryEmbeddingJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RetryEmbeddingJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryEmbeddingJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryEmbeddingJobRequestMultiError) AllErrors() []error { return m }
+
+// RetryEmbeddingJobRequestValidationError is the validation error returned by
+// RetryEmbeddingJobRequest.Validate if the designated constraints aren't met.
+type RetryEmbeddingJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryEmbeddingJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryEmbeddingJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryEmbeddingJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryEmbeddingJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryEmbeddingJobRequestValidationError) ErrorName() string {
+	return "RetryEmbeddingJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryEmbeddingJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryEmbeddingJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryEmbeddingJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryEmbeddingJobRequestValidationError{}
+
+// Validate checks the field values on EmbeddingJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EmbeddingJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmbeddingJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmbeddingJobResponseMultiError, or nil if none found.
+func (m *EmbeddingJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmbeddingJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmbeddingJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmbeddingJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmbeddingJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EmbeddingJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmbeddingJobResponseMultiError is an error wrapping multiple validation
+// errors returned by EmbeddingJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EmbeddingJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmbeddingJobResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmbeddingJobResponseMultiError) AllErrors() []error { return m }
+
+// EmbeddingJobResponseValidationError is the validation error returned by
+// EmbeddingJobResponse.Validate if the designated constraints aren't met.
+type EmbeddingJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmbeddingJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmbeddingJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmbeddingJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmbeddingJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmbeddingJobResponseValidationError) ErrorName() string {
+	return "EmbeddingJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmbeddingJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmbeddingJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmbeddingJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmbeddingJobResponseValidationError{}
+
+// Validate checks the field values on ReindexAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReindexAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexAllRequestMultiError, or nil if none found.
+func (m *ReindexAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for OnlyMissing
+
+	// no validation rules for Shadow
+
+	if m.Since != nil {
+		// no validation rules for Since
+	}
+
+	if m.BatchSize != nil {
+
+		if val := m.GetBatchSize(); val < 1 || val > 500 {
+			err := ReindexAllRequestValidationError{
+				field:  "BatchSize",
+				reason: "value must be inside range [1, 500]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Concurrency != nil {
+
+		if val := m.GetConcurrency(); val < 1 || val > 16 {
+			err := ReindexAllRequestValidationError{
+				field:  "Concurrency",
+				reason: "value must be inside range [1, 16]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReindexAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexAllRequestMultiError is an error wrapping multiple validation errors
+// returned by ReindexAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReindexAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexAllRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexAllRequestMultiError) AllErrors() []error { return m }
+
+// ReindexAllRequestValidationError is the validation error returned by
+// ReindexAllRequest.Validate if the designated constraints aren't met.
+type ReindexAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexAllRequestValidationError) ErrorName() string {
+	return "ReindexAllRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReindexAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexAllRequestValidationError{}
+
+// Validate checks the field values on ReindexProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReindexProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexProgressMultiError, or nil if none found.
+func (m *ReindexProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Updated
+
+	// no validation rules for Failed
+
+	// no validation rules for Done
+
+	if len(errors) > 0 {
+		return ReindexProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexProgressMultiError is an error wrapping multiple validation errors
+// returned by ReindexProgress.ValidateAll() if the designated constraints
+// aren't met.
+type ReindexProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexProgressMultiError) AllErrors() []error { return m }
+
+// ReindexProgressValidationError is the validation error returned by
+// ReindexProgress.Validate if the designated constraints aren't met.
+type ReindexProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexProgressValidationError) ErrorName() string { return "ReindexProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReindexProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexProgressValidationError{}
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: embedding.proto
+
+package leadexchangev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmbeddingService_ListEmbeddingJobs_FullMethodName = "/leadexchange.v1.EmbeddingService/ListEmbeddingJobs"
+	EmbeddingService_RetryEmbeddingJob_FullMethodName = "/leadexchange.v1.EmbeddingService/RetryEmbeddingJob"
+	EmbeddingService_ReindexAll_FullMethodName        = "/leadexchange.v1.EmbeddingService/ReindexAll"
+)
+
+// EmbeddingServiceClient is the client API for EmbeddingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EmbeddingService — администрирование генерации embedding (только для администраторов).
+type EmbeddingServiceClient interface {
+	// Получить задания outbox генерации embedding (по умолчанию — все, новые первыми).
+	ListEmbeddingJobs(ctx context.Context, in *ListEmbeddingJobsRequest, opts ...grpc.CallOption) (*ListEmbeddingJobsResponse, error)
+	// Вернуть задание из dead-letter в очередь со сброшенным счётчиком попыток.
+	RetryEmbeddingJob(ctx context.Context, in *RetryEmbeddingJobRequest, opts ...grpc.CallOption) (*EmbeddingJobResponse, error)
+	// Переиндексировать embedding всех лидов и объектов пачками через ML сервис (например, после смены модели).
+	// Прогресс отправляется в поток после каждой пачки; обрыв соединения останавливает переиндексацию.
+	// Для длительных запусков есть команда cmd/reindex.
+	ReindexAll(ctx context.Context, in *ReindexAllRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReindexProgress], error)
+}
+
+type embeddingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmbeddingServiceClient(cc grpc.ClientConnInterface) EmbeddingServiceClient {
+	return &embeddingServiceClient{cc}
+}
+
+func (c *embeddingServiceClient) ListEmbeddingJobs(ctx context.Context, in *ListEmbeddingJobsRequest, opts ...grpc.CallOption) (*ListEmbeddingJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmbeddingJobsResponse)
+	err := c.cc.Invoke(ctx, EmbeddingService_ListEmbeddingJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *embeddingServiceClient) RetryEmbeddingJob(ctx context.Context, in *RetryEmbeddingJobRequest, opts ...grpc.CallOption) (*EmbeddingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingJobResponse)
+	err := c.cc.Invoke(ctx, EmbeddingService_RetryEmbeddingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *embeddingServiceClient) ReindexAll(ctx context.Context, in *ReindexAllRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReindexProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EmbeddingService_ServiceDesc.Streams[0], EmbeddingService_ReindexAll_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReindexAllRequest, ReindexProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmbeddingService_ReindexAllClient = grpc.ServerStreamingClient[ReindexProgress]
+
+// EmbeddingServiceServer is the server API for EmbeddingService service.
+// All implementations must embed UnimplementedEmbeddingServiceServer
+// for forward compatibility.
+//
+// EmbeddingService — администрирование генерации embedding (только для администраторов).
+type EmbeddingServiceServer interface {
+	// Получить задания outbox генерации embedding (по умолчанию — все, новые первыми).
+	ListEmbeddingJobs(context.Context, *ListEmbeddingJobsRequest) (*ListEmbeddingJobsResponse, error)
+	// Вернуть задание из dead-letter в очередь со сброшенным счётчиком попыток.
+	RetryEmbeddingJob(context.Context, *RetryEmbeddingJobRequest) (*EmbeddingJobResponse, error)
+	// Переиндексировать embedding всех лидов и объектов пачками через ML сервис (например, после смены модели).
+	// Прогресс отправляется в поток после каждой пачки; обрыв соединения останавливает переиндексацию.
+	// Для длительных запусков есть команда cmd/reindex.
+	ReindexAll(*ReindexAllRequest, grpc.ServerStreamingServer[ReindexProgress]) error
+	mustEmbedUnimplementedEmbeddingServiceServer()
+}
+
+// UnimplementedEmbeddingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmbeddingServiceServer struct{}
+
+func (UnimplementedEmbeddingServiceServer) ListEmbeddingJobs(context.Context, *ListEmbeddingJobsRequest) (*ListEmbeddingJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEmbeddingJobs not implemented")
+}
+func (UnimplementedEmbeddingServiceServer) RetryEmbeddingJob(context.Context, *RetryEmbeddingJobRequest) (*EmbeddingJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryEmbeddingJob not implemented")
+}
+func (UnimplementedEmbeddingServiceServer) ReindexAll(*ReindexAllRequest, grpc.ServerStreamingServer[ReindexProgress]) error {
+	return status.Error(codes.Unimplemented, "method ReindexAll not implemented")
+}
+func (UnimplementedEmbeddingServiceServer) mustEmbedUnimplementedEmbeddingServiceServer() {}
+func (UnimplementedEmbeddingServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeEmbeddingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmbeddingServiceServer will
+// result in compilation errors.
+type UnsafeEmbeddingServiceServer interface {
+	mustEmbedUnimplementedEmbeddingServiceServer()
+}
+
+func RegisterEmbeddingServiceServer(s grpc.ServiceRegistrar, srv EmbeddingServiceServer) {
+	// If the following call panics, it indicates UnimplementedEmbeddingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmbeddingService_ServiceDesc, srv)
+}
+
+func _EmbeddingService_ListEmbeddingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmbeddingJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmbeddingServiceServer).ListEmbeddingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmbeddingService_ListEmbeddingJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmbeddingServiceServer).ListEmbeddingJobs(ctx, req.(*ListEmbeddingJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmbeddingService_RetryEmbeddingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryEmbeddingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmbeddingServiceServer).RetryEmbeddingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmbeddingService_RetryEmbeddingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmbeddingServiceServer).RetryEmbeddingJob(ctx, req.(*RetryEmbeddingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmbeddingService_ReindexAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReindexAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EmbeddingServiceServer).ReindexAll(m, &grpc.GenericServerStream[ReindexAllRequest, ReindexProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EmbeddingService_ReindexAllServer = grpc.ServerStreamingServer[ReindexProgress]
+
+// EmbeddingService_ServiceDesc is the grpc.ServiceDesc for EmbeddingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmbeddingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "leadexchange.v1.EmbeddingService",
+	HandlerType: (*EmbeddingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEmbeddingJobs",
+			Handler:    _EmbeddingService_ListEmbeddingJobs_Handler,
+		},
+		{
+			MethodName: "RetryEmbeddingJob",
+			Handler:    _EmbeddingService_RetryEmbeddingJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReindexAll",
+			Handler:       _EmbeddingService_ReindexAll_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "embedding.proto",
+}
@@ -84,8 +84,17 @@ func (x *UploadFileRequest) GetContentType() string {
 }
 
 type UploadFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Временная ссылка на скачивание (24 часа).
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Ключ объекта в хранилище — передаётся в AddPropertyImages.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Реальный MIME-тип и размер файла (для CompleteUpload и UploadFileStream).
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Копии изображения: thumbnail, card и full в основном формате (JPEG или PNG) и WebP
+	// для thumbnail и card. Основная копия full лежит под самим key; EXIF удалён.
+	Variants      []*ImageVariant `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,6 +136,139 @@ func (x *UploadFileResponse) GetUrl() string {
 	return ""
 }
 
+func (x *UploadFileResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadFileResponse) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// ImageVariant — обработанная копия загруженного изображения.
+type ImageVariant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Размер: thumbnail (до 320px), card (до 800px) или full (до 2048px по большей стороне).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Формат: jpeg, png или webp.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Ключ объекта: key для основной копии, key/<name>.<ext> для остальных.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// Временная ссылка на скачивание.
+	Url           string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType   string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int32  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Size          int64  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	mi := &file_file_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_file_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_file_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImageVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 type UploadFilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Files         []*UploadFileRequest   `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
@@ -136,7 +278,7 @@ type UploadFilesRequest struct {
 
 func (x *UploadFilesRequest) Reset() {
 	*x = UploadFilesRequest{}
-	mi := &file_file_proto_msgTypes[2]
+	mi := &file_file_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +290,7 @@ func (x *UploadFilesRequest) String() string {
 func (*UploadFilesRequest) ProtoMessage() {}
 
 func (x *UploadFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_file_proto_msgTypes[2]
+	mi := &file_file_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +303,7 @@ func (x *UploadFilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadFilesRequest.ProtoReflect.Descriptor instead.
 func (*UploadFilesRequest) Descriptor() ([]byte, []int) {
-	return file_file_proto_rawDescGZIP(), []int{2}
+	return file_file_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UploadFilesRequest) GetFiles() []*UploadFileRequest {
@@ -172,15 +314,19 @@ func (x *UploadFilesRequest) GetFiles() []*UploadFileRequest {
 }
 
 type UploadFilesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Urls  []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Ключи объектов в том же порядке, что и urls.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Загруженные файлы с копиями в том же порядке (когда включена обработка загрузок).
+	Files         []*UploadFileResponse `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UploadFilesResponse) Reset() {
 	*x = UploadFilesResponse{}
-	mi := &file_file_proto_msgTypes[3]
+	mi := &file_file_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -192,7 +338,7 @@ func (x *UploadFilesResponse) String() string {
 func (*UploadFilesResponse) ProtoMessage() {}
 
 func (x *UploadFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_file_proto_msgTypes[3]
+	mi := &file_file_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +351,7 @@ func (x *UploadFilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadFilesResponse.ProtoReflect.Descriptor instead.
 func (*UploadFilesResponse) Descriptor() ([]byte, []int) {
-	return file_file_proto_rawDescGZIP(), []int{3}
+	return file_file_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UploadFilesResponse) GetUrls() []string {
@@ -215,6 +361,348 @@ func (x *UploadFilesResponse) GetUrls() []string {
 	return nil
 }
 
+func (x *UploadFilesResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *UploadFilesResponse) GetFiles() []*UploadFileResponse {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type CreateUploadURLRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FileName    string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Точный размер файла в байтах (не больше UPLOAD_MAX_SIZE).
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadURLRequest) Reset() {
+	*x = CreateUploadURLRequest{}
+	mi := &file_file_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadURLRequest) ProtoMessage() {}
+
+func (x *CreateUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_file_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateUploadURLRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CreateUploadURLRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateUploadURLRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CreateUploadURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ключ объекта — передаётся в CompleteUpload.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Ссылка для PUT с содержимым файла.
+	UploadUrl string `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	Method    string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// Заголовки, которые нужно передать в PUT без изменений.
+	Headers       map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt     string            `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadURLResponse) Reset() {
+	*x = CreateUploadURLResponse{}
+	mi := &file_file_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadURLResponse) ProtoMessage() {}
+
+func (x *CreateUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_file_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*CreateUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_file_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateUploadURLResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateUploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *CreateUploadURLResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CreateUploadURLResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *CreateUploadURLResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type CompleteUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteUploadRequest) Reset() {
+	*x = CompleteUploadRequest{}
+	mi := &file_file_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteUploadRequest) ProtoMessage() {}
+
+func (x *CompleteUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
+	return file_file_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompleteUploadRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UploadFileMetadata struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FileName    string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Точный размер файла в байтах (не больше UPLOAD_MAX_SIZE).
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileMetadata) Reset() {
+	*x = UploadFileMetadata{}
+	mi := &file_file_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileMetadata) ProtoMessage() {}
+
+func (x *UploadFileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_file_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileMetadata.ProtoReflect.Descriptor instead.
+func (*UploadFileMetadata) Descriptor() ([]byte, []int) {
+	return file_file_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UploadFileMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadFileMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadFileMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type UploadFileStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadFileStreamRequest_Metadata
+	//	*UploadFileStreamRequest_Chunk
+	Data          isUploadFileStreamRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileStreamRequest) Reset() {
+	*x = UploadFileStreamRequest{}
+	mi := &file_file_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileStreamRequest) ProtoMessage() {}
+
+func (x *UploadFileStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_file_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileStreamRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileStreamRequest) Descriptor() ([]byte, []int) {
+	return file_file_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadFileStreamRequest) GetData() isUploadFileStreamRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadFileStreamRequest) GetMetadata() *UploadFileMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadFileStreamRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadFileStreamRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadFileStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadFileStreamRequest_Data interface {
+	isUploadFileStreamRequest_Data()
+}
+
+type UploadFileStreamRequest_Metadata struct {
+	Metadata *UploadFileMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadFileStreamRequest_Chunk struct {
+	// Очередной кусок файла (не больше 1 МБ).
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadFileStreamRequest_Metadata) isUploadFileStreamRequest_Data() {}
+
+func (*UploadFileStreamRequest_Chunk) isUploadFileStreamRequest_Data() {}
+
 var File_file_proto protoreflect.FileDescriptor
 
 const file_file_proto_rawDesc = "" +
@@ -224,19 +712,65 @@ const file_file_proto_rawDesc = "" +
 	"\x11UploadFileRequest\x12 \n" +
 	"\x04file\x18\x01 \x01(\fB\f\xfaB\tz\a\x10\x01\x18\x80\x80\xc0\x02R\x04file\x12$\n" +
 	"\tfile_name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bfileName\x129\n" +
-	"\fcontent_type\x18\x03 \x01(\tB\x16\xfaB\x13r\x11R\x04jpegR\x03pngR\x04webpR\vcontentType\"&\n" +
+	"\fcontent_type\x18\x03 \x01(\tB\x16\xfaB\x13r\x11R\x04jpegR\x03pngR\x04webpR\vcontentType\"\xaa\x01\n" +
 	"\x12UploadFileResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"Z\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x129\n" +
+	"\bvariants\x18\x05 \x03(\v2\x1d.leadexchange.v1.ImageVariantR\bvariants\"\xc3\x01\n" +
+	"\fImageVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x12\n" +
+	"\x04size\x18\b \x01(\x03R\x04size\"Z\n" +
 	"\x12UploadFilesRequest\x12D\n" +
 	"\x05files\x18\x01 \x03(\v2\".leadexchange.v1.UploadFileRequestB\n" +
 	"\xfaB\a\x92\x01\x04\b\x01\x10\n" +
-	"R\x05files\")\n" +
+	"R\x05files\"x\n" +
 	"\x13UploadFilesResponse\x12\x12\n" +
-	"\x04urls\x18\x01 \x03(\tR\x04urls2\xf9\x01\n" +
+	"\x04urls\x18\x01 \x03(\tR\x04urls\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\x129\n" +
+	"\x05files\x18\x03 \x03(\v2#.leadexchange.v1.UploadFileResponseR\x05files\"\x99\x01\n" +
+	"\x16CreateUploadURLRequest\x12'\n" +
+	"\tfile_name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bfileName\x129\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\x16\xfaB\x13r\x11R\x04jpegR\x03pngR\x04webpR\vcontentType\x12\x1b\n" +
+	"\x04size\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x04size\"\x8e\x02\n" +
+	"\x17CreateUploadURLResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12O\n" +
+	"\aheaders\x18\x04 \x03(\v25.leadexchange.v1.CreateUploadURLResponse.HeadersEntryR\aheaders\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
+	"\x15CompleteUploadRequest\x12\x1c\n" +
+	"\x03key\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x04R\x03key\"\x95\x01\n" +
+	"\x12UploadFileMetadata\x12'\n" +
+	"\tfile_name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bfileName\x129\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\x16\xfaB\x13r\x11R\x04jpegR\x03pngR\x04webpR\vcontentType\x12\x1b\n" +
+	"\x04size\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x04size\"\x8e\x01\n" +
+	"\x17UploadFileStreamRequest\x12A\n" +
+	"\bmetadata\x18\x01 \x01(\v2#.leadexchange.v1.UploadFileMetadataH\x00R\bmetadata\x12#\n" +
+	"\x05chunk\x18\x02 \x01(\fB\v\xfaB\bz\x06\x10\x01\x18\x80\x80@H\x00R\x05chunkB\v\n" +
+	"\x04data\x12\x03\xf8B\x012\xe4\x04\n" +
 	"\vFileService\x12r\n" +
 	"\n" +
 	"UploadFile\x12\".leadexchange.v1.UploadFileRequest\x1a#.leadexchange.v1.UploadFileResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/files/upload\x12v\n" +
-	"\vUploadFiles\x12#.leadexchange.v1.UploadFilesRequest\x1a$.leadexchange.v1.UploadFilesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/files/uploadsB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+	"\vUploadFiles\x12#.leadexchange.v1.UploadFilesRequest\x1a$.leadexchange.v1.UploadFilesResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/files/uploads\x12\x85\x01\n" +
+	"\x0fCreateUploadURL\x12'.leadexchange.v1.CreateUploadURLRequest\x1a(.leadexchange.v1.CreateUploadURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/files/upload-url\x12|\n" +
+	"\x0eCompleteUpload\x12&.leadexchange.v1.CompleteUploadRequest\x1a#.leadexchange.v1.UploadFileResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/files/complete\x12c\n" +
+	"\x10UploadFileStream\x12(.leadexchange.v1.UploadFileStreamRequest\x1a#.leadexchange.v1.UploadFileResponse(\x01B4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
 	file_file_proto_rawDescOnce sync.Once
@@ -250,24 +784,41 @@ func file_file_proto_rawDescGZIP() []byte {
 	return file_file_proto_rawDescData
 }
 
-var file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_file_proto_goTypes = []any{
-	(*UploadFileRequest)(nil),   // 0: leadexchange.v1.UploadFileRequest
-	(*UploadFileResponse)(nil),  // 1: leadexchange.v1.UploadFileResponse
-	(*UploadFilesRequest)(nil),  // 2: leadexchange.v1.UploadFilesRequest
-	(*UploadFilesResponse)(nil), // 3: leadexchange.v1.UploadFilesResponse
+	(*UploadFileRequest)(nil),       // 0: leadexchange.v1.UploadFileRequest
+	(*UploadFileResponse)(nil),      // 1: leadexchange.v1.UploadFileResponse
+	(*ImageVariant)(nil),            // 2: leadexchange.v1.ImageVariant
+	(*UploadFilesRequest)(nil),      // 3: leadexchange.v1.UploadFilesRequest
+	(*UploadFilesResponse)(nil),     // 4: leadexchange.v1.UploadFilesResponse
+	(*CreateUploadURLRequest)(nil),  // 5: leadexchange.v1.CreateUploadURLRequest
+	(*CreateUploadURLResponse)(nil), // 6: leadexchange.v1.CreateUploadURLResponse
+	(*CompleteUploadRequest)(nil),   // 7: leadexchange.v1.CompleteUploadRequest
+	(*UploadFileMetadata)(nil),      // 8: leadexchange.v1.UploadFileMetadata
+	(*UploadFileStreamRequest)(nil), // 9: leadexchange.v1.UploadFileStreamRequest
+	nil,                             // 10: leadexchange.v1.CreateUploadURLResponse.HeadersEntry
 }
 var file_file_proto_depIdxs = []int32{
-	0, // 0: leadexchange.v1.UploadFilesRequest.files:type_name -> leadexchange.v1.UploadFileRequest
-	0, // 1: leadexchange.v1.FileService.UploadFile:input_type -> leadexchange.v1.UploadFileRequest
-	2, // 2: leadexchange.v1.FileService.UploadFiles:input_type -> leadexchange.v1.UploadFilesRequest
-	1, // 3: leadexchange.v1.FileService.UploadFile:output_type -> leadexchange.v1.UploadFileResponse
-	3, // 4: leadexchange.v1.FileService.UploadFiles:output_type -> leadexchange.v1.UploadFilesResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: leadexchange.v1.UploadFileResponse.variants:type_name -> leadexchange.v1.ImageVariant
+	0,  // 1: leadexchange.v1.UploadFilesRequest.files:type_name -> leadexchange.v1.UploadFileRequest
+	1,  // 2: leadexchange.v1.UploadFilesResponse.files:type_name -> leadexchange.v1.UploadFileResponse
+	10, // 3: leadexchange.v1.CreateUploadURLResponse.headers:type_name -> leadexchange.v1.CreateUploadURLResponse.HeadersEntry
+	8,  // 4: leadexchange.v1.UploadFileStreamRequest.metadata:type_name -> leadexchange.v1.UploadFileMetadata
+	0,  // 5: leadexchange.v1.FileService.UploadFile:input_type -> leadexchange.v1.UploadFileRequest
+	3,  // 6: leadexchange.v1.FileService.UploadFiles:input_type -> leadexchange.v1.UploadFilesRequest
+	5,  // 7: leadexchange.v1.FileService.CreateUploadURL:input_type -> leadexchange.v1.CreateUploadURLRequest
+	7,  // 8: leadexchange.v1.FileService.CompleteUpload:input_type -> leadexchange.v1.CompleteUploadRequest
+	9,  // 9: leadexchange.v1.FileService.UploadFileStream:input_type -> leadexchange.v1.UploadFileStreamRequest
+	1,  // 10: leadexchange.v1.FileService.UploadFile:output_type -> leadexchange.v1.UploadFileResponse
+	4,  // 11: leadexchange.v1.FileService.UploadFiles:output_type -> leadexchange.v1.UploadFilesResponse
+	6,  // 12: leadexchange.v1.FileService.CreateUploadURL:output_type -> leadexchange.v1.CreateUploadURLResponse
+	1,  // 13: leadexchange.v1.FileService.CompleteUpload:output_type -> leadexchange.v1.UploadFileResponse
+	1,  // 14: leadexchange.v1.FileService.UploadFileStream:output_type -> leadexchange.v1.UploadFileResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_file_proto_init() }
@@ -275,13 +826,17 @@ func file_file_proto_init() {
 	if File_file_proto != nil {
 		return
 	}
+	file_file_proto_msgTypes[9].OneofWrappers = []any{
+		(*UploadFileStreamRequest_Metadata)(nil),
+		(*UploadFileStreamRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_file_proto_rawDesc), len(file_file_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
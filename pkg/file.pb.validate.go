@@ -200,6 +200,46 @@ func (m *UploadFileResponse) validate(all bool) error {
 
 	// no validation rules for Url
 
+	// no validation rules for Key
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	for idx, item := range m.GetVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFileResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFileResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFileResponseValidationError{
+					field:  fmt.Sprintf("Variants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UploadFileResponseMultiError(errors)
 	}
@@ -280,6 +320,121 @@ var _ interface {
 	ErrorName() string
 } = UploadFileResponseValidationError{}
 
+// Validate checks the field values on ImageVariant with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageVariant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageVariant with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageVariantMultiError, or
+// nil if none found.
+func (m *ImageVariant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageVariant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Format
+
+	// no validation rules for Key
+
+	// no validation rules for Url
+
+	// no validation rules for ContentType
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ImageVariantMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageVariantMultiError is an error wrapping multiple validation errors
+// returned by ImageVariant.ValidateAll() if the designated constraints aren't met.
+type ImageVariantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageVariantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageVariantMultiError) AllErrors() []error { return m }
+
+// ImageVariantValidationError is the validation error returned by
+// ImageVariant.Validate if the designated constraints aren't met.
+type ImageVariantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageVariantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageVariantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageVariantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageVariantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageVariantValidationError) ErrorName() string { return "ImageVariantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageVariantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageVariant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageVariantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageVariantValidationError{}
+
 // Validate checks the field values on UploadFilesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -449,6 +604,40 @@ func (m *UploadFilesResponse) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFilesResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFilesResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFilesResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UploadFilesResponseMultiError(errors)
 	}
@@ -528,3 +717,694 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UploadFilesResponseValidationError{}
+
+// Validate checks the field values on CreateUploadURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUploadURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUploadURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUploadURLRequestMultiError, or nil if none found.
+func (m *CreateUploadURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUploadURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFileName()); l < 1 || l > 255 {
+		err := CreateUploadURLRequestValidationError{
+			field:  "FileName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateUploadURLRequest_ContentType_InLookup[m.GetContentType()]; !ok {
+		err := CreateUploadURLRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be in list [jpeg png webp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := CreateUploadURLRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUploadURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUploadURLRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUploadURLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUploadURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUploadURLRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUploadURLRequestMultiError) AllErrors() []error { return m }
+
+// CreateUploadURLRequestValidationError is the validation error returned by
+// CreateUploadURLRequest.Validate if the designated constraints aren't met.
+type CreateUploadURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUploadURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUploadURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUploadURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUploadURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUploadURLRequestValidationError) ErrorName() string {
+	return "CreateUploadURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUploadURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUploadURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUploadURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUploadURLRequestValidationError{}
+
+var _CreateUploadURLRequest_ContentType_InLookup = map[string]struct{}{
+	"jpeg": {},
+	"png":  {},
+	"webp": {},
+}
+
+// Validate checks the field values on CreateUploadURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUploadURLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUploadURLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUploadURLResponseMultiError, or nil if none found.
+func (m *CreateUploadURLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUploadURLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for UploadUrl
+
+	// no validation rules for Method
+
+	// no validation rules for Headers
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return CreateUploadURLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUploadURLResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUploadURLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUploadURLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUploadURLResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUploadURLResponseMultiError) AllErrors() []error { return m }
+
+// CreateUploadURLResponseValidationError is the validation error returned by
+// CreateUploadURLResponse.Validate if the designated constraints aren't met.
+type CreateUploadURLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUploadURLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUploadURLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUploadURLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUploadURLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUploadURLResponseValidationError) ErrorName() string {
+	return "CreateUploadURLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUploadURLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUploadURLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUploadURLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUploadURLResponseValidationError{}
+
+// Validate checks the field values on CompleteUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteUploadRequestMultiError, or nil if none found.
+func (m *CompleteUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 512 {
+		err := CompleteUploadRequestValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 512 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteUploadRequestMultiError) AllErrors() []error { return m }
+
+// CompleteUploadRequestValidationError is the validation error returned by
+// CompleteUploadRequest.Validate if the designated constraints aren't met.
+type CompleteUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteUploadRequestValidationError) ErrorName() string {
+	return "CompleteUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteUploadRequestValidationError{}
+
+// Validate checks the field values on UploadFileMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileMetadataMultiError, or nil if none found.
+func (m *UploadFileMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFileName()); l < 1 || l > 255 {
+		err := UploadFileMetadataValidationError{
+			field:  "FileName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UploadFileMetadata_ContentType_InLookup[m.GetContentType()]; !ok {
+		err := UploadFileMetadataValidationError{
+			field:  "ContentType",
+			reason: "value must be in list [jpeg png webp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := UploadFileMetadataValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadFileMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileMetadataMultiError is an error wrapping multiple validation errors
+// returned by UploadFileMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type UploadFileMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileMetadataMultiError) AllErrors() []error { return m }
+
+// UploadFileMetadataValidationError is the validation error returned by
+// UploadFileMetadata.Validate if the designated constraints aren't met.
+type UploadFileMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileMetadataValidationError) ErrorName() string {
+	return "UploadFileMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileMetadataValidationError{}
+
+var _UploadFileMetadata_ContentType_InLookup = map[string]struct{}{
+	"jpeg": {},
+	"png":  {},
+	"webp": {},
+}
+
+// Validate checks the field values on UploadFileStreamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadFileStreamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadFileStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadFileStreamRequestMultiError, or nil if none found.
+func (m *UploadFileStreamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadFileStreamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofDataPresent := false
+	switch v := m.Data.(type) {
+	case *UploadFileStreamRequest_Metadata:
+		if v == nil {
+			err := UploadFileStreamRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadFileStreamRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadFileStreamRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadFileStreamRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadFileStreamRequest_Chunk:
+		if v == nil {
+			err := UploadFileStreamRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if l := len(m.GetChunk()); l < 1 || l > 1048576 {
+			err := UploadFileStreamRequestValidationError{
+				field:  "Chunk",
+				reason: "value length must be between 1 and 1048576 bytes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofDataPresent {
+		err := UploadFileStreamRequestValidationError{
+			field:  "Data",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadFileStreamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadFileStreamRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadFileStreamRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadFileStreamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadFileStreamRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadFileStreamRequestMultiError) AllErrors() []error { return m }
+
+// UploadFileStreamRequestValidationError is the validation error returned by
+// UploadFileStreamRequest.Validate if the designated constraints aren't met.
+type UploadFileStreamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadFileStreamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadFileStreamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadFileStreamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadFileStreamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadFileStreamRequestValidationError) ErrorName() string {
+	return "UploadFileStreamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadFileStreamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadFileStreamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadFileStreamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadFileStreamRequestValidationError{}
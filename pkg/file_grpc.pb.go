@@ -19,18 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FileService_UploadFile_FullMethodName  = "/leadexchange.v1.FileService/UploadFile"
-	FileService_UploadFiles_FullMethodName = "/leadexchange.v1.FileService/UploadFiles"
+	FileService_UploadFile_FullMethodName       = "/leadexchange.v1.FileService/UploadFile"
+	FileService_UploadFiles_FullMethodName      = "/leadexchange.v1.FileService/UploadFiles"
+	FileService_CreateUploadURL_FullMethodName  = "/leadexchange.v1.FileService/CreateUploadURL"
+	FileService_CompleteUpload_FullMethodName   = "/leadexchange.v1.FileService/CompleteUpload"
+	FileService_UploadFileStream_FullMethodName = "/leadexchange.v1.FileService/UploadFileStream"
 )
 
 // FileServiceClient is the client API for FileService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FileServiceClient interface {
-	// Загрузка одного изображения.
+	// Загрузка одного изображения целиком в запросе (base64 через gateway).
+	// Для больших файлов используйте CreateUploadURL + CompleteUpload или UploadFileStream.
 	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
 	// Загрузка нескольких изображений.
 	UploadFiles(ctx context.Context, in *UploadFilesRequest, opts ...grpc.CallOption) (*UploadFilesResponse, error)
+	// Ссылка на загрузку файла напрямую в хранилище (presigned PUT).
+	// Тип и размер заявляются заранее и входят в подпись ссылки.
+	CreateUploadURL(ctx context.Context, in *CreateUploadURLRequest, opts ...grpc.CallOption) (*CreateUploadURLResponse, error)
+	// Подтверждение загрузки по ссылке: проверяет, что файл есть в хранилище,
+	// определяет его реальный тип и размер и регистрирует его.
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
+	// Потоковая загрузка для gRPC-клиентов: первое сообщение — метаданные, дальше — куски файла.
+	UploadFileStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFileStreamRequest, UploadFileResponse], error)
 }
 
 type fileServiceClient struct {
@@ -61,14 +73,56 @@ func (c *fileServiceClient) UploadFiles(ctx context.Context, in *UploadFilesRequ
 	return out, nil
 }
 
+func (c *fileServiceClient) CreateUploadURL(ctx context.Context, in *CreateUploadURLRequest, opts ...grpc.CallOption) (*CreateUploadURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUploadURLResponse)
+	err := c.cc.Invoke(ctx, FileService_CreateUploadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*UploadFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadFileResponse)
+	err := c.cc.Invoke(ctx, FileService_CompleteUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) UploadFileStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFileStreamRequest, UploadFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FileService_ServiceDesc.Streams[0], FileService_UploadFileStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadFileStreamRequest, UploadFileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FileService_UploadFileStreamClient = grpc.ClientStreamingClient[UploadFileStreamRequest, UploadFileResponse]
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
 type FileServiceServer interface {
-	// Загрузка одного изображения.
+	// Загрузка одного изображения целиком в запросе (base64 через gateway).
+	// Для больших файлов используйте CreateUploadURL + CompleteUpload или UploadFileStream.
 	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
 	// Загрузка нескольких изображений.
 	UploadFiles(context.Context, *UploadFilesRequest) (*UploadFilesResponse, error)
+	// Ссылка на загрузку файла напрямую в хранилище (presigned PUT).
+	// Тип и размер заявляются заранее и входят в подпись ссылки.
+	CreateUploadURL(context.Context, *CreateUploadURLRequest) (*CreateUploadURLResponse, error)
+	// Подтверждение загрузки по ссылке: проверяет, что файл есть в хранилище,
+	// определяет его реальный тип и размер и регистрирует его.
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*UploadFileResponse, error)
+	// Потоковая загрузка для gRPC-клиентов: первое сообщение — метаданные, дальше — куски файла.
+	UploadFileStream(grpc.ClientStreamingServer[UploadFileStreamRequest, UploadFileResponse]) error
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -85,6 +139,15 @@ func (UnimplementedFileServiceServer) UploadFile(context.Context, *UploadFileReq
 func (UnimplementedFileServiceServer) UploadFiles(context.Context, *UploadFilesRequest) (*UploadFilesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UploadFiles not implemented")
 }
+func (UnimplementedFileServiceServer) CreateUploadURL(context.Context, *CreateUploadURLRequest) (*CreateUploadURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUploadURL not implemented")
+}
+func (UnimplementedFileServiceServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*UploadFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteUpload not implemented")
+}
+func (UnimplementedFileServiceServer) UploadFileStream(grpc.ClientStreamingServer[UploadFileStreamRequest, UploadFileResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadFileStream not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -142,6 +205,49 @@ func _FileService_UploadFiles_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_CreateUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CreateUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CreateUploadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CreateUploadURL(ctx, req.(*CreateUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_CompleteUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_UploadFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FileServiceServer).UploadFileStream(&grpc.GenericServerStream[UploadFileStreamRequest, UploadFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FileService_UploadFileStreamServer = grpc.ClientStreamingServer[UploadFileStreamRequest, UploadFileResponse]
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,7 +263,21 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadFiles",
 			Handler:    _FileService_UploadFiles_Handler,
 		},
+		{
+			MethodName: "CreateUploadURL",
+			Handler:    _FileService_CreateUploadURL_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _FileService_CompleteUpload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFileStream",
+			Handler:       _FileService_UploadFileStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "file.proto",
 }
@@ -79,25 +79,86 @@ func (LeadStatus) EnumDescriptor() ([]byte, []int) {
 	return file_lead_proto_rawDescGZIP(), []int{0}
 }
 
+// ContactAccessReason — основание для доступа к контактам лида.
+type ContactAccessReason int32
+
+const (
+	ContactAccessReason_CONTACT_ACCESS_REASON_UNSPECIFIED ContactAccessReason = 0
+	// Владелец лида
+	ContactAccessReason_CONTACT_ACCESS_REASON_OWNER ContactAccessReason = 1
+	// Покупатель по завершённой сделке
+	ContactAccessReason_CONTACT_ACCESS_REASON_BUYER ContactAccessReason = 2
+	// Администратор
+	ContactAccessReason_CONTACT_ACCESS_REASON_ADMIN ContactAccessReason = 3
+)
+
+// Enum value maps for ContactAccessReason.
+var (
+	ContactAccessReason_name = map[int32]string{
+		0: "CONTACT_ACCESS_REASON_UNSPECIFIED",
+		1: "CONTACT_ACCESS_REASON_OWNER",
+		2: "CONTACT_ACCESS_REASON_BUYER",
+		3: "CONTACT_ACCESS_REASON_ADMIN",
+	}
+	ContactAccessReason_value = map[string]int32{
+		"CONTACT_ACCESS_REASON_UNSPECIFIED": 0,
+		"CONTACT_ACCESS_REASON_OWNER":       1,
+		"CONTACT_ACCESS_REASON_BUYER":       2,
+		"CONTACT_ACCESS_REASON_ADMIN":       3,
+	}
+)
+
+func (x ContactAccessReason) Enum() *ContactAccessReason {
+	p := new(ContactAccessReason)
+	*p = x
+	return p
+}
+
+func (x ContactAccessReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactAccessReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_lead_proto_enumTypes[1].Descriptor()
+}
+
+func (ContactAccessReason) Type() protoreflect.EnumType {
+	return &file_lead_proto_enumTypes[1]
+}
+
+func (x ContactAccessReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactAccessReason.Descriptor instead.
+func (ContactAccessReason) EnumDescriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{1}
+}
+
 // Lead — сущность лида.
 type Lead struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Requirement   []byte                 `protobuf:"bytes,4,opt,name=requirement,proto3" json:"requirement,omitempty"`
-	ContactName   string                 `protobuf:"bytes,5,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
-	ContactPhone  string                 `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
-	ContactEmail  string                 `protobuf:"bytes,7,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
-	Status        LeadStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus" json:"status,omitempty"`
-	OwnerUserId   string                 `protobuf:"bytes,9,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
-	CreatedUserId string                 `protobuf:"bytes,10,opt,name=created_user_id,json=createdUserId,proto3" json:"created_user_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	City          *string                `protobuf:"bytes,13,opt,name=city,proto3,oneof" json:"city,omitempty"`
-	PropertyType  PropertyType           `protobuf:"varint,14,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LeadId      string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// JSON требований в формате LeadRequirement (совпадает с structured_requirement)
+	Requirement   []byte       `protobuf:"bytes,4,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	ContactName   string       `protobuf:"bytes,5,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone  string       `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail  string       `protobuf:"bytes,7,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Status        LeadStatus   `protobuf:"varint,8,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus" json:"status,omitempty"`
+	OwnerUserId   string       `protobuf:"bytes,9,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	CreatedUserId string       `protobuf:"bytes,10,opt,name=created_user_id,json=createdUserId,proto3" json:"created_user_id,omitempty"`
+	CreatedAt     string       `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string       `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	City          *string      `protobuf:"bytes,13,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	PropertyType  PropertyType `protobuf:"varint,14,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
+	// true, если contact_name/contact_phone/contact_email замаскированы для текущего пользователя
+	ContactsMasked bool `protobuf:"varint,15,opt,name=contacts_masked,json=contactsMasked,proto3" json:"contacts_masked,omitempty"`
+	// Типизированные требования лида
+	StructuredRequirement *LeadRequirement `protobuf:"bytes,16,opt,name=structured_requirement,json=structuredRequirement,proto3" json:"structured_requirement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Lead) Reset() {
@@ -228,23 +289,185 @@ func (x *Lead) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
-type CreateLeadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Requirement   []byte                 `protobuf:"bytes,3,opt,name=requirement,proto3" json:"requirement,omitempty"`
-	ContactName   string                 `protobuf:"bytes,4,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
-	ContactPhone  string                 `protobuf:"bytes,5,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
-	ContactEmail  string                 `protobuf:"bytes,6,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
-	City          *string                `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
-	PropertyType  PropertyType           `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
+func (x *Lead) GetContactsMasked() bool {
+	if x != nil {
+		return x.ContactsMasked
+	}
+	return false
+}
+
+func (x *Lead) GetStructuredRequirement() *LeadRequirement {
+	if x != nil {
+		return x.StructuredRequirement
+	}
+	return nil
+}
+
+// LeadRequirement — типизированные требования лида к объекту (версия схемы 1).
+// Все поля необязательны; диапазоны задаются парами min/max.
+type LeadRequirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Версия схемы; 0 означает текущую
+	Version          int32        `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	BudgetMin        *int64       `protobuf:"varint,2,opt,name=budget_min,json=budgetMin,proto3,oneof" json:"budget_min,omitempty"`
+	BudgetMax        *int64       `protobuf:"varint,3,opt,name=budget_max,json=budgetMax,proto3,oneof" json:"budget_max,omitempty"`
+	RoomsMin         *int32       `protobuf:"varint,4,opt,name=rooms_min,json=roomsMin,proto3,oneof" json:"rooms_min,omitempty"`
+	RoomsMax         *int32       `protobuf:"varint,5,opt,name=rooms_max,json=roomsMax,proto3,oneof" json:"rooms_max,omitempty"`
+	AreaMin          *float64     `protobuf:"fixed64,6,opt,name=area_min,json=areaMin,proto3,oneof" json:"area_min,omitempty"`
+	AreaMax          *float64     `protobuf:"fixed64,7,opt,name=area_max,json=areaMax,proto3,oneof" json:"area_max,omitempty"`
+	FloorMin         *int32       `protobuf:"varint,8,opt,name=floor_min,json=floorMin,proto3,oneof" json:"floor_min,omitempty"`
+	FloorMax         *int32       `protobuf:"varint,9,opt,name=floor_max,json=floorMax,proto3,oneof" json:"floor_max,omitempty"`
+	Districts        []string     `protobuf:"bytes,10,rep,name=districts,proto3" json:"districts,omitempty"`
+	MustHaveFeatures []string     `protobuf:"bytes,11,rep,name=must_have_features,json=mustHaveFeatures,proto3" json:"must_have_features,omitempty"`
+	PropertyType     PropertyType `protobuf:"varint,12,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
+	// Срок, до которого нужен объект (RFC 3339)
+	Deadline      *string `protobuf:"bytes,13,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *LeadRequirement) Reset() {
+	*x = LeadRequirement{}
+	mi := &file_lead_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadRequirement) ProtoMessage() {}
+
+func (x *LeadRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadRequirement.ProtoReflect.Descriptor instead.
+func (*LeadRequirement) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeadRequirement) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetBudgetMin() int64 {
+	if x != nil && x.BudgetMin != nil {
+		return *x.BudgetMin
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetBudgetMax() int64 {
+	if x != nil && x.BudgetMax != nil {
+		return *x.BudgetMax
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetRoomsMin() int32 {
+	if x != nil && x.RoomsMin != nil {
+		return *x.RoomsMin
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetRoomsMax() int32 {
+	if x != nil && x.RoomsMax != nil {
+		return *x.RoomsMax
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetAreaMin() float64 {
+	if x != nil && x.AreaMin != nil {
+		return *x.AreaMin
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetAreaMax() float64 {
+	if x != nil && x.AreaMax != nil {
+		return *x.AreaMax
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetFloorMin() int32 {
+	if x != nil && x.FloorMin != nil {
+		return *x.FloorMin
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetFloorMax() int32 {
+	if x != nil && x.FloorMax != nil {
+		return *x.FloorMax
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetDistricts() []string {
+	if x != nil {
+		return x.Districts
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetMustHaveFeatures() []string {
+	if x != nil {
+		return x.MustHaveFeatures
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetPropertyType() PropertyType {
+	if x != nil {
+		return x.PropertyType
+	}
+	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
+}
+
+func (x *LeadRequirement) GetDeadline() string {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return ""
+}
+
+type CreateLeadRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// JSON требований: формат LeadRequirement (с полем version) или старый свободный формат
+	// (price, roomNumber, area, district), который конвертируется в LeadRequirement
+	Requirement  []byte       `protobuf:"bytes,3,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	ContactName  string       `protobuf:"bytes,4,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone string       `protobuf:"bytes,5,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail string       `protobuf:"bytes,6,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	City         *string      `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	PropertyType PropertyType `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
+	// Типизированные требования; если заданы, поле requirement игнорируется
+	StructuredRequirement *LeadRequirement `protobuf:"bytes,9,opt,name=structured_requirement,json=structuredRequirement,proto3" json:"structured_requirement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
 func (x *CreateLeadRequest) Reset() {
 	*x = CreateLeadRequest{}
-	mi := &file_lead_proto_msgTypes[1]
+	mi := &file_lead_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -256,7 +479,7 @@ func (x *CreateLeadRequest) String() string {
 func (*CreateLeadRequest) ProtoMessage() {}
 
 func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[1]
+	mi := &file_lead_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +492,7 @@ func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeadRequest.ProtoReflect.Descriptor instead.
 func (*CreateLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{1}
+	return file_lead_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateLeadRequest) GetTitle() string {
@@ -328,6 +551,13 @@ func (x *CreateLeadRequest) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
+func (x *CreateLeadRequest) GetStructuredRequirement() *LeadRequirement {
+	if x != nil {
+		return x.StructuredRequirement
+	}
+	return nil
+}
+
 type GetLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
@@ -337,7 +567,7 @@ type GetLeadRequest struct {
 
 func (x *GetLeadRequest) Reset() {
 	*x = GetLeadRequest{}
-	mi := &file_lead_proto_msgTypes[2]
+	mi := &file_lead_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +579,7 @@ func (x *GetLeadRequest) String() string {
 func (*GetLeadRequest) ProtoMessage() {}
 
 func (x *GetLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[2]
+	mi := &file_lead_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +592,7 @@ func (x *GetLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeadRequest.ProtoReflect.Descriptor instead.
 func (*GetLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{2}
+	return file_lead_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetLeadRequest) GetLeadId() string {
@@ -385,7 +615,7 @@ type ListLeadsRequest struct {
 
 func (x *ListLeadsRequest) Reset() {
 	*x = ListLeadsRequest{}
-	mi := &file_lead_proto_msgTypes[3]
+	mi := &file_lead_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +627,7 @@ func (x *ListLeadsRequest) String() string {
 func (*ListLeadsRequest) ProtoMessage() {}
 
 func (x *ListLeadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[3]
+	mi := &file_lead_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +640,7 @@ func (x *ListLeadsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeadsRequest.ProtoReflect.Descriptor instead.
 func (*ListLeadsRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{3}
+	return file_lead_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListLeadsRequest) GetFilter() *ListLeadsRequest_Filter {
@@ -441,11 +671,242 @@ func (x *ListLeadsRequest) GetOrderBy() string {
 	return ""
 }
 
-func (x *ListLeadsRequest) GetOrderDirection() string {
-	if x != nil && x.OrderDirection != nil {
-		return *x.OrderDirection
+func (x *ListLeadsRequest) GetOrderDirection() string {
+	if x != nil && x.OrderDirection != nil {
+		return *x.OrderDirection
+	}
+	return ""
+}
+
+type MatchLeadsRequest struct {
+	state      protoimpl.MessageState   `protogen:"open.v1"`
+	PropertyId string                   `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Filter     *ListLeadsRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit      *int32                   `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// ID пресета весов (balanced, budget_first, location_first, family, semantic, visual_first).
+	WeightPreset  *string `protobuf:"bytes,4,opt,name=weight_preset,json=weightPreset,proto3,oneof" json:"weight_preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchLeadsRequest) Reset() {
+	*x = MatchLeadsRequest{}
+	mi := &file_lead_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchLeadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchLeadsRequest) ProtoMessage() {}
+
+func (x *MatchLeadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchLeadsRequest.ProtoReflect.Descriptor instead.
+func (*MatchLeadsRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MatchLeadsRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *MatchLeadsRequest) GetFilter() *ListLeadsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MatchLeadsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *MatchLeadsRequest) GetWeightPreset() string {
+	if x != nil && x.WeightPreset != nil {
+		return *x.WeightPreset
+	}
+	return ""
+}
+
+// MatchedLead — лид с коэффициентом схожести и взвешенными scores (как в MatchedProperty).
+type MatchedLead struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Lead             *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
+	Similarity       float64                `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	TotalScore       *float64               `protobuf:"fixed64,3,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
+	PriceScore       *float64               `protobuf:"fixed64,4,opt,name=price_score,json=priceScore,proto3,oneof" json:"price_score,omitempty"`
+	DistrictScore    *float64               `protobuf:"fixed64,5,opt,name=district_score,json=districtScore,proto3,oneof" json:"district_score,omitempty"`
+	RoomsScore       *float64               `protobuf:"fixed64,6,opt,name=rooms_score,json=roomsScore,proto3,oneof" json:"rooms_score,omitempty"`
+	AreaScore        *float64               `protobuf:"fixed64,7,opt,name=area_score,json=areaScore,proto3,oneof" json:"area_score,omitempty"`
+	SemanticScore    *float64               `protobuf:"fixed64,8,opt,name=semantic_score,json=semanticScore,proto3,oneof" json:"semantic_score,omitempty"`
+	MatchExplanation *string                `protobuf:"bytes,9,opt,name=match_explanation,json=matchExplanation,proto3,oneof" json:"match_explanation,omitempty"`
+	// Соответствие фотографий объекта визуальным пожеланиям лида (ремонт, вид, особенности).
+	VisualScore   *float64 `protobuf:"fixed64,10,opt,name=visual_score,json=visualScore,proto3,oneof" json:"visual_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchedLead) Reset() {
+	*x = MatchedLead{}
+	mi := &file_lead_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchedLead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchedLead) ProtoMessage() {}
+
+func (x *MatchedLead) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchedLead.ProtoReflect.Descriptor instead.
+func (*MatchedLead) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MatchedLead) GetLead() *Lead {
+	if x != nil {
+		return x.Lead
+	}
+	return nil
+}
+
+func (x *MatchedLead) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetTotalScore() float64 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetPriceScore() float64 {
+	if x != nil && x.PriceScore != nil {
+		return *x.PriceScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetDistrictScore() float64 {
+	if x != nil && x.DistrictScore != nil {
+		return *x.DistrictScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetRoomsScore() float64 {
+	if x != nil && x.RoomsScore != nil {
+		return *x.RoomsScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetAreaScore() float64 {
+	if x != nil && x.AreaScore != nil {
+		return *x.AreaScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetSemanticScore() float64 {
+	if x != nil && x.SemanticScore != nil {
+		return *x.SemanticScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetMatchExplanation() string {
+	if x != nil && x.MatchExplanation != nil {
+		return *x.MatchExplanation
+	}
+	return ""
+}
+
+func (x *MatchedLead) GetVisualScore() float64 {
+	if x != nil && x.VisualScore != nil {
+		return *x.VisualScore
+	}
+	return 0
+}
+
+type MatchLeadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*MatchedLead         `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchLeadsResponse) Reset() {
+	*x = MatchLeadsResponse{}
+	mi := &file_lead_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchLeadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchLeadsResponse) ProtoMessage() {}
+
+func (x *MatchLeadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchLeadsResponse.ProtoReflect.Descriptor instead.
+func (*MatchLeadsResponse) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MatchLeadsResponse) GetMatches() []*MatchedLead {
+	if x != nil {
+		return x.Matches
 	}
-	return ""
+	return nil
 }
 
 type ReindexLeadRequest struct {
@@ -457,7 +918,7 @@ type ReindexLeadRequest struct {
 
 func (x *ReindexLeadRequest) Reset() {
 	*x = ReindexLeadRequest{}
-	mi := &file_lead_proto_msgTypes[4]
+	mi := &file_lead_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +930,7 @@ func (x *ReindexLeadRequest) String() string {
 func (*ReindexLeadRequest) ProtoMessage() {}
 
 func (x *ReindexLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[4]
+	mi := &file_lead_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +943,7 @@ func (x *ReindexLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexLeadRequest.ProtoReflect.Descriptor instead.
 func (*ReindexLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{4}
+	return file_lead_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReindexLeadRequest) GetLeadId() string {
@@ -502,7 +963,7 @@ type ReindexLeadResponse struct {
 
 func (x *ReindexLeadResponse) Reset() {
 	*x = ReindexLeadResponse{}
-	mi := &file_lead_proto_msgTypes[5]
+	mi := &file_lead_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +975,7 @@ func (x *ReindexLeadResponse) String() string {
 func (*ReindexLeadResponse) ProtoMessage() {}
 
 func (x *ReindexLeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[5]
+	mi := &file_lead_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +988,7 @@ func (x *ReindexLeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexLeadResponse.ProtoReflect.Descriptor instead.
 func (*ReindexLeadResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{5}
+	return file_lead_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReindexLeadResponse) GetSuccess() bool {
@@ -544,6 +1005,69 @@ func (x *ReindexLeadResponse) GetMessage() string {
 	return ""
 }
 
+type SubscribeLeadsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Те же условия, что и в ListLeads
+	Filter *ListLeadsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// UUID объекта вызывающего пользователя: приходят только лиды, семантически похожие на него
+	SimilarToPropertyId *string `protobuf:"bytes,2,opt,name=similar_to_property_id,json=similarToPropertyId,proto3,oneof" json:"similar_to_property_id,omitempty"`
+	// Минимальное косинусное сходство с объектом (по умолчанию 0.5)
+	MinSimilarity *float64 `protobuf:"fixed64,3,opt,name=min_similarity,json=minSimilarity,proto3,oneof" json:"min_similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeLeadsRequest) Reset() {
+	*x = SubscribeLeadsRequest{}
+	mi := &file_lead_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLeadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLeadsRequest) ProtoMessage() {}
+
+func (x *SubscribeLeadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeLeadsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeLeadsRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubscribeLeadsRequest) GetFilter() *ListLeadsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SubscribeLeadsRequest) GetSimilarToPropertyId() string {
+	if x != nil && x.SimilarToPropertyId != nil {
+		return *x.SimilarToPropertyId
+	}
+	return ""
+}
+
+func (x *SubscribeLeadsRequest) GetMinSimilarity() float64 {
+	if x != nil && x.MinSimilarity != nil {
+		return *x.MinSimilarity
+	}
+	return 0
+}
+
 type ListLeadsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Leads         []*Lead                `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
@@ -553,7 +1077,7 @@ type ListLeadsResponse struct {
 
 func (x *ListLeadsResponse) Reset() {
 	*x = ListLeadsResponse{}
-	mi := &file_lead_proto_msgTypes[6]
+	mi := &file_lead_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -565,7 +1089,7 @@ func (x *ListLeadsResponse) String() string {
 func (*ListLeadsResponse) ProtoMessage() {}
 
 func (x *ListLeadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[6]
+	mi := &file_lead_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +1102,7 @@ func (x *ListLeadsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeadsResponse.ProtoReflect.Descriptor instead.
 func (*ListLeadsResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{6}
+	return file_lead_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListLeadsResponse) GetLeads() []*Lead {
@@ -589,22 +1113,25 @@ func (x *ListLeadsResponse) GetLeads() []*Lead {
 }
 
 type UpdateLeadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Requirement   []byte                 `protobuf:"bytes,4,opt,name=requirement,proto3,oneof" json:"requirement,omitempty"`
-	Status        *LeadStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus,oneof" json:"status,omitempty"`
-	OwnerUserId   *string                `protobuf:"bytes,6,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
-	City          *string                `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
-	PropertyType  *PropertyType          `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LeadId      string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// JSON требований, см. CreateLeadRequest.requirement
+	Requirement  []byte        `protobuf:"bytes,4,opt,name=requirement,proto3,oneof" json:"requirement,omitempty"`
+	Status       *LeadStatus   `protobuf:"varint,5,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus,oneof" json:"status,omitempty"`
+	OwnerUserId  *string       `protobuf:"bytes,6,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	City         *string       `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	PropertyType *PropertyType `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	// Типизированные требования; если заданы, поле requirement игнорируется
+	StructuredRequirement *LeadRequirement `protobuf:"bytes,9,opt,name=structured_requirement,json=structuredRequirement,proto3" json:"structured_requirement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateLeadRequest) Reset() {
 	*x = UpdateLeadRequest{}
-	mi := &file_lead_proto_msgTypes[7]
+	mi := &file_lead_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +1143,7 @@ func (x *UpdateLeadRequest) String() string {
 func (*UpdateLeadRequest) ProtoMessage() {}
 
 func (x *UpdateLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[7]
+	mi := &file_lead_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +1156,7 @@ func (x *UpdateLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeadRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{7}
+	return file_lead_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateLeadRequest) GetLeadId() string {
@@ -688,6 +1215,13 @@ func (x *UpdateLeadRequest) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
+func (x *UpdateLeadRequest) GetStructuredRequirement() *LeadRequirement {
+	if x != nil {
+		return x.StructuredRequirement
+	}
+	return nil
+}
+
 type LeadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
@@ -697,7 +1231,7 @@ type LeadResponse struct {
 
 func (x *LeadResponse) Reset() {
 	*x = LeadResponse{}
-	mi := &file_lead_proto_msgTypes[8]
+	mi := &file_lead_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -709,7 +1243,7 @@ func (x *LeadResponse) String() string {
 func (*LeadResponse) ProtoMessage() {}
 
 func (x *LeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[8]
+	mi := &file_lead_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +1256,7 @@ func (x *LeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeadResponse.ProtoReflect.Descriptor instead.
 func (*LeadResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{8}
+	return file_lead_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LeadResponse) GetLead() *Lead {
@@ -732,6 +1266,118 @@ func (x *LeadResponse) GetLead() *Lead {
 	return nil
 }
 
+type RevealLeadContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealLeadContactsRequest) Reset() {
+	*x = RevealLeadContactsRequest{}
+	mi := &file_lead_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealLeadContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealLeadContactsRequest) ProtoMessage() {}
+
+func (x *RevealLeadContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealLeadContactsRequest.ProtoReflect.Descriptor instead.
+func (*RevealLeadContactsRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevealLeadContactsRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+type RevealLeadContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContactName   string                 `protobuf:"bytes,1,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhone  string                 `protobuf:"bytes,2,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	ContactEmail  string                 `protobuf:"bytes,3,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	Reason        ContactAccessReason    `protobuf:"varint,4,opt,name=reason,proto3,enum=leadexchange.v1.ContactAccessReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealLeadContactsResponse) Reset() {
+	*x = RevealLeadContactsResponse{}
+	mi := &file_lead_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealLeadContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealLeadContactsResponse) ProtoMessage() {}
+
+func (x *RevealLeadContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealLeadContactsResponse.ProtoReflect.Descriptor instead.
+func (*RevealLeadContactsResponse) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevealLeadContactsResponse) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *RevealLeadContactsResponse) GetContactPhone() string {
+	if x != nil {
+		return x.ContactPhone
+	}
+	return ""
+}
+
+func (x *RevealLeadContactsResponse) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *RevealLeadContactsResponse) GetReason() ContactAccessReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ContactAccessReason_CONTACT_ACCESS_REASON_UNSPECIFIED
+}
+
 type GetClarificationQuestionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
@@ -741,7 +1387,7 @@ type GetClarificationQuestionsRequest struct {
 
 func (x *GetClarificationQuestionsRequest) Reset() {
 	*x = GetClarificationQuestionsRequest{}
-	mi := &file_lead_proto_msgTypes[9]
+	mi := &file_lead_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +1399,7 @@ func (x *GetClarificationQuestionsRequest) String() string {
 func (*GetClarificationQuestionsRequest) ProtoMessage() {}
 
 func (x *GetClarificationQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[9]
+	mi := &file_lead_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1412,7 @@ func (x *GetClarificationQuestionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClarificationQuestionsRequest.ProtoReflect.Descriptor instead.
 func (*GetClarificationQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{9}
+	return file_lead_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetClarificationQuestionsRequest) GetLeadId() string {
@@ -789,7 +1435,7 @@ type ClarificationQuestion struct {
 
 func (x *ClarificationQuestion) Reset() {
 	*x = ClarificationQuestion{}
-	mi := &file_lead_proto_msgTypes[10]
+	mi := &file_lead_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1447,7 @@ func (x *ClarificationQuestion) String() string {
 func (*ClarificationQuestion) ProtoMessage() {}
 
 func (x *ClarificationQuestion) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[10]
+	mi := &file_lead_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1460,7 @@ func (x *ClarificationQuestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClarificationQuestion.ProtoReflect.Descriptor instead.
 func (*ClarificationQuestion) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{10}
+	return file_lead_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ClarificationQuestion) GetField() string {
@@ -865,7 +1511,7 @@ type GetClarificationQuestionsResponse struct {
 
 func (x *GetClarificationQuestionsResponse) Reset() {
 	*x = GetClarificationQuestionsResponse{}
-	mi := &file_lead_proto_msgTypes[11]
+	mi := &file_lead_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1523,7 @@ func (x *GetClarificationQuestionsResponse) String() string {
 func (*GetClarificationQuestionsResponse) ProtoMessage() {}
 
 func (x *GetClarificationQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[11]
+	mi := &file_lead_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1536,7 @@ func (x *GetClarificationQuestionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetClarificationQuestionsResponse.ProtoReflect.Descriptor instead.
 func (*GetClarificationQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{11}
+	return file_lead_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetClarificationQuestionsResponse) GetNeedsClarification() bool {
@@ -938,7 +1584,7 @@ type ClarificationAnswer struct {
 
 func (x *ClarificationAnswer) Reset() {
 	*x = ClarificationAnswer{}
-	mi := &file_lead_proto_msgTypes[12]
+	mi := &file_lead_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1596,7 @@ func (x *ClarificationAnswer) String() string {
 func (*ClarificationAnswer) ProtoMessage() {}
 
 func (x *ClarificationAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[12]
+	mi := &file_lead_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1609,7 @@ func (x *ClarificationAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClarificationAnswer.ProtoReflect.Descriptor instead.
 func (*ClarificationAnswer) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{12}
+	return file_lead_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ClarificationAnswer) GetField() string {
@@ -990,7 +1636,7 @@ type ApplyClarificationAnswersRequest struct {
 
 func (x *ApplyClarificationAnswersRequest) Reset() {
 	*x = ApplyClarificationAnswersRequest{}
-	mi := &file_lead_proto_msgTypes[13]
+	mi := &file_lead_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1002,7 +1648,7 @@ func (x *ApplyClarificationAnswersRequest) String() string {
 func (*ApplyClarificationAnswersRequest) ProtoMessage() {}
 
 func (x *ApplyClarificationAnswersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[13]
+	mi := &file_lead_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1661,7 @@ func (x *ApplyClarificationAnswersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyClarificationAnswersRequest.ProtoReflect.Descriptor instead.
 func (*ApplyClarificationAnswersRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{13}
+	return file_lead_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ApplyClarificationAnswersRequest) GetLeadId() string {
@@ -1033,17 +1679,18 @@ func (x *ApplyClarificationAnswersRequest) GetAnswers() []*ClarificationAnswer {
 }
 
 type ApplyClarificationAnswersResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NewRequirement []byte                 `protobuf:"bytes,2,opt,name=new_requirement,json=newRequirement,proto3" json:"new_requirement,omitempty"`
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Success               bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NewRequirement        []byte                 `protobuf:"bytes,2,opt,name=new_requirement,json=newRequirement,proto3" json:"new_requirement,omitempty"`
+	Message               string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	StructuredRequirement *LeadRequirement       `protobuf:"bytes,4,opt,name=structured_requirement,json=structuredRequirement,proto3" json:"structured_requirement,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ApplyClarificationAnswersResponse) Reset() {
 	*x = ApplyClarificationAnswersResponse{}
-	mi := &file_lead_proto_msgTypes[14]
+	mi := &file_lead_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1702,7 @@ func (x *ApplyClarificationAnswersResponse) String() string {
 func (*ApplyClarificationAnswersResponse) ProtoMessage() {}
 
 func (x *ApplyClarificationAnswersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[14]
+	mi := &file_lead_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1715,7 @@ func (x *ApplyClarificationAnswersResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApplyClarificationAnswersResponse.ProtoReflect.Descriptor instead.
 func (*ApplyClarificationAnswersResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{14}
+	return file_lead_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ApplyClarificationAnswersResponse) GetSuccess() bool {
@@ -1092,20 +1739,29 @@ func (x *ApplyClarificationAnswersResponse) GetMessage() string {
 	return ""
 }
 
+func (x *ApplyClarificationAnswersResponse) GetStructuredRequirement() *LeadRequirement {
+	if x != nil {
+		return x.StructuredRequirement
+	}
+	return nil
+}
+
 type MatchWeights struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	District      float64                `protobuf:"fixed64,2,opt,name=district,proto3" json:"district,omitempty"`
-	Rooms         float64                `protobuf:"fixed64,3,opt,name=rooms,proto3" json:"rooms,omitempty"`
-	Area          float64                `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
-	Semantic      float64                `protobuf:"fixed64,5,opt,name=semantic,proto3" json:"semantic,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Price    float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	District float64                `protobuf:"fixed64,2,opt,name=district,proto3" json:"district,omitempty"`
+	Rooms    float64                `protobuf:"fixed64,3,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	Area     float64                `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
+	Semantic float64                `protobuf:"fixed64,5,opt,name=semantic,proto3" json:"semantic,omitempty"`
+	// Вес оценки по фотографиям (visual_features объекта).
+	Visual        float64 `protobuf:"fixed64,6,opt,name=visual,proto3" json:"visual,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MatchWeights) Reset() {
 	*x = MatchWeights{}
-	mi := &file_lead_proto_msgTypes[15]
+	mi := &file_lead_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1773,7 @@ func (x *MatchWeights) String() string {
 func (*MatchWeights) ProtoMessage() {}
 
 func (x *MatchWeights) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[15]
+	mi := &file_lead_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1786,7 @@ func (x *MatchWeights) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchWeights.ProtoReflect.Descriptor instead.
 func (*MatchWeights) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{15}
+	return file_lead_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MatchWeights) GetPrice() float64 {
@@ -1168,6 +1824,13 @@ func (x *MatchWeights) GetSemantic() float64 {
 	return 0
 }
 
+func (x *MatchWeights) GetVisual() float64 {
+	if x != nil {
+		return x.Visual
+	}
+	return 0
+}
+
 type ExtractedCriteria struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	TargetPrice        *int64                 `protobuf:"varint,1,opt,name=target_price,json=targetPrice,proto3,oneof" json:"target_price,omitempty"`
@@ -1177,13 +1840,18 @@ type ExtractedCriteria struct {
 	PreferredDistricts []string               `protobuf:"bytes,5,rep,name=preferred_districts,json=preferredDistricts,proto3" json:"preferred_districts,omitempty"`
 	MustHaveFeatures   []string               `protobuf:"bytes,6,rep,name=must_have_features,json=mustHaveFeatures,proto3" json:"must_have_features,omitempty"`
 	NiceToHaveFeatures []string               `protobuf:"bytes,7,rep,name=nice_to_have_features,json=niceToHaveFeatures,proto3" json:"nice_to_have_features,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Визуальные пожелания, проверяемые по фотографиям:
+	// ожидаемая оценка ремонта (0-1), нужен ли хороший вид, особенности (balcony, terrace, ...).
+	MinVisualQuality *float64 `protobuf:"fixed64,8,opt,name=min_visual_quality,json=minVisualQuality,proto3,oneof" json:"min_visual_quality,omitempty"`
+	WantsGoodView    bool     `protobuf:"varint,9,opt,name=wants_good_view,json=wantsGoodView,proto3" json:"wants_good_view,omitempty"`
+	VisualFeatures   []string `protobuf:"bytes,10,rep,name=visual_features,json=visualFeatures,proto3" json:"visual_features,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ExtractedCriteria) Reset() {
 	*x = ExtractedCriteria{}
-	mi := &file_lead_proto_msgTypes[16]
+	mi := &file_lead_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1195,7 +1863,7 @@ func (x *ExtractedCriteria) String() string {
 func (*ExtractedCriteria) ProtoMessage() {}
 
 func (x *ExtractedCriteria) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[16]
+	mi := &file_lead_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +1876,7 @@ func (x *ExtractedCriteria) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractedCriteria.ProtoReflect.Descriptor instead.
 func (*ExtractedCriteria) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{16}
+	return file_lead_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ExtractedCriteria) GetTargetPrice() int64 {
@@ -1260,6 +1928,27 @@ func (x *ExtractedCriteria) GetNiceToHaveFeatures() []string {
 	return nil
 }
 
+func (x *ExtractedCriteria) GetMinVisualQuality() float64 {
+	if x != nil && x.MinVisualQuality != nil {
+		return *x.MinVisualQuality
+	}
+	return 0
+}
+
+func (x *ExtractedCriteria) GetWantsGoodView() bool {
+	if x != nil {
+		return x.WantsGoodView
+	}
+	return false
+}
+
+func (x *ExtractedCriteria) GetVisualFeatures() []string {
+	if x != nil {
+		return x.VisualFeatures
+	}
+	return nil
+}
+
 type AnalyzeLeadIntentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
@@ -1269,7 +1958,7 @@ type AnalyzeLeadIntentRequest struct {
 
 func (x *AnalyzeLeadIntentRequest) Reset() {
 	*x = AnalyzeLeadIntentRequest{}
-	mi := &file_lead_proto_msgTypes[17]
+	mi := &file_lead_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1281,7 +1970,7 @@ func (x *AnalyzeLeadIntentRequest) String() string {
 func (*AnalyzeLeadIntentRequest) ProtoMessage() {}
 
 func (x *AnalyzeLeadIntentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[17]
+	mi := &file_lead_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +1983,7 @@ func (x *AnalyzeLeadIntentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzeLeadIntentRequest.ProtoReflect.Descriptor instead.
 func (*AnalyzeLeadIntentRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{17}
+	return file_lead_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AnalyzeLeadIntentRequest) GetLeadId() string {
@@ -1318,7 +2007,7 @@ type AnalyzeLeadIntentResponse struct {
 
 func (x *AnalyzeLeadIntentResponse) Reset() {
 	*x = AnalyzeLeadIntentResponse{}
-	mi := &file_lead_proto_msgTypes[18]
+	mi := &file_lead_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +2019,7 @@ func (x *AnalyzeLeadIntentResponse) String() string {
 func (*AnalyzeLeadIntentResponse) ProtoMessage() {}
 
 func (x *AnalyzeLeadIntentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[18]
+	mi := &file_lead_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +2032,7 @@ func (x *AnalyzeLeadIntentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzeLeadIntentResponse.ProtoReflect.Descriptor instead.
 func (*AnalyzeLeadIntentResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{18}
+	return file_lead_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AnalyzeLeadIntentResponse) GetRecommendedWeights() *MatchWeights {
@@ -1401,7 +2090,7 @@ type ListLeadsRequest_Filter struct {
 
 func (x *ListLeadsRequest_Filter) Reset() {
 	*x = ListLeadsRequest_Filter{}
-	mi := &file_lead_proto_msgTypes[19]
+	mi := &file_lead_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1413,7 +2102,7 @@ func (x *ListLeadsRequest_Filter) String() string {
 func (*ListLeadsRequest_Filter) ProtoMessage() {}
 
 func (x *ListLeadsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[19]
+	mi := &file_lead_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +2115,7 @@ func (x *ListLeadsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeadsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListLeadsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{3, 0}
+	return file_lead_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *ListLeadsRequest_Filter) GetStatus() LeadStatus {
@@ -1469,7 +2158,7 @@ var File_lead_proto protoreflect.FileDescriptor
 const file_lead_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"lead.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0eproperty.proto\"\xd9\x04\n" +
+	"lead.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0eproperty.proto\"\xdb\x05\n" +
 	"\x04Lead\x12\x17\n" +
 	"\alead_id\x18\x01 \x01(\tR\x06leadId\x12\x1d\n" +
 	"\x05title\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12 \n" +
@@ -1488,8 +2177,42 @@ const file_lead_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x17\n" +
 	"\x04city\x18\r \x01(\tH\x00R\x04city\x88\x01\x01\x12B\n" +
-	"\rproperty_type\x18\x0e \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyTypeB\a\n" +
-	"\x05_city\"\xfa\x02\n" +
+	"\rproperty_type\x18\x0e \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyType\x12'\n" +
+	"\x0fcontacts_masked\x18\x0f \x01(\bR\x0econtactsMasked\x12W\n" +
+	"\x16structured_requirement\x18\x10 \x01(\v2 .leadexchange.v1.LeadRequirementR\x15structuredRequirementB\a\n" +
+	"\x05_city\"\xff\x05\n" +
+	"\x0fLeadRequirement\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12+\n" +
+	"\n" +
+	"budget_min\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x00R\tbudgetMin\x88\x01\x01\x12+\n" +
+	"\n" +
+	"budget_max\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x01R\tbudgetMax\x88\x01\x01\x12+\n" +
+	"\trooms_min\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00H\x02R\broomsMin\x88\x01\x01\x12+\n" +
+	"\trooms_max\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00H\x03R\broomsMax\x88\x01\x01\x12.\n" +
+	"\barea_min\x18\x06 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x04R\aareaMin\x88\x01\x01\x12.\n" +
+	"\barea_max\x18\a \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00H\x05R\aareaMax\x88\x01\x01\x125\n" +
+	"\tfloor_min\x18\b \x01(\x05B\x13\xfaB\x10\x1a\x0e\x18\xc8\x01(\xb8\xfe\xff\xff\xff\xff\xff\xff\xff\x01H\x06R\bfloorMin\x88\x01\x01\x125\n" +
+	"\tfloor_max\x18\t \x01(\x05B\x13\xfaB\x10\x1a\x0e\x18\xc8\x01(\xb8\xfe\xff\xff\xff\xff\xff\xff\xff\x01H\aR\bfloorMax\x88\x01\x01\x12.\n" +
+	"\tdistricts\x18\n" +
+	" \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\tdistricts\x12>\n" +
+	"\x12must_have_features\x18\v \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\x10mustHaveFeatures\x12B\n" +
+	"\rproperty_type\x18\f \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyType\x12\x1f\n" +
+	"\bdeadline\x18\r \x01(\tH\bR\bdeadline\x88\x01\x01B\r\n" +
+	"\v_budget_minB\r\n" +
+	"\v_budget_maxB\f\n" +
+	"\n" +
+	"_rooms_minB\f\n" +
+	"\n" +
+	"_rooms_maxB\v\n" +
+	"\t_area_minB\v\n" +
+	"\t_area_maxB\f\n" +
+	"\n" +
+	"_floor_minB\f\n" +
+	"\n" +
+	"_floor_maxB\v\n" +
+	"\t_deadline\"\xd3\x03\n" +
 	"\x11CreateLeadRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
@@ -1499,7 +2222,8 @@ const file_lead_proto_rawDesc = "" +
 	"\rcontact_email\x18\x06 \x01(\tB\n" +
 	"\xfaB\ar\x05\xd0\x01\x01`\x01R\fcontactEmail\x12\x17\n" +
 	"\x04city\x18\a \x01(\tH\x00R\x04city\x88\x01\x01\x12B\n" +
-	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyTypeB\a\n" +
+	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyType\x12W\n" +
+	"\x16structured_requirement\x18\t \x01(\v2 .leadexchange.v1.LeadRequirementR\x15structuredRequirementB\a\n" +
 	"\x05_city\"3\n" +
 	"\x0eGetLeadRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xef\x04\n" +
@@ -1525,14 +2249,56 @@ const file_lead_proto_rawDesc = "" +
 	"_page_sizeB\r\n" +
 	"\v_page_tokenB\v\n" +
 	"\t_order_byB\x12\n" +
-	"\x10_order_direction\"7\n" +
+	"\x10_order_direction\"\xec\x01\n" +
+	"\x11MatchLeadsRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12@\n" +
+	"\x06filter\x18\x02 \x01(\v2(.leadexchange.v1.ListLeadsRequest.FilterR\x06filter\x12$\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x00R\x05limit\x88\x01\x01\x12(\n" +
+	"\rweight_preset\x18\x04 \x01(\tH\x01R\fweightPreset\x88\x01\x01B\b\n" +
+	"\x06_limitB\x10\n" +
+	"\x0e_weight_preset\"\xac\x04\n" +
+	"\vMatchedLead\x12)\n" +
+	"\x04lead\x18\x01 \x01(\v2\x15.leadexchange.v1.LeadR\x04lead\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12$\n" +
+	"\vtotal_score\x18\x03 \x01(\x01H\x00R\n" +
+	"totalScore\x88\x01\x01\x12$\n" +
+	"\vprice_score\x18\x04 \x01(\x01H\x01R\n" +
+	"priceScore\x88\x01\x01\x12*\n" +
+	"\x0edistrict_score\x18\x05 \x01(\x01H\x02R\rdistrictScore\x88\x01\x01\x12$\n" +
+	"\vrooms_score\x18\x06 \x01(\x01H\x03R\n" +
+	"roomsScore\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"area_score\x18\a \x01(\x01H\x04R\tareaScore\x88\x01\x01\x12*\n" +
+	"\x0esemantic_score\x18\b \x01(\x01H\x05R\rsemanticScore\x88\x01\x01\x120\n" +
+	"\x11match_explanation\x18\t \x01(\tH\x06R\x10matchExplanation\x88\x01\x01\x12&\n" +
+	"\fvisual_score\x18\n" +
+	" \x01(\x01H\aR\vvisualScore\x88\x01\x01B\x0e\n" +
+	"\f_total_scoreB\x0e\n" +
+	"\f_price_scoreB\x11\n" +
+	"\x0f_district_scoreB\x0e\n" +
+	"\f_rooms_scoreB\r\n" +
+	"\v_area_scoreB\x11\n" +
+	"\x0f_semantic_scoreB\x14\n" +
+	"\x12_match_explanationB\x0f\n" +
+	"\r_visual_score\"L\n" +
+	"\x12MatchLeadsResponse\x126\n" +
+	"\amatches\x18\x01 \x03(\v2\x1c.leadexchange.v1.MatchedLeadR\amatches\"7\n" +
 	"\x12ReindexLeadRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"I\n" +
 	"\x13ReindexLeadResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x90\x02\n" +
+	"\x15SubscribeLeadsRequest\x12@\n" +
+	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListLeadsRequest.FilterR\x06filter\x12B\n" +
+	"\x16similar_to_property_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x13similarToPropertyId\x88\x01\x01\x12C\n" +
+	"\x0emin_similarity\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\rminSimilarity\x88\x01\x01B\x19\n" +
+	"\x17_similar_to_property_idB\x11\n" +
+	"\x0f_min_similarity\"@\n" +
 	"\x11ListLeadsResponse\x12+\n" +
-	"\x05leads\x18\x01 \x03(\v2\x15.leadexchange.v1.LeadR\x05leads\"\xc6\x03\n" +
+	"\x05leads\x18\x01 \x03(\v2\x15.leadexchange.v1.LeadR\x05leads\"\x9f\x04\n" +
 	"\x11UpdateLeadRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
@@ -1541,7 +2307,8 @@ const file_lead_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\x0e2\x1b.leadexchange.v1.LeadStatusH\x03R\x06status\x88\x01\x01\x12'\n" +
 	"\rowner_user_id\x18\x06 \x01(\tH\x04R\vownerUserId\x88\x01\x01\x12\x17\n" +
 	"\x04city\x18\a \x01(\tH\x05R\x04city\x88\x01\x01\x12G\n" +
-	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x06R\fpropertyType\x88\x01\x01B\b\n" +
+	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x06R\fpropertyType\x88\x01\x01\x12W\n" +
+	"\x16structured_requirement\x18\t \x01(\v2 .leadexchange.v1.LeadRequirementR\x15structuredRequirementB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_requirementB\t\n" +
@@ -1550,7 +2317,14 @@ const file_lead_proto_rawDesc = "" +
 	"\x05_cityB\x10\n" +
 	"\x0e_property_type\"9\n" +
 	"\fLeadResponse\x12)\n" +
-	"\x04lead\x18\x01 \x01(\v2\x15.leadexchange.v1.LeadR\x04lead\"E\n" +
+	"\x04lead\x18\x01 \x01(\v2\x15.leadexchange.v1.LeadR\x04lead\">\n" +
+	"\x19RevealLeadContactsRequest\x12!\n" +
+	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xc7\x01\n" +
+	"\x1aRevealLeadContactsResponse\x12!\n" +
+	"\fcontact_name\x18\x01 \x01(\tR\vcontactName\x12#\n" +
+	"\rcontact_phone\x18\x02 \x01(\tR\fcontactPhone\x12#\n" +
+	"\rcontact_email\x18\x03 \x01(\tR\fcontactEmail\x12<\n" +
+	"\x06reason\x18\x04 \x01(\x0e2$.leadexchange.v1.ContactAccessReasonR\x06reason\"E\n" +
 	" GetClarificationQuestionsRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xbb\x01\n" +
 	"\x15ClarificationQuestion\x12\x14\n" +
@@ -1572,17 +2346,19 @@ const file_lead_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"\x85\x01\n" +
 	" ApplyClarificationAnswersRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x12>\n" +
-	"\aanswers\x18\x02 \x03(\v2$.leadexchange.v1.ClarificationAnswerR\aanswers\"\x80\x01\n" +
+	"\aanswers\x18\x02 \x03(\v2$.leadexchange.v1.ClarificationAnswerR\aanswers\"\xd9\x01\n" +
 	"!ApplyClarificationAnswersResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
 	"\x0fnew_requirement\x18\x02 \x01(\fR\x0enewRequirement\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x86\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12W\n" +
+	"\x16structured_requirement\x18\x04 \x01(\v2 .leadexchange.v1.LeadRequirementR\x15structuredRequirement\"\x9e\x01\n" +
 	"\fMatchWeights\x12\x14\n" +
 	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x1a\n" +
 	"\bdistrict\x18\x02 \x01(\x01R\bdistrict\x12\x14\n" +
 	"\x05rooms\x18\x03 \x01(\x01R\x05rooms\x12\x12\n" +
 	"\x04area\x18\x04 \x01(\x01R\x04area\x12\x1a\n" +
-	"\bsemantic\x18\x05 \x01(\x01R\bsemantic\"\x8f\x03\n" +
+	"\bsemantic\x18\x05 \x01(\x01R\bsemantic\x12\x16\n" +
+	"\x06visual\x18\x06 \x01(\x01R\x06visual\"\xaa\x04\n" +
 	"\x11ExtractedCriteria\x12&\n" +
 	"\ftarget_price\x18\x01 \x01(\x03H\x00R\vtargetPrice\x88\x01\x01\x12,\n" +
 	"\x0ftarget_district\x18\x02 \x01(\tH\x01R\x0etargetDistrict\x88\x01\x01\x12&\n" +
@@ -1591,11 +2367,16 @@ const file_lead_proto_rawDesc = "" +
 	"targetArea\x88\x01\x01\x12/\n" +
 	"\x13preferred_districts\x18\x05 \x03(\tR\x12preferredDistricts\x12,\n" +
 	"\x12must_have_features\x18\x06 \x03(\tR\x10mustHaveFeatures\x121\n" +
-	"\x15nice_to_have_features\x18\a \x03(\tR\x12niceToHaveFeaturesB\x0f\n" +
+	"\x15nice_to_have_features\x18\a \x03(\tR\x12niceToHaveFeatures\x121\n" +
+	"\x12min_visual_quality\x18\b \x01(\x01H\x04R\x10minVisualQuality\x88\x01\x01\x12&\n" +
+	"\x0fwants_good_view\x18\t \x01(\bR\rwantsGoodView\x12'\n" +
+	"\x0fvisual_features\x18\n" +
+	" \x03(\tR\x0evisualFeaturesB\x0f\n" +
 	"\r_target_priceB\x12\n" +
 	"\x10_target_districtB\x0f\n" +
 	"\r_target_roomsB\x0e\n" +
-	"\f_target_area\"=\n" +
+	"\f_target_areaB\x15\n" +
+	"\x13_min_visual_quality\"=\n" +
 	"\x18AnalyzeLeadIntentRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xb8\x02\n" +
 	"\x19AnalyzeLeadIntentResponse\x12N\n" +
@@ -1613,14 +2394,23 @@ const file_lead_proto_rawDesc = "" +
 	"\x0fLEAD_STATUS_NEW\x10\x01\x12\x19\n" +
 	"\x15LEAD_STATUS_PUBLISHED\x10\x02\x12\x19\n" +
 	"\x15LEAD_STATUS_PURCHASED\x10\x03\x12\x17\n" +
-	"\x13LEAD_STATUS_DELETED\x10\x042\xac\b\n" +
+	"\x13LEAD_STATUS_DELETED\x10\x04*\x9f\x01\n" +
+	"\x13ContactAccessReason\x12%\n" +
+	"!CONTACT_ACCESS_REASON_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bCONTACT_ACCESS_REASON_OWNER\x10\x01\x12\x1f\n" +
+	"\x1bCONTACT_ACCESS_REASON_BUYER\x10\x02\x12\x1f\n" +
+	"\x1bCONTACT_ACCESS_REASON_ADMIN\x10\x032\xaf\v\n" +
 	"\vLeadService\x12e\n" +
 	"\n" +
 	"CreateLead\x12\".leadexchange.v1.CreateLeadRequest\x1a\x1d.leadexchange.v1.LeadResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/leads\x12f\n" +
 	"\aGetLead\x12\x1f.leadexchange.v1.GetLeadRequest\x1a\x1d.leadexchange.v1.LeadResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/leads/{lead_id}\x12e\n" +
-	"\tListLeads\x12!.leadexchange.v1.ListLeadsRequest\x1a\".leadexchange.v1.ListLeadsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/leads\x12o\n" +
+	"\tListLeads\x12!.leadexchange.v1.ListLeadsRequest\x1a\".leadexchange.v1.ListLeadsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/leads\x12n\n" +
+	"\x0eSubscribeLeads\x12&.leadexchange.v1.SubscribeLeadsRequest\x1a\x15.leadexchange.v1.Lead\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/leads/subscribe0\x01\x12q\n" +
+	"\n" +
+	"MatchLeads\x12\".leadexchange.v1.MatchLeadsRequest\x1a#.leadexchange.v1.MatchLeadsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/leads/match\x12o\n" +
 	"\n" +
-	"UpdateLead\x12\".leadexchange.v1.UpdateLeadRequest\x1a\x1d.leadexchange.v1.LeadResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/leads/{lead_id}\x12\x80\x01\n" +
+	"UpdateLead\x12\".leadexchange.v1.UpdateLeadRequest\x1a\x1d.leadexchange.v1.LeadResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/leads/{lead_id}\x12\x9d\x01\n" +
+	"\x12RevealLeadContacts\x12*.leadexchange.v1.RevealLeadContactsRequest\x1a+.leadexchange.v1.RevealLeadContactsResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/leads/{lead_id}/contacts/reveal\x12\x80\x01\n" +
 	"\vReindexLead\x12#.leadexchange.v1.ReindexLeadRequest\x1a$.leadexchange.v1.ReindexLeadResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/leads/{lead_id}/reindex\x12\xad\x01\n" +
 	"\x19GetClarificationQuestions\x121.leadexchange.v1.GetClarificationQuestionsRequest\x1a2.leadexchange.v1.GetClarificationQuestionsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/leads/{lead_id}/clarification\x12\xb0\x01\n" +
 	"\x19ApplyClarificationAnswers\x121.leadexchange.v1.ApplyClarificationAnswersRequest\x1a2.leadexchange.v1.ApplyClarificationAnswersResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/leads/{lead_id}/clarification\x12\x8f\x01\n" +
@@ -1638,68 +2428,92 @@ func file_lead_proto_rawDescGZIP() []byte {
 	return file_lead_proto_rawDescData
 }
 
-var file_lead_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_lead_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_lead_proto_goTypes = []any{
 	(LeadStatus)(0),                           // 0: leadexchange.v1.LeadStatus
-	(*Lead)(nil),                              // 1: leadexchange.v1.Lead
-	(*CreateLeadRequest)(nil),                 // 2: leadexchange.v1.CreateLeadRequest
-	(*GetLeadRequest)(nil),                    // 3: leadexchange.v1.GetLeadRequest
-	(*ListLeadsRequest)(nil),                  // 4: leadexchange.v1.ListLeadsRequest
-	(*ReindexLeadRequest)(nil),                // 5: leadexchange.v1.ReindexLeadRequest
-	(*ReindexLeadResponse)(nil),               // 6: leadexchange.v1.ReindexLeadResponse
-	(*ListLeadsResponse)(nil),                 // 7: leadexchange.v1.ListLeadsResponse
-	(*UpdateLeadRequest)(nil),                 // 8: leadexchange.v1.UpdateLeadRequest
-	(*LeadResponse)(nil),                      // 9: leadexchange.v1.LeadResponse
-	(*GetClarificationQuestionsRequest)(nil),  // 10: leadexchange.v1.GetClarificationQuestionsRequest
-	(*ClarificationQuestion)(nil),             // 11: leadexchange.v1.ClarificationQuestion
-	(*GetClarificationQuestionsResponse)(nil), // 12: leadexchange.v1.GetClarificationQuestionsResponse
-	(*ClarificationAnswer)(nil),               // 13: leadexchange.v1.ClarificationAnswer
-	(*ApplyClarificationAnswersRequest)(nil),  // 14: leadexchange.v1.ApplyClarificationAnswersRequest
-	(*ApplyClarificationAnswersResponse)(nil), // 15: leadexchange.v1.ApplyClarificationAnswersResponse
-	(*MatchWeights)(nil),                      // 16: leadexchange.v1.MatchWeights
-	(*ExtractedCriteria)(nil),                 // 17: leadexchange.v1.ExtractedCriteria
-	(*AnalyzeLeadIntentRequest)(nil),          // 18: leadexchange.v1.AnalyzeLeadIntentRequest
-	(*AnalyzeLeadIntentResponse)(nil),         // 19: leadexchange.v1.AnalyzeLeadIntentResponse
-	(*ListLeadsRequest_Filter)(nil),           // 20: leadexchange.v1.ListLeadsRequest.Filter
-	(PropertyType)(0),                         // 21: leadexchange.v1.PropertyType
+	(ContactAccessReason)(0),                  // 1: leadexchange.v1.ContactAccessReason
+	(*Lead)(nil),                              // 2: leadexchange.v1.Lead
+	(*LeadRequirement)(nil),                   // 3: leadexchange.v1.LeadRequirement
+	(*CreateLeadRequest)(nil),                 // 4: leadexchange.v1.CreateLeadRequest
+	(*GetLeadRequest)(nil),                    // 5: leadexchange.v1.GetLeadRequest
+	(*ListLeadsRequest)(nil),                  // 6: leadexchange.v1.ListLeadsRequest
+	(*MatchLeadsRequest)(nil),                 // 7: leadexchange.v1.MatchLeadsRequest
+	(*MatchedLead)(nil),                       // 8: leadexchange.v1.MatchedLead
+	(*MatchLeadsResponse)(nil),                // 9: leadexchange.v1.MatchLeadsResponse
+	(*ReindexLeadRequest)(nil),                // 10: leadexchange.v1.ReindexLeadRequest
+	(*ReindexLeadResponse)(nil),               // 11: leadexchange.v1.ReindexLeadResponse
+	(*SubscribeLeadsRequest)(nil),             // 12: leadexchange.v1.SubscribeLeadsRequest
+	(*ListLeadsResponse)(nil),                 // 13: leadexchange.v1.ListLeadsResponse
+	(*UpdateLeadRequest)(nil),                 // 14: leadexchange.v1.UpdateLeadRequest
+	(*LeadResponse)(nil),                      // 15: leadexchange.v1.LeadResponse
+	(*RevealLeadContactsRequest)(nil),         // 16: leadexchange.v1.RevealLeadContactsRequest
+	(*RevealLeadContactsResponse)(nil),        // 17: leadexchange.v1.RevealLeadContactsResponse
+	(*GetClarificationQuestionsRequest)(nil),  // 18: leadexchange.v1.GetClarificationQuestionsRequest
+	(*ClarificationQuestion)(nil),             // 19: leadexchange.v1.ClarificationQuestion
+	(*GetClarificationQuestionsResponse)(nil), // 20: leadexchange.v1.GetClarificationQuestionsResponse
+	(*ClarificationAnswer)(nil),               // 21: leadexchange.v1.ClarificationAnswer
+	(*ApplyClarificationAnswersRequest)(nil),  // 22: leadexchange.v1.ApplyClarificationAnswersRequest
+	(*ApplyClarificationAnswersResponse)(nil), // 23: leadexchange.v1.ApplyClarificationAnswersResponse
+	(*MatchWeights)(nil),                      // 24: leadexchange.v1.MatchWeights
+	(*ExtractedCriteria)(nil),                 // 25: leadexchange.v1.ExtractedCriteria
+	(*AnalyzeLeadIntentRequest)(nil),          // 26: leadexchange.v1.AnalyzeLeadIntentRequest
+	(*AnalyzeLeadIntentResponse)(nil),         // 27: leadexchange.v1.AnalyzeLeadIntentResponse
+	(*ListLeadsRequest_Filter)(nil),           // 28: leadexchange.v1.ListLeadsRequest.Filter
+	(PropertyType)(0),                         // 29: leadexchange.v1.PropertyType
 }
 var file_lead_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.Lead.status:type_name -> leadexchange.v1.LeadStatus
-	21, // 1: leadexchange.v1.Lead.property_type:type_name -> leadexchange.v1.PropertyType
-	21, // 2: leadexchange.v1.CreateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	20, // 3: leadexchange.v1.ListLeadsRequest.filter:type_name -> leadexchange.v1.ListLeadsRequest.Filter
-	1,  // 4: leadexchange.v1.ListLeadsResponse.leads:type_name -> leadexchange.v1.Lead
-	0,  // 5: leadexchange.v1.UpdateLeadRequest.status:type_name -> leadexchange.v1.LeadStatus
-	21, // 6: leadexchange.v1.UpdateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	1,  // 7: leadexchange.v1.LeadResponse.lead:type_name -> leadexchange.v1.Lead
-	11, // 8: leadexchange.v1.GetClarificationQuestionsResponse.questions:type_name -> leadexchange.v1.ClarificationQuestion
-	13, // 9: leadexchange.v1.ApplyClarificationAnswersRequest.answers:type_name -> leadexchange.v1.ClarificationAnswer
-	16, // 10: leadexchange.v1.AnalyzeLeadIntentResponse.recommended_weights:type_name -> leadexchange.v1.MatchWeights
-	17, // 11: leadexchange.v1.AnalyzeLeadIntentResponse.extracted_criteria:type_name -> leadexchange.v1.ExtractedCriteria
-	0,  // 12: leadexchange.v1.ListLeadsRequest.Filter.status:type_name -> leadexchange.v1.LeadStatus
-	21, // 13: leadexchange.v1.ListLeadsRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
-	2,  // 14: leadexchange.v1.LeadService.CreateLead:input_type -> leadexchange.v1.CreateLeadRequest
-	3,  // 15: leadexchange.v1.LeadService.GetLead:input_type -> leadexchange.v1.GetLeadRequest
-	4,  // 16: leadexchange.v1.LeadService.ListLeads:input_type -> leadexchange.v1.ListLeadsRequest
-	8,  // 17: leadexchange.v1.LeadService.UpdateLead:input_type -> leadexchange.v1.UpdateLeadRequest
-	5,  // 18: leadexchange.v1.LeadService.ReindexLead:input_type -> leadexchange.v1.ReindexLeadRequest
-	10, // 19: leadexchange.v1.LeadService.GetClarificationQuestions:input_type -> leadexchange.v1.GetClarificationQuestionsRequest
-	14, // 20: leadexchange.v1.LeadService.ApplyClarificationAnswers:input_type -> leadexchange.v1.ApplyClarificationAnswersRequest
-	18, // 21: leadexchange.v1.LeadService.AnalyzeLeadIntent:input_type -> leadexchange.v1.AnalyzeLeadIntentRequest
-	9,  // 22: leadexchange.v1.LeadService.CreateLead:output_type -> leadexchange.v1.LeadResponse
-	9,  // 23: leadexchange.v1.LeadService.GetLead:output_type -> leadexchange.v1.LeadResponse
-	7,  // 24: leadexchange.v1.LeadService.ListLeads:output_type -> leadexchange.v1.ListLeadsResponse
-	9,  // 25: leadexchange.v1.LeadService.UpdateLead:output_type -> leadexchange.v1.LeadResponse
-	6,  // 26: leadexchange.v1.LeadService.ReindexLead:output_type -> leadexchange.v1.ReindexLeadResponse
-	12, // 27: leadexchange.v1.LeadService.GetClarificationQuestions:output_type -> leadexchange.v1.GetClarificationQuestionsResponse
-	15, // 28: leadexchange.v1.LeadService.ApplyClarificationAnswers:output_type -> leadexchange.v1.ApplyClarificationAnswersResponse
-	19, // 29: leadexchange.v1.LeadService.AnalyzeLeadIntent:output_type -> leadexchange.v1.AnalyzeLeadIntentResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	29, // 1: leadexchange.v1.Lead.property_type:type_name -> leadexchange.v1.PropertyType
+	3,  // 2: leadexchange.v1.Lead.structured_requirement:type_name -> leadexchange.v1.LeadRequirement
+	29, // 3: leadexchange.v1.LeadRequirement.property_type:type_name -> leadexchange.v1.PropertyType
+	29, // 4: leadexchange.v1.CreateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
+	3,  // 5: leadexchange.v1.CreateLeadRequest.structured_requirement:type_name -> leadexchange.v1.LeadRequirement
+	28, // 6: leadexchange.v1.ListLeadsRequest.filter:type_name -> leadexchange.v1.ListLeadsRequest.Filter
+	28, // 7: leadexchange.v1.MatchLeadsRequest.filter:type_name -> leadexchange.v1.ListLeadsRequest.Filter
+	2,  // 8: leadexchange.v1.MatchedLead.lead:type_name -> leadexchange.v1.Lead
+	8,  // 9: leadexchange.v1.MatchLeadsResponse.matches:type_name -> leadexchange.v1.MatchedLead
+	28, // 10: leadexchange.v1.SubscribeLeadsRequest.filter:type_name -> leadexchange.v1.ListLeadsRequest.Filter
+	2,  // 11: leadexchange.v1.ListLeadsResponse.leads:type_name -> leadexchange.v1.Lead
+	0,  // 12: leadexchange.v1.UpdateLeadRequest.status:type_name -> leadexchange.v1.LeadStatus
+	29, // 13: leadexchange.v1.UpdateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
+	3,  // 14: leadexchange.v1.UpdateLeadRequest.structured_requirement:type_name -> leadexchange.v1.LeadRequirement
+	2,  // 15: leadexchange.v1.LeadResponse.lead:type_name -> leadexchange.v1.Lead
+	1,  // 16: leadexchange.v1.RevealLeadContactsResponse.reason:type_name -> leadexchange.v1.ContactAccessReason
+	19, // 17: leadexchange.v1.GetClarificationQuestionsResponse.questions:type_name -> leadexchange.v1.ClarificationQuestion
+	21, // 18: leadexchange.v1.ApplyClarificationAnswersRequest.answers:type_name -> leadexchange.v1.ClarificationAnswer
+	3,  // 19: leadexchange.v1.ApplyClarificationAnswersResponse.structured_requirement:type_name -> leadexchange.v1.LeadRequirement
+	24, // 20: leadexchange.v1.AnalyzeLeadIntentResponse.recommended_weights:type_name -> leadexchange.v1.MatchWeights
+	25, // 21: leadexchange.v1.AnalyzeLeadIntentResponse.extracted_criteria:type_name -> leadexchange.v1.ExtractedCriteria
+	0,  // 22: leadexchange.v1.ListLeadsRequest.Filter.status:type_name -> leadexchange.v1.LeadStatus
+	29, // 23: leadexchange.v1.ListLeadsRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	4,  // 24: leadexchange.v1.LeadService.CreateLead:input_type -> leadexchange.v1.CreateLeadRequest
+	5,  // 25: leadexchange.v1.LeadService.GetLead:input_type -> leadexchange.v1.GetLeadRequest
+	6,  // 26: leadexchange.v1.LeadService.ListLeads:input_type -> leadexchange.v1.ListLeadsRequest
+	12, // 27: leadexchange.v1.LeadService.SubscribeLeads:input_type -> leadexchange.v1.SubscribeLeadsRequest
+	7,  // 28: leadexchange.v1.LeadService.MatchLeads:input_type -> leadexchange.v1.MatchLeadsRequest
+	14, // 29: leadexchange.v1.LeadService.UpdateLead:input_type -> leadexchange.v1.UpdateLeadRequest
+	16, // 30: leadexchange.v1.LeadService.RevealLeadContacts:input_type -> leadexchange.v1.RevealLeadContactsRequest
+	10, // 31: leadexchange.v1.LeadService.ReindexLead:input_type -> leadexchange.v1.ReindexLeadRequest
+	18, // 32: leadexchange.v1.LeadService.GetClarificationQuestions:input_type -> leadexchange.v1.GetClarificationQuestionsRequest
+	22, // 33: leadexchange.v1.LeadService.ApplyClarificationAnswers:input_type -> leadexchange.v1.ApplyClarificationAnswersRequest
+	26, // 34: leadexchange.v1.LeadService.AnalyzeLeadIntent:input_type -> leadexchange.v1.AnalyzeLeadIntentRequest
+	15, // 35: leadexchange.v1.LeadService.CreateLead:output_type -> leadexchange.v1.LeadResponse
+	15, // 36: leadexchange.v1.LeadService.GetLead:output_type -> leadexchange.v1.LeadResponse
+	13, // 37: leadexchange.v1.LeadService.ListLeads:output_type -> leadexchange.v1.ListLeadsResponse
+	2,  // 38: leadexchange.v1.LeadService.SubscribeLeads:output_type -> leadexchange.v1.Lead
+	9,  // 39: leadexchange.v1.LeadService.MatchLeads:output_type -> leadexchange.v1.MatchLeadsResponse
+	15, // 40: leadexchange.v1.LeadService.UpdateLead:output_type -> leadexchange.v1.LeadResponse
+	17, // 41: leadexchange.v1.LeadService.RevealLeadContacts:output_type -> leadexchange.v1.RevealLeadContactsResponse
+	11, // 42: leadexchange.v1.LeadService.ReindexLead:output_type -> leadexchange.v1.ReindexLeadResponse
+	20, // 43: leadexchange.v1.LeadService.GetClarificationQuestions:output_type -> leadexchange.v1.GetClarificationQuestionsResponse
+	23, // 44: leadexchange.v1.LeadService.ApplyClarificationAnswers:output_type -> leadexchange.v1.ApplyClarificationAnswersResponse
+	27, // 45: leadexchange.v1.LeadService.AnalyzeLeadIntent:output_type -> leadexchange.v1.AnalyzeLeadIntentResponse
+	35, // [35:46] is the sub-list for method output_type
+	24, // [24:35] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_lead_proto_init() }
@@ -1710,17 +2524,21 @@ func file_lead_proto_init() {
 	file_property_proto_init()
 	file_lead_proto_msgTypes[0].OneofWrappers = []any{}
 	file_lead_proto_msgTypes[1].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[3].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[7].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[16].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[19].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[2].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[4].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[5].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[6].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[10].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[12].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[23].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lead_proto_rawDesc), len(file_lead_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
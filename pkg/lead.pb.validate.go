@@ -146,6 +146,37 @@ func (m *Lead) validate(all bool) error {
 
 	// no validation rules for PropertyType
 
+	// no validation rules for ContactsMasked
+
+	if all {
+		switch v := interface{}(m.GetStructuredRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeadValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeadValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStructuredRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeadValidationError{
+				field:  "StructuredRequirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.City != nil {
 		// no validation rules for City
 	}
@@ -287,6 +318,288 @@ var _ interface {
 
 var _Lead_ContactPhone_Pattern = regexp.MustCompile("^\\+?[0-9\\s()-]{7,}$")
 
+// Validate checks the field values on LeadRequirement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeadRequirement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeadRequirement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeadRequirementMultiError, or nil if none found.
+func (m *LeadRequirement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeadRequirement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(m.GetDistricts()) > 20 {
+		err := LeadRequirementValidationError{
+			field:  "Districts",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDistricts() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := LeadRequirementValidationError{
+				field:  fmt.Sprintf("Districts[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetMustHaveFeatures()) > 20 {
+		err := LeadRequirementValidationError{
+			field:  "MustHaveFeatures",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMustHaveFeatures() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := LeadRequirementValidationError{
+				field:  fmt.Sprintf("MustHaveFeatures[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PropertyType
+
+	if m.BudgetMin != nil {
+
+		if m.GetBudgetMin() < 0 {
+			err := LeadRequirementValidationError{
+				field:  "BudgetMin",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.BudgetMax != nil {
+
+		if m.GetBudgetMax() <= 0 {
+			err := LeadRequirementValidationError{
+				field:  "BudgetMax",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.RoomsMin != nil {
+
+		if val := m.GetRoomsMin(); val < 0 || val > 50 {
+			err := LeadRequirementValidationError{
+				field:  "RoomsMin",
+				reason: "value must be inside range [0, 50]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.RoomsMax != nil {
+
+		if val := m.GetRoomsMax(); val < 0 || val > 50 {
+			err := LeadRequirementValidationError{
+				field:  "RoomsMax",
+				reason: "value must be inside range [0, 50]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AreaMin != nil {
+
+		if m.GetAreaMin() <= 0 {
+			err := LeadRequirementValidationError{
+				field:  "AreaMin",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AreaMax != nil {
+
+		if m.GetAreaMax() <= 0 {
+			err := LeadRequirementValidationError{
+				field:  "AreaMax",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.FloorMin != nil {
+
+		if val := m.GetFloorMin(); val < -200 || val > 200 {
+			err := LeadRequirementValidationError{
+				field:  "FloorMin",
+				reason: "value must be inside range [-200, 200]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.FloorMax != nil {
+
+		if val := m.GetFloorMax(); val < -200 || val > 200 {
+			err := LeadRequirementValidationError{
+				field:  "FloorMax",
+				reason: "value must be inside range [-200, 200]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Deadline != nil {
+		// no validation rules for Deadline
+	}
+
+	if len(errors) > 0 {
+		return LeadRequirementMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeadRequirementMultiError is an error wrapping multiple validation errors
+// returned by LeadRequirement.ValidateAll() if the designated constraints
+// aren't met.
+type LeadRequirementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeadRequirementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeadRequirementMultiError) AllErrors() []error { return m }
+
+// LeadRequirementValidationError is the validation error returned by
+// LeadRequirement.Validate if the designated constraints aren't met.
+type LeadRequirementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeadRequirementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeadRequirementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeadRequirementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeadRequirementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeadRequirementValidationError) ErrorName() string { return "LeadRequirementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeadRequirementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeadRequirement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeadRequirementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeadRequirementValidationError{}
+
 // Validate checks the field values on CreateLeadRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -364,6 +677,35 @@ func (m *CreateLeadRequest) validate(all bool) error {
 
 	// no validation rules for PropertyType
 
+	if all {
+		switch v := interface{}(m.GetStructuredRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLeadRequestValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLeadRequestValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStructuredRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLeadRequestValidationError{
+				field:  "StructuredRequirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.City != nil {
 		// no validation rules for City
 	}
@@ -765,31 +1107,31 @@ var _ interface {
 	ErrorName() string
 } = ListLeadsRequestValidationError{}
 
-// Validate checks the field values on ReindexLeadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReindexLeadRequest) Validate() error {
+// Validate checks the field values on MatchLeadsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MatchLeadsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReindexLeadRequest with the rules
+// ValidateAll checks the field values on MatchLeadsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReindexLeadRequestMultiError, or nil if none found.
-func (m *ReindexLeadRequest) ValidateAll() error {
+// MatchLeadsRequestMultiError, or nil if none found.
+func (m *MatchLeadsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReindexLeadRequest) validate(all bool) error {
+func (m *MatchLeadsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = ReindexLeadRequestValidationError{
-			field:  "LeadId",
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = MatchLeadsRequestValidationError{
+			field:  "PropertyId",
 			reason: "value must be a valid UUID",
 			cause:  err,
 		}
@@ -799,9 +1141,477 @@ func (m *ReindexLeadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return ReindexLeadRequestMultiError(errors)
-	}
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchLeadsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchLeadsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchLeadsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := MatchLeadsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeightPreset != nil {
+		// no validation rules for WeightPreset
+	}
+
+	if len(errors) > 0 {
+		return MatchLeadsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MatchLeadsRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MatchLeadsRequestMultiError is an error wrapping multiple validation errors
+// returned by MatchLeadsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MatchLeadsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchLeadsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchLeadsRequestMultiError) AllErrors() []error { return m }
+
+// MatchLeadsRequestValidationError is the validation error returned by
+// MatchLeadsRequest.Validate if the designated constraints aren't met.
+type MatchLeadsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchLeadsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchLeadsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchLeadsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchLeadsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchLeadsRequestValidationError) ErrorName() string {
+	return "MatchLeadsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchLeadsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchLeadsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchLeadsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchLeadsRequestValidationError{}
+
+// Validate checks the field values on MatchedLead with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MatchedLead) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchedLead with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MatchedLeadMultiError, or
+// nil if none found.
+func (m *MatchedLead) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchedLead) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLead()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchedLeadValidationError{
+					field:  "Lead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchedLeadValidationError{
+					field:  "Lead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLead()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchedLeadValidationError{
+				field:  "Lead",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Similarity
+
+	if m.TotalScore != nil {
+		// no validation rules for TotalScore
+	}
+
+	if m.PriceScore != nil {
+		// no validation rules for PriceScore
+	}
+
+	if m.DistrictScore != nil {
+		// no validation rules for DistrictScore
+	}
+
+	if m.RoomsScore != nil {
+		// no validation rules for RoomsScore
+	}
+
+	if m.AreaScore != nil {
+		// no validation rules for AreaScore
+	}
+
+	if m.SemanticScore != nil {
+		// no validation rules for SemanticScore
+	}
+
+	if m.MatchExplanation != nil {
+		// no validation rules for MatchExplanation
+	}
+
+	if m.VisualScore != nil {
+		// no validation rules for VisualScore
+	}
+
+	if len(errors) > 0 {
+		return MatchedLeadMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchedLeadMultiError is an error wrapping multiple validation errors
+// returned by MatchedLead.ValidateAll() if the designated constraints aren't met.
+type MatchedLeadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchedLeadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchedLeadMultiError) AllErrors() []error { return m }
+
+// MatchedLeadValidationError is the validation error returned by
+// MatchedLead.Validate if the designated constraints aren't met.
+type MatchedLeadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchedLeadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchedLeadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchedLeadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchedLeadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchedLeadValidationError) ErrorName() string { return "MatchedLeadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchedLeadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchedLead.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchedLeadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchedLeadValidationError{}
+
+// Validate checks the field values on MatchLeadsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MatchLeadsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchLeadsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MatchLeadsResponseMultiError, or nil if none found.
+func (m *MatchLeadsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchLeadsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MatchLeadsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MatchLeadsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MatchLeadsResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MatchLeadsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchLeadsResponseMultiError is an error wrapping multiple validation errors
+// returned by MatchLeadsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MatchLeadsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchLeadsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchLeadsResponseMultiError) AllErrors() []error { return m }
+
+// MatchLeadsResponseValidationError is the validation error returned by
+// MatchLeadsResponse.Validate if the designated constraints aren't met.
+type MatchLeadsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchLeadsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchLeadsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchLeadsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchLeadsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchLeadsResponseValidationError) ErrorName() string {
+	return "MatchLeadsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchLeadsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchLeadsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchLeadsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchLeadsResponseValidationError{}
+
+// Validate checks the field values on ReindexLeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReindexLeadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexLeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexLeadRequestMultiError, or nil if none found.
+func (m *ReindexLeadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexLeadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = ReindexLeadRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReindexLeadRequestMultiError(errors)
+	}
 
 	return nil
 }
@@ -902,31 +1712,201 @@ func (m *ReindexLeadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReindexLeadResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *ReindexLeadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ReindexLeadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexLeadResponseMultiError is an error wrapping multiple validation
+// errors returned by ReindexLeadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReindexLeadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexLeadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexLeadResponseMultiError) AllErrors() []error { return m }
+
+// ReindexLeadResponseValidationError is the validation error returned by
+// ReindexLeadResponse.Validate if the designated constraints aren't met.
+type ReindexLeadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexLeadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexLeadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexLeadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexLeadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexLeadResponseValidationError) ErrorName() string {
+	return "ReindexLeadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReindexLeadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexLeadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexLeadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexLeadResponseValidationError{}
+
+// Validate checks the field values on SubscribeLeadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeLeadsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeLeadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeLeadsRequestMultiError, or nil if none found.
+func (m *SubscribeLeadsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeLeadsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscribeLeadsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubscribeLeadsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscribeLeadsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SimilarToPropertyId != nil {
+
+		if err := m._validateUuid(m.GetSimilarToPropertyId()); err != nil {
+			err = SubscribeLeadsRequestValidationError{
+				field:  "SimilarToPropertyId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MinSimilarity != nil {
+
+		if val := m.GetMinSimilarity(); val < 0 || val > 1 {
+			err := SubscribeLeadsRequestValidationError{
+				field:  "MinSimilarity",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for Success
+	if len(errors) > 0 {
+		return SubscribeLeadsRequestMultiError(errors)
+	}
 
-	// no validation rules for Message
+	return nil
+}
 
-	if len(errors) > 0 {
-		return ReindexLeadResponseMultiError(errors)
+func (m *SubscribeLeadsRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
 	}
 
 	return nil
 }
 
-// ReindexLeadResponseMultiError is an error wrapping multiple validation
-// errors returned by ReindexLeadResponse.ValidateAll() if the designated
+// SubscribeLeadsRequestMultiError is an error wrapping multiple validation
+// errors returned by SubscribeLeadsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ReindexLeadResponseMultiError []error
+type SubscribeLeadsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReindexLeadResponseMultiError) Error() string {
+func (m SubscribeLeadsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -935,11 +1915,11 @@ func (m ReindexLeadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReindexLeadResponseMultiError) AllErrors() []error { return m }
+func (m SubscribeLeadsRequestMultiError) AllErrors() []error { return m }
 
-// ReindexLeadResponseValidationError is the validation error returned by
-// ReindexLeadResponse.Validate if the designated constraints aren't met.
-type ReindexLeadResponseValidationError struct {
+// SubscribeLeadsRequestValidationError is the validation error returned by
+// SubscribeLeadsRequest.Validate if the designated constraints aren't met.
+type SubscribeLeadsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -947,24 +1927,24 @@ type ReindexLeadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReindexLeadResponseValidationError) Field() string { return e.field }
+func (e SubscribeLeadsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReindexLeadResponseValidationError) Reason() string { return e.reason }
+func (e SubscribeLeadsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReindexLeadResponseValidationError) Cause() error { return e.cause }
+func (e SubscribeLeadsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReindexLeadResponseValidationError) Key() bool { return e.key }
+func (e SubscribeLeadsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReindexLeadResponseValidationError) ErrorName() string {
-	return "ReindexLeadResponseValidationError"
+func (e SubscribeLeadsRequestValidationError) ErrorName() string {
+	return "SubscribeLeadsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReindexLeadResponseValidationError) Error() string {
+func (e SubscribeLeadsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -976,14 +1956,14 @@ func (e ReindexLeadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReindexLeadResponse.%s: %s%s",
+		"invalid %sSubscribeLeadsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReindexLeadResponseValidationError{}
+var _ error = SubscribeLeadsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -991,7 +1971,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReindexLeadResponseValidationError{}
+} = SubscribeLeadsRequestValidationError{}
 
 // Validate checks the field values on ListLeadsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -1163,6 +2143,35 @@ func (m *UpdateLeadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetStructuredRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeadRequestValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeadRequestValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStructuredRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeadRequestValidationError{
+				field:  "StructuredRequirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Title != nil {
 		// no validation rules for Title
 	}
@@ -1407,6 +2416,238 @@ var _ interface {
 	ErrorName() string
 } = LeadResponseValidationError{}
 
+// Validate checks the field values on RevealLeadContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevealLeadContactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevealLeadContactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevealLeadContactsRequestMultiError, or nil if none found.
+func (m *RevealLeadContactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevealLeadContactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = RevealLeadContactsRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevealLeadContactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevealLeadContactsRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevealLeadContactsRequestMultiError is an error wrapping multiple validation
+// errors returned by RevealLeadContactsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RevealLeadContactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevealLeadContactsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevealLeadContactsRequestMultiError) AllErrors() []error { return m }
+
+// RevealLeadContactsRequestValidationError is the validation error returned by
+// RevealLeadContactsRequest.Validate if the designated constraints aren't met.
+type RevealLeadContactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevealLeadContactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevealLeadContactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevealLeadContactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevealLeadContactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevealLeadContactsRequestValidationError) ErrorName() string {
+	return "RevealLeadContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevealLeadContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevealLeadContactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevealLeadContactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevealLeadContactsRequestValidationError{}
+
+// Validate checks the field values on RevealLeadContactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevealLeadContactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevealLeadContactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevealLeadContactsResponseMultiError, or nil if none found.
+func (m *RevealLeadContactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevealLeadContactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContactName
+
+	// no validation rules for ContactPhone
+
+	// no validation rules for ContactEmail
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RevealLeadContactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevealLeadContactsResponseMultiError is an error wrapping multiple
+// validation errors returned by RevealLeadContactsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevealLeadContactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevealLeadContactsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevealLeadContactsResponseMultiError) AllErrors() []error { return m }
+
+// RevealLeadContactsResponseValidationError is the validation error returned
+// by RevealLeadContactsResponse.Validate if the designated constraints aren't met.
+type RevealLeadContactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevealLeadContactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevealLeadContactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevealLeadContactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevealLeadContactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevealLeadContactsResponseValidationError) ErrorName() string {
+	return "RevealLeadContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevealLeadContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevealLeadContactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevealLeadContactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevealLeadContactsResponseValidationError{}
+
 // Validate checks the field values on GetClarificationQuestionsRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -2081,6 +3322,35 @@ func (m *ApplyClarificationAnswersResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	if all {
+		switch v := interface{}(m.GetStructuredRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyClarificationAnswersResponseValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplyClarificationAnswersResponseValidationError{
+					field:  "StructuredRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStructuredRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyClarificationAnswersResponseValidationError{
+				field:  "StructuredRequirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ApplyClarificationAnswersResponseMultiError(errors)
 	}
@@ -2195,6 +3465,8 @@ func (m *MatchWeights) validate(all bool) error {
 
 	// no validation rules for Semantic
 
+	// no validation rules for Visual
+
 	if len(errors) > 0 {
 		return MatchWeightsMultiError(errors)
 	}
@@ -2294,6 +3566,8 @@ func (m *ExtractedCriteria) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for WantsGoodView
+
 	if m.TargetPrice != nil {
 		// no validation rules for TargetPrice
 	}
@@ -2310,6 +3584,10 @@ func (m *ExtractedCriteria) validate(all bool) error {
 		// no validation rules for TargetArea
 	}
 
+	if m.MinVisualQuality != nil {
+		// no validation rules for MinVisualQuality
+	}
+
 	if len(errors) > 0 {
 		return ExtractedCriteriaMultiError(errors)
 	}
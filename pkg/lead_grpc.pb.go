@@ -22,7 +22,10 @@ const (
 	LeadService_CreateLead_FullMethodName                = "/leadexchange.v1.LeadService/CreateLead"
 	LeadService_GetLead_FullMethodName                   = "/leadexchange.v1.LeadService/GetLead"
 	LeadService_ListLeads_FullMethodName                 = "/leadexchange.v1.LeadService/ListLeads"
+	LeadService_SubscribeLeads_FullMethodName            = "/leadexchange.v1.LeadService/SubscribeLeads"
+	LeadService_MatchLeads_FullMethodName                = "/leadexchange.v1.LeadService/MatchLeads"
 	LeadService_UpdateLead_FullMethodName                = "/leadexchange.v1.LeadService/UpdateLead"
+	LeadService_RevealLeadContacts_FullMethodName        = "/leadexchange.v1.LeadService/RevealLeadContacts"
 	LeadService_ReindexLead_FullMethodName               = "/leadexchange.v1.LeadService/ReindexLead"
 	LeadService_GetClarificationQuestions_FullMethodName = "/leadexchange.v1.LeadService/GetClarificationQuestions"
 	LeadService_ApplyClarificationAnswers_FullMethodName = "/leadexchange.v1.LeadService/ApplyClarificationAnswers"
@@ -39,8 +42,17 @@ type LeadServiceClient interface {
 	GetLead(ctx context.Context, in *GetLeadRequest, opts ...grpc.CallOption) (*LeadResponse, error)
 	// Получить список лидов по фильтру.
 	ListLeads(ctx context.Context, in *ListLeadsRequest, opts ...grpc.CallOption) (*ListLeadsResponse, error)
+	// Подписаться на поток новых и опубликованных лидов по фильтру.
+	// Без фильтра по статусу приходят только опубликованные (PUBLISHED) лиды.
+	SubscribeLeads(ctx context.Context, in *SubscribeLeadsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Lead], error)
+	// Найти подходящие лиды для объекта недвижимости (обратный матчинг).
+	// Без фильтра по статусу ищутся только опубликованные (PUBLISHED) лиды.
+	MatchLeads(ctx context.Context, in *MatchLeadsRequest, opts ...grpc.CallOption) (*MatchLeadsResponse, error)
 	// Обновить лида.
 	UpdateLead(ctx context.Context, in *UpdateLeadRequest, opts ...grpc.CallOption) (*LeadResponse, error)
+	// Раскрыть контактные данные лида (владельцу, покупателю по завершённой сделке или администратору).
+	// Каждый вызов записывается в аудит.
+	RevealLeadContacts(ctx context.Context, in *RevealLeadContactsRequest, opts ...grpc.CallOption) (*RevealLeadContactsResponse, error)
 	// Переиндексировать лида вручную.
 	ReindexLead(ctx context.Context, in *ReindexLeadRequest, opts ...grpc.CallOption) (*ReindexLeadResponse, error)
 	// Получить уточняющие вопросы для "короткого" лида.
@@ -89,6 +101,35 @@ func (c *leadServiceClient) ListLeads(ctx context.Context, in *ListLeadsRequest,
 	return out, nil
 }
 
+func (c *leadServiceClient) SubscribeLeads(ctx context.Context, in *SubscribeLeadsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Lead], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LeadService_ServiceDesc.Streams[0], LeadService_SubscribeLeads_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeLeadsRequest, Lead]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LeadService_SubscribeLeadsClient = grpc.ServerStreamingClient[Lead]
+
+func (c *leadServiceClient) MatchLeads(ctx context.Context, in *MatchLeadsRequest, opts ...grpc.CallOption) (*MatchLeadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchLeadsResponse)
+	err := c.cc.Invoke(ctx, LeadService_MatchLeads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leadServiceClient) UpdateLead(ctx context.Context, in *UpdateLeadRequest, opts ...grpc.CallOption) (*LeadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LeadResponse)
@@ -99,6 +140,16 @@ func (c *leadServiceClient) UpdateLead(ctx context.Context, in *UpdateLeadReques
 	return out, nil
 }
 
+func (c *leadServiceClient) RevealLeadContacts(ctx context.Context, in *RevealLeadContactsRequest, opts ...grpc.CallOption) (*RevealLeadContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealLeadContactsResponse)
+	err := c.cc.Invoke(ctx, LeadService_RevealLeadContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leadServiceClient) ReindexLead(ctx context.Context, in *ReindexLeadRequest, opts ...grpc.CallOption) (*ReindexLeadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReindexLeadResponse)
@@ -149,8 +200,17 @@ type LeadServiceServer interface {
 	GetLead(context.Context, *GetLeadRequest) (*LeadResponse, error)
 	// Получить список лидов по фильтру.
 	ListLeads(context.Context, *ListLeadsRequest) (*ListLeadsResponse, error)
+	// Подписаться на поток новых и опубликованных лидов по фильтру.
+	// Без фильтра по статусу приходят только опубликованные (PUBLISHED) лиды.
+	SubscribeLeads(*SubscribeLeadsRequest, grpc.ServerStreamingServer[Lead]) error
+	// Найти подходящие лиды для объекта недвижимости (обратный матчинг).
+	// Без фильтра по статусу ищутся только опубликованные (PUBLISHED) лиды.
+	MatchLeads(context.Context, *MatchLeadsRequest) (*MatchLeadsResponse, error)
 	// Обновить лида.
 	UpdateLead(context.Context, *UpdateLeadRequest) (*LeadResponse, error)
+	// Раскрыть контактные данные лида (владельцу, покупателю по завершённой сделке или администратору).
+	// Каждый вызов записывается в аудит.
+	RevealLeadContacts(context.Context, *RevealLeadContactsRequest) (*RevealLeadContactsResponse, error)
 	// Переиндексировать лида вручную.
 	ReindexLead(context.Context, *ReindexLeadRequest) (*ReindexLeadResponse, error)
 	// Получить уточняющие вопросы для "короткого" лида.
@@ -178,9 +238,18 @@ func (UnimplementedLeadServiceServer) GetLead(context.Context, *GetLeadRequest)
 func (UnimplementedLeadServiceServer) ListLeads(context.Context, *ListLeadsRequest) (*ListLeadsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListLeads not implemented")
 }
+func (UnimplementedLeadServiceServer) SubscribeLeads(*SubscribeLeadsRequest, grpc.ServerStreamingServer[Lead]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeLeads not implemented")
+}
+func (UnimplementedLeadServiceServer) MatchLeads(context.Context, *MatchLeadsRequest) (*MatchLeadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MatchLeads not implemented")
+}
 func (UnimplementedLeadServiceServer) UpdateLead(context.Context, *UpdateLeadRequest) (*LeadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateLead not implemented")
 }
+func (UnimplementedLeadServiceServer) RevealLeadContacts(context.Context, *RevealLeadContactsRequest) (*RevealLeadContactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevealLeadContacts not implemented")
+}
 func (UnimplementedLeadServiceServer) ReindexLead(context.Context, *ReindexLeadRequest) (*ReindexLeadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReindexLead not implemented")
 }
@@ -268,6 +337,35 @@ func _LeadService_ListLeads_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeadService_SubscribeLeads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeLeadsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeadServiceServer).SubscribeLeads(m, &grpc.GenericServerStream[SubscribeLeadsRequest, Lead]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LeadService_SubscribeLeadsServer = grpc.ServerStreamingServer[Lead]
+
+func _LeadService_MatchLeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchLeadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).MatchLeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_MatchLeads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).MatchLeads(ctx, req.(*MatchLeadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeadService_UpdateLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateLeadRequest)
 	if err := dec(in); err != nil {
@@ -286,6 +384,24 @@ func _LeadService_UpdateLead_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeadService_RevealLeadContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealLeadContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).RevealLeadContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_RevealLeadContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).RevealLeadContacts(ctx, req.(*RevealLeadContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeadService_ReindexLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReindexLeadRequest)
 	if err := dec(in); err != nil {
@@ -377,10 +493,18 @@ var LeadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLeads",
 			Handler:    _LeadService_ListLeads_Handler,
 		},
+		{
+			MethodName: "MatchLeads",
+			Handler:    _LeadService_MatchLeads_Handler,
+		},
 		{
 			MethodName: "UpdateLead",
 			Handler:    _LeadService_UpdateLead_Handler,
 		},
+		{
+			MethodName: "RevealLeadContacts",
+			Handler:    _LeadService_RevealLeadContacts_Handler,
+		},
 		{
 			MethodName: "ReindexLead",
 			Handler:    _LeadService_ReindexLead_Handler,
@@ -398,6 +522,12 @@ var LeadService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _LeadService_AnalyzeLeadIntent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLeads",
+			Handler:       _LeadService_SubscribeLeads_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "lead.proto",
 }
@@ -0,0 +1,646 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _notification_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Type
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	// no validation rules for Payload
+
+	// no validation rules for Read
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UnreadOnly != nil {
+		// no validation rules for UnreadOnly
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := ListNotificationsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsResponseMultiError, or nil if none found.
+func (m *ListNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return ListNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotificationsResponseValidationError is the validation error returned by
+// ListNotificationsResponse.Validate if the designated constraints aren't met.
+type ListNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsResponseValidationError) ErrorName() string {
+	return "ListNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsResponseValidationError{}
+
+// Validate checks the field values on MarkReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadRequestMultiError, or nil if none found.
+func (m *MarkReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotificationIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = MarkReadRequestValidationError{
+				field:  fmt.Sprintf("NotificationIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for All
+
+	if len(errors) > 0 {
+		return MarkReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MarkReadRequest) _validateUuid(uuid string) error {
+	if matched := _notification_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MarkReadRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadRequestValidationError is the validation error returned by
+// MarkReadRequest.Validate if the designated constraints aren't met.
+type MarkReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadRequestValidationError) ErrorName() string { return "MarkReadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadRequestValidationError{}
+
+// Validate checks the field values on MarkReadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadResponseMultiError, or nil if none found.
+func (m *MarkReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Updated
+
+	if len(errors) > 0 {
+		return MarkReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadResponseMultiError is an error wrapping multiple validation errors
+// returned by MarkReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkReadResponseValidationError is the validation error returned by
+// MarkReadResponse.Validate if the designated constraints aren't met.
+type MarkReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadResponseValidationError) ErrorName() string { return "MarkReadResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadResponseValidationError{}
@@ -135,6 +135,67 @@ func (PropertyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_property_proto_rawDescGZIP(), []int{1}
 }
 
+// ImageAnalysisStatus — состояние анализа фотографий объекта.
+type ImageAnalysisStatus int32
+
+const (
+	ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_UNSPECIFIED ImageAnalysisStatus = 0
+	// Анализ ещё не запускался.
+	ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_NOT_STARTED ImageAnalysisStatus = 1
+	ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_PENDING     ImageAnalysisStatus = 2
+	ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_PROCESSING  ImageAnalysisStatus = 3
+	ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_DONE        ImageAnalysisStatus = 4
+	// Часть фотографий проанализировать не удалось, подробности — в error.
+	ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_FAILED ImageAnalysisStatus = 5
+)
+
+// Enum value maps for ImageAnalysisStatus.
+var (
+	ImageAnalysisStatus_name = map[int32]string{
+		0: "IMAGE_ANALYSIS_STATUS_UNSPECIFIED",
+		1: "IMAGE_ANALYSIS_STATUS_NOT_STARTED",
+		2: "IMAGE_ANALYSIS_STATUS_PENDING",
+		3: "IMAGE_ANALYSIS_STATUS_PROCESSING",
+		4: "IMAGE_ANALYSIS_STATUS_DONE",
+		5: "IMAGE_ANALYSIS_STATUS_FAILED",
+	}
+	ImageAnalysisStatus_value = map[string]int32{
+		"IMAGE_ANALYSIS_STATUS_UNSPECIFIED": 0,
+		"IMAGE_ANALYSIS_STATUS_NOT_STARTED": 1,
+		"IMAGE_ANALYSIS_STATUS_PENDING":     2,
+		"IMAGE_ANALYSIS_STATUS_PROCESSING":  3,
+		"IMAGE_ANALYSIS_STATUS_DONE":        4,
+		"IMAGE_ANALYSIS_STATUS_FAILED":      5,
+	}
+)
+
+func (x ImageAnalysisStatus) Enum() *ImageAnalysisStatus {
+	p := new(ImageAnalysisStatus)
+	*p = x
+	return p
+}
+
+func (x ImageAnalysisStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageAnalysisStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_property_proto_enumTypes[2].Descriptor()
+}
+
+func (ImageAnalysisStatus) Type() protoreflect.EnumType {
+	return &file_property_proto_enumTypes[2]
+}
+
+func (x ImageAnalysisStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageAnalysisStatus.Descriptor instead.
+func (ImageAnalysisStatus) EnumDescriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{2}
+}
+
 // Property — сущность объекта недвижимости.
 type Property struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -152,6 +213,8 @@ type Property struct {
 	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	City          *string                `protobuf:"bytes,14,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	// Галерея в порядке показа; первая фотография — обложка.
+	Images        []*PropertyImage `protobuf:"bytes,15,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -284,6 +347,102 @@ func (x *Property) GetCity() string {
 	return ""
 }
 
+func (x *Property) GetImages() []*PropertyImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// PropertyImage — фотография из галереи объекта.
+type PropertyImage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ImageId string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	// Ключ объекта в хранилище.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Временная ссылка на скачивание, выдаётся заново при каждом чтении.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Позиция в галерее, начиная с 0.
+	Position  int32  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Копии фотографии разных размеров; пусто для файлов, загруженных до обработки изображений.
+	Variants      []*ImageVariant `protobuf:"bytes,6,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyImage) Reset() {
+	*x = PropertyImage{}
+	mi := &file_property_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyImage) ProtoMessage() {}
+
+func (x *PropertyImage) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyImage.ProtoReflect.Descriptor instead.
+func (*PropertyImage) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PropertyImage) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PropertyImage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
 type CreatePropertyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -300,7 +459,7 @@ type CreatePropertyRequest struct {
 
 func (x *CreatePropertyRequest) Reset() {
 	*x = CreatePropertyRequest{}
-	mi := &file_property_proto_msgTypes[1]
+	mi := &file_property_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +471,7 @@ func (x *CreatePropertyRequest) String() string {
 func (*CreatePropertyRequest) ProtoMessage() {}
 
 func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[1]
+	mi := &file_property_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +484,7 @@ func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePropertyRequest.ProtoReflect.Descriptor instead.
 func (*CreatePropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{1}
+	return file_property_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePropertyRequest) GetTitle() string {
@@ -393,7 +552,7 @@ type GetPropertyRequest struct {
 
 func (x *GetPropertyRequest) Reset() {
 	*x = GetPropertyRequest{}
-	mi := &file_property_proto_msgTypes[2]
+	mi := &file_property_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +564,7 @@ func (x *GetPropertyRequest) String() string {
 func (*GetPropertyRequest) ProtoMessage() {}
 
 func (x *GetPropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[2]
+	mi := &file_property_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +577,7 @@ func (x *GetPropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyRequest.ProtoReflect.Descriptor instead.
 func (*GetPropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{2}
+	return file_property_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetPropertyRequest) GetPropertyId() string {
@@ -441,7 +600,7 @@ type ListPropertiesRequest struct {
 
 func (x *ListPropertiesRequest) Reset() {
 	*x = ListPropertiesRequest{}
-	mi := &file_property_proto_msgTypes[3]
+	mi := &file_property_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +612,7 @@ func (x *ListPropertiesRequest) String() string {
 func (*ListPropertiesRequest) ProtoMessage() {}
 
 func (x *ListPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[3]
+	mi := &file_property_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +625,7 @@ func (x *ListPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*ListPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{3}
+	return file_property_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListPropertiesRequest) GetFilter() *ListPropertiesRequest_Filter {
@@ -513,7 +672,7 @@ type ListPropertiesResponse struct {
 
 func (x *ListPropertiesResponse) Reset() {
 	*x = ListPropertiesResponse{}
-	mi := &file_property_proto_msgTypes[4]
+	mi := &file_property_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +684,7 @@ func (x *ListPropertiesResponse) String() string {
 func (*ListPropertiesResponse) ProtoMessage() {}
 
 func (x *ListPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[4]
+	mi := &file_property_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +697,7 @@ func (x *ListPropertiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertiesResponse.ProtoReflect.Descriptor instead.
 func (*ListPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{4}
+	return file_property_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListPropertiesResponse) GetProperties() []*Property {
@@ -567,7 +726,7 @@ type UpdatePropertyRequest struct {
 
 func (x *UpdatePropertyRequest) Reset() {
 	*x = UpdatePropertyRequest{}
-	mi := &file_property_proto_msgTypes[5]
+	mi := &file_property_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +738,7 @@ func (x *UpdatePropertyRequest) String() string {
 func (*UpdatePropertyRequest) ProtoMessage() {}
 
 func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[5]
+	mi := &file_property_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +751,7 @@ func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePropertyRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{5}
+	return file_property_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdatePropertyRequest) GetPropertyId() string {
@@ -681,7 +840,7 @@ type PropertyResponse struct {
 
 func (x *PropertyResponse) Reset() {
 	*x = PropertyResponse{}
-	mi := &file_property_proto_msgTypes[6]
+	mi := &file_property_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +852,7 @@ func (x *PropertyResponse) String() string {
 func (*PropertyResponse) ProtoMessage() {}
 
 func (x *PropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[6]
+	mi := &file_property_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +865,7 @@ func (x *PropertyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertyResponse.ProtoReflect.Descriptor instead.
 func (*PropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{6}
+	return file_property_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PropertyResponse) GetProperty() *Property {
@@ -728,7 +887,7 @@ type MatchPropertiesRequest struct {
 
 func (x *MatchPropertiesRequest) Reset() {
 	*x = MatchPropertiesRequest{}
-	mi := &file_property_proto_msgTypes[7]
+	mi := &file_property_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +899,7 @@ func (x *MatchPropertiesRequest) String() string {
 func (*MatchPropertiesRequest) ProtoMessage() {}
 
 func (x *MatchPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[7]
+	mi := &file_property_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +912,7 @@ func (x *MatchPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{7}
+	return file_property_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MatchPropertiesRequest) GetLeadId() string {
@@ -790,13 +949,15 @@ type MatchedProperty struct {
 	AreaScore        *float64 `protobuf:"fixed64,7,opt,name=area_score,json=areaScore,proto3,oneof" json:"area_score,omitempty"`
 	SemanticScore    *float64 `protobuf:"fixed64,8,opt,name=semantic_score,json=semanticScore,proto3,oneof" json:"semantic_score,omitempty"`
 	MatchExplanation *string  `protobuf:"bytes,9,opt,name=match_explanation,json=matchExplanation,proto3,oneof" json:"match_explanation,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Соответствие фотографий объекта визуальным пожеланиям лида (ремонт, вид, особенности).
+	VisualScore   *float64 `protobuf:"fixed64,10,opt,name=visual_score,json=visualScore,proto3,oneof" json:"visual_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MatchedProperty) Reset() {
 	*x = MatchedProperty{}
-	mi := &file_property_proto_msgTypes[8]
+	mi := &file_property_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +969,7 @@ func (x *MatchedProperty) String() string {
 func (*MatchedProperty) ProtoMessage() {}
 
 func (x *MatchedProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[8]
+	mi := &file_property_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +982,7 @@ func (x *MatchedProperty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchedProperty.ProtoReflect.Descriptor instead.
 func (*MatchedProperty) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{8}
+	return file_property_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MatchedProperty) GetProperty() *Property {
@@ -887,6 +1048,13 @@ func (x *MatchedProperty) GetMatchExplanation() string {
 	return ""
 }
 
+func (x *MatchedProperty) GetVisualScore() float64 {
+	if x != nil && x.VisualScore != nil {
+		return *x.VisualScore
+	}
+	return 0
+}
+
 // MatchPropertiesResponse — ответ с подходящими объектами.
 type MatchPropertiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -897,7 +1065,7 @@ type MatchPropertiesResponse struct {
 
 func (x *MatchPropertiesResponse) Reset() {
 	*x = MatchPropertiesResponse{}
-	mi := &file_property_proto_msgTypes[9]
+	mi := &file_property_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1077,7 @@ func (x *MatchPropertiesResponse) String() string {
 func (*MatchPropertiesResponse) ProtoMessage() {}
 
 func (x *MatchPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[9]
+	mi := &file_property_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1090,7 @@ func (x *MatchPropertiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesResponse.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{9}
+	return file_property_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MatchPropertiesResponse) GetMatches() []*MatchedProperty {
@@ -932,6 +1100,250 @@ func (x *MatchPropertiesResponse) GetMatches() []*MatchedProperty {
 	return nil
 }
 
+type AddPropertyImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPropertyImagesRequest) Reset() {
+	*x = AddPropertyImagesRequest{}
+	mi := &file_property_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPropertyImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPropertyImagesRequest) ProtoMessage() {}
+
+func (x *AddPropertyImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPropertyImagesRequest.ProtoReflect.Descriptor instead.
+func (*AddPropertyImagesRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddPropertyImagesRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *AddPropertyImagesRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ListPropertyImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPropertyImagesRequest) Reset() {
+	*x = ListPropertyImagesRequest{}
+	mi := &file_property_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPropertyImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPropertyImagesRequest) ProtoMessage() {}
+
+func (x *ListPropertyImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPropertyImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPropertyImagesRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPropertyImagesRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+type ReorderPropertyImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPropertyImagesRequest) Reset() {
+	*x = ReorderPropertyImagesRequest{}
+	mi := &file_property_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPropertyImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPropertyImagesRequest) ProtoMessage() {}
+
+func (x *ReorderPropertyImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPropertyImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPropertyImagesRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderPropertyImagesRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *ReorderPropertyImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type DeletePropertyImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	ImageId       string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePropertyImageRequest) Reset() {
+	*x = DeletePropertyImageRequest{}
+	mi := &file_property_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePropertyImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePropertyImageRequest) ProtoMessage() {}
+
+func (x *DeletePropertyImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePropertyImageRequest.ProtoReflect.Descriptor instead.
+func (*DeletePropertyImageRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeletePropertyImageRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *DeletePropertyImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type PropertyImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*PropertyImage       `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyImagesResponse) Reset() {
+	*x = PropertyImagesResponse{}
+	mi := &file_property_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyImagesResponse) ProtoMessage() {}
+
+func (x *PropertyImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyImagesResponse.ProtoReflect.Descriptor instead.
+func (*PropertyImagesResponse) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PropertyImagesResponse) GetImages() []*PropertyImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 type ReindexPropertyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
@@ -941,7 +1353,7 @@ type ReindexPropertyRequest struct {
 
 func (x *ReindexPropertyRequest) Reset() {
 	*x = ReindexPropertyRequest{}
-	mi := &file_property_proto_msgTypes[10]
+	mi := &file_property_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1365,7 @@ func (x *ReindexPropertyRequest) String() string {
 func (*ReindexPropertyRequest) ProtoMessage() {}
 
 func (x *ReindexPropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[10]
+	mi := &file_property_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1378,7 @@ func (x *ReindexPropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexPropertyRequest.ProtoReflect.Descriptor instead.
 func (*ReindexPropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{10}
+	return file_property_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReindexPropertyRequest) GetPropertyId() string {
@@ -986,7 +1398,7 @@ type ReindexPropertyResponse struct {
 
 func (x *ReindexPropertyResponse) Reset() {
 	*x = ReindexPropertyResponse{}
-	mi := &file_property_proto_msgTypes[11]
+	mi := &file_property_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +1410,7 @@ func (x *ReindexPropertyResponse) String() string {
 func (*ReindexPropertyResponse) ProtoMessage() {}
 
 func (x *ReindexPropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[11]
+	mi := &file_property_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1423,7 @@ func (x *ReindexPropertyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexPropertyResponse.ProtoReflect.Descriptor instead.
 func (*ReindexPropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{11}
+	return file_property_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReindexPropertyResponse) GetSuccess() bool {
@@ -1044,7 +1456,7 @@ type PropertyFilter struct {
 
 func (x *PropertyFilter) Reset() {
 	*x = PropertyFilter{}
-	mi := &file_property_proto_msgTypes[12]
+	mi := &file_property_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1468,7 @@ func (x *PropertyFilter) String() string {
 func (*PropertyFilter) ProtoMessage() {}
 
 func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[12]
+	mi := &file_property_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1481,7 @@ func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertyFilter.ProtoReflect.Descriptor instead.
 func (*PropertyFilter) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{12}
+	return file_property_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PropertyFilter) GetCity() string {
@@ -1136,7 +1548,7 @@ type MatchPropertiesAdvancedRequest struct {
 
 func (x *MatchPropertiesAdvancedRequest) Reset() {
 	*x = MatchPropertiesAdvancedRequest{}
-	mi := &file_property_proto_msgTypes[13]
+	mi := &file_property_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1148,7 +1560,7 @@ func (x *MatchPropertiesAdvancedRequest) String() string {
 func (*MatchPropertiesAdvancedRequest) ProtoMessage() {}
 
 func (x *MatchPropertiesAdvancedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[13]
+	mi := &file_property_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +1573,7 @@ func (x *MatchPropertiesAdvancedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesAdvancedRequest.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesAdvancedRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{13}
+	return file_property_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MatchPropertiesAdvancedRequest) GetLeadId() string {
@@ -1216,7 +1628,7 @@ type GetPropertyJSONLDRequest struct {
 
 func (x *GetPropertyJSONLDRequest) Reset() {
 	*x = GetPropertyJSONLDRequest{}
-	mi := &file_property_proto_msgTypes[14]
+	mi := &file_property_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1228,7 +1640,7 @@ func (x *GetPropertyJSONLDRequest) String() string {
 func (*GetPropertyJSONLDRequest) ProtoMessage() {}
 
 func (x *GetPropertyJSONLDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[14]
+	mi := &file_property_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,7 +1653,7 @@ func (x *GetPropertyJSONLDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyJSONLDRequest.ProtoReflect.Descriptor instead.
 func (*GetPropertyJSONLDRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{14}
+	return file_property_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetPropertyJSONLDRequest) GetPropertyId() string {
@@ -1267,7 +1679,7 @@ type GetPropertyJSONLDResponse struct {
 
 func (x *GetPropertyJSONLDResponse) Reset() {
 	*x = GetPropertyJSONLDResponse{}
-	mi := &file_property_proto_msgTypes[15]
+	mi := &file_property_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1279,7 +1691,7 @@ func (x *GetPropertyJSONLDResponse) String() string {
 func (*GetPropertyJSONLDResponse) ProtoMessage() {}
 
 func (x *GetPropertyJSONLDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[15]
+	mi := &file_property_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1704,7 @@ func (x *GetPropertyJSONLDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyJSONLDResponse.ProtoReflect.Descriptor instead.
 func (*GetPropertyJSONLDResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{15}
+	return file_property_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetPropertyJSONLDResponse) GetJsonldData() []byte {
@@ -1320,7 +1732,7 @@ type GenerateListingContentRequest struct {
 
 func (x *GenerateListingContentRequest) Reset() {
 	*x = GenerateListingContentRequest{}
-	mi := &file_property_proto_msgTypes[16]
+	mi := &file_property_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +1744,7 @@ func (x *GenerateListingContentRequest) String() string {
 func (*GenerateListingContentRequest) ProtoMessage() {}
 
 func (x *GenerateListingContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[16]
+	mi := &file_property_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +1757,7 @@ func (x *GenerateListingContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateListingContentRequest.ProtoReflect.Descriptor instead.
 func (*GenerateListingContentRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{16}
+	return file_property_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GenerateListingContentRequest) GetPropertyId() string {
@@ -1430,7 +1842,7 @@ type GenerateListingContentResponse struct {
 
 func (x *GenerateListingContentResponse) Reset() {
 	*x = GenerateListingContentResponse{}
-	mi := &file_property_proto_msgTypes[17]
+	mi := &file_property_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1442,7 +1854,7 @@ func (x *GenerateListingContentResponse) String() string {
 func (*GenerateListingContentResponse) ProtoMessage() {}
 
 func (x *GenerateListingContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[17]
+	mi := &file_property_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +1867,7 @@ func (x *GenerateListingContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateListingContentResponse.ProtoReflect.Descriptor instead.
 func (*GenerateListingContentResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{17}
+	return file_property_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GenerateListingContentResponse) GetTitle() string {
@@ -1487,16 +1899,21 @@ func (x *GenerateListingContentResponse) GetConfidence() float64 {
 }
 
 type AnalyzePropertyImagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
-	ImageUrls     []string               `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// Не используется: анализируются фотографии из галереи объекта.
+	//
+	// Deprecated: Marked as deprecated in property.proto.
+	ImageUrls []string `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	// Проанализировать заново и уже проанализированные фотографии.
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AnalyzePropertyImagesRequest) Reset() {
 	*x = AnalyzePropertyImagesRequest{}
-	mi := &file_property_proto_msgTypes[18]
+	mi := &file_property_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1508,7 +1925,7 @@ func (x *AnalyzePropertyImagesRequest) String() string {
 func (*AnalyzePropertyImagesRequest) ProtoMessage() {}
 
 func (x *AnalyzePropertyImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[18]
+	mi := &file_property_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +1938,7 @@ func (x *AnalyzePropertyImagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzePropertyImagesRequest.ProtoReflect.Descriptor instead.
 func (*AnalyzePropertyImagesRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{18}
+	return file_property_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AnalyzePropertyImagesRequest) GetPropertyId() string {
@@ -1531,6 +1948,7 @@ func (x *AnalyzePropertyImagesRequest) GetPropertyId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in property.proto.
 func (x *AnalyzePropertyImagesRequest) GetImageUrls() []string {
 	if x != nil {
 		return x.ImageUrls
@@ -1538,6 +1956,57 @@ func (x *AnalyzePropertyImagesRequest) GetImageUrls() []string {
 	return nil
 }
 
+func (x *AnalyzePropertyImagesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetPropertyImageAnalysisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPropertyImageAnalysisRequest) Reset() {
+	*x = GetPropertyImageAnalysisRequest{}
+	mi := &file_property_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPropertyImageAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertyImageAnalysisRequest) ProtoMessage() {}
+
+func (x *GetPropertyImageAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertyImageAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetPropertyImageAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPropertyImageAnalysisRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
 type ImageFeature struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1549,7 +2018,7 @@ type ImageFeature struct {
 
 func (x *ImageFeature) Reset() {
 	*x = ImageFeature{}
-	mi := &file_property_proto_msgTypes[19]
+	mi := &file_property_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1561,7 +2030,7 @@ func (x *ImageFeature) String() string {
 func (*ImageFeature) ProtoMessage() {}
 
 func (x *ImageFeature) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[19]
+	mi := &file_property_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,7 +2043,7 @@ func (x *ImageFeature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageFeature.ProtoReflect.Descriptor instead.
 func (*ImageFeature) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{19}
+	return file_property_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ImageFeature) GetName() string {
@@ -1605,13 +2074,17 @@ type ImageAnalysisResult struct {
 	QualityScore     float64                `protobuf:"fixed64,3,opt,name=quality_score,json=qualityScore,proto3" json:"quality_score,omitempty"`
 	ViewType         *string                `protobuf:"bytes,4,opt,name=view_type,json=viewType,proto3,oneof" json:"view_type,omitempty"`
 	Brightness       float64                `protobuf:"fixed64,5,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	ImageId          string                 `protobuf:"bytes,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Confidence       float64                `protobuf:"fixed64,7,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Tags             map[string]string      `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AnalyzedAt       string                 `protobuf:"bytes,9,opt,name=analyzed_at,json=analyzedAt,proto3" json:"analyzed_at,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ImageAnalysisResult) Reset() {
 	*x = ImageAnalysisResult{}
-	mi := &file_property_proto_msgTypes[20]
+	mi := &file_property_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1623,7 +2096,7 @@ func (x *ImageAnalysisResult) String() string {
 func (*ImageAnalysisResult) ProtoMessage() {}
 
 func (x *ImageAnalysisResult) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[20]
+	mi := &file_property_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,7 +2109,7 @@ func (x *ImageAnalysisResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageAnalysisResult.ProtoReflect.Descriptor instead.
 func (*ImageAnalysisResult) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{20}
+	return file_property_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ImageAnalysisResult) GetDetectedFeatures() []*ImageFeature {
@@ -1674,6 +2147,34 @@ func (x *ImageAnalysisResult) GetBrightness() float64 {
 	return 0
 }
 
+func (x *ImageAnalysisResult) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *ImageAnalysisResult) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ImageAnalysisResult) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImageAnalysisResult) GetAnalyzedAt() string {
+	if x != nil {
+		return x.AnalyzedAt
+	}
+	return ""
+}
+
 type AnalyzePropertyImagesResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	TotalImages       int32                  `protobuf:"varint,1,opt,name=total_images,json=totalImages,proto3" json:"total_images,omitempty"`
@@ -1682,14 +2183,22 @@ type AnalyzePropertyImagesResponse struct {
 	AllFeatures       []*ImageFeature        `protobuf:"bytes,4,rep,name=all_features,json=allFeatures,proto3" json:"all_features,omitempty"`
 	ViewTypes         []string               `protobuf:"bytes,5,rep,name=view_types,json=viewTypes,proto3" json:"view_types,omitempty"`
 	OverallAssessment string                 `protobuf:"bytes,6,opt,name=overall_assessment,json=overallAssessment,proto3" json:"overall_assessment,omitempty"`
-	ImageResults      []*ImageAnalysisResult `protobuf:"bytes,7,rep,name=image_results,json=imageResults,proto3" json:"image_results,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Результаты по проанализированным фотографиям в порядке галереи.
+	ImageResults []*ImageAnalysisResult `protobuf:"bytes,7,rep,name=image_results,json=imageResults,proto3" json:"image_results,omitempty"`
+	PropertyId   string                 `protobuf:"bytes,8,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Status       ImageAnalysisStatus    `protobuf:"varint,9,opt,name=status,proto3,enum=leadexchange.v1.ImageAnalysisStatus" json:"status,omitempty"`
+	Error        *string                `protobuf:"bytes,10,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Время завершения последнего анализа.
+	AnalyzedAt *string `protobuf:"bytes,11,opt,name=analyzed_at,json=analyzedAt,proto3,oneof" json:"analyzed_at,omitempty"`
+	// Фотографии галереи, которые ещё не проанализированы.
+	PendingImages int32 `protobuf:"varint,12,opt,name=pending_images,json=pendingImages,proto3" json:"pending_images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AnalyzePropertyImagesResponse) Reset() {
 	*x = AnalyzePropertyImagesResponse{}
-	mi := &file_property_proto_msgTypes[21]
+	mi := &file_property_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +2210,7 @@ func (x *AnalyzePropertyImagesResponse) String() string {
 func (*AnalyzePropertyImagesResponse) ProtoMessage() {}
 
 func (x *AnalyzePropertyImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[21]
+	mi := &file_property_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2223,7 @@ func (x *AnalyzePropertyImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzePropertyImagesResponse.ProtoReflect.Descriptor instead.
 func (*AnalyzePropertyImagesResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{21}
+	return file_property_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AnalyzePropertyImagesResponse) GetTotalImages() int32 {
@@ -1766,6 +2275,41 @@ func (x *AnalyzePropertyImagesResponse) GetImageResults() []*ImageAnalysisResult
 	return nil
 }
 
+func (x *AnalyzePropertyImagesResponse) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *AnalyzePropertyImagesResponse) GetStatus() ImageAnalysisStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImageAnalysisStatus_IMAGE_ANALYSIS_STATUS_UNSPECIFIED
+}
+
+func (x *AnalyzePropertyImagesResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *AnalyzePropertyImagesResponse) GetAnalyzedAt() string {
+	if x != nil && x.AnalyzedAt != nil {
+		return *x.AnalyzedAt
+	}
+	return ""
+}
+
+func (x *AnalyzePropertyImagesResponse) GetPendingImages() int32 {
+	if x != nil {
+		return x.PendingImages
+	}
+	return 0
+}
+
 type ListPropertiesRequest_Filter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *PropertyStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=leadexchange.v1.PropertyStatus,oneof" json:"status,omitempty"`
@@ -1783,7 +2327,7 @@ type ListPropertiesRequest_Filter struct {
 
 func (x *ListPropertiesRequest_Filter) Reset() {
 	*x = ListPropertiesRequest_Filter{}
-	mi := &file_property_proto_msgTypes[22]
+	mi := &file_property_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1795,7 +2339,7 @@ func (x *ListPropertiesRequest_Filter) String() string {
 func (*ListPropertiesRequest_Filter) ProtoMessage() {}
 
 func (x *ListPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[22]
+	mi := &file_property_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2352,7 @@ func (x *ListPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertiesRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListPropertiesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{3, 0}
+	return file_property_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *ListPropertiesRequest_Filter) GetStatus() PropertyStatus {
@@ -1889,7 +2433,7 @@ type MatchPropertiesRequest_Filter struct {
 
 func (x *MatchPropertiesRequest_Filter) Reset() {
 	*x = MatchPropertiesRequest_Filter{}
-	mi := &file_property_proto_msgTypes[23]
+	mi := &file_property_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1901,7 +2445,7 @@ func (x *MatchPropertiesRequest_Filter) String() string {
 func (*MatchPropertiesRequest_Filter) ProtoMessage() {}
 
 func (x *MatchPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[23]
+	mi := &file_property_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1914,7 +2458,7 @@ func (x *MatchPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesRequest_Filter.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{7, 0}
+	return file_property_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *MatchPropertiesRequest_Filter) GetStatus() PropertyStatus {
@@ -1970,7 +2514,8 @@ var File_property_proto protoreflect.FileDescriptor
 
 const file_property_proto_rawDesc = "" +
 	"\n" +
-	"\x0eproperty.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xb8\x04\n" +
+	"\x0eproperty.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\n" +
+	"file.proto\"\xf0\x04\n" +
 	"\bProperty\x12\x1f\n" +
 	"\vproperty_id\x18\x01 \x01(\tR\n" +
 	"propertyId\x12\x1d\n" +
@@ -1989,11 +2534,20 @@ const file_property_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\tR\tupdatedAt\x12\x17\n" +
-	"\x04city\x18\x0e \x01(\tH\x03R\x04city\x88\x01\x01B\a\n" +
+	"\x04city\x18\x0e \x01(\tH\x03R\x04city\x88\x01\x01\x126\n" +
+	"\x06images\x18\x0f \x03(\v2\x1e.leadexchange.v1.PropertyImageR\x06imagesB\a\n" +
 	"\x05_areaB\b\n" +
 	"\x06_priceB\b\n" +
 	"\x06_roomsB\a\n" +
-	"\x05_city\"\xd7\x02\n" +
+	"\x05_city\"\xc4\x01\n" +
+	"\rPropertyImage\x12\x19\n" +
+	"\bimage_id\x18\x01 \x01(\tR\aimageId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x129\n" +
+	"\bvariants\x18\x06 \x03(\v2\x1d.leadexchange.v1.ImageVariantR\bvariants\"\xd7\x02\n" +
 	"\x15CreatePropertyRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
@@ -2099,7 +2653,7 @@ const file_property_proto_rawDesc = "" +
 	"\n" +
 	"_max_priceB\a\n" +
 	"\x05_cityB\b\n" +
-	"\x06_limit\"\x83\x04\n" +
+	"\x06_limit\"\xbc\x04\n" +
 	"\x0fMatchedProperty\x125\n" +
 	"\bproperty\x18\x01 \x01(\v2\x19.leadexchange.v1.PropertyR\bproperty\x12\x1e\n" +
 	"\n" +
@@ -2115,16 +2669,36 @@ const file_property_proto_rawDesc = "" +
 	"\n" +
 	"area_score\x18\a \x01(\x01H\x04R\tareaScore\x88\x01\x01\x12*\n" +
 	"\x0esemantic_score\x18\b \x01(\x01H\x05R\rsemanticScore\x88\x01\x01\x120\n" +
-	"\x11match_explanation\x18\t \x01(\tH\x06R\x10matchExplanation\x88\x01\x01B\x0e\n" +
+	"\x11match_explanation\x18\t \x01(\tH\x06R\x10matchExplanation\x88\x01\x01\x12&\n" +
+	"\fvisual_score\x18\n" +
+	" \x01(\x01H\aR\vvisualScore\x88\x01\x01B\x0e\n" +
 	"\f_total_scoreB\x0e\n" +
 	"\f_price_scoreB\x11\n" +
 	"\x0f_district_scoreB\x0e\n" +
 	"\f_rooms_scoreB\r\n" +
 	"\v_area_scoreB\x11\n" +
 	"\x0f_semantic_scoreB\x14\n" +
-	"\x12_match_explanation\"U\n" +
+	"\x12_match_explanationB\x0f\n" +
+	"\r_visual_score\"U\n" +
 	"\x17MatchPropertiesResponse\x12:\n" +
-	"\amatches\x18\x01 \x03(\v2 .leadexchange.v1.MatchedPropertyR\amatches\"C\n" +
+	"\amatches\x18\x01 \x03(\v2 .leadexchange.v1.MatchedPropertyR\amatches\"n\n" +
+	"\x18AddPropertyImagesRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12'\n" +
+	"\x04keys\x18\x02 \x03(\tB\x13\xfaB\x10\x92\x01\r\b\x01\x10\x1e\"\ar\x05\x10\x01\x18\x80\x04R\x04keys\"F\n" +
+	"\x19ListPropertyImagesRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\"w\n" +
+	"\x1cReorderPropertyImagesRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12,\n" +
+	"\timage_ids\x18\x02 \x03(\tB\x0f\xfaB\f\x92\x01\t\b\x01\"\x05r\x03\xb0\x01\x01R\bimageIds\"l\n" +
+	"\x1aDeletePropertyImageRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12#\n" +
+	"\bimage_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aimageId\"P\n" +
+	"\x16PropertyImagesResponse\x126\n" +
+	"\x06images\x18\x01 \x03(\v2\x1e.leadexchange.v1.PropertyImageR\x06images\"C\n" +
 	"\x16ReindexPropertyRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
 	"propertyId\"M\n" +
@@ -2198,18 +2772,22 @@ const file_property_proto_rawDesc = "" +
 	"\bkeywords\x18\x03 \x03(\tR\bkeywords\x12\x1e\n" +
 	"\n" +
 	"confidence\x18\x04 \x01(\x01R\n" +
-	"confidence\"h\n" +
+	"confidence\"\x82\x01\n" +
 	"\x1cAnalyzePropertyImagesRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"propertyId\x12\x1d\n" +
+	"propertyId\x12!\n" +
 	"\n" +
-	"image_urls\x18\x02 \x03(\tR\timageUrls\"^\n" +
+	"image_urls\x18\x02 \x03(\tB\x02\x18\x01R\timageUrls\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"L\n" +
+	"\x1fGetPropertyImageAnalysisRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\"^\n" +
 	"\fImageFeature\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
 	"confidence\x18\x02 \x01(\x01R\n" +
 	"confidence\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\"\x86\x02\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"\xdf\x03\n" +
 	"\x13ImageAnalysisResult\x12J\n" +
 	"\x11detected_features\x18\x01 \x03(\v2\x1d.leadexchange.v1.ImageFeatureR\x10detectedFeatures\x12 \n" +
 	"\troom_type\x18\x02 \x01(\tH\x00R\broomType\x88\x01\x01\x12#\n" +
@@ -2217,11 +2795,21 @@ const file_property_proto_rawDesc = "" +
 	"\tview_type\x18\x04 \x01(\tH\x01R\bviewType\x88\x01\x01\x12\x1e\n" +
 	"\n" +
 	"brightness\x18\x05 \x01(\x01R\n" +
-	"brightnessB\f\n" +
+	"brightness\x12\x19\n" +
+	"\bimage_id\x18\x06 \x01(\tR\aimageId\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\a \x01(\x01R\n" +
+	"confidence\x12B\n" +
+	"\x04tags\x18\b \x03(\v2..leadexchange.v1.ImageAnalysisResult.TagsEntryR\x04tags\x12\x1f\n" +
+	"\vanalyzed_at\x18\t \x01(\tR\n" +
+	"analyzedAt\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
 	"_room_typeB\f\n" +
 	"\n" +
-	"_view_type\"\xed\x02\n" +
+	"_view_type\"\xce\x04\n" +
 	"\x1dAnalyzePropertyImagesResponse\x12!\n" +
 	"\ftotal_images\x18\x01 \x01(\x05R\vtotalImages\x12'\n" +
 	"\x0faverage_quality\x18\x02 \x01(\x01R\x0eaverageQuality\x12%\n" +
@@ -2230,7 +2818,17 @@ const file_property_proto_rawDesc = "" +
 	"\n" +
 	"view_types\x18\x05 \x03(\tR\tviewTypes\x12-\n" +
 	"\x12overall_assessment\x18\x06 \x01(\tR\x11overallAssessment\x12I\n" +
-	"\rimage_results\x18\a \x03(\v2$.leadexchange.v1.ImageAnalysisResultR\fimageResults*\x99\x01\n" +
+	"\rimage_results\x18\a \x03(\v2$.leadexchange.v1.ImageAnalysisResultR\fimageResults\x12\x1f\n" +
+	"\vproperty_id\x18\b \x01(\tR\n" +
+	"propertyId\x12<\n" +
+	"\x06status\x18\t \x01(\x0e2$.leadexchange.v1.ImageAnalysisStatusR\x06status\x12\x19\n" +
+	"\x05error\x18\n" +
+	" \x01(\tH\x00R\x05error\x88\x01\x01\x12$\n" +
+	"\vanalyzed_at\x18\v \x01(\tH\x01R\n" +
+	"analyzedAt\x88\x01\x01\x12%\n" +
+	"\x0epending_images\x18\f \x01(\x05R\rpendingImagesB\b\n" +
+	"\x06_errorB\x0e\n" +
+	"\f_analyzed_at*\x99\x01\n" +
 	"\fPropertyType\x12\x1d\n" +
 	"\x19PROPERTY_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17PROPERTY_TYPE_APARTMENT\x10\x01\x12\x17\n" +
@@ -2242,7 +2840,14 @@ const file_property_proto_rawDesc = "" +
 	"\x13PROPERTY_STATUS_NEW\x10\x01\x12\x1d\n" +
 	"\x19PROPERTY_STATUS_PUBLISHED\x10\x02\x12\x18\n" +
 	"\x14PROPERTY_STATUS_SOLD\x10\x03\x12\x1b\n" +
-	"\x17PROPERTY_STATUS_DELETED\x10\x042\xbc\v\n" +
+	"\x17PROPERTY_STATUS_DELETED\x10\x04*\xee\x01\n" +
+	"\x13ImageAnalysisStatus\x12%\n" +
+	"!IMAGE_ANALYSIS_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!IMAGE_ANALYSIS_STATUS_NOT_STARTED\x10\x01\x12!\n" +
+	"\x1dIMAGE_ANALYSIS_STATUS_PENDING\x10\x02\x12$\n" +
+	" IMAGE_ANALYSIS_STATUS_PROCESSING\x10\x03\x12\x1e\n" +
+	"\x1aIMAGE_ANALYSIS_STATUS_DONE\x10\x04\x12 \n" +
+	"\x1cIMAGE_ANALYSIS_STATUS_FAILED\x10\x052\xf1\x11\n" +
 	"\x0fPropertyService\x12v\n" +
 	"\x0eCreateProperty\x12&.leadexchange.v1.CreatePropertyRequest\x1a!.leadexchange.v1.PropertyResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/properties\x12{\n" +
 	"\vGetProperty\x12#.leadexchange.v1.GetPropertyRequest\x1a!.leadexchange.v1.PropertyResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/properties/{property_id}\x12y\n" +
@@ -2253,7 +2858,12 @@ const file_property_proto_rawDesc = "" +
 	"\x17MatchPropertiesAdvanced\x12/.leadexchange.v1.MatchPropertiesAdvancedRequest\x1a(.leadexchange.v1.MatchPropertiesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/properties/match/advanced\x12\x97\x01\n" +
 	"\x11GetPropertyJSONLD\x12).leadexchange.v1.GetPropertyJSONLDRequest\x1a*.leadexchange.v1.GetPropertyJSONLDResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/properties/{property_id}/jsonld\x12\xa5\x01\n" +
 	"\x16GenerateListingContent\x12..leadexchange.v1.GenerateListingContentRequest\x1a/.leadexchange.v1.GenerateListingContentResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/properties/generate-content\x12\xae\x01\n" +
-	"\x15AnalyzePropertyImages\x12-.leadexchange.v1.AnalyzePropertyImagesRequest\x1a..leadexchange.v1.AnalyzePropertyImagesResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/properties/{property_id}/analyze-imagesB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+	"\x15AnalyzePropertyImages\x12-.leadexchange.v1.AnalyzePropertyImagesRequest\x1a..leadexchange.v1.AnalyzePropertyImagesResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/properties/{property_id}/analyze-images\x12\xb1\x01\n" +
+	"\x18GetPropertyImageAnalysis\x120.leadexchange.v1.GetPropertyImageAnalysisRequest\x1a..leadexchange.v1.AnalyzePropertyImagesResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/properties/{property_id}/image-analysis\x12\x97\x01\n" +
+	"\x11AddPropertyImages\x12).leadexchange.v1.AddPropertyImagesRequest\x1a'.leadexchange.v1.PropertyImagesResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/properties/{property_id}/images\x12\x96\x01\n" +
+	"\x12ListPropertyImages\x12*.leadexchange.v1.ListPropertyImagesRequest\x1a'.leadexchange.v1.PropertyImagesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/properties/{property_id}/images\x12\xa5\x01\n" +
+	"\x15ReorderPropertyImages\x12-.leadexchange.v1.ReorderPropertyImagesRequest\x1a'.leadexchange.v1.PropertyImagesResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/properties/{property_id}/images/order\x12\xa3\x01\n" +
+	"\x13DeletePropertyImage\x12+.leadexchange.v1.DeletePropertyImageRequest\x1a'.leadexchange.v1.PropertyImagesResponse\"6\x82\xd3\xe4\x93\x020*./v1/properties/{property_id}/images/{image_id}B4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
 	file_property_proto_rawDescOnce sync.Once
@@ -2267,83 +2877,108 @@ func file_property_proto_rawDescGZIP() []byte {
 	return file_property_proto_rawDescData
 }
 
-var file_property_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_property_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_property_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_property_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_property_proto_goTypes = []any{
-	(PropertyType)(0),                      // 0: leadexchange.v1.PropertyType
-	(PropertyStatus)(0),                    // 1: leadexchange.v1.PropertyStatus
-	(*Property)(nil),                       // 2: leadexchange.v1.Property
-	(*CreatePropertyRequest)(nil),          // 3: leadexchange.v1.CreatePropertyRequest
-	(*GetPropertyRequest)(nil),             // 4: leadexchange.v1.GetPropertyRequest
-	(*ListPropertiesRequest)(nil),          // 5: leadexchange.v1.ListPropertiesRequest
-	(*ListPropertiesResponse)(nil),         // 6: leadexchange.v1.ListPropertiesResponse
-	(*UpdatePropertyRequest)(nil),          // 7: leadexchange.v1.UpdatePropertyRequest
-	(*PropertyResponse)(nil),               // 8: leadexchange.v1.PropertyResponse
-	(*MatchPropertiesRequest)(nil),         // 9: leadexchange.v1.MatchPropertiesRequest
-	(*MatchedProperty)(nil),                // 10: leadexchange.v1.MatchedProperty
-	(*MatchPropertiesResponse)(nil),        // 11: leadexchange.v1.MatchPropertiesResponse
-	(*ReindexPropertyRequest)(nil),         // 12: leadexchange.v1.ReindexPropertyRequest
-	(*ReindexPropertyResponse)(nil),        // 13: leadexchange.v1.ReindexPropertyResponse
-	(*PropertyFilter)(nil),                 // 14: leadexchange.v1.PropertyFilter
-	(*MatchPropertiesAdvancedRequest)(nil), // 15: leadexchange.v1.MatchPropertiesAdvancedRequest
-	(*GetPropertyJSONLDRequest)(nil),       // 16: leadexchange.v1.GetPropertyJSONLDRequest
-	(*GetPropertyJSONLDResponse)(nil),      // 17: leadexchange.v1.GetPropertyJSONLDResponse
-	(*GenerateListingContentRequest)(nil),  // 18: leadexchange.v1.GenerateListingContentRequest
-	(*GenerateListingContentResponse)(nil), // 19: leadexchange.v1.GenerateListingContentResponse
-	(*AnalyzePropertyImagesRequest)(nil),   // 20: leadexchange.v1.AnalyzePropertyImagesRequest
-	(*ImageFeature)(nil),                   // 21: leadexchange.v1.ImageFeature
-	(*ImageAnalysisResult)(nil),            // 22: leadexchange.v1.ImageAnalysisResult
-	(*AnalyzePropertyImagesResponse)(nil),  // 23: leadexchange.v1.AnalyzePropertyImagesResponse
-	(*ListPropertiesRequest_Filter)(nil),   // 24: leadexchange.v1.ListPropertiesRequest.Filter
-	(*MatchPropertiesRequest_Filter)(nil),  // 25: leadexchange.v1.MatchPropertiesRequest.Filter
+	(PropertyType)(0),                       // 0: leadexchange.v1.PropertyType
+	(PropertyStatus)(0),                     // 1: leadexchange.v1.PropertyStatus
+	(ImageAnalysisStatus)(0),                // 2: leadexchange.v1.ImageAnalysisStatus
+	(*Property)(nil),                        // 3: leadexchange.v1.Property
+	(*PropertyImage)(nil),                   // 4: leadexchange.v1.PropertyImage
+	(*CreatePropertyRequest)(nil),           // 5: leadexchange.v1.CreatePropertyRequest
+	(*GetPropertyRequest)(nil),              // 6: leadexchange.v1.GetPropertyRequest
+	(*ListPropertiesRequest)(nil),           // 7: leadexchange.v1.ListPropertiesRequest
+	(*ListPropertiesResponse)(nil),          // 8: leadexchange.v1.ListPropertiesResponse
+	(*UpdatePropertyRequest)(nil),           // 9: leadexchange.v1.UpdatePropertyRequest
+	(*PropertyResponse)(nil),                // 10: leadexchange.v1.PropertyResponse
+	(*MatchPropertiesRequest)(nil),          // 11: leadexchange.v1.MatchPropertiesRequest
+	(*MatchedProperty)(nil),                 // 12: leadexchange.v1.MatchedProperty
+	(*MatchPropertiesResponse)(nil),         // 13: leadexchange.v1.MatchPropertiesResponse
+	(*AddPropertyImagesRequest)(nil),        // 14: leadexchange.v1.AddPropertyImagesRequest
+	(*ListPropertyImagesRequest)(nil),       // 15: leadexchange.v1.ListPropertyImagesRequest
+	(*ReorderPropertyImagesRequest)(nil),    // 16: leadexchange.v1.ReorderPropertyImagesRequest
+	(*DeletePropertyImageRequest)(nil),      // 17: leadexchange.v1.DeletePropertyImageRequest
+	(*PropertyImagesResponse)(nil),          // 18: leadexchange.v1.PropertyImagesResponse
+	(*ReindexPropertyRequest)(nil),          // 19: leadexchange.v1.ReindexPropertyRequest
+	(*ReindexPropertyResponse)(nil),         // 20: leadexchange.v1.ReindexPropertyResponse
+	(*PropertyFilter)(nil),                  // 21: leadexchange.v1.PropertyFilter
+	(*MatchPropertiesAdvancedRequest)(nil),  // 22: leadexchange.v1.MatchPropertiesAdvancedRequest
+	(*GetPropertyJSONLDRequest)(nil),        // 23: leadexchange.v1.GetPropertyJSONLDRequest
+	(*GetPropertyJSONLDResponse)(nil),       // 24: leadexchange.v1.GetPropertyJSONLDResponse
+	(*GenerateListingContentRequest)(nil),   // 25: leadexchange.v1.GenerateListingContentRequest
+	(*GenerateListingContentResponse)(nil),  // 26: leadexchange.v1.GenerateListingContentResponse
+	(*AnalyzePropertyImagesRequest)(nil),    // 27: leadexchange.v1.AnalyzePropertyImagesRequest
+	(*GetPropertyImageAnalysisRequest)(nil), // 28: leadexchange.v1.GetPropertyImageAnalysisRequest
+	(*ImageFeature)(nil),                    // 29: leadexchange.v1.ImageFeature
+	(*ImageAnalysisResult)(nil),             // 30: leadexchange.v1.ImageAnalysisResult
+	(*AnalyzePropertyImagesResponse)(nil),   // 31: leadexchange.v1.AnalyzePropertyImagesResponse
+	(*ListPropertiesRequest_Filter)(nil),    // 32: leadexchange.v1.ListPropertiesRequest.Filter
+	(*MatchPropertiesRequest_Filter)(nil),   // 33: leadexchange.v1.MatchPropertiesRequest.Filter
+	nil,                                     // 34: leadexchange.v1.ImageAnalysisResult.TagsEntry
+	(*ImageVariant)(nil),                    // 35: leadexchange.v1.ImageVariant
 }
 var file_property_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.Property.property_type:type_name -> leadexchange.v1.PropertyType
 	1,  // 1: leadexchange.v1.Property.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 2: leadexchange.v1.CreatePropertyRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	24, // 3: leadexchange.v1.ListPropertiesRequest.filter:type_name -> leadexchange.v1.ListPropertiesRequest.Filter
-	2,  // 4: leadexchange.v1.ListPropertiesResponse.properties:type_name -> leadexchange.v1.Property
-	0,  // 5: leadexchange.v1.UpdatePropertyRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	1,  // 6: leadexchange.v1.UpdatePropertyRequest.status:type_name -> leadexchange.v1.PropertyStatus
-	2,  // 7: leadexchange.v1.PropertyResponse.property:type_name -> leadexchange.v1.Property
-	25, // 8: leadexchange.v1.MatchPropertiesRequest.filter:type_name -> leadexchange.v1.MatchPropertiesRequest.Filter
-	2,  // 9: leadexchange.v1.MatchedProperty.property:type_name -> leadexchange.v1.Property
-	10, // 10: leadexchange.v1.MatchPropertiesResponse.matches:type_name -> leadexchange.v1.MatchedProperty
-	1,  // 11: leadexchange.v1.PropertyFilter.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 12: leadexchange.v1.PropertyFilter.property_type:type_name -> leadexchange.v1.PropertyType
-	14, // 13: leadexchange.v1.MatchPropertiesAdvancedRequest.filter:type_name -> leadexchange.v1.PropertyFilter
-	21, // 14: leadexchange.v1.ImageAnalysisResult.detected_features:type_name -> leadexchange.v1.ImageFeature
-	21, // 15: leadexchange.v1.AnalyzePropertyImagesResponse.all_features:type_name -> leadexchange.v1.ImageFeature
-	22, // 16: leadexchange.v1.AnalyzePropertyImagesResponse.image_results:type_name -> leadexchange.v1.ImageAnalysisResult
-	1,  // 17: leadexchange.v1.ListPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 18: leadexchange.v1.ListPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
-	1,  // 19: leadexchange.v1.MatchPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 20: leadexchange.v1.MatchPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
-	3,  // 21: leadexchange.v1.PropertyService.CreateProperty:input_type -> leadexchange.v1.CreatePropertyRequest
-	4,  // 22: leadexchange.v1.PropertyService.GetProperty:input_type -> leadexchange.v1.GetPropertyRequest
-	5,  // 23: leadexchange.v1.PropertyService.ListProperties:input_type -> leadexchange.v1.ListPropertiesRequest
-	7,  // 24: leadexchange.v1.PropertyService.UpdateProperty:input_type -> leadexchange.v1.UpdatePropertyRequest
-	9,  // 25: leadexchange.v1.PropertyService.MatchProperties:input_type -> leadexchange.v1.MatchPropertiesRequest
-	12, // 26: leadexchange.v1.PropertyService.ReindexProperty:input_type -> leadexchange.v1.ReindexPropertyRequest
-	15, // 27: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:input_type -> leadexchange.v1.MatchPropertiesAdvancedRequest
-	16, // 28: leadexchange.v1.PropertyService.GetPropertyJSONLD:input_type -> leadexchange.v1.GetPropertyJSONLDRequest
-	18, // 29: leadexchange.v1.PropertyService.GenerateListingContent:input_type -> leadexchange.v1.GenerateListingContentRequest
-	20, // 30: leadexchange.v1.PropertyService.AnalyzePropertyImages:input_type -> leadexchange.v1.AnalyzePropertyImagesRequest
-	8,  // 31: leadexchange.v1.PropertyService.CreateProperty:output_type -> leadexchange.v1.PropertyResponse
-	8,  // 32: leadexchange.v1.PropertyService.GetProperty:output_type -> leadexchange.v1.PropertyResponse
-	6,  // 33: leadexchange.v1.PropertyService.ListProperties:output_type -> leadexchange.v1.ListPropertiesResponse
-	8,  // 34: leadexchange.v1.PropertyService.UpdateProperty:output_type -> leadexchange.v1.PropertyResponse
-	11, // 35: leadexchange.v1.PropertyService.MatchProperties:output_type -> leadexchange.v1.MatchPropertiesResponse
-	13, // 36: leadexchange.v1.PropertyService.ReindexProperty:output_type -> leadexchange.v1.ReindexPropertyResponse
-	11, // 37: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:output_type -> leadexchange.v1.MatchPropertiesResponse
-	17, // 38: leadexchange.v1.PropertyService.GetPropertyJSONLD:output_type -> leadexchange.v1.GetPropertyJSONLDResponse
-	19, // 39: leadexchange.v1.PropertyService.GenerateListingContent:output_type -> leadexchange.v1.GenerateListingContentResponse
-	23, // 40: leadexchange.v1.PropertyService.AnalyzePropertyImages:output_type -> leadexchange.v1.AnalyzePropertyImagesResponse
-	31, // [31:41] is the sub-list for method output_type
-	21, // [21:31] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	4,  // 2: leadexchange.v1.Property.images:type_name -> leadexchange.v1.PropertyImage
+	35, // 3: leadexchange.v1.PropertyImage.variants:type_name -> leadexchange.v1.ImageVariant
+	0,  // 4: leadexchange.v1.CreatePropertyRequest.property_type:type_name -> leadexchange.v1.PropertyType
+	32, // 5: leadexchange.v1.ListPropertiesRequest.filter:type_name -> leadexchange.v1.ListPropertiesRequest.Filter
+	3,  // 6: leadexchange.v1.ListPropertiesResponse.properties:type_name -> leadexchange.v1.Property
+	0,  // 7: leadexchange.v1.UpdatePropertyRequest.property_type:type_name -> leadexchange.v1.PropertyType
+	1,  // 8: leadexchange.v1.UpdatePropertyRequest.status:type_name -> leadexchange.v1.PropertyStatus
+	3,  // 9: leadexchange.v1.PropertyResponse.property:type_name -> leadexchange.v1.Property
+	33, // 10: leadexchange.v1.MatchPropertiesRequest.filter:type_name -> leadexchange.v1.MatchPropertiesRequest.Filter
+	3,  // 11: leadexchange.v1.MatchedProperty.property:type_name -> leadexchange.v1.Property
+	12, // 12: leadexchange.v1.MatchPropertiesResponse.matches:type_name -> leadexchange.v1.MatchedProperty
+	4,  // 13: leadexchange.v1.PropertyImagesResponse.images:type_name -> leadexchange.v1.PropertyImage
+	1,  // 14: leadexchange.v1.PropertyFilter.status:type_name -> leadexchange.v1.PropertyStatus
+	0,  // 15: leadexchange.v1.PropertyFilter.property_type:type_name -> leadexchange.v1.PropertyType
+	21, // 16: leadexchange.v1.MatchPropertiesAdvancedRequest.filter:type_name -> leadexchange.v1.PropertyFilter
+	29, // 17: leadexchange.v1.ImageAnalysisResult.detected_features:type_name -> leadexchange.v1.ImageFeature
+	34, // 18: leadexchange.v1.ImageAnalysisResult.tags:type_name -> leadexchange.v1.ImageAnalysisResult.TagsEntry
+	29, // 19: leadexchange.v1.AnalyzePropertyImagesResponse.all_features:type_name -> leadexchange.v1.ImageFeature
+	30, // 20: leadexchange.v1.AnalyzePropertyImagesResponse.image_results:type_name -> leadexchange.v1.ImageAnalysisResult
+	2,  // 21: leadexchange.v1.AnalyzePropertyImagesResponse.status:type_name -> leadexchange.v1.ImageAnalysisStatus
+	1,  // 22: leadexchange.v1.ListPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
+	0,  // 23: leadexchange.v1.ListPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	1,  // 24: leadexchange.v1.MatchPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
+	0,  // 25: leadexchange.v1.MatchPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	5,  // 26: leadexchange.v1.PropertyService.CreateProperty:input_type -> leadexchange.v1.CreatePropertyRequest
+	6,  // 27: leadexchange.v1.PropertyService.GetProperty:input_type -> leadexchange.v1.GetPropertyRequest
+	7,  // 28: leadexchange.v1.PropertyService.ListProperties:input_type -> leadexchange.v1.ListPropertiesRequest
+	9,  // 29: leadexchange.v1.PropertyService.UpdateProperty:input_type -> leadexchange.v1.UpdatePropertyRequest
+	11, // 30: leadexchange.v1.PropertyService.MatchProperties:input_type -> leadexchange.v1.MatchPropertiesRequest
+	19, // 31: leadexchange.v1.PropertyService.ReindexProperty:input_type -> leadexchange.v1.ReindexPropertyRequest
+	22, // 32: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:input_type -> leadexchange.v1.MatchPropertiesAdvancedRequest
+	23, // 33: leadexchange.v1.PropertyService.GetPropertyJSONLD:input_type -> leadexchange.v1.GetPropertyJSONLDRequest
+	25, // 34: leadexchange.v1.PropertyService.GenerateListingContent:input_type -> leadexchange.v1.GenerateListingContentRequest
+	27, // 35: leadexchange.v1.PropertyService.AnalyzePropertyImages:input_type -> leadexchange.v1.AnalyzePropertyImagesRequest
+	28, // 36: leadexchange.v1.PropertyService.GetPropertyImageAnalysis:input_type -> leadexchange.v1.GetPropertyImageAnalysisRequest
+	14, // 37: leadexchange.v1.PropertyService.AddPropertyImages:input_type -> leadexchange.v1.AddPropertyImagesRequest
+	15, // 38: leadexchange.v1.PropertyService.ListPropertyImages:input_type -> leadexchange.v1.ListPropertyImagesRequest
+	16, // 39: leadexchange.v1.PropertyService.ReorderPropertyImages:input_type -> leadexchange.v1.ReorderPropertyImagesRequest
+	17, // 40: leadexchange.v1.PropertyService.DeletePropertyImage:input_type -> leadexchange.v1.DeletePropertyImageRequest
+	10, // 41: leadexchange.v1.PropertyService.CreateProperty:output_type -> leadexchange.v1.PropertyResponse
+	10, // 42: leadexchange.v1.PropertyService.GetProperty:output_type -> leadexchange.v1.PropertyResponse
+	8,  // 43: leadexchange.v1.PropertyService.ListProperties:output_type -> leadexchange.v1.ListPropertiesResponse
+	10, // 44: leadexchange.v1.PropertyService.UpdateProperty:output_type -> leadexchange.v1.PropertyResponse
+	13, // 45: leadexchange.v1.PropertyService.MatchProperties:output_type -> leadexchange.v1.MatchPropertiesResponse
+	20, // 46: leadexchange.v1.PropertyService.ReindexProperty:output_type -> leadexchange.v1.ReindexPropertyResponse
+	13, // 47: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:output_type -> leadexchange.v1.MatchPropertiesResponse
+	24, // 48: leadexchange.v1.PropertyService.GetPropertyJSONLD:output_type -> leadexchange.v1.GetPropertyJSONLDResponse
+	26, // 49: leadexchange.v1.PropertyService.GenerateListingContent:output_type -> leadexchange.v1.GenerateListingContentResponse
+	31, // 50: leadexchange.v1.PropertyService.AnalyzePropertyImages:output_type -> leadexchange.v1.AnalyzePropertyImagesResponse
+	31, // 51: leadexchange.v1.PropertyService.GetPropertyImageAnalysis:output_type -> leadexchange.v1.AnalyzePropertyImagesResponse
+	18, // 52: leadexchange.v1.PropertyService.AddPropertyImages:output_type -> leadexchange.v1.PropertyImagesResponse
+	18, // 53: leadexchange.v1.PropertyService.ListPropertyImages:output_type -> leadexchange.v1.PropertyImagesResponse
+	18, // 54: leadexchange.v1.PropertyService.ReorderPropertyImages:output_type -> leadexchange.v1.PropertyImagesResponse
+	18, // 55: leadexchange.v1.PropertyService.DeletePropertyImage:output_type -> leadexchange.v1.PropertyImagesResponse
+	41, // [41:56] is the sub-list for method output_type
+	26, // [26:41] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_property_proto_init() }
@@ -2351,26 +2986,28 @@ func file_property_proto_init() {
 	if File_property_proto != nil {
 		return
 	}
+	file_file_proto_init()
 	file_property_proto_msgTypes[0].OneofWrappers = []any{}
-	file_property_proto_msgTypes[1].OneofWrappers = []any{}
-	file_property_proto_msgTypes[3].OneofWrappers = []any{}
-	file_property_proto_msgTypes[5].OneofWrappers = []any{}
-	file_property_proto_msgTypes[7].OneofWrappers = []any{}
+	file_property_proto_msgTypes[2].OneofWrappers = []any{}
+	file_property_proto_msgTypes[4].OneofWrappers = []any{}
+	file_property_proto_msgTypes[6].OneofWrappers = []any{}
 	file_property_proto_msgTypes[8].OneofWrappers = []any{}
-	file_property_proto_msgTypes[12].OneofWrappers = []any{}
-	file_property_proto_msgTypes[13].OneofWrappers = []any{}
-	file_property_proto_msgTypes[14].OneofWrappers = []any{}
-	file_property_proto_msgTypes[16].OneofWrappers = []any{}
+	file_property_proto_msgTypes[9].OneofWrappers = []any{}
+	file_property_proto_msgTypes[18].OneofWrappers = []any{}
+	file_property_proto_msgTypes[19].OneofWrappers = []any{}
 	file_property_proto_msgTypes[20].OneofWrappers = []any{}
 	file_property_proto_msgTypes[22].OneofWrappers = []any{}
-	file_property_proto_msgTypes[23].OneofWrappers = []any{}
+	file_property_proto_msgTypes[27].OneofWrappers = []any{}
+	file_property_proto_msgTypes[28].OneofWrappers = []any{}
+	file_property_proto_msgTypes[29].OneofWrappers = []any{}
+	file_property_proto_msgTypes[30].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_property_proto_rawDesc), len(file_property_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   24,
+			NumEnums:      3,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -118,6 +118,40 @@ func (m *Property) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PropertyValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PropertyValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PropertyValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.Area != nil {
 		// no validation rules for Area
 	}
@@ -219,6 +253,150 @@ var _ interface {
 	ErrorName() string
 } = PropertyValidationError{}
 
+// Validate checks the field values on PropertyImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PropertyImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PropertyImage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PropertyImageMultiError, or
+// nil if none found.
+func (m *PropertyImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PropertyImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for Key
+
+	// no validation rules for Url
+
+	// no validation rules for Position
+
+	// no validation rules for CreatedAt
+
+	for idx, item := range m.GetVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PropertyImageValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PropertyImageValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PropertyImageValidationError{
+					field:  fmt.Sprintf("Variants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PropertyImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// PropertyImageMultiError is an error wrapping multiple validation errors
+// returned by PropertyImage.ValidateAll() if the designated constraints
+// aren't met.
+type PropertyImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PropertyImageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PropertyImageMultiError) AllErrors() []error { return m }
+
+// PropertyImageValidationError is the validation error returned by
+// PropertyImage.Validate if the designated constraints aren't met.
+type PropertyImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PropertyImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PropertyImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PropertyImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PropertyImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PropertyImageValidationError) ErrorName() string { return "PropertyImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PropertyImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPropertyImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PropertyImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PropertyImageValidationError{}
+
 // Validate checks the field values on CreatePropertyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1183,18 +1361,739 @@ func (e MatchPropertiesRequestValidationError) Field() string { return e.field }
 func (e MatchPropertiesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MatchPropertiesRequestValidationError) Cause() error { return e.cause }
+func (e MatchPropertiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchPropertiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchPropertiesRequestValidationError) ErrorName() string {
+	return "MatchPropertiesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchPropertiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchPropertiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchPropertiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchPropertiesRequestValidationError{}
+
+// Validate checks the field values on MatchedProperty with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MatchedProperty) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchedProperty with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MatchedPropertyMultiError, or nil if none found.
+func (m *MatchedProperty) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchedProperty) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProperty()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchedPropertyValidationError{
+					field:  "Property",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchedPropertyValidationError{
+					field:  "Property",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProperty()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchedPropertyValidationError{
+				field:  "Property",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Similarity
+
+	if m.TotalScore != nil {
+		// no validation rules for TotalScore
+	}
+
+	if m.PriceScore != nil {
+		// no validation rules for PriceScore
+	}
+
+	if m.DistrictScore != nil {
+		// no validation rules for DistrictScore
+	}
+
+	if m.RoomsScore != nil {
+		// no validation rules for RoomsScore
+	}
+
+	if m.AreaScore != nil {
+		// no validation rules for AreaScore
+	}
+
+	if m.SemanticScore != nil {
+		// no validation rules for SemanticScore
+	}
+
+	if m.MatchExplanation != nil {
+		// no validation rules for MatchExplanation
+	}
+
+	if m.VisualScore != nil {
+		// no validation rules for VisualScore
+	}
+
+	if len(errors) > 0 {
+		return MatchedPropertyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchedPropertyMultiError is an error wrapping multiple validation errors
+// returned by MatchedProperty.ValidateAll() if the designated constraints
+// aren't met.
+type MatchedPropertyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchedPropertyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchedPropertyMultiError) AllErrors() []error { return m }
+
+// MatchedPropertyValidationError is the validation error returned by
+// MatchedProperty.Validate if the designated constraints aren't met.
+type MatchedPropertyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchedPropertyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchedPropertyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchedPropertyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchedPropertyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchedPropertyValidationError) ErrorName() string { return "MatchedPropertyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchedPropertyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchedProperty.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchedPropertyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchedPropertyValidationError{}
+
+// Validate checks the field values on MatchPropertiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MatchPropertiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchPropertiesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MatchPropertiesResponseMultiError, or nil if none found.
+func (m *MatchPropertiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchPropertiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MatchPropertiesResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MatchPropertiesResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MatchPropertiesResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MatchPropertiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchPropertiesResponseMultiError is an error wrapping multiple validation
+// errors returned by MatchPropertiesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MatchPropertiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchPropertiesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchPropertiesResponseMultiError) AllErrors() []error { return m }
+
+// MatchPropertiesResponseValidationError is the validation error returned by
+// MatchPropertiesResponse.Validate if the designated constraints aren't met.
+type MatchPropertiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchPropertiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchPropertiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchPropertiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchPropertiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchPropertiesResponseValidationError) ErrorName() string {
+	return "MatchPropertiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchPropertiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchPropertiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchPropertiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchPropertiesResponseValidationError{}
+
+// Validate checks the field values on AddPropertyImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddPropertyImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddPropertyImagesRequestMultiError, or nil if none found.
+func (m *AddPropertyImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddPropertyImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = AddPropertyImagesRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetKeys()); l < 1 || l > 30 {
+		err := AddPropertyImagesRequestValidationError{
+			field:  "Keys",
+			reason: "value must contain between 1 and 30 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 512 {
+			err := AddPropertyImagesRequestValidationError{
+				field:  fmt.Sprintf("Keys[%v]", idx),
+				reason: "value length must be between 1 and 512 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddPropertyImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddPropertyImagesRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddPropertyImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by AddPropertyImagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddPropertyImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddPropertyImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddPropertyImagesRequestMultiError) AllErrors() []error { return m }
+
+// AddPropertyImagesRequestValidationError is the validation error returned by
+// AddPropertyImagesRequest.Validate if the designated constraints aren't met.
+type AddPropertyImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddPropertyImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddPropertyImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddPropertyImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddPropertyImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddPropertyImagesRequestValidationError) ErrorName() string {
+	return "AddPropertyImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddPropertyImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddPropertyImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddPropertyImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddPropertyImagesRequestValidationError{}
+
+// Validate checks the field values on ListPropertyImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPropertyImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPropertyImagesRequestMultiError, or nil if none found.
+func (m *ListPropertyImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPropertyImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = ListPropertyImagesRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPropertyImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListPropertyImagesRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListPropertyImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPropertyImagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPropertyImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPropertyImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPropertyImagesRequestMultiError) AllErrors() []error { return m }
+
+// ListPropertyImagesRequestValidationError is the validation error returned by
+// ListPropertyImagesRequest.Validate if the designated constraints aren't met.
+type ListPropertyImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPropertyImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPropertyImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPropertyImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPropertyImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPropertyImagesRequestValidationError) ErrorName() string {
+	return "ListPropertyImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPropertyImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPropertyImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPropertyImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPropertyImagesRequestValidationError{}
+
+// Validate checks the field values on ReorderPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderPropertyImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderPropertyImagesRequestMultiError, or nil if none found.
+func (m *ReorderPropertyImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderPropertyImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = ReorderPropertyImagesRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetImageIds()) < 1 {
+		err := ReorderPropertyImagesRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImageIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = ReorderPropertyImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReorderPropertyImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReorderPropertyImagesRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReorderPropertyImagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ReorderPropertyImagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReorderPropertyImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderPropertyImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderPropertyImagesRequestMultiError) AllErrors() []error { return m }
+
+// ReorderPropertyImagesRequestValidationError is the validation error returned
+// by ReorderPropertyImagesRequest.Validate if the designated constraints
+// aren't met.
+type ReorderPropertyImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderPropertyImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderPropertyImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderPropertyImagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MatchPropertiesRequestValidationError) Key() bool { return e.key }
+func (e ReorderPropertyImagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MatchPropertiesRequestValidationError) ErrorName() string {
-	return "MatchPropertiesRequestValidationError"
+func (e ReorderPropertyImagesRequestValidationError) ErrorName() string {
+	return "ReorderPropertyImagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MatchPropertiesRequestValidationError) Error() string {
+func (e ReorderPropertyImagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1206,14 +2105,14 @@ func (e MatchPropertiesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMatchPropertiesRequest.%s: %s%s",
+		"invalid %sReorderPropertyImagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MatchPropertiesRequestValidationError{}
+var _ error = ReorderPropertyImagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1221,103 +2120,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MatchPropertiesRequestValidationError{}
+} = ReorderPropertyImagesRequestValidationError{}
 
-// Validate checks the field values on MatchedProperty with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MatchedProperty) Validate() error {
+// Validate checks the field values on DeletePropertyImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePropertyImageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MatchedProperty with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeletePropertyImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MatchedPropertyMultiError, or nil if none found.
-func (m *MatchedProperty) ValidateAll() error {
+// DeletePropertyImageRequestMultiError, or nil if none found.
+func (m *DeletePropertyImageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MatchedProperty) validate(all bool) error {
+func (m *DeletePropertyImageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProperty()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MatchedPropertyValidationError{
-					field:  "Property",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MatchedPropertyValidationError{
-					field:  "Property",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = DeletePropertyImageRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
-	} else if v, ok := interface{}(m.GetProperty()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MatchedPropertyValidationError{
-				field:  "Property",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Similarity
-
-	if m.TotalScore != nil {
-		// no validation rules for TotalScore
-	}
-
-	if m.PriceScore != nil {
-		// no validation rules for PriceScore
-	}
-
-	if m.DistrictScore != nil {
-		// no validation rules for DistrictScore
-	}
-
-	if m.RoomsScore != nil {
-		// no validation rules for RoomsScore
-	}
-
-	if m.AreaScore != nil {
-		// no validation rules for AreaScore
+	if err := m._validateUuid(m.GetImageId()); err != nil {
+		err = DeletePropertyImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.SemanticScore != nil {
-		// no validation rules for SemanticScore
+	if len(errors) > 0 {
+		return DeletePropertyImageRequestMultiError(errors)
 	}
 
-	if m.MatchExplanation != nil {
-		// no validation rules for MatchExplanation
-	}
+	return nil
+}
 
-	if len(errors) > 0 {
-		return MatchedPropertyMultiError(errors)
+func (m *DeletePropertyImageRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
 	}
 
 	return nil
 }
 
-// MatchedPropertyMultiError is an error wrapping multiple validation errors
-// returned by MatchedProperty.ValidateAll() if the designated constraints
-// aren't met.
-type MatchedPropertyMultiError []error
+// DeletePropertyImageRequestMultiError is an error wrapping multiple
+// validation errors returned by DeletePropertyImageRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePropertyImageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MatchedPropertyMultiError) Error() string {
+func (m DeletePropertyImageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1326,11 +2198,11 @@ func (m MatchedPropertyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MatchedPropertyMultiError) AllErrors() []error { return m }
+func (m DeletePropertyImageRequestMultiError) AllErrors() []error { return m }
 
-// MatchedPropertyValidationError is the validation error returned by
-// MatchedProperty.Validate if the designated constraints aren't met.
-type MatchedPropertyValidationError struct {
+// DeletePropertyImageRequestValidationError is the validation error returned
+// by DeletePropertyImageRequest.Validate if the designated constraints aren't met.
+type DeletePropertyImageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1338,22 +2210,24 @@ type MatchedPropertyValidationError struct {
 }
 
 // Field function returns field value.
-func (e MatchedPropertyValidationError) Field() string { return e.field }
+func (e DeletePropertyImageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MatchedPropertyValidationError) Reason() string { return e.reason }
+func (e DeletePropertyImageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MatchedPropertyValidationError) Cause() error { return e.cause }
+func (e DeletePropertyImageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MatchedPropertyValidationError) Key() bool { return e.key }
+func (e DeletePropertyImageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MatchedPropertyValidationError) ErrorName() string { return "MatchedPropertyValidationError" }
+func (e DeletePropertyImageRequestValidationError) ErrorName() string {
+	return "DeletePropertyImageRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MatchedPropertyValidationError) Error() string {
+func (e DeletePropertyImageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1365,14 +2239,14 @@ func (e MatchedPropertyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMatchedProperty.%s: %s%s",
+		"invalid %sDeletePropertyImageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MatchedPropertyValidationError{}
+var _ error = DeletePropertyImageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1380,47 +2254,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MatchedPropertyValidationError{}
+} = DeletePropertyImageRequestValidationError{}
 
-// Validate checks the field values on MatchPropertiesResponse with the rules
+// Validate checks the field values on PropertyImagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MatchPropertiesResponse) Validate() error {
+func (m *PropertyImagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MatchPropertiesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PropertyImagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MatchPropertiesResponseMultiError, or nil if none found.
-func (m *MatchPropertiesResponse) ValidateAll() error {
+// PropertyImagesResponseMultiError, or nil if none found.
+func (m *PropertyImagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MatchPropertiesResponse) validate(all bool) error {
+func (m *PropertyImagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMatches() {
+	for idx, item := range m.GetImages() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MatchPropertiesResponseValidationError{
-						field:  fmt.Sprintf("Matches[%v]", idx),
+					errors = append(errors, PropertyImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MatchPropertiesResponseValidationError{
-						field:  fmt.Sprintf("Matches[%v]", idx),
+					errors = append(errors, PropertyImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1428,8 +2302,8 @@ func (m *MatchPropertiesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MatchPropertiesResponseValidationError{
-					field:  fmt.Sprintf("Matches[%v]", idx),
+				return PropertyImagesResponseValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1439,19 +2313,19 @@ func (m *MatchPropertiesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MatchPropertiesResponseMultiError(errors)
+		return PropertyImagesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MatchPropertiesResponseMultiError is an error wrapping multiple validation
-// errors returned by MatchPropertiesResponse.ValidateAll() if the designated
+// PropertyImagesResponseMultiError is an error wrapping multiple validation
+// errors returned by PropertyImagesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type MatchPropertiesResponseMultiError []error
+type PropertyImagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MatchPropertiesResponseMultiError) Error() string {
+func (m PropertyImagesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1460,11 +2334,11 @@ func (m MatchPropertiesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MatchPropertiesResponseMultiError) AllErrors() []error { return m }
+func (m PropertyImagesResponseMultiError) AllErrors() []error { return m }
 
-// MatchPropertiesResponseValidationError is the validation error returned by
-// MatchPropertiesResponse.Validate if the designated constraints aren't met.
-type MatchPropertiesResponseValidationError struct {
+// PropertyImagesResponseValidationError is the validation error returned by
+// PropertyImagesResponse.Validate if the designated constraints aren't met.
+type PropertyImagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1472,24 +2346,24 @@ type MatchPropertiesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MatchPropertiesResponseValidationError) Field() string { return e.field }
+func (e PropertyImagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MatchPropertiesResponseValidationError) Reason() string { return e.reason }
+func (e PropertyImagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MatchPropertiesResponseValidationError) Cause() error { return e.cause }
+func (e PropertyImagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MatchPropertiesResponseValidationError) Key() bool { return e.key }
+func (e PropertyImagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MatchPropertiesResponseValidationError) ErrorName() string {
-	return "MatchPropertiesResponseValidationError"
+func (e PropertyImagesResponseValidationError) ErrorName() string {
+	return "PropertyImagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MatchPropertiesResponseValidationError) Error() string {
+func (e PropertyImagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1501,14 +2375,14 @@ func (e MatchPropertiesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMatchPropertiesResponse.%s: %s%s",
+		"invalid %sPropertyImagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MatchPropertiesResponseValidationError{}
+var _ error = PropertyImagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1516,7 +2390,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MatchPropertiesResponseValidationError{}
+} = PropertyImagesResponseValidationError{}
 
 // Validate checks the field values on ReindexPropertyRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -2554,6 +3428,8 @@ func (m *AnalyzePropertyImagesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Force
+
 	if len(errors) > 0 {
 		return AnalyzePropertyImagesRequestMultiError(errors)
 	}
@@ -2643,6 +3519,129 @@ var _ interface {
 	ErrorName() string
 } = AnalyzePropertyImagesRequestValidationError{}
 
+// Validate checks the field values on GetPropertyImageAnalysisRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPropertyImageAnalysisRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPropertyImageAnalysisRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetPropertyImageAnalysisRequestMultiError, or nil if none found.
+func (m *GetPropertyImageAnalysisRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPropertyImageAnalysisRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = GetPropertyImageAnalysisRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPropertyImageAnalysisRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPropertyImageAnalysisRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPropertyImageAnalysisRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPropertyImageAnalysisRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetPropertyImageAnalysisRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPropertyImageAnalysisRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPropertyImageAnalysisRequestMultiError) AllErrors() []error { return m }
+
+// GetPropertyImageAnalysisRequestValidationError is the validation error
+// returned by GetPropertyImageAnalysisRequest.Validate if the designated
+// constraints aren't met.
+type GetPropertyImageAnalysisRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPropertyImageAnalysisRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPropertyImageAnalysisRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPropertyImageAnalysisRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPropertyImageAnalysisRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPropertyImageAnalysisRequestValidationError) ErrorName() string {
+	return "GetPropertyImageAnalysisRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPropertyImageAnalysisRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPropertyImageAnalysisRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPropertyImageAnalysisRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPropertyImageAnalysisRequestValidationError{}
+
 // Validate checks the field values on ImageFeature with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2808,6 +3807,14 @@ func (m *ImageAnalysisResult) validate(all bool) error {
 
 	// no validation rules for Brightness
 
+	// no validation rules for ImageId
+
+	// no validation rules for Confidence
+
+	// no validation rules for Tags
+
+	// no validation rules for AnalyzedAt
+
 	if m.RoomType != nil {
 		// no validation rules for RoomType
 	}
@@ -2992,6 +3999,20 @@ func (m *AnalyzePropertyImagesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for PropertyId
+
+	// no validation rules for Status
+
+	// no validation rules for PendingImages
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if m.AnalyzedAt != nil {
+		// no validation rules for AnalyzedAt
+	}
+
 	if len(errors) > 0 {
 		return AnalyzePropertyImagesResponseMultiError(errors)
 	}
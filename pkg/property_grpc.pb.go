@@ -19,16 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PropertyService_CreateProperty_FullMethodName          = "/leadexchange.v1.PropertyService/CreateProperty"
-	PropertyService_GetProperty_FullMethodName             = "/leadexchange.v1.PropertyService/GetProperty"
-	PropertyService_ListProperties_FullMethodName          = "/leadexchange.v1.PropertyService/ListProperties"
-	PropertyService_UpdateProperty_FullMethodName          = "/leadexchange.v1.PropertyService/UpdateProperty"
-	PropertyService_MatchProperties_FullMethodName         = "/leadexchange.v1.PropertyService/MatchProperties"
-	PropertyService_ReindexProperty_FullMethodName         = "/leadexchange.v1.PropertyService/ReindexProperty"
-	PropertyService_MatchPropertiesAdvanced_FullMethodName = "/leadexchange.v1.PropertyService/MatchPropertiesAdvanced"
-	PropertyService_GetPropertyJSONLD_FullMethodName       = "/leadexchange.v1.PropertyService/GetPropertyJSONLD"
-	PropertyService_GenerateListingContent_FullMethodName  = "/leadexchange.v1.PropertyService/GenerateListingContent"
-	PropertyService_AnalyzePropertyImages_FullMethodName   = "/leadexchange.v1.PropertyService/AnalyzePropertyImages"
+	PropertyService_CreateProperty_FullMethodName           = "/leadexchange.v1.PropertyService/CreateProperty"
+	PropertyService_GetProperty_FullMethodName              = "/leadexchange.v1.PropertyService/GetProperty"
+	PropertyService_ListProperties_FullMethodName           = "/leadexchange.v1.PropertyService/ListProperties"
+	PropertyService_UpdateProperty_FullMethodName           = "/leadexchange.v1.PropertyService/UpdateProperty"
+	PropertyService_MatchProperties_FullMethodName          = "/leadexchange.v1.PropertyService/MatchProperties"
+	PropertyService_ReindexProperty_FullMethodName          = "/leadexchange.v1.PropertyService/ReindexProperty"
+	PropertyService_MatchPropertiesAdvanced_FullMethodName  = "/leadexchange.v1.PropertyService/MatchPropertiesAdvanced"
+	PropertyService_GetPropertyJSONLD_FullMethodName        = "/leadexchange.v1.PropertyService/GetPropertyJSONLD"
+	PropertyService_GenerateListingContent_FullMethodName   = "/leadexchange.v1.PropertyService/GenerateListingContent"
+	PropertyService_AnalyzePropertyImages_FullMethodName    = "/leadexchange.v1.PropertyService/AnalyzePropertyImages"
+	PropertyService_GetPropertyImageAnalysis_FullMethodName = "/leadexchange.v1.PropertyService/GetPropertyImageAnalysis"
+	PropertyService_AddPropertyImages_FullMethodName        = "/leadexchange.v1.PropertyService/AddPropertyImages"
+	PropertyService_ListPropertyImages_FullMethodName       = "/leadexchange.v1.PropertyService/ListPropertyImages"
+	PropertyService_ReorderPropertyImages_FullMethodName    = "/leadexchange.v1.PropertyService/ReorderPropertyImages"
+	PropertyService_DeletePropertyImage_FullMethodName      = "/leadexchange.v1.PropertyService/DeletePropertyImage"
 )
 
 // PropertyServiceClient is the client API for PropertyService service.
@@ -53,8 +58,19 @@ type PropertyServiceClient interface {
 	GetPropertyJSONLD(ctx context.Context, in *GetPropertyJSONLDRequest, opts ...grpc.CallOption) (*GetPropertyJSONLDResponse, error)
 	// Сгенерировать заголовок и описание с помощью AI.
 	GenerateListingContent(ctx context.Context, in *GenerateListingContentRequest, opts ...grpc.CallOption) (*GenerateListingContentResponse, error)
-	// Анализ изображений объекта недвижимости.
+	// Анализ фотографий галереи объекта компьютерным зрением. Небольшие галереи анализируются
+	// в запросе, большие — в фоне: ответ приходит со статусом PENDING, результат — через GetPropertyImageAnalysis.
 	AnalyzePropertyImages(ctx context.Context, in *AnalyzePropertyImagesRequest, opts ...grpc.CallOption) (*AnalyzePropertyImagesResponse, error)
+	// Сохранённые результаты анализа фотографий объекта и состояние фонового анализа.
+	GetPropertyImageAnalysis(ctx context.Context, in *GetPropertyImageAnalysisRequest, opts ...grpc.CallOption) (*AnalyzePropertyImagesResponse, error)
+	// Добавить фотографии в галерею объекта (ключи объектов из FileService.UploadFile).
+	AddPropertyImages(ctx context.Context, in *AddPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
+	// Получить галерею объекта со свежими ссылками на фотографии.
+	ListPropertyImages(ctx context.Context, in *ListPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
+	// Изменить порядок фотографий: передаются все image_id галереи в новом порядке.
+	ReorderPropertyImages(ctx context.Context, in *ReorderPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
+	// Удалить фотографию из галереи.
+	DeletePropertyImage(ctx context.Context, in *DeletePropertyImageRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
 }
 
 type propertyServiceClient struct {
@@ -165,6 +181,56 @@ func (c *propertyServiceClient) AnalyzePropertyImages(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *propertyServiceClient) GetPropertyImageAnalysis(ctx context.Context, in *GetPropertyImageAnalysisRequest, opts ...grpc.CallOption) (*AnalyzePropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzePropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_GetPropertyImageAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) AddPropertyImages(ctx context.Context, in *AddPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_AddPropertyImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ListPropertyImages(ctx context.Context, in *ListPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ListPropertyImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ReorderPropertyImages(ctx context.Context, in *ReorderPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ReorderPropertyImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) DeletePropertyImage(ctx context.Context, in *DeletePropertyImageRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_DeletePropertyImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PropertyServiceServer is the server API for PropertyService service.
 // All implementations must embed UnimplementedPropertyServiceServer
 // for forward compatibility.
@@ -187,8 +253,19 @@ type PropertyServiceServer interface {
 	GetPropertyJSONLD(context.Context, *GetPropertyJSONLDRequest) (*GetPropertyJSONLDResponse, error)
 	// Сгенерировать заголовок и описание с помощью AI.
 	GenerateListingContent(context.Context, *GenerateListingContentRequest) (*GenerateListingContentResponse, error)
-	// Анализ изображений объекта недвижимости.
+	// Анализ фотографий галереи объекта компьютерным зрением. Небольшие галереи анализируются
+	// в запросе, большие — в фоне: ответ приходит со статусом PENDING, результат — через GetPropertyImageAnalysis.
 	AnalyzePropertyImages(context.Context, *AnalyzePropertyImagesRequest) (*AnalyzePropertyImagesResponse, error)
+	// Сохранённые результаты анализа фотографий объекта и состояние фонового анализа.
+	GetPropertyImageAnalysis(context.Context, *GetPropertyImageAnalysisRequest) (*AnalyzePropertyImagesResponse, error)
+	// Добавить фотографии в галерею объекта (ключи объектов из FileService.UploadFile).
+	AddPropertyImages(context.Context, *AddPropertyImagesRequest) (*PropertyImagesResponse, error)
+	// Получить галерею объекта со свежими ссылками на фотографии.
+	ListPropertyImages(context.Context, *ListPropertyImagesRequest) (*PropertyImagesResponse, error)
+	// Изменить порядок фотографий: передаются все image_id галереи в новом порядке.
+	ReorderPropertyImages(context.Context, *ReorderPropertyImagesRequest) (*PropertyImagesResponse, error)
+	// Удалить фотографию из галереи.
+	DeletePropertyImage(context.Context, *DeletePropertyImageRequest) (*PropertyImagesResponse, error)
 	mustEmbedUnimplementedPropertyServiceServer()
 }
 
@@ -229,6 +306,21 @@ func (UnimplementedPropertyServiceServer) GenerateListingContent(context.Context
 func (UnimplementedPropertyServiceServer) AnalyzePropertyImages(context.Context, *AnalyzePropertyImagesRequest) (*AnalyzePropertyImagesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AnalyzePropertyImages not implemented")
 }
+func (UnimplementedPropertyServiceServer) GetPropertyImageAnalysis(context.Context, *GetPropertyImageAnalysisRequest) (*AnalyzePropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPropertyImageAnalysis not implemented")
+}
+func (UnimplementedPropertyServiceServer) AddPropertyImages(context.Context, *AddPropertyImagesRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddPropertyImages not implemented")
+}
+func (UnimplementedPropertyServiceServer) ListPropertyImages(context.Context, *ListPropertyImagesRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPropertyImages not implemented")
+}
+func (UnimplementedPropertyServiceServer) ReorderPropertyImages(context.Context, *ReorderPropertyImagesRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderPropertyImages not implemented")
+}
+func (UnimplementedPropertyServiceServer) DeletePropertyImage(context.Context, *DeletePropertyImageRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePropertyImage not implemented")
+}
 func (UnimplementedPropertyServiceServer) mustEmbedUnimplementedPropertyServiceServer() {}
 func (UnimplementedPropertyServiceServer) testEmbeddedByValue()                         {}
 
@@ -430,6 +522,96 @@ func _PropertyService_AnalyzePropertyImages_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PropertyService_GetPropertyImageAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyImageAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).GetPropertyImageAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_GetPropertyImageAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).GetPropertyImageAnalysis(ctx, req.(*GetPropertyImageAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_AddPropertyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPropertyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).AddPropertyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_AddPropertyImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).AddPropertyImages(ctx, req.(*AddPropertyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ListPropertyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPropertyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ListPropertyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ListPropertyImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ListPropertyImages(ctx, req.(*ListPropertyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ReorderPropertyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPropertyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ReorderPropertyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ReorderPropertyImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ReorderPropertyImages(ctx, req.(*ReorderPropertyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_DeletePropertyImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePropertyImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).DeletePropertyImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_DeletePropertyImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).DeletePropertyImage(ctx, req.(*DeletePropertyImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PropertyService_ServiceDesc is the grpc.ServiceDesc for PropertyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -477,6 +659,26 @@ var PropertyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzePropertyImages",
 			Handler:    _PropertyService_AnalyzePropertyImages_Handler,
 		},
+		{
+			MethodName: "GetPropertyImageAnalysis",
+			Handler:    _PropertyService_GetPropertyImageAnalysis_Handler,
+		},
+		{
+			MethodName: "AddPropertyImages",
+			Handler:    _PropertyService_AddPropertyImages_Handler,
+		},
+		{
+			MethodName: "ListPropertyImages",
+			Handler:    _PropertyService_ListPropertyImages_Handler,
+		},
+		{
+			MethodName: "ReorderPropertyImages",
+			Handler:    _PropertyService_ReorderPropertyImages_Handler,
+		},
+		{
+			MethodName: "DeletePropertyImage",
+			Handler:    _PropertyService_DeletePropertyImage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "property.proto",
@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: saved_search.proto
+
+package leadexchangev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SavedSearch — сохранённый поиск объектов, периодически перезапускаемый на сервере.
+type SavedSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearchId string                 `protobuf:"bytes,1,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Лид, по которому ищутся объекты; если не задан — поиск только по фильтру.
+	LeadId *string         `protobuf:"bytes,3,opt,name=lead_id,json=leadId,proto3,oneof" json:"lead_id,omitempty"`
+	Filter *PropertyFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// ID пресета весов (balanced, budget_first, location_first, family, semantic, visual_first).
+	WeightPreset  *string `protobuf:"bytes,5,opt,name=weight_preset,json=weightPreset,proto3,oneof" json:"weight_preset,omitempty"`
+	Limit         int32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Enabled       bool    `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastRunAt     string  `protobuf:"bytes,8,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	CreatedAt     string  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_saved_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavedSearch) GetSavedSearchId() string {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetLeadId() string {
+	if x != nil && x.LeadId != nil {
+		return *x.LeadId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilter() *PropertyFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetWeightPreset() string {
+	if x != nil && x.WeightPreset != nil {
+		return *x.WeightPreset
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SavedSearch) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LeadId       *string                `protobuf:"bytes,2,opt,name=lead_id,json=leadId,proto3,oneof" json:"lead_id,omitempty"`
+	Filter       *PropertyFilter        `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	WeightPreset *string                `protobuf:"bytes,4,opt,name=weight_preset,json=weightPreset,proto3,oneof" json:"weight_preset,omitempty"`
+	// Сколько лучших объектов учитывать (по умолчанию 20, максимум 100).
+	Limit         *int32 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_saved_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetLeadId() string {
+	if x != nil && x.LeadId != nil {
+		return *x.LeadId
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetFilter() *PropertyFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateSavedSearchRequest) GetWeightPreset() string {
+	if x != nil && x.WeightPreset != nil {
+		return *x.WeightPreset
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchResponse) Reset() {
+	*x = SavedSearchResponse{}
+	mi := &file_saved_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchResponse) ProtoMessage() {}
+
+func (x *SavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SavedSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+type ListSavedSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_saved_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{3}
+}
+
+type ListSavedSearchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_saved_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearchId string                 `protobuf:"bytes,1,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_saved_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteSavedSearchRequest) GetSavedSearchId() string {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return ""
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_saved_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{6}
+}
+
+var File_saved_search_proto protoreflect.FileDescriptor
+
+const file_saved_search_proto_rawDesc = "" +
+	"\n" +
+	"\x12saved_search.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0eproperty.proto\"\xd7\x02\n" +
+	"\vSavedSearch\x12&\n" +
+	"\x0fsaved_search_id\x18\x01 \x01(\tR\rsavedSearchId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\alead_id\x18\x03 \x01(\tH\x00R\x06leadId\x88\x01\x01\x127\n" +
+	"\x06filter\x18\x04 \x01(\v2\x1f.leadexchange.v1.PropertyFilterR\x06filter\x12(\n" +
+	"\rweight_preset\x18\x05 \x01(\tH\x01R\fweightPreset\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x1e\n" +
+	"\vlast_run_at\x18\b \x01(\tR\tlastRunAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAtB\n" +
+	"\n" +
+	"\b_lead_idB\x10\n" +
+	"\x0e_weight_preset\"\x93\x02\n" +
+	"\x18CreateSavedSearchRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x04name\x12&\n" +
+	"\alead_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06leadId\x88\x01\x01\x127\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1f.leadexchange.v1.PropertyFilterR\x06filter\x12(\n" +
+	"\rweight_preset\x18\x04 \x01(\tH\x01R\fweightPreset\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x02R\x05limit\x88\x01\x01B\n" +
+	"\n" +
+	"\b_lead_idB\x10\n" +
+	"\x0e_weight_presetB\b\n" +
+	"\x06_limit\"V\n" +
+	"\x13SavedSearchResponse\x12?\n" +
+	"\fsaved_search\x18\x01 \x01(\v2\x1c.leadexchange.v1.SavedSearchR\vsavedSearch\"\x1a\n" +
+	"\x18ListSavedSearchesRequest\"`\n" +
+	"\x19ListSavedSearchesResponse\x12C\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x1c.leadexchange.v1.SavedSearchR\rsavedSearches\"L\n" +
+	"\x18DeleteSavedSearchRequest\x120\n" +
+	"\x0fsaved_search_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\rsavedSearchId\"\x1b\n" +
+	"\x19DeleteSavedSearchResponse2\xbe\x03\n" +
+	"\x12SavedSearchService\x12\x83\x01\n" +
+	"\x11CreateSavedSearch\x12).leadexchange.v1.CreateSavedSearchRequest\x1a$.leadexchange.v1.SavedSearchResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/saved-searches\x12\x86\x01\n" +
+	"\x11ListSavedSearches\x12).leadexchange.v1.ListSavedSearchesRequest\x1a*.leadexchange.v1.ListSavedSearchesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/saved-searches\x12\x98\x01\n" +
+	"\x11DeleteSavedSearch\x12).leadexchange.v1.DeleteSavedSearchRequest\x1a*.leadexchange.v1.DeleteSavedSearchResponse\",\x82\xd3\xe4\x93\x02&*$/v1/saved-searches/{saved_search_id}B4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_saved_search_proto_rawDescOnce sync.Once
+	file_saved_search_proto_rawDescData []byte
+)
+
+func file_saved_search_proto_rawDescGZIP() []byte {
+	file_saved_search_proto_rawDescOnce.Do(func() {
+		file_saved_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_saved_search_proto_rawDesc), len(file_saved_search_proto_rawDesc)))
+	})
+	return file_saved_search_proto_rawDescData
+}
+
+var file_saved_search_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_saved_search_proto_goTypes = []any{
+	(*SavedSearch)(nil),               // 0: leadexchange.v1.SavedSearch
+	(*CreateSavedSearchRequest)(nil),  // 1: leadexchange.v1.CreateSavedSearchRequest
+	(*SavedSearchResponse)(nil),       // 2: leadexchange.v1.SavedSearchResponse
+	(*ListSavedSearchesRequest)(nil),  // 3: leadexchange.v1.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil), // 4: leadexchange.v1.ListSavedSearchesResponse
+	(*DeleteSavedSearchRequest)(nil),  // 5: leadexchange.v1.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil), // 6: leadexchange.v1.DeleteSavedSearchResponse
+	(*PropertyFilter)(nil),            // 7: leadexchange.v1.PropertyFilter
+}
+var file_saved_search_proto_depIdxs = []int32{
+	7, // 0: leadexchange.v1.SavedSearch.filter:type_name -> leadexchange.v1.PropertyFilter
+	7, // 1: leadexchange.v1.CreateSavedSearchRequest.filter:type_name -> leadexchange.v1.PropertyFilter
+	0, // 2: leadexchange.v1.SavedSearchResponse.saved_search:type_name -> leadexchange.v1.SavedSearch
+	0, // 3: leadexchange.v1.ListSavedSearchesResponse.saved_searches:type_name -> leadexchange.v1.SavedSearch
+	1, // 4: leadexchange.v1.SavedSearchService.CreateSavedSearch:input_type -> leadexchange.v1.CreateSavedSearchRequest
+	3, // 5: leadexchange.v1.SavedSearchService.ListSavedSearches:input_type -> leadexchange.v1.ListSavedSearchesRequest
+	5, // 6: leadexchange.v1.SavedSearchService.DeleteSavedSearch:input_type -> leadexchange.v1.DeleteSavedSearchRequest
+	2, // 7: leadexchange.v1.SavedSearchService.CreateSavedSearch:output_type -> leadexchange.v1.SavedSearchResponse
+	4, // 8: leadexchange.v1.SavedSearchService.ListSavedSearches:output_type -> leadexchange.v1.ListSavedSearchesResponse
+	6, // 9: leadexchange.v1.SavedSearchService.DeleteSavedSearch:output_type -> leadexchange.v1.DeleteSavedSearchResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_saved_search_proto_init() }
+func file_saved_search_proto_init() {
+	if File_saved_search_proto != nil {
+		return
+	}
+	file_property_proto_init()
+	file_saved_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_saved_search_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_saved_search_proto_rawDesc), len(file_saved_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_saved_search_proto_goTypes,
+		DependencyIndexes: file_saved_search_proto_depIdxs,
+		MessageInfos:      file_saved_search_proto_msgTypes,
+	}.Build()
+	File_saved_search_proto = out.File
+	file_saved_search_proto_goTypes = nil
+	file_saved_search_proto_depIdxs = nil
+}
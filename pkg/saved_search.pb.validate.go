@@ -0,0 +1,965 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: saved_search.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _saved_search_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SavedSearch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SavedSearchMultiError, or
+// nil if none found.
+func (m *SavedSearch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SavedSearchId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Enabled
+
+	// no validation rules for LastRunAt
+
+	// no validation rules for CreatedAt
+
+	if m.LeadId != nil {
+		// no validation rules for LeadId
+	}
+
+	if m.WeightPreset != nil {
+		// no validation rules for WeightPreset
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchMultiError is an error wrapping multiple validation errors
+// returned by SavedSearch.ValidateAll() if the designated constraints aren't met.
+type SavedSearchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchMultiError) AllErrors() []error { return m }
+
+// SavedSearchValidationError is the validation error returned by
+// SavedSearch.Validate if the designated constraints aren't met.
+type SavedSearchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchValidationError) ErrorName() string { return "SavedSearchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SavedSearchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchValidationError{}
+
+// Validate checks the field values on CreateSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSavedSearchRequestMultiError, or nil if none found.
+func (m *CreateSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreateSavedSearchRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSavedSearchRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.LeadId != nil {
+
+		if err := m._validateUuid(m.GetLeadId()); err != nil {
+			err = CreateSavedSearchRequestValidationError{
+				field:  "LeadId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeightPreset != nil {
+		// no validation rules for WeightPreset
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := CreateSavedSearchRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateSavedSearchRequest) _validateUuid(uuid string) error {
+	if matched := _saved_search_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// CreateSavedSearchRequestValidationError is the validation error returned by
+// CreateSavedSearchRequest.Validate if the designated constraints aren't met.
+type CreateSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSavedSearchRequestValidationError) ErrorName() string {
+	return "CreateSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSavedSearchRequestValidationError{}
+
+// Validate checks the field values on SavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavedSearchResponseMultiError, or nil if none found.
+func (m *SavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSavedSearch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "SavedSearch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "SavedSearch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSavedSearch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchResponseValidationError{
+				field:  "SavedSearch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by SavedSearchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SavedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchResponseMultiError) AllErrors() []error { return m }
+
+// SavedSearchResponseValidationError is the validation error returned by
+// SavedSearchResponse.Validate if the designated constraints aren't met.
+type SavedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchResponseValidationError) ErrorName() string {
+	return "SavedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchResponseValidationError{}
+
+// Validate checks the field values on ListSavedSearchesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSavedSearchesRequestMultiError, or nil if none found.
+func (m *ListSavedSearchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSavedSearchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSavedSearchesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSavedSearchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchesRequestMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchesRequestValidationError is the validation error returned by
+// ListSavedSearchesRequest.Validate if the designated constraints aren't met.
+type ListSavedSearchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchesRequestValidationError) ErrorName() string {
+	return "ListSavedSearchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchesRequestValidationError{}
+
+// Validate checks the field values on ListSavedSearchesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSavedSearchesResponseMultiError, or nil if none found.
+func (m *ListSavedSearchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSavedSearches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("SavedSearches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("SavedSearches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSavedSearchesResponseValidationError{
+					field:  fmt.Sprintf("SavedSearches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSavedSearchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSavedSearchesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSavedSearchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchesResponseMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchesResponseValidationError is the validation error returned by
+// ListSavedSearchesResponse.Validate if the designated constraints aren't met.
+type ListSavedSearchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchesResponseValidationError) ErrorName() string {
+	return "ListSavedSearchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchesResponseValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchRequestMultiError, or nil if none found.
+func (m *DeleteSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSavedSearchId()); err != nil {
+		err = DeleteSavedSearchRequestValidationError{
+			field:  "SavedSearchId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSavedSearchRequest) _validateUuid(uuid string) error {
+	if matched := _saved_search_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchRequestValidationError is the validation error returned by
+// DeleteSavedSearchRequest.Validate if the designated constraints aren't met.
+type DeleteSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchRequestValidationError) ErrorName() string {
+	return "DeleteSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchRequestValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchResponseMultiError, or nil if none found.
+func (m *DeleteSavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSavedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteSavedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchResponseValidationError is the validation error returned by
+// DeleteSavedSearchResponse.Validate if the designated constraints aren't met.
+type DeleteSavedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchResponseValidationError) ErrorName() string {
+	return "DeleteSavedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchResponseValidationError{}
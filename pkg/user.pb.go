@@ -370,6 +370,50 @@ func (x *UpdateUserStatusRequest) GetStatus() UserStatus {
 	return UserStatus_USER_STATUS_UNSPECIFIED
 }
 
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type ListUsersRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Filter        *ListUsersRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
@@ -379,7 +423,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_user_proto_msgTypes[3]
+	mi := &file_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +435,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[3]
+	mi := &file_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +448,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{3}
+	return file_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListUsersRequest) GetFilter() *ListUsersRequest_Filter {
@@ -423,7 +467,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_user_proto_msgTypes[4]
+	mi := &file_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -435,7 +479,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[4]
+	mi := &file_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +492,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{4}
+	return file_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListUsersResponse) GetUsers() []*UserProfile {
@@ -473,7 +517,7 @@ type ListUsersRequest_Filter struct {
 
 func (x *ListUsersRequest_Filter) Reset() {
 	*x = ListUsersRequest_Filter{}
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +529,7 @@ func (x *ListUsersRequest_Filter) String() string {
 func (*ListUsersRequest_Filter) ProtoMessage() {}
 
 func (x *ListUsersRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +542,7 @@ func (x *ListUsersRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{3, 0}
+	return file_user_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *ListUsersRequest_Filter) GetEmail() string {
@@ -589,7 +633,9 @@ const file_user_proto_rawDesc = "" +
 	"\v_avatar_url\"{\n" +
 	"\x17UpdateUserStatusRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12=\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.UserStatusB\b\xfaB\x05\x82\x01\x02 \x00R\x06status\"\xd4\x03\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.UserStatusB\b\xfaB\x05\x82\x01\x02 \x00R\x06status\"6\n" +
+	"\x11UnlockUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\xd4\x03\n" +
 	"\x10ListUsersRequest\x12E\n" +
 	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListUsersRequest.FilterH\x00R\x06filter\x88\x01\x01\x1a\xed\x02\n" +
 	"\x06Filter\x12\x19\n" +
@@ -622,12 +668,14 @@ const file_user_proto_rawDesc = "" +
 	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x16\n" +
 	"\x12USER_STATUS_BANNED\x10\x02\x12\x19\n" +
-	"\x15USER_STATUS_SUSPENDED\x10\x032\xc8\x03\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x032\xbb\x04\n" +
 	"\vUserService\x12\\\n" +
 	"\n" +
 	"GetProfile\x12\x16.google.protobuf.Empty\x1a\x1c.leadexchange.v1.UserProfile\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/user/profile\x12q\n" +
 	"\rUpdateProfile\x12%.leadexchange.v1.UpdateProfileRequest\x1a\x1c.leadexchange.v1.UserProfile\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/user/profile\x12\x80\x01\n" +
-	"\x10UpdateUserStatus\x12(.leadexchange.v1.UpdateUserStatusRequest\x1a\x1c.leadexchange.v1.UserProfile\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/user/{user_id}/status\x12e\n" +
+	"\x10UpdateUserStatus\x12(.leadexchange.v1.UpdateUserStatusRequest\x1a\x1c.leadexchange.v1.UserProfile\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/user/{user_id}/status\x12q\n" +
+	"\n" +
+	"UnlockUser\x12\".leadexchange.v1.UnlockUserRequest\x1a\x1c.leadexchange.v1.UserProfile\"!\x82\xd3\xe4\x93\x02\x1b\"\x19/v1/user/{user_id}/unlock\x12e\n" +
 	"\tListUsers\x12!.leadexchange.v1.ListUsersRequest\x1a\".leadexchange.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/usersB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
@@ -643,36 +691,39 @@ func file_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_user_proto_goTypes = []any{
 	(UserRole)(0),                   // 0: leadexchange.v1.UserRole
 	(UserStatus)(0),                 // 1: leadexchange.v1.UserStatus
 	(*UserProfile)(nil),             // 2: leadexchange.v1.UserProfile
 	(*UpdateProfileRequest)(nil),    // 3: leadexchange.v1.UpdateProfileRequest
 	(*UpdateUserStatusRequest)(nil), // 4: leadexchange.v1.UpdateUserStatusRequest
-	(*ListUsersRequest)(nil),        // 5: leadexchange.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),       // 6: leadexchange.v1.ListUsersResponse
-	(*ListUsersRequest_Filter)(nil), // 7: leadexchange.v1.ListUsersRequest.Filter
-	(*emptypb.Empty)(nil),           // 8: google.protobuf.Empty
+	(*UnlockUserRequest)(nil),       // 5: leadexchange.v1.UnlockUserRequest
+	(*ListUsersRequest)(nil),        // 6: leadexchange.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),       // 7: leadexchange.v1.ListUsersResponse
+	(*ListUsersRequest_Filter)(nil), // 8: leadexchange.v1.ListUsersRequest.Filter
+	(*emptypb.Empty)(nil),           // 9: google.protobuf.Empty
 }
 var file_user_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.UserProfile.role:type_name -> leadexchange.v1.UserRole
 	1,  // 1: leadexchange.v1.UserProfile.status:type_name -> leadexchange.v1.UserStatus
 	1,  // 2: leadexchange.v1.UpdateUserStatusRequest.status:type_name -> leadexchange.v1.UserStatus
-	7,  // 3: leadexchange.v1.ListUsersRequest.filter:type_name -> leadexchange.v1.ListUsersRequest.Filter
+	8,  // 3: leadexchange.v1.ListUsersRequest.filter:type_name -> leadexchange.v1.ListUsersRequest.Filter
 	2,  // 4: leadexchange.v1.ListUsersResponse.users:type_name -> leadexchange.v1.UserProfile
 	0,  // 5: leadexchange.v1.ListUsersRequest.Filter.role:type_name -> leadexchange.v1.UserRole
 	1,  // 6: leadexchange.v1.ListUsersRequest.Filter.status:type_name -> leadexchange.v1.UserStatus
-	8,  // 7: leadexchange.v1.UserService.GetProfile:input_type -> google.protobuf.Empty
+	9,  // 7: leadexchange.v1.UserService.GetProfile:input_type -> google.protobuf.Empty
 	3,  // 8: leadexchange.v1.UserService.UpdateProfile:input_type -> leadexchange.v1.UpdateProfileRequest
 	4,  // 9: leadexchange.v1.UserService.UpdateUserStatus:input_type -> leadexchange.v1.UpdateUserStatusRequest
-	5,  // 10: leadexchange.v1.UserService.ListUsers:input_type -> leadexchange.v1.ListUsersRequest
-	2,  // 11: leadexchange.v1.UserService.GetProfile:output_type -> leadexchange.v1.UserProfile
-	2,  // 12: leadexchange.v1.UserService.UpdateProfile:output_type -> leadexchange.v1.UserProfile
-	2,  // 13: leadexchange.v1.UserService.UpdateUserStatus:output_type -> leadexchange.v1.UserProfile
-	6,  // 14: leadexchange.v1.UserService.ListUsers:output_type -> leadexchange.v1.ListUsersResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
+	5,  // 10: leadexchange.v1.UserService.UnlockUser:input_type -> leadexchange.v1.UnlockUserRequest
+	6,  // 11: leadexchange.v1.UserService.ListUsers:input_type -> leadexchange.v1.ListUsersRequest
+	2,  // 12: leadexchange.v1.UserService.GetProfile:output_type -> leadexchange.v1.UserProfile
+	2,  // 13: leadexchange.v1.UserService.UpdateProfile:output_type -> leadexchange.v1.UserProfile
+	2,  // 14: leadexchange.v1.UserService.UpdateUserStatus:output_type -> leadexchange.v1.UserProfile
+	2,  // 15: leadexchange.v1.UserService.UnlockUser:output_type -> leadexchange.v1.UserProfile
+	7,  // 16: leadexchange.v1.UserService.ListUsers:output_type -> leadexchange.v1.ListUsersResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -685,15 +736,15 @@ func file_user_proto_init() {
 	}
 	file_user_proto_msgTypes[0].OneofWrappers = []any{}
 	file_user_proto_msgTypes[1].OneofWrappers = []any{}
-	file_user_proto_msgTypes[3].OneofWrappers = []any{}
-	file_user_proto_msgTypes[5].OneofWrappers = []any{}
+	file_user_proto_msgTypes[4].OneofWrappers = []any{}
+	file_user_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
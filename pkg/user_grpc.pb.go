@@ -23,6 +23,7 @@ const (
 	UserService_GetProfile_FullMethodName       = "/leadexchange.v1.UserService/GetProfile"
 	UserService_UpdateProfile_FullMethodName    = "/leadexchange.v1.UserService/UpdateProfile"
 	UserService_UpdateUserStatus_FullMethodName = "/leadexchange.v1.UserService/UpdateUserStatus"
+	UserService_UnlockUser_FullMethodName       = "/leadexchange.v1.UserService/UnlockUser"
 	UserService_ListUsers_FullMethodName        = "/leadexchange.v1.UserService/ListUsers"
 )
 
@@ -34,9 +35,11 @@ type UserServiceClient interface {
 	GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserProfile, error)
 	// Обновить профиль пользователя.
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UserProfile, error)
-	// Изменить статус пользователя.
+	// Изменить статус пользователя (только для администратора).
 	UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UserProfile, error)
-	// Получить список пользователей.
+	// Снять блокировку входа после серии неудачных попыток (только для администратора).
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UserProfile, error)
+	// Получить список пользователей (только для администратора).
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 }
 
@@ -78,6 +81,16 @@ func (c *userServiceClient) UpdateUserStatus(ctx context.Context, in *UpdateUser
 	return out, nil
 }
 
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UserProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserProfile)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUsersResponse)
@@ -96,9 +109,11 @@ type UserServiceServer interface {
 	GetProfile(context.Context, *emptypb.Empty) (*UserProfile, error)
 	// Обновить профиль пользователя.
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UserProfile, error)
-	// Изменить статус пользователя.
+	// Изменить статус пользователя (только для администратора).
 	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UserProfile, error)
-	// Получить список пользователей.
+	// Снять блокировку входа после серии неудачных попыток (только для администратора).
+	UnlockUser(context.Context, *UnlockUserRequest) (*UserProfile, error)
+	// Получить список пользователей (только для администратора).
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
@@ -119,6 +134,9 @@ func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProf
 func (UnimplementedUserServiceServer) UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UserProfile, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateUserStatus not implemented")
 }
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *UnlockUserRequest) (*UserProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockUser not implemented")
+}
 func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
 }
@@ -197,6 +215,24 @@ func _UserService_UpdateUserStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUsersRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +270,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserStatus",
 			Handler:    _UserService_UpdateUserStatus_Handler,
 		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
 		{
 			MethodName: "ListUsers",
 			Handler:    _UserService_ListUsers_Handler,